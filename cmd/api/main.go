@@ -6,13 +6,18 @@ import (
 	"github.com/gabrielmrtt/taski/config"
 	"github.com/gabrielmrtt/taski/docs"
 	authinfra "github.com/gabrielmrtt/taski/internal/auth/infra"
+	exportinfra "github.com/gabrielmrtt/taski/internal/export/infra"
+	notificationinfra "github.com/gabrielmrtt/taski/internal/notification/infra"
 	organizationinfra "github.com/gabrielmrtt/taski/internal/organization/infra"
 	projectinfra "github.com/gabrielmrtt/taski/internal/project/infra"
+	realtimeinfra "github.com/gabrielmrtt/taski/internal/realtime/infra"
 	roleinfra "github.com/gabrielmrtt/taski/internal/role/infra"
+	searchinfra "github.com/gabrielmrtt/taski/internal/search/infra"
 	sharedpostgres "github.com/gabrielmrtt/taski/internal/shared/postgres"
 	taskinfra "github.com/gabrielmrtt/taski/internal/task/infra"
 	teaminfra "github.com/gabrielmrtt/taski/internal/team/infra"
 	userinfra "github.com/gabrielmrtt/taski/internal/user/infra"
+	webhookinfra "github.com/gabrielmrtt/taski/internal/webhook/infra"
 	workspaceinfra "github.com/gabrielmrtt/taski/internal/workspace/infra"
 	"github.com/gin-gonic/gin"
 	swaggerFiles "github.com/swaggo/files"
@@ -67,6 +72,26 @@ func bootstrapApplication() {
 			RouterGroup:  g,
 			DbConnection: dbConnection,
 		})
+		searchinfra.BootstrapInfra(searchinfra.BootstrapInfraOptions{
+			RouterGroup:  g,
+			DbConnection: dbConnection,
+		})
+		exportinfra.BootstrapInfra(exportinfra.BootstrapInfraOptions{
+			RouterGroup:  g,
+			DbConnection: dbConnection,
+		})
+		webhookinfra.BootstrapInfra(webhookinfra.BootstrapInfraOptions{
+			RouterGroup:  g,
+			DbConnection: dbConnection,
+		})
+		notificationinfra.BootstrapInfra(notificationinfra.BootstrapInfraOptions{
+			RouterGroup:  g,
+			DbConnection: dbConnection,
+		})
+		realtimeinfra.BootstrapInfra(realtimeinfra.BootstrapInfraOptions{
+			RouterGroup:  g,
+			DbConnection: dbConnection,
+		})
 	}
 
 	engine.GET("/swagger/*any", ginSwagger.WrapHandler(swaggerFiles.Handler))
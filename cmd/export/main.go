@@ -0,0 +1,96 @@
+package main
+
+import (
+	"log"
+	"os"
+
+	"github.com/gabrielmrtt/taski/internal/core"
+	exportservice "github.com/gabrielmrtt/taski/internal/export/service"
+	projectdatabase "github.com/gabrielmrtt/taski/internal/project/infra/database"
+	sharedpostgres "github.com/gabrielmrtt/taski/internal/shared/postgres"
+	storagedatabase "github.com/gabrielmrtt/taski/internal/storage/infra/database"
+	taskdatabase "github.com/gabrielmrtt/taski/internal/task/infra/database"
+)
+
+type ExportConfig struct {
+	OrganizationId string
+	ProjectId      string
+	UserId         string
+	FilePath       string
+}
+
+func createExportProjectService() *exportservice.ExportProjectService {
+	connection := sharedpostgres.GetPostgresConnection()
+
+	projectRepository := projectdatabase.NewProjectBunRepository(connection)
+	projectTaskStatusRepository := projectdatabase.NewProjectTaskStatusBunRepository(connection)
+	projectTaskStatusTransitionRepository := projectdatabase.NewProjectTaskStatusTransitionBunRepository(connection)
+	projectTaskCategoryRepository := projectdatabase.NewProjectTaskCategoryBunRepository(connection)
+	projectTaskCustomFieldRepository := projectdatabase.NewProjectTaskCustomFieldBunRepository(connection)
+	projectDocumentRepository := projectdatabase.NewProjectDocumentBunRepository(connection)
+	taskRepository := taskdatabase.NewTaskBunRepository(connection)
+	taskCommentRepository := taskdatabase.NewTaskCommentBunRepository(connection)
+	taskActionRepository := taskdatabase.NewTaskActionBunRepository(connection)
+	uploadedFileRepository := storagedatabase.NewUploadedFileBunRepository(connection)
+	storageRepository := storagedatabase.NewLocalStorageRepository()
+
+	return exportservice.NewExportProjectService(projectRepository, projectTaskStatusRepository, projectTaskStatusTransitionRepository, projectTaskCategoryRepository, projectTaskCustomFieldRepository, projectDocumentRepository, taskRepository, taskCommentRepository, taskActionRepository, uploadedFileRepository, storageRepository)
+}
+
+func executeExport(config ExportConfig) {
+	exportProjectService := createExportProjectService()
+
+	log.Printf("Exporting project %s", config.ProjectId)
+
+	projectExport, err := exportProjectService.Execute(exportservice.ExportProjectInput{
+		OrganizationIdentity: core.NewIdentityFromPublic(config.OrganizationId),
+		ProjectIdentity:      core.NewIdentityFromPublic(config.ProjectId),
+		UserExporterIdentity: core.NewIdentityFromPublic(config.UserId),
+	})
+	if err != nil {
+		log.Fatalf("Export failed: %v", err)
+	}
+
+	filePath := config.FilePath
+	if filePath == "" {
+		filePath = projectExport.FileName
+	}
+
+	file, err := os.Create(filePath)
+	if err != nil {
+		log.Fatalf("Failed to create export file: %v", err)
+	}
+	defer file.Close()
+
+	err = exportProjectService.WriteArchive(projectExport, file)
+	if err != nil {
+		os.Remove(filePath)
+		log.Fatalf("Failed to write export archive: %v", err)
+	}
+
+	log.Printf("Exported %d tasks, %d documents and %d files to %s", len(projectExport.Tasks), len(projectExport.Documents), len(projectExport.Files), filePath)
+}
+
+func parseArguments() ExportConfig {
+	if len(os.Args) < 4 {
+		log.Fatalf("Usage: export <organizationId> <projectId> <userId> [file]")
+	}
+
+	config := ExportConfig{
+		OrganizationId: os.Args[1],
+		ProjectId:      os.Args[2],
+		UserId:         os.Args[3],
+		FilePath:       "",
+	}
+
+	if len(os.Args) >= 5 {
+		config.FilePath = os.Args[4]
+	}
+
+	return config
+}
+
+func main() {
+	config := parseArguments()
+	executeExport(config)
+}
@@ -0,0 +1,148 @@
+package main
+
+import (
+	"encoding/json"
+	"log"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/gabrielmrtt/taski/internal/core"
+	coredatabase "github.com/gabrielmrtt/taski/internal/core/database"
+	notificationinfra "github.com/gabrielmrtt/taski/internal/notification/infra"
+	notificationevents "github.com/gabrielmrtt/taski/internal/notification/infra/events"
+	projectdatabase "github.com/gabrielmrtt/taski/internal/project/infra/database"
+	realtimedatabase "github.com/gabrielmrtt/taski/internal/realtime/infra/database"
+	realtimeevents "github.com/gabrielmrtt/taski/internal/realtime/infra/events"
+	sharedpostgres "github.com/gabrielmrtt/taski/internal/shared/postgres"
+	storagedatabase "github.com/gabrielmrtt/taski/internal/storage/infra/database"
+	"github.com/gabrielmrtt/taski/internal/task"
+	taskdatabase "github.com/gabrielmrtt/taski/internal/task/infra/database"
+	taskservice "github.com/gabrielmrtt/taski/internal/task/services"
+	webhookinfra "github.com/gabrielmrtt/taski/internal/webhook/infra"
+	webhookevents "github.com/gabrielmrtt/taski/internal/webhook/infra/events"
+	workspacedatabase "github.com/gabrielmrtt/taski/internal/workspace/infra/database"
+)
+
+type ImportConfig struct {
+	OrganizationId string
+	ProjectId      string
+	UserId         string
+	FilePath       string
+	ColumnMapping  map[string]string
+	DryRun         bool
+}
+
+func createImportTasksService() *taskservice.ImportTasksService {
+	connection := sharedpostgres.GetPostgresConnection()
+
+	taskRepository := notificationevents.NewTaskRepository(taskdatabase.NewTaskBunRepository(connection), notificationinfra.NewNotifyUserService(connection))
+	projectRepository := projectdatabase.NewProjectBunRepository(connection)
+	workspaceRepository := workspacedatabase.NewWorkspaceBunRepository(connection)
+	taskActionRepository := realtimeevents.NewTaskActionRepository(
+		webhookevents.NewTaskActionRepository(taskdatabase.NewTaskActionBunRepository(connection), taskRepository, projectRepository, workspaceRepository, webhookinfra.NewPublishWebhookEventService(connection)),
+		taskRepository, projectRepository, workspaceRepository, realtimedatabase.NewRealtimeEventBunRepository(connection),
+	)
+	projectUserRepository := projectdatabase.NewProjectUserBunRepository(connection)
+	projectTaskStatusRepository := projectdatabase.NewProjectTaskStatusBunRepository(connection)
+	projectTaskCategoryRepository := projectdatabase.NewProjectTaskCategoryBunRepository(connection)
+	projectTaskCustomFieldRepository := projectdatabase.NewProjectTaskCustomFieldBunRepository(connection)
+	uploadedFileRepository := storagedatabase.NewUploadedFileBunRepository(connection)
+	transactionRepository := coredatabase.NewTransactionBunRepository(connection)
+
+	createTaskService := taskservice.NewCreateTaskService(taskRepository, taskActionRepository, projectRepository, projectUserRepository, projectTaskStatusRepository, projectTaskCategoryRepository, projectTaskCustomFieldRepository, uploadedFileRepository, transactionRepository)
+
+	return taskservice.NewImportTasksService(taskRepository, projectRepository, projectTaskStatusRepository, projectTaskCategoryRepository, createTaskService, transactionRepository)
+}
+
+func getImportFormat(filePath string) task.TaskImportFormats {
+	switch strings.ToLower(filepath.Ext(filePath)) {
+	case ".csv":
+		return task.TaskImportFormatCsv
+	case ".json":
+		return task.TaskImportFormatJson
+	default:
+		log.Fatalf("Unsupported import file extension: %s, expected .csv or .json", filepath.Ext(filePath))
+		return ""
+	}
+}
+
+func executeImport(config ImportConfig) {
+	content, err := os.ReadFile(config.FilePath)
+	if err != nil {
+		log.Fatalf("Failed to read import file: %v", err)
+	}
+
+	log.Printf("Importing tasks from %s into project %s", config.FilePath, config.ProjectId)
+	if config.DryRun {
+		log.Println("Dry run, no task will be created")
+	}
+
+	report, err := createImportTasksService().Execute(taskservice.ImportTasksInput{
+		OrganizationIdentity: core.NewIdentityFromPublic(config.OrganizationId),
+		ProjectIdentity:      core.NewIdentityFromPublic(config.ProjectId),
+		UserCreatorIdentity:  core.NewIdentityFromPublic(config.UserId),
+		Format:               getImportFormat(config.FilePath),
+		Content:              content,
+		ColumnMapping:        config.ColumnMapping,
+		DryRun:               config.DryRun,
+	})
+	if err != nil {
+		if invalidInputError, ok := err.(*core.InvalidInputError); ok {
+			for _, field := range invalidInputError.Fields {
+				log.Printf("%s: %s", field.Field, field.Error)
+			}
+		}
+
+		log.Fatalf("Import failed: %v", err)
+	}
+
+	encodedReport, err := json.MarshalIndent(report, "", "  ")
+	if err != nil {
+		log.Fatalf("Failed to encode import report: %v", err)
+	}
+
+	os.Stdout.Write(append(encodedReport, '\n'))
+
+	log.Printf("Import completed: %d created, %d skipped, %d failed", report.Created, report.Skipped, report.Failed)
+
+	if report.Failed > 0 {
+		os.Exit(1)
+	}
+}
+
+func parseArguments() ImportConfig {
+	if len(os.Args) < 5 {
+		log.Fatalf("Usage: import <organizationId> <projectId> <userId> <file> [dry-run] [field=column ...]")
+	}
+
+	config := ImportConfig{
+		OrganizationId: os.Args[1],
+		ProjectId:      os.Args[2],
+		UserId:         os.Args[3],
+		FilePath:       os.Args[4],
+		ColumnMapping:  make(map[string]string),
+		DryRun:         false,
+	}
+
+	for _, argument := range os.Args[5:] {
+		if argument == "dry-run" {
+			config.DryRun = true
+			continue
+		}
+
+		field, column, ok := strings.Cut(argument, "=")
+		if !ok {
+			log.Fatalf("Invalid argument: %s, expected dry-run or a field=column mapping", argument)
+		}
+
+		config.ColumnMapping[field] = column
+	}
+
+	return config
+}
+
+func main() {
+	config := parseArguments()
+	executeImport(config)
+}
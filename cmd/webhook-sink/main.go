@@ -0,0 +1,100 @@
+package main
+
+import (
+	"crypto/hmac"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"os"
+	"strconv"
+	"time"
+
+	"github.com/gabrielmrtt/taski/internal/webhook"
+	"github.com/gabrielmrtt/taski/pkg/hashutils"
+)
+
+type SinkConfig struct {
+	Port   string
+	Secret string
+	Status int
+}
+
+/*
+The sink rejects signatures older than this, as receivers should to prevent replays.
+*/
+const maxTimestampAgeSeconds = 5 * 60
+
+func verifySignature(config SinkConfig, request *http.Request, body []byte) string {
+	if config.Secret == "" {
+		return "not checked (no secret)"
+	}
+
+	timestamp, err := strconv.ParseInt(request.Header.Get(webhook.WebhookTimestampHeader), 10, 64)
+	if err != nil {
+		return "invalid (bad timestamp)"
+	}
+
+	if age := time.Now().Unix() - timestamp; age > maxTimestampAgeSeconds || age < -maxTimestampAgeSeconds {
+		return "invalid (timestamp too old)"
+	}
+
+	expected := "sha256=" + hashutils.HmacSha256(config.Secret, fmt.Sprintf("%d.%s", timestamp, body))
+	if !hmac.Equal([]byte(expected), []byte(request.Header.Get(webhook.WebhookSignatureHeader))) {
+		return "invalid (mismatch)"
+	}
+
+	return "valid"
+}
+
+func handleDelivery(config SinkConfig) http.HandlerFunc {
+	return func(writer http.ResponseWriter, request *http.Request) {
+		body, err := io.ReadAll(request.Body)
+		if err != nil {
+			http.Error(writer, err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		log.Printf("%s %s", request.Method, request.URL.Path)
+		log.Printf("  event:     %s", request.Header.Get(webhook.WebhookEventHeader))
+		log.Printf("  delivery:  %s", request.Header.Get(webhook.WebhookDeliveryHeader))
+		log.Printf("  signature: %s", verifySignature(config, request, body))
+		log.Printf("  body:      %s", body)
+
+		writer.WriteHeader(config.Status)
+	}
+}
+
+func parseArguments() SinkConfig {
+	if len(os.Args) < 2 {
+		log.Fatalf("Usage: webhook-sink <port> [secret] [status]")
+	}
+
+	config := SinkConfig{
+		Port:   os.Args[1],
+		Secret: "",
+		Status: http.StatusOK,
+	}
+
+	if len(os.Args) >= 3 {
+		config.Secret = os.Args[2]
+	}
+
+	if len(os.Args) >= 4 {
+		status, err := strconv.Atoi(os.Args[3])
+		if err != nil || status < 100 || status > 599 {
+			log.Fatalf("Invalid status: %s", os.Args[3])
+		}
+
+		config.Status = status
+	}
+
+	return config
+}
+
+func main() {
+	config := parseArguments()
+
+	log.Printf("Listening for webhooks on :%s, answering %d", config.Port, config.Status)
+	log.Fatal(http.ListenAndServe(":"+config.Port, handleDelivery(config)))
+}
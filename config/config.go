@@ -1,6 +1,8 @@
 package config
 
 import (
+	"crypto/hkdf"
+	"crypto/sha256"
 	"fmt"
 	"os"
 	"strconv"
@@ -10,22 +12,39 @@ import (
 )
 
 type Config struct {
-	Env                  string
-	ApiVersion           string
-	AppPort              string
-	PostgresHost         string
-	PostgresPort         string
-	PostgresUsername     string
-	PostgresPassword     string
-	PostgresName         string
-	MailHost             string
-	MailPort             string
-	MailUsername         string
-	MailPassword         string
-	MailFrom             string
-	JwtSecret            string
-	JwtExpirationMinutes int64
-	StorageLocalBasePath string
+	Env                          string
+	ApiVersion                   string
+	AppPort                      string
+	AppUrl                       string
+	WebAppUrl                    string
+	PostgresHost                 string
+	PostgresPort                 string
+	PostgresUsername             string
+	PostgresPassword             string
+	PostgresName                 string
+	MailHost                     string
+	MailPort                     string
+	MailUsername                 string
+	MailPassword                 string
+	MailFrom                     string
+	JwtSecret                    string
+	JwtExpirationMinutes         int64
+	CursorSecret                 string
+	StorageLocalBasePath         string
+	WebhookAllowPrivateAddresses bool
+}
+
+/*
+deriveSecret derives a key for another use from a secret with HKDF, so that a key leaked from one use does not reveal
+the secret nor the keys of the other uses.
+*/
+func deriveSecret(secret string, label string) string {
+	key, err := hkdf.Key(sha256.New, []byte(secret), nil, "taski "+label, 32)
+	if err != nil {
+		panic(fmt.Errorf("failed to derive the %s secret: %w", label, err))
+	}
+
+	return string(key)
 }
 
 func getEnvOrDefault(key, defaultValue string) string {
@@ -46,6 +65,8 @@ func loadConfig() *Config {
 	cfg.Env = getEnvOrDefault("ENV", "development")
 	cfg.ApiVersion = getEnvOrDefault("API_VERSION", "v1")
 	cfg.AppPort = getEnvOrDefault("APP_PORT", "8080")
+	cfg.AppUrl = getEnvOrDefault("APP_URL", fmt.Sprintf("http://localhost:%s", cfg.AppPort))
+	cfg.WebAppUrl = getEnvOrDefault("WEB_APP_URL", cfg.AppUrl)
 	cfg.PostgresHost = getEnvOrDefault("POSTGRES_HOST", "localhost")
 	cfg.PostgresPort = getEnvOrDefault("POSTGRES_PORT", "5432")
 	cfg.PostgresUsername = getEnvOrDefault("POSTGRES_USER", "postgres")
@@ -57,6 +78,10 @@ func loadConfig() *Config {
 	cfg.MailPassword = getEnvOrDefault("MAIL_PASSWORD", "")
 	cfg.MailFrom = getEnvOrDefault("MAIL_FROM", "noreply@taski.com")
 	cfg.JwtSecret = getEnvOrDefault("JWT_SECRET", "default-jwt-secret-for-development")
+	cfg.CursorSecret = getEnvOrDefault("CURSOR_SECRET", "")
+	if cfg.CursorSecret == "" {
+		cfg.CursorSecret = deriveSecret(cfg.JwtSecret, "cursor")
+	}
 	cfg.StorageLocalBasePath = getEnvOrDefault("STORAGE_LOCAL_BASE_PATH", "./storage")
 
 	expirationMinutesStr := getEnvOrDefault("JWT_EXPIRATION_MINUTES", "60")
@@ -66,6 +91,12 @@ func loadConfig() *Config {
 	}
 	cfg.JwtExpirationMinutes = expirationMinutes
 
+	webhookAllowPrivateAddresses, err := strconv.ParseBool(getEnvOrDefault("WEBHOOK_ALLOW_PRIVATE_ADDRESSES", "false"))
+	if err != nil {
+		panic(fmt.Errorf("failed to parse WEBHOOK_ALLOW_PRIVATE_ADDRESSES: %w", err))
+	}
+	cfg.WebhookAllowPrivateAddresses = webhookAllowPrivateAddresses
+
 	return cfg
 }
 
@@ -0,0 +1,38 @@
+package config
+
+import "testing"
+
+func TestDeriveSecret(t *testing.T) {
+	tests := []struct {
+		name   string
+		secret string
+		label  string
+	}{
+		{name: "cursor", secret: "jwt-secret", label: "cursor"},
+		{name: "other label", secret: "jwt-secret", label: "other"},
+		{name: "other secret", secret: "other-jwt-secret", label: "cursor"},
+	}
+
+	derived := make(map[string]string)
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			key := deriveSecret(tt.secret, tt.label)
+
+			if key == tt.secret || len(key) != 32 {
+				t.Fatalf("expected a 32 bytes key other than the secret, got %q", key)
+			}
+
+			if key != deriveSecret(tt.secret, tt.label) {
+				t.Error("expected the same key for the same secret and label")
+			}
+
+			for name, other := range derived {
+				if other == key {
+					t.Errorf("expected a key other than the one of %s", name)
+				}
+			}
+
+			derived[tt.name] = key
+		})
+	}
+}
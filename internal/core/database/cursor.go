@@ -0,0 +1,220 @@
+package coredatabase
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/gabrielmrtt/taski/config"
+	"github.com/gabrielmrtt/taski/internal/core"
+	"github.com/uptrace/bun"
+)
+
+// CursorPagination holds the state of a keyset paginated query between building it and reading its rows
+type CursorPagination struct {
+	signature string
+	perPage   int
+	backward  bool
+	seeking   bool
+}
+
+// sortSignature identifies the sort a cursor was issued for so it is not reused with a different one
+func sortSignature(columns []SortColumn) string {
+	var parts []string = make([]string, len(columns))
+	for i, column := range columns {
+		parts[i] = column.Key + ":" + string(column.Direction)
+	}
+
+	return strings.Join(parts, ",")
+}
+
+func cursorSecret() []byte {
+	return []byte(config.GetInstance().CursorSecret)
+}
+
+func signCursorPayload(secret []byte, payload string) []byte {
+	mac := hmac.New(sha256.New, secret)
+	mac.Write([]byte(payload))
+	return mac.Sum(nil)
+}
+
+// encodeCursor issues a cursor for the row with the given public id, signed so that clients cannot forge one
+func encodeCursor(secret []byte, signature string, publicId string) string {
+	payload := signature + "|" + publicId
+	return base64.RawURLEncoding.EncodeToString([]byte(payload)) + "." + base64.RawURLEncoding.EncodeToString(signCursorPayload(secret, payload))
+}
+
+// decodeCursor checks the cursor was issued by encodeCursor for the same sort and returns the internal id of its row
+func decodeCursor(secret []byte, field string, token string, signature string) (string, error) {
+	invalidCursorError := core.NewInvalidInputError("invalid cursor", []core.InvalidInputErrorField{
+		{
+			Field: field,
+			Error: "cursor is invalid or was issued for a different sort",
+		},
+	})
+
+	encodedPayload, encodedMac, ok := strings.Cut(token, ".")
+	if !ok {
+		return "", invalidCursorError
+	}
+
+	payload, err := base64.RawURLEncoding.DecodeString(encodedPayload)
+	if err != nil {
+		return "", invalidCursorError
+	}
+
+	mac, err := base64.RawURLEncoding.DecodeString(encodedMac)
+	if err != nil || !hmac.Equal(mac, signCursorPayload(secret, string(payload))) {
+		return "", invalidCursorError
+	}
+
+	parts := strings.SplitN(string(payload), "|", 2)
+	if len(parts) != 2 || parts[0] != signature || parts[1] == "" {
+		return "", invalidCursorError
+	}
+
+	identity := core.NewIdentityFromPublic(parts[1])
+	if identity.IsEmpty() {
+		return "", invalidCursorError
+	}
+
+	return identity.Internal.String(), nil
+}
+
+// seekCondition builds the condition selecting the rows placed after the row with the given id in the order of the
+// columns. NULL values are placed last in ascending order and first in descending order, as Postgres does by default.
+func seekCondition(columns []SortColumn, idColumn string, internalId string) (string, []interface{}) {
+	var table string = strings.Split(idColumn, ".")[0]
+	var conditions []string = make([]string, 0)
+	var args []interface{} = make([]interface{}, 0)
+
+	boundary := func(column SortColumn) string {
+		args = append(args, internalId)
+		return fmt.Sprintf("(SELECT %s FROM %s WHERE %s = ?)", column.Column, table, idColumn)
+	}
+
+	for i, column := range columns {
+		var parts []string = make([]string, 0)
+
+		for _, previous := range columns[:i] {
+			parts = append(parts, fmt.Sprintf("%s IS NOT DISTINCT FROM %s", previous.Column, boundary(previous)))
+		}
+
+		if column.Direction == core.SortDirectionDesc {
+			parts = append(parts, fmt.Sprintf("(%s IS NOT NULL AND (%s IS NULL OR %s < %s))", column.Column, boundary(column), column.Column, boundary(column)))
+		} else {
+			parts = append(parts, fmt.Sprintf("(%s IS NOT NULL AND (%s IS NULL OR %s > %s))", boundary(column), column.Column, column.Column, boundary(column)))
+		}
+
+		conditions = append(conditions, "("+strings.Join(parts, " AND ")+")")
+	}
+
+	return "(" + strings.Join(conditions, " OR ") + ")", args
+}
+
+// ApplyCursorPagination orders and limits the bun query for keyset pagination over the resolved sort columns, which
+// must end with the id column. An empty after cursor starts at the first page and an empty before cursor at the last
+// one. One extra row is selected to know whether there are more rows.
+func ApplyCursorPagination(query *bun.SelectQuery, sortableFields SortableFields, columns []SortColumn, pagination core.PaginationInput) (*bun.SelectQuery, *CursorPagination, error) {
+	var perPage int = 10
+	if pagination.PerPage != nil {
+		perPage = *pagination.PerPage
+	}
+
+	cursorPagination := &CursorPagination{
+		signature: sortSignature(columns),
+		perPage:   perPage,
+		backward:  pagination.Before != nil,
+	}
+
+	var orderColumns []SortColumn = columns
+	if cursorPagination.backward {
+		orderColumns = make([]SortColumn, len(columns))
+		for i, column := range columns {
+			orderColumns[i] = column
+			if column.Direction == core.SortDirectionDesc {
+				orderColumns[i].Direction = core.SortDirectionAsc
+			} else {
+				orderColumns[i].Direction = core.SortDirectionDesc
+			}
+		}
+	}
+
+	var field string = "after"
+	var token *string = pagination.After
+	if cursorPagination.backward {
+		field = "before"
+		token = pagination.Before
+	}
+
+	if token != nil && *token != "" {
+		cursorPagination.seeking = true
+		internalId, err := decodeCursor(cursorSecret(), field, *token, cursorPagination.signature)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		condition, args := seekCondition(orderColumns, sortableFields.IdColumn, internalId)
+		query = query.Where(condition, args...)
+	}
+
+	query = ApplySortColumns(query, orderColumns)
+	query = query.Limit(perPage + 1)
+
+	return query, cursorPagination, nil
+}
+
+// NewCursorPaginationOutput trims the extra row selected by ApplyCursorPagination, restores the sort order of a
+// backward page and issues the cursors of the neighbour pages from the public ids of the rows.
+func NewCursorPaginationOutput[T any](data []T, publicIds []string, cursorPagination *CursorPagination, total int) *core.PaginationOutput[T] {
+	var hasExtraRow bool = len(data) > cursorPagination.perPage
+	if hasExtraRow {
+		data = data[:cursorPagination.perPage]
+		publicIds = publicIds[:cursorPagination.perPage]
+	}
+
+	if cursorPagination.backward {
+		slices.Reverse(data)
+		slices.Reverse(publicIds)
+	}
+
+	var nextCursor *string = nil
+	var prevCursor *string = nil
+	var hasMore bool = hasExtraRow
+
+	if len(publicIds) > 0 {
+		first := encodeCursor(cursorSecret(), cursorPagination.signature, publicIds[0])
+		last := encodeCursor(cursorSecret(), cursorPagination.signature, publicIds[len(publicIds)-1])
+
+		if cursorPagination.backward {
+			hasMore = cursorPagination.seeking
+			if cursorPagination.seeking {
+				nextCursor = &last
+			}
+
+			if hasExtraRow {
+				prevCursor = &first
+			}
+		} else {
+			if cursorPagination.seeking {
+				prevCursor = &first
+			}
+
+			if hasExtraRow {
+				nextCursor = &last
+			}
+		}
+	}
+
+	return &core.PaginationOutput[T]{
+		Data:       data,
+		Page:       0,
+		HasMore:    hasMore,
+		Total:      total,
+		NextCursor: nextCursor,
+		PrevCursor: prevCursor,
+	}
+}
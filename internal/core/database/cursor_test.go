@@ -0,0 +1,185 @@
+package coredatabase
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/gabrielmrtt/taski/internal/core"
+)
+
+func TestDecodeCursor(t *testing.T) {
+	secret := []byte("cursor-secret")
+	identity := core.NewIdentity("tsk")
+	signature := "priority:desc,id:asc"
+	token := encodeCursor(secret, signature, identity.Public)
+
+	payload, mac, _ := strings.Cut(token, ".")
+	otherToken := encodeCursor(secret, signature, core.NewIdentity("tsk").Public)
+	_, otherMac, _ := strings.Cut(otherToken, ".")
+
+	tests := []struct {
+		name      string
+		secret    []byte
+		token     string
+		signature string
+		valid     bool
+	}{
+		{name: "valid", secret: secret, token: token, signature: signature, valid: true},
+		{name: "different sort", secret: secret, token: token, signature: "priority:asc,id:asc"},
+		{name: "different secret", secret: []byte("other-secret"), token: token, signature: signature},
+		{name: "swapped signature", secret: secret, token: payload + "." + otherMac, signature: signature},
+		{name: "truncated signature", secret: secret, token: payload + "." + mac[:len(mac)-2], signature: signature},
+		{name: "without signature", secret: secret, token: payload, signature: signature},
+		{name: "not base64", secret: secret, token: "***." + mac, signature: signature},
+		{name: "empty public id", secret: secret, token: encodeCursor(secret, signature, ""), signature: signature},
+		{name: "invalid public id", secret: secret, token: encodeCursor(secret, signature, "tsk_0"), signature: signature},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			internalId, err := decodeCursor(tt.secret, "after", tt.token, tt.signature)
+
+			if tt.valid {
+				if err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+
+				if internalId != identity.Internal.String() {
+					t.Errorf("expected internal id %s, got %s", identity.Internal.String(), internalId)
+				}
+
+				return
+			}
+
+			var invalidInputError *core.InvalidInputError
+			if !errors.As(err, &invalidInputError) {
+				t.Fatalf("expected an invalid input error, got %v", err)
+			}
+
+			if invalidInputError.Fields[0].Field != "after" {
+				t.Errorf("expected the error on after, got %s", invalidInputError.Fields[0].Field)
+			}
+		})
+	}
+}
+
+func TestEncodeCursorDoesNotExposeInternalId(t *testing.T) {
+	identity := core.NewIdentity("tsk")
+	token := encodeCursor([]byte("cursor-secret"), "id:asc", identity.Public)
+
+	payload, _, _ := strings.Cut(token, ".")
+	if strings.Contains(token, identity.Internal.String()) || strings.Contains(payload, identity.Internal.String()) {
+		t.Errorf("cursor %s exposes the internal id", token)
+	}
+}
+
+func TestSeekCondition(t *testing.T) {
+	tests := []struct {
+		name      string
+		columns   []SortColumn
+		condition string
+		args      int
+	}{
+		{
+			name:      "id only",
+			columns:   []SortColumn{{Key: "id", Column: "task.internal_id", Direction: core.SortDirectionAsc}},
+			condition: "((((SELECT task.internal_id FROM task WHERE task.internal_id = ?) IS NOT NULL AND (task.internal_id IS NULL OR task.internal_id > (SELECT task.internal_id FROM task WHERE task.internal_id = ?)))))",
+			args:      2,
+		},
+		{
+			name: "descending column then id",
+			columns: []SortColumn{
+				{Key: "priority", Column: "task.priority", Direction: core.SortDirectionDesc},
+				{Key: "id", Column: "task.internal_id", Direction: core.SortDirectionAsc},
+			},
+			condition: "(((task.priority IS NOT NULL AND ((SELECT task.priority FROM task WHERE task.internal_id = ?) IS NULL OR task.priority < (SELECT task.priority FROM task WHERE task.internal_id = ?)))) OR " +
+				"(task.priority IS NOT DISTINCT FROM (SELECT task.priority FROM task WHERE task.internal_id = ?) AND ((SELECT task.internal_id FROM task WHERE task.internal_id = ?) IS NOT NULL AND (task.internal_id IS NULL OR task.internal_id > (SELECT task.internal_id FROM task WHERE task.internal_id = ?)))))",
+			args: 5,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			condition, args := seekCondition(tt.columns, "task.internal_id", "row-id")
+
+			if condition != tt.condition {
+				t.Errorf("expected condition\n%s\ngot\n%s", tt.condition, condition)
+			}
+
+			if len(args) != tt.args || strings.Count(condition, "?") != len(args) {
+				t.Fatalf("expected %d args matching the placeholders, got %d", tt.args, len(args))
+			}
+
+			for _, arg := range args {
+				if arg != "row-id" {
+					t.Errorf("expected every arg to be the row id, got %v", arg)
+				}
+			}
+		})
+	}
+}
+
+func TestNewCursorPaginationOutput(t *testing.T) {
+	tests := []struct {
+		name       string
+		data       []int
+		backward   bool
+		seeking    bool
+		expected   []int
+		hasMore    bool
+		nextCursor bool
+		prevCursor bool
+	}{
+		{name: "first page", data: []int{1, 2, 3}, expected: []int{1, 2}, hasMore: true, nextCursor: true},
+		{name: "last page", data: []int{5, 6}, seeking: true, expected: []int{5, 6}, prevCursor: true},
+		{name: "single page", data: []int{1}, expected: []int{1}},
+		{name: "backward page", data: []int{4, 3, 2}, backward: true, seeking: true, expected: []int{3, 4}, hasMore: true, nextCursor: true, prevCursor: true},
+		{name: "backward first page", data: []int{2, 1}, backward: true, seeking: true, expected: []int{1, 2}, hasMore: true, nextCursor: true},
+		{name: "empty", data: []int{}, expected: []int{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var publicIds []string = make([]string, len(tt.data))
+			for i := range tt.data {
+				publicIds[i] = core.NewIdentity("tsk").Public
+			}
+
+			output := NewCursorPaginationOutput(tt.data, publicIds, &CursorPagination{
+				signature: "id:asc",
+				perPage:   2,
+				backward:  tt.backward,
+				seeking:   tt.seeking,
+			}, 10)
+
+			if len(output.Data) != len(tt.expected) {
+				t.Fatalf("expected %v, got %v", tt.expected, output.Data)
+			}
+
+			for i := range tt.expected {
+				if output.Data[i] != tt.expected[i] {
+					t.Fatalf("expected %v, got %v", tt.expected, output.Data)
+				}
+			}
+
+			if output.HasMore != tt.hasMore {
+				t.Errorf("expected has more %t, got %t", tt.hasMore, output.HasMore)
+			}
+
+			if (output.NextCursor != nil) != tt.nextCursor {
+				t.Errorf("expected next cursor %t, got %v", tt.nextCursor, output.NextCursor)
+			}
+
+			if (output.PrevCursor != nil) != tt.prevCursor {
+				t.Errorf("expected prev cursor %t, got %v", tt.prevCursor, output.PrevCursor)
+			}
+
+			if output.NextCursor != nil {
+				if _, err := decodeCursor(cursorSecret(), "after", *output.NextCursor, "id:asc"); err != nil {
+					t.Errorf("expected the next cursor to decode, got %v", err)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,134 @@
+package coredatabase
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/gabrielmrtt/taski/internal/core"
+	"github.com/uptrace/bun"
+)
+
+// MustFilterableColumns pairs the filterable fields of a domain with the columns they filter and panics, when the
+// package is initialized, if a field has no column or a column no field. This keeps the two registries from drifting.
+func MustFilterableColumns(fields map[string]core.FilterFieldType, columns map[string]string) map[string]string {
+	for field := range fields {
+		if columns[field] == "" {
+			panic(fmt.Sprintf("filterable field %s has no column", field))
+		}
+	}
+
+	for field := range columns {
+		if _, ok := fields[field]; !ok {
+			panic(fmt.Sprintf("filterable column of %s has no field", field))
+		}
+	}
+
+	return columns
+}
+
+// ApplyFilterExpression applies a resolved filter expression to the bun query as a group of where clauses. The
+// columns map each field allowed in the expression to the column it filters.
+func ApplyFilterExpression(query *bun.SelectQuery, expression core.FilterExpression, columns map[string]string) *bun.SelectQuery {
+	if expression == nil {
+		return query
+	}
+
+	return applyFilterExpression(query, " AND ", expression, columns, false)
+}
+
+// applyFilterExpression adds the expression to the query joined by sep. Negations are pushed down to the
+// comparisons so that every node maps to a plain where group.
+func applyFilterExpression(query *bun.SelectQuery, sep string, expression core.FilterExpression, columns map[string]string, negated bool) *bun.SelectQuery {
+	switch e := expression.(type) {
+	case core.FilterAndExpression:
+		var innerSep string = " AND "
+		if negated {
+			innerSep = " OR "
+		}
+
+		return query.WhereGroup(sep, func(q *bun.SelectQuery) *bun.SelectQuery {
+			q = applyFilterExpression(q, " AND ", e.Left, columns, negated)
+			return applyFilterExpression(q, innerSep, e.Right, columns, negated)
+		})
+	case core.FilterOrExpression:
+		var innerSep string = " OR "
+		if negated {
+			innerSep = " AND "
+		}
+
+		return query.WhereGroup(sep, func(q *bun.SelectQuery) *bun.SelectQuery {
+			q = applyFilterExpression(q, " AND ", e.Left, columns, negated)
+			return applyFilterExpression(q, innerSep, e.Right, columns, negated)
+		})
+	case core.FilterNotExpression:
+		return applyFilterExpression(query, sep, e.Expression, columns, !negated)
+	case *core.FilterComparisonExpression:
+		// A field without a column matches no row instead of putting an empty column in the query
+		column, ok := columns[e.Field]
+		if !ok || column == "" {
+			return whereFilterCondition(query, sep, "FALSE")
+		}
+
+		condition, args := filterComparisonCondition(e, column, negated)
+		return whereFilterCondition(query, sep, condition, args...)
+	}
+
+	return query
+}
+
+func whereFilterCondition(query *bun.SelectQuery, sep string, condition string, args ...interface{}) *bun.SelectQuery {
+	if sep == " OR " {
+		return query.WhereOr(condition, args...)
+	}
+
+	return query.Where(condition, args...)
+}
+
+var likePatternReplacer = strings.NewReplacer(`\`, `\\`, "%", `\%`, "_", `\_`)
+
+// filterComparisonCondition builds the where condition of a comparison. Negated operators also match the rows
+// where the column has no value.
+func filterComparisonCondition(comparison *core.FilterComparisonExpression, column string, negated bool) (string, []interface{}) {
+	var operator core.FilterOperator = comparison.Operator
+	if negated {
+		operator = operator.Negate()
+	}
+
+	if comparison.IsNullComparison() {
+		if operator == core.FilterOperatorEquals {
+			return fmt.Sprintf("%s IS NULL", column), nil
+		}
+
+		return fmt.Sprintf("%s IS NOT NULL", column), nil
+	}
+
+	var value interface{} = nil
+	if len(comparison.Values) > 0 {
+		value = comparison.Values[0]
+	}
+
+	switch operator {
+	case core.FilterOperatorEquals:
+		return fmt.Sprintf("%s = ?", column), []interface{}{value}
+	case core.FilterOperatorNotEquals:
+		return fmt.Sprintf("%s IS DISTINCT FROM ?", column), []interface{}{value}
+	case core.FilterOperatorGreaterThan:
+		return fmt.Sprintf("%s > ?", column), []interface{}{value}
+	case core.FilterOperatorGreaterThanOrEqual:
+		return fmt.Sprintf("%s >= ?", column), []interface{}{value}
+	case core.FilterOperatorLessThan:
+		return fmt.Sprintf("%s < ?", column), []interface{}{value}
+	case core.FilterOperatorLessThanOrEqual:
+		return fmt.Sprintf("%s <= ?", column), []interface{}{value}
+	case core.FilterOperatorContains:
+		return fmt.Sprintf("%s ILIKE ?", column), []interface{}{"%" + likePatternReplacer.Replace(fmt.Sprint(value)) + "%"}
+	case core.FilterOperatorNotContains:
+		return fmt.Sprintf("(%s IS NULL OR %s NOT ILIKE ?)", column, column), []interface{}{"%" + likePatternReplacer.Replace(fmt.Sprint(value)) + "%"}
+	case core.FilterOperatorIn:
+		return fmt.Sprintf("%s IN (?)", column), []interface{}{bun.In(comparison.Values)}
+	case core.FilterOperatorNotIn:
+		return fmt.Sprintf("(%s IS NULL OR %s NOT IN (?))", column, column), []interface{}{bun.In(comparison.Values)}
+	}
+
+	return "FALSE", nil
+}
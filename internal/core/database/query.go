@@ -1,17 +1,20 @@
 package coredatabase
 
 import (
+	"context"
 	"fmt"
 	"slices"
+	"strings"
 
 	"github.com/gabrielmrtt/taski/internal/core"
+	"github.com/gabrielmrtt/taski/pkg/stringutils"
 	"github.com/uptrace/bun"
 )
 
 // ApplyComparableFilter applies the comparable filter to the bun query
 func ApplyComparableFilter[T any](query *bun.SelectQuery, field string, filter *core.ComparableFilter[T]) *bun.SelectQuery {
 	if filter.Equals != nil {
-		if filter.Negate != nil {
+		if filter.Negate != nil && *filter.Negate {
 			query.Where(fmt.Sprintf("%s != ?", field), filter.Equals)
 		} else {
 			query.Where(fmt.Sprintf("%s = ?", field), filter.Equals)
@@ -19,7 +22,7 @@ func ApplyComparableFilter[T any](query *bun.SelectQuery, field string, filter *
 	}
 
 	if filter.Like != nil {
-		if filter.Negate != nil {
+		if filter.Negate != nil && *filter.Negate {
 			query.Where(fmt.Sprintf("%s NOT ILIKE ?", field), filter.Like)
 		} else {
 			query.Where(fmt.Sprintf("%s ILIKE ?", field), filter.Like)
@@ -27,22 +30,22 @@ func ApplyComparableFilter[T any](query *bun.SelectQuery, field string, filter *
 	}
 
 	if filter.In != nil {
-		if filter.Negate != nil {
-			query.Where(fmt.Sprintf("%s NOT IN (?)", field), filter.In)
+		if filter.Negate != nil && *filter.Negate {
+			query.Where(fmt.Sprintf("%s NOT IN (?)", field), bun.In(*filter.In))
 		} else {
-			query.Where(fmt.Sprintf("%s IN (?)", field), filter.In)
+			query.Where(fmt.Sprintf("%s IN (?)", field), bun.In(*filter.In))
 		}
 	}
 
 	if filter.GreaterThan != nil {
-		if filter.Negate != nil {
+		if filter.Negate != nil && *filter.Negate {
 			query.Where(fmt.Sprintf("%s <= ?", field), filter.GreaterThan)
 		} else {
 			query.Where(fmt.Sprintf("%s > ?", field), filter.GreaterThan)
 		}
 	}
 	if filter.LessThan != nil {
-		if filter.Negate != nil {
+		if filter.Negate != nil && *filter.Negate {
 			query.Where(fmt.Sprintf("%s >= ?", field), filter.LessThan)
 		} else {
 			query.Where(fmt.Sprintf("%s < ?", field), filter.LessThan)
@@ -50,7 +53,7 @@ func ApplyComparableFilter[T any](query *bun.SelectQuery, field string, filter *
 	}
 
 	if filter.GreaterThanOrEqual != nil {
-		if filter.Negate != nil {
+		if filter.Negate != nil && *filter.Negate {
 			query.Where(fmt.Sprintf("%s < ?", field), filter.GreaterThanOrEqual)
 		} else {
 			query.Where(fmt.Sprintf("%s >= ?", field), filter.GreaterThanOrEqual)
@@ -58,13 +61,21 @@ func ApplyComparableFilter[T any](query *bun.SelectQuery, field string, filter *
 	}
 
 	if filter.LessThanOrEqual != nil {
-		if filter.Negate != nil {
+		if filter.Negate != nil && *filter.Negate {
 			query.Where(fmt.Sprintf("%s > ?", field), filter.LessThanOrEqual)
 		} else {
 			query.Where(fmt.Sprintf("%s <= ?", field), filter.LessThanOrEqual)
 		}
 	}
 
+	if filter.NotNull != nil {
+		if *filter.NotNull {
+			query.Where(fmt.Sprintf("%s IS NOT NULL", field))
+		} else {
+			query.Where(fmt.Sprintf("%s IS NULL", field))
+		}
+	}
+
 	return query
 }
 
@@ -86,34 +97,262 @@ func ApplyPagination(query *bun.SelectQuery, pagination core.PaginationInput) *b
 	return query
 }
 
-// ApplySort applies the sorts to the bun query in the order of the sorts input
-func ApplySort(query *bun.SelectQuery, sorts ...core.SortInput) *bun.SelectQuery {
+// ApplyOffsetPagination applies the pagination to the bun query like ApplyPagination. When the rows are not counted,
+// one extra row is selected to know whether there are more pages.
+func ApplyOffsetPagination(query *bun.SelectQuery, pagination core.PaginationInput) *bun.SelectQuery {
+	query = ApplyPagination(query, pagination)
+
+	if !pagination.ShouldCount() {
+		var limit int = 10
+		if pagination.PerPage != nil {
+			limit = *pagination.PerPage
+		}
+
+		query.Limit(limit + 1)
+	}
+
+	return query
+}
+
+// NewOffsetPaginationOutput builds the page of rows selected with ApplyOffsetPagination. Without a count, HasMore comes
+// from the extra row, which is trimmed, and Total is 0.
+func NewOffsetPaginationOutput[T any](data []T, pagination core.PaginationInput, total int) *core.PaginationOutput[T] {
+	var page int = 1
+	var perPage int = 10
+
+	if pagination.Page != nil {
+		page = *pagination.Page
+	}
+
+	if pagination.PerPage != nil {
+		perPage = *pagination.PerPage
+	}
+
+	if pagination.ShouldCount() {
+		return &core.PaginationOutput[T]{
+			Data:    data,
+			Page:    page,
+			HasMore: core.HasMorePages(page, total, perPage),
+			Total:   total,
+		}
+	}
+
+	var hasMore bool = len(data) > perPage
+	if hasMore {
+		data = data[:perPage]
+	}
+
+	return &core.PaginationOutput[T]{
+		Data:    data,
+		Page:    page,
+		HasMore: hasMore,
+		Total:   0,
+	}
+}
+
+// SortableFields maps the sort keys accepted by a list endpoint to the columns they order by. IdColumn is always
+// appended as the last sort so that rows with equal values keep a stable order between pages.
+type SortableFields struct {
+	Fields   map[string]string
+	IdColumn string
+}
+
+// Keys returns the accepted sort keys in alphabetical order
+func (s SortableFields) Keys() []string {
+	var keys []string = make([]string, 0, len(s.Fields))
+	for key := range s.Fields {
+		keys = append(keys, key)
+	}
+
+	slices.Sort(keys)
+	return keys
+}
+
+// SortColumn is a sort key resolved to the column it orders by
+type SortColumn struct {
+	Key       string
+	Column    string
+	Direction core.SortDirection
+}
+
+// ResolveSort resolves the sorts input to the columns declared in the sortable fields. A sort can hold several comma
+// separated keys and a key prefixed with "-" is sorted in descending order regardless of the sort direction.
+// Keys that are not declared in the sortable fields are rejected with an invalid input error. The id column is
+// always returned as the last column.
+func ResolveSort(sortableFields SortableFields, sorts ...core.SortInput) ([]SortColumn, error) {
+	var columns []SortColumn = make([]SortColumn, 0)
+	var appliedKeys []string = make([]string, 0)
+
 	for _, sort := range sorts {
 		var sortDirection core.SortDirection = core.SortDirectionAsc
-		var sortBy *string = sort.By
 
-		if sort.Direction != nil {
-			sortDirection = *sort.Direction
+		// Requests send an empty direction when the client gives none
+		if sort.Direction != nil && *sort.Direction != "" {
+			sortDirection = core.SortDirection(strings.ToLower(string(*sort.Direction)))
+		}
+
+		if sortDirection != core.SortDirectionAsc && sortDirection != core.SortDirectionDesc {
+			return nil, core.NewInvalidInputError("invalid sort", []core.InvalidInputErrorField{
+				{
+					Field: "sortDirection",
+					Error: "sort direction must be asc or desc",
+				},
+			})
 		}
 
-		if sortBy != nil {
-			query = query.Order(fmt.Sprintf("%s %s", *sortBy, sortDirection))
+		if sort.By == nil {
+			continue
 		}
+
+		for _, key := range strings.Split(*sort.By, ",") {
+			var keyDirection core.SortDirection = sortDirection
+
+			key = strings.TrimSpace(key)
+			if key == "" {
+				continue
+			}
+
+			if strings.HasPrefix(key, "-") {
+				key = strings.TrimPrefix(key, "-")
+				keyDirection = core.SortDirectionDesc
+			}
+
+			column, ok := sortableFields.Fields[key]
+			if !ok {
+				return nil, core.NewInvalidInputError("invalid sort", []core.InvalidInputErrorField{
+					{
+						Field: "sortBy",
+						Error: fmt.Sprintf("cannot sort by %s, valid keys are: %s", key, strings.Join(sortableFields.Keys(), ", ")),
+					},
+				})
+			}
+
+			if slices.Contains(appliedKeys, key) {
+				continue
+			}
+
+			columns = append(columns, SortColumn{Key: key, Column: column, Direction: keyDirection})
+			appliedKeys = append(appliedKeys, key)
+		}
+	}
+
+	if sortableFields.IdColumn != "" {
+		columns = append(columns, SortColumn{Key: "id", Column: sortableFields.IdColumn, Direction: core.SortDirectionAsc})
+	}
+
+	return columns, nil
+}
+
+// ApplySortColumns orders the bun query by the resolved sort columns
+func ApplySortColumns(query *bun.SelectQuery, columns []SortColumn) *bun.SelectQuery {
+	for _, column := range columns {
+		query = query.OrderExpr(fmt.Sprintf("%s %s", column.Column, strings.ToUpper(string(column.Direction))))
 	}
 
 	return query
 }
 
-// ApplyRelations applies the relations to the bun query
-func ApplyRelations(query *bun.SelectQuery, relationsInput core.RelationsInput) *bun.SelectQuery {
+// ApplySort applies the sorts to the bun query in the order of the sorts input, see ResolveSort
+func ApplySort(query *bun.SelectQuery, sortableFields SortableFields, sorts ...core.SortInput) (*bun.SelectQuery, error) {
+	columns, err := ResolveSort(sortableFields, sorts...)
+	if err != nil {
+		return nil, err
+	}
+
+	return ApplySortColumns(query, columns), nil
+}
+
+// IncludableRelations lists the bun relations a list or get endpoint lets clients include. A relation is
+// includable when it is listed or is a parent of a listed relation, and has at most MaxDepth levels.
+type IncludableRelations struct {
+	Relations []string
+	MaxDepth  int
+}
+
+// Keys returns the includable relations as clients write them, in alphabetical order
+func (i IncludableRelations) Keys() []string {
+	var keys []string = make([]string, 0, len(i.Relations))
+	for _, relation := range i.Relations {
+		keys = append(keys, relationKey(relation))
+	}
+
+	slices.Sort(keys)
+	return keys
+}
+
+// relationKey returns the bun relation name as clients write it
+func relationKey(relation string) string {
+	var parts []string = strings.Split(relation, ".")
+	for i, part := range parts {
+		parts[i] = stringutils.PascalCaseToCamelCase(part)
+	}
+
+	return strings.Join(parts, ".")
+}
+
+// Allows reports whether the relation can be included
+func (i IncludableRelations) Allows(relation string) bool {
+	if i.MaxDepth > 0 && len(strings.Split(relation, ".")) > i.MaxDepth {
+		return false
+	}
+
+	for _, includableRelation := range i.Relations {
+		if includableRelation == relation || strings.HasPrefix(includableRelation, relation+".") {
+			return true
+		}
+	}
+
+	return false
+}
+
+// ApplyRelations applies the relations to the bun query. Relations that are not includable are rejected with an
+// invalid input error instead of reaching bun.
+func ApplyRelations(query *bun.SelectQuery, includableRelations IncludableRelations, relationsInput core.RelationsInput) (*bun.SelectQuery, error) {
 	var alreadyAppliedRelations []string = make([]string, 0)
 
 	for _, relation := range relationsInput {
-		if !slices.Contains(alreadyAppliedRelations, relation) {
-			query = query.Relation(relation)
-			alreadyAppliedRelations = append(alreadyAppliedRelations, relation)
+		if relation == "" || slices.Contains(alreadyAppliedRelations, relation) {
+			continue
+		}
+
+		if !includableRelations.Allows(relation) {
+			var keys []string = includableRelations.Keys()
+			var validRelations string = "none"
+			if len(keys) > 0 {
+				validRelations = strings.Join(keys, ", ")
+			}
+
+			return nil, core.NewInvalidInputError("invalid relations", []core.InvalidInputErrorField{
+				{
+					Field: "relations",
+					Error: fmt.Sprintf("cannot include %s, valid relations are: %s", relationKey(relation), validRelations),
+				},
+			})
 		}
+
+		query = query.Relation(relation)
+		alreadyAppliedRelations = append(alreadyAppliedRelations, relation)
 	}
 
-	return query
+	return query, nil
+}
+
+// ExecRevisionedUpdate runs the update only on the row still at the given revision. The update must set the next
+// revision. A row changed by someone else since it was read is left as is and fails with a precondition error.
+func ExecRevisionedUpdate(query *bun.UpdateQuery, revisionColumn string, revision int64, resource string) error {
+	result, err := query.Where(revisionColumn+" = ?", revision).Exec(context.Background())
+	if err != nil {
+		return err
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if rowsAffected == 0 {
+		return core.NewPreconditionFailedError(resource + " was modified since it was read")
+	}
+
+	return nil
 }
@@ -0,0 +1,173 @@
+package coredatabase
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/gabrielmrtt/taski/internal/core"
+	"github.com/uptrace/bun"
+	"github.com/uptrace/bun/dialect/pgdialect"
+)
+
+// recordingConnector is a database driver that records the statements it executes and reports a fixed number of
+// affected rows, so queries can be checked without a database.
+type recordingConnector struct {
+	rowsAffected int64
+	statements   []string
+}
+
+func (c *recordingConnector) Connect(context.Context) (driver.Conn, error) {
+	return &recordingConn{connector: c}, nil
+}
+
+func (c *recordingConnector) Driver() driver.Driver {
+	return nil
+}
+
+type recordingConn struct {
+	connector *recordingConnector
+}
+
+func (c *recordingConn) Prepare(string) (driver.Stmt, error) {
+	return nil, errors.New("prepared statements are not supported")
+}
+
+func (c *recordingConn) Close() error {
+	return nil
+}
+
+func (c *recordingConn) Begin() (driver.Tx, error) {
+	return nil, errors.New("transactions are not supported")
+}
+
+func (c *recordingConn) ExecContext(_ context.Context, query string, _ []driver.NamedValue) (driver.Result, error) {
+	c.connector.statements = append(c.connector.statements, query)
+	return driver.RowsAffected(c.connector.rowsAffected), nil
+}
+
+type revisionedRow struct {
+	bun.BaseModel `bun:"table:task,alias:task"`
+
+	InternalId string `bun:"internal_id,pk"`
+	Name       string `bun:"name"`
+	Revision   int64  `bun:"revision"`
+}
+
+func TestExecRevisionedUpdate(t *testing.T) {
+	tests := []struct {
+		name         string
+		rowsAffected int64
+		valid        bool
+	}{
+		{name: "row at the revision", rowsAffected: 1, valid: true},
+		{name: "row changed since read", rowsAffected: 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			connector := &recordingConnector{rowsAffected: tt.rowsAffected}
+			db := bun.NewDB(sql.OpenDB(connector), pgdialect.New())
+			defer db.Close()
+
+			row := &revisionedRow{InternalId: "row-id", Name: "renamed", Revision: 4}
+			query := db.NewUpdate().Model(row).Where("task.internal_id = ?", row.InternalId)
+
+			err := ExecRevisionedUpdate(query, "task.revision", 3, "task")
+
+			if len(connector.statements) != 1 {
+				t.Fatalf("expected one statement, got %d", len(connector.statements))
+			}
+
+			if !strings.Contains(connector.statements[0], `"revision" = 4`) || !strings.HasSuffix(connector.statements[0], "AND (task.revision = 3)") {
+				t.Errorf("expected the update to set the next revision only on the read one, got %s", connector.statements[0])
+			}
+
+			if tt.valid {
+				if err != nil {
+					t.Errorf("unexpected error: %v", err)
+				}
+
+				return
+			}
+
+			var preconditionFailedError *core.PreconditionFailedError
+			if !errors.As(err, &preconditionFailedError) {
+				t.Errorf("expected a precondition failed error, got %v", err)
+			}
+		})
+	}
+}
+
+func TestApplyOffsetPagination(t *testing.T) {
+	withTotal := true
+	withoutTotal := false
+	page := 3
+	perPage := 20
+
+	tests := []struct {
+		name       string
+		pagination core.PaginationInput
+		expected   string
+	}{
+		{name: "defaults", pagination: core.PaginationInput{}, expected: "LIMIT 10"},
+		{name: "counted page", pagination: core.PaginationInput{Page: &page, PerPage: &perPage}, expected: "LIMIT 20 OFFSET 40"},
+		{name: "explicitly counted", pagination: core.PaginationInput{Page: &page, PerPage: &perPage, WithTotal: &withTotal}, expected: "LIMIT 20 OFFSET 40"},
+		{name: "uncounted page selects an extra row", pagination: core.PaginationInput{Page: &page, PerPage: &perPage, WithTotal: &withoutTotal}, expected: "LIMIT 21 OFFSET 40"},
+	}
+
+	db := bun.NewDB(sql.OpenDB(&recordingConnector{}), pgdialect.New())
+	defer db.Close()
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			query := ApplyOffsetPagination(db.NewSelect().Model((*revisionedRow)(nil)), tt.pagination).String()
+			if !strings.HasSuffix(query, tt.expected) {
+				t.Errorf("expected the query to end with %s, got %s", tt.expected, query)
+			}
+		})
+	}
+}
+
+func TestNewOffsetPaginationOutput(t *testing.T) {
+	withoutTotal := false
+	page := 2
+	perPage := 2
+
+	tests := []struct {
+		name       string
+		data       []int
+		pagination core.PaginationInput
+		total      int
+		expected   []int
+		hasMore    bool
+	}{
+		{name: "counted with more pages", data: []int{3, 4}, pagination: core.PaginationInput{Page: &page, PerPage: &perPage}, total: 5, expected: []int{3, 4}, hasMore: true},
+		{name: "counted last page", data: []int{3, 4}, pagination: core.PaginationInput{Page: &page, PerPage: &perPage}, total: 4, expected: []int{3, 4}},
+		{name: "uncounted with extra row", data: []int{3, 4, 5}, pagination: core.PaginationInput{Page: &page, PerPage: &perPage, WithTotal: &withoutTotal}, expected: []int{3, 4}, hasMore: true},
+		{name: "uncounted last page", data: []int{3, 4}, pagination: core.PaginationInput{Page: &page, PerPage: &perPage, WithTotal: &withoutTotal}, expected: []int{3, 4}},
+		{name: "uncounted empty page", data: []int{}, pagination: core.PaginationInput{Page: &page, PerPage: &perPage, WithTotal: &withoutTotal}, expected: []int{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			output := NewOffsetPaginationOutput(tt.data, tt.pagination, tt.total)
+
+			if fmt.Sprint(output.Data) != fmt.Sprint(tt.expected) {
+				t.Errorf("expected %v, got %v", tt.expected, output.Data)
+			}
+
+			if output.HasMore != tt.hasMore {
+				t.Errorf("expected has more %t, got %t", tt.hasMore, output.HasMore)
+			}
+
+			if output.Total != tt.total || output.Page != page {
+				t.Errorf("expected page %d of total %d, got page %d of total %d", page, tt.total, output.Page, output.Total)
+			}
+		})
+	}
+}
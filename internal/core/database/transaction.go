@@ -26,6 +26,22 @@ func (t *TransactionBun) IsClosed() bool {
 	return t.closed
 }
 
+// Savepoint marks a point of the transaction that RollbackToSavepoint can undo the later writes back to
+func (t *TransactionBun) Savepoint(name string) error {
+	_, err := t.Tx.ExecContext(context.Background(), "SAVEPOINT ?", bun.Ident(name))
+	return err
+}
+
+func (t *TransactionBun) RollbackToSavepoint(name string) error {
+	_, err := t.Tx.ExecContext(context.Background(), "ROLLBACK TO SAVEPOINT ?", bun.Ident(name))
+	return err
+}
+
+func (t *TransactionBun) ReleaseSavepoint(name string) error {
+	_, err := t.Tx.ExecContext(context.Background(), "RELEASE SAVEPOINT ?", bun.Ident(name))
+	return err
+}
+
 type TransactionBunRepository struct {
 	db *bun.DB
 }
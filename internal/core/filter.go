@@ -0,0 +1,671 @@
+package core
+
+import (
+	"fmt"
+	"math"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+
+	"github.com/gabrielmrtt/taski/pkg/datetimeutils"
+)
+
+/*
+Filter expressions are the small boolean language accepted by the filter query parameter of list endpoints, e.g.
+
+	priority>=3 and (status in [pts_a, pts_b] or due<now+7d)
+
+Comparisons are joined with and, or and not, grouped with parentheses and compare an allowed field with a value.
+Values are numbers, quoted or bare strings, null, lists in brackets and dates written as now, now+7d, now-2h,
+2006-01-02 or RFC3339. "!=", "!~" and "not in" also match fields without a value.
+*/
+
+/*
+Filter expressions are bounded so that a single query string cannot recurse without end or build an unbounded SQL
+condition: by their length, their nesting of parentheses and not, their number of comparisons and the number of values
+of a list.
+*/
+const (
+	MaxFilterExpressionLength = 2000
+	MaxFilterExpressionDepth  = 16
+	MaxFilterExpressionTerms  = 50
+	MaxFilterListValues       = 100
+)
+
+type FilterFieldType string
+
+const (
+	FilterFieldTypeString   FilterFieldType = "string"
+	FilterFieldTypeNumber   FilterFieldType = "number"
+	FilterFieldTypeDate     FilterFieldType = "date"
+	FilterFieldTypeIdentity FilterFieldType = "identity"
+)
+
+type FilterOperator string
+
+const (
+	FilterOperatorEquals             FilterOperator = "="
+	FilterOperatorNotEquals          FilterOperator = "!="
+	FilterOperatorGreaterThan        FilterOperator = ">"
+	FilterOperatorGreaterThanOrEqual FilterOperator = ">="
+	FilterOperatorLessThan           FilterOperator = "<"
+	FilterOperatorLessThanOrEqual    FilterOperator = "<="
+	FilterOperatorContains           FilterOperator = "~"
+	FilterOperatorNotContains        FilterOperator = "!~"
+	FilterOperatorIn                 FilterOperator = "in"
+	FilterOperatorNotIn              FilterOperator = "not in"
+)
+
+var filterOperatorsByFieldType = map[FilterFieldType][]FilterOperator{
+	FilterFieldTypeString: {
+		FilterOperatorEquals, FilterOperatorNotEquals, FilterOperatorContains, FilterOperatorNotContains,
+		FilterOperatorIn, FilterOperatorNotIn,
+	},
+	FilterFieldTypeNumber: {
+		FilterOperatorEquals, FilterOperatorNotEquals, FilterOperatorGreaterThan, FilterOperatorGreaterThanOrEqual,
+		FilterOperatorLessThan, FilterOperatorLessThanOrEqual, FilterOperatorIn, FilterOperatorNotIn,
+	},
+	FilterFieldTypeDate: {
+		FilterOperatorEquals, FilterOperatorNotEquals, FilterOperatorGreaterThan, FilterOperatorGreaterThanOrEqual,
+		FilterOperatorLessThan, FilterOperatorLessThanOrEqual, FilterOperatorIn, FilterOperatorNotIn,
+	},
+	FilterFieldTypeIdentity: {
+		FilterOperatorEquals, FilterOperatorNotEquals, FilterOperatorIn, FilterOperatorNotIn,
+	},
+}
+
+/*
+Negate returns the operator matching exactly the rows the operator does not match.
+*/
+func (o FilterOperator) Negate() FilterOperator {
+	switch o {
+	case FilterOperatorEquals:
+		return FilterOperatorNotEquals
+	case FilterOperatorNotEquals:
+		return FilterOperatorEquals
+	case FilterOperatorGreaterThan:
+		return FilterOperatorLessThanOrEqual
+	case FilterOperatorGreaterThanOrEqual:
+		return FilterOperatorLessThan
+	case FilterOperatorLessThan:
+		return FilterOperatorGreaterThanOrEqual
+	case FilterOperatorLessThanOrEqual:
+		return FilterOperatorGreaterThan
+	case FilterOperatorContains:
+		return FilterOperatorNotContains
+	case FilterOperatorNotContains:
+		return FilterOperatorContains
+	case FilterOperatorIn:
+		return FilterOperatorNotIn
+	case FilterOperatorNotIn:
+		return FilterOperatorIn
+	}
+
+	return o
+}
+
+type FilterValueKind string
+
+const (
+	FilterValueKindString FilterValueKind = "string"
+	FilterValueKindNumber FilterValueKind = "number"
+	FilterValueKindDate   FilterValueKind = "date"
+	FilterValueKindNull   FilterValueKind = "null"
+	FilterValueKindList   FilterValueKind = "list"
+)
+
+/*
+FilterValue is a literal value of a filter expression as written by the client. Bare words are kept as strings and
+only interpreted once the type of the compared field is known.
+*/
+type FilterValue struct {
+	Kind   FilterValueKind
+	Raw    string
+	Number float64
+	Date   int64
+	List   []FilterValue
+}
+
+type FilterExpression interface {
+	isFilterExpression()
+}
+
+type FilterAndExpression struct {
+	Left  FilterExpression
+	Right FilterExpression
+}
+
+type FilterOrExpression struct {
+	Left  FilterExpression
+	Right FilterExpression
+}
+
+type FilterNotExpression struct {
+	Expression FilterExpression
+}
+
+/*
+FilterComparisonExpression compares a field with a value. Values holds the value resolved to the type of the field
+by ResolveFilterExpression; it is empty when comparing with null.
+*/
+type FilterComparisonExpression struct {
+	Field    string
+	Operator FilterOperator
+	Value    FilterValue
+	Values   []interface{}
+	Position int
+}
+
+func (FilterAndExpression) isFilterExpression()         {}
+func (FilterOrExpression) isFilterExpression()          {}
+func (FilterNotExpression) isFilterExpression()         {}
+func (*FilterComparisonExpression) isFilterExpression() {}
+
+/*
+IsNullComparison reports whether the comparison checks that the field has or does not have a value.
+*/
+func (c *FilterComparisonExpression) IsNullComparison() bool {
+	return c.Value.Kind == FilterValueKindNull
+}
+
+func newFilterError(position int, message string) error {
+	return NewInvalidInputError("invalid filter", []InvalidInputErrorField{
+		{
+			Field: "filter",
+			Error: fmt.Sprintf("%s at position %d", message, position+1),
+		},
+	})
+}
+
+type filterTokenKind int
+
+const (
+	filterTokenEnd filterTokenKind = iota
+	filterTokenWord
+	filterTokenString
+	filterTokenOperator
+	filterTokenLeftParen
+	filterTokenRightParen
+	filterTokenLeftBracket
+	filterTokenRightBracket
+	filterTokenComma
+)
+
+type filterToken struct {
+	kind     filterTokenKind
+	value    string
+	position int
+}
+
+func isFilterWordRune(r rune) bool {
+	return unicode.IsLetter(r) || unicode.IsDigit(r) || strings.ContainsRune("_.:+-", r)
+}
+
+func tokenizeFilterExpression(input string) ([]filterToken, error) {
+	var tokens []filterToken = make([]filterToken, 0)
+	var runes []rune = []rune(input)
+
+	for i := 0; i < len(runes); {
+		r := runes[i]
+
+		switch {
+		case unicode.IsSpace(r):
+			i++
+		case r == '(':
+			tokens = append(tokens, filterToken{kind: filterTokenLeftParen, value: "(", position: i})
+			i++
+		case r == ')':
+			tokens = append(tokens, filterToken{kind: filterTokenRightParen, value: ")", position: i})
+			i++
+		case r == '[':
+			tokens = append(tokens, filterToken{kind: filterTokenLeftBracket, value: "[", position: i})
+			i++
+		case r == ']':
+			tokens = append(tokens, filterToken{kind: filterTokenRightBracket, value: "]", position: i})
+			i++
+		case r == ',':
+			tokens = append(tokens, filterToken{kind: filterTokenComma, value: ",", position: i})
+			i++
+		case r == '=' || r == '~':
+			tokens = append(tokens, filterToken{kind: filterTokenOperator, value: string(r), position: i})
+			i++
+		case r == '!' || r == '<' || r == '>':
+			if i+1 < len(runes) && (runes[i+1] == '=' || (r == '!' && runes[i+1] == '~')) {
+				tokens = append(tokens, filterToken{kind: filterTokenOperator, value: string(runes[i : i+2]), position: i})
+				i += 2
+				continue
+			}
+
+			if r == '!' {
+				return nil, newFilterError(i, "unexpected character !")
+			}
+
+			tokens = append(tokens, filterToken{kind: filterTokenOperator, value: string(r), position: i})
+			i++
+		case r == '"' || r == '\'':
+			start := i
+			var value strings.Builder
+			i++
+			for i < len(runes) && runes[i] != r {
+				if runes[i] == '\\' && i+1 < len(runes) {
+					i++
+				}
+				value.WriteRune(runes[i])
+				i++
+			}
+
+			if i >= len(runes) {
+				return nil, newFilterError(start, "unterminated string")
+			}
+
+			tokens = append(tokens, filterToken{kind: filterTokenString, value: value.String(), position: start})
+			i++
+		case isFilterWordRune(r):
+			start := i
+			for i < len(runes) && isFilterWordRune(runes[i]) {
+				i++
+			}
+
+			tokens = append(tokens, filterToken{kind: filterTokenWord, value: string(runes[start:i]), position: start})
+		default:
+			return nil, newFilterError(i, fmt.Sprintf("unexpected character %c", r))
+		}
+	}
+
+	tokens = append(tokens, filterToken{kind: filterTokenEnd, position: len(runes)})
+	return tokens, nil
+}
+
+type filterParser struct {
+	tokens  []filterToken
+	current int
+	depth   int
+	terms   int
+}
+
+func (p *filterParser) enter(token filterToken) error {
+	p.depth++
+	if p.depth > MaxFilterExpressionDepth {
+		return newFilterError(token.position, fmt.Sprintf("filter cannot be nested more than %d levels deep", MaxFilterExpressionDepth))
+	}
+
+	return nil
+}
+
+func (p *filterParser) leave() {
+	p.depth--
+}
+
+func (p *filterParser) peek() filterToken {
+	return p.tokens[p.current]
+}
+
+func (p *filterParser) next() filterToken {
+	token := p.tokens[p.current]
+	if token.kind != filterTokenEnd {
+		p.current++
+	}
+
+	return token
+}
+
+func (p *filterParser) isKeyword(token filterToken, keyword string) bool {
+	return token.kind == filterTokenWord && strings.EqualFold(token.value, keyword)
+}
+
+func (p *filterParser) unexpected(token filterToken) error {
+	if token.kind == filterTokenEnd {
+		return newFilterError(token.position, "unexpected end of filter")
+	}
+
+	return newFilterError(token.position, "unexpected "+token.value)
+}
+
+func (p *filterParser) parseOr() (FilterExpression, error) {
+	left, err := p.parseAnd()
+	if err != nil {
+		return nil, err
+	}
+
+	for p.isKeyword(p.peek(), "or") {
+		p.next()
+		right, err := p.parseAnd()
+		if err != nil {
+			return nil, err
+		}
+
+		left = FilterOrExpression{Left: left, Right: right}
+	}
+
+	return left, nil
+}
+
+func (p *filterParser) parseAnd() (FilterExpression, error) {
+	left, err := p.parseUnary()
+	if err != nil {
+		return nil, err
+	}
+
+	for p.isKeyword(p.peek(), "and") {
+		p.next()
+		right, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+
+		left = FilterAndExpression{Left: left, Right: right}
+	}
+
+	return left, nil
+}
+
+func (p *filterParser) parseUnary() (FilterExpression, error) {
+	token := p.peek()
+
+	if p.isKeyword(token, "not") {
+		p.next()
+		if err := p.enter(token); err != nil {
+			return nil, err
+		}
+		defer p.leave()
+
+		expression, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+
+		return FilterNotExpression{Expression: expression}, nil
+	}
+
+	if token.kind == filterTokenLeftParen {
+		p.next()
+		if err := p.enter(token); err != nil {
+			return nil, err
+		}
+		defer p.leave()
+
+		expression, err := p.parseOr()
+		if err != nil {
+			return nil, err
+		}
+
+		if closing := p.next(); closing.kind != filterTokenRightParen {
+			return nil, p.unexpected(closing)
+		}
+
+		return expression, nil
+	}
+
+	return p.parseComparison()
+}
+
+func (p *filterParser) parseComparison() (FilterExpression, error) {
+	field := p.next()
+	if field.kind != filterTokenWord {
+		return nil, p.unexpected(field)
+	}
+
+	p.terms++
+	if p.terms > MaxFilterExpressionTerms {
+		return nil, newFilterError(field.position, fmt.Sprintf("filter cannot have more than %d comparisons", MaxFilterExpressionTerms))
+	}
+
+	var operator FilterOperator
+	token := p.next()
+
+	switch {
+	case token.kind == filterTokenOperator:
+		operator = FilterOperator(token.value)
+	case p.isKeyword(token, "in"):
+		operator = FilterOperatorIn
+	case p.isKeyword(token, "not") && p.isKeyword(p.peek(), "in"):
+		p.next()
+		operator = FilterOperatorNotIn
+	default:
+		return nil, p.unexpected(token)
+	}
+
+	var value FilterValue
+	var err error
+	if operator == FilterOperatorIn || operator == FilterOperatorNotIn {
+		value, err = p.parseList()
+	} else {
+		value, err = p.parseValue()
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return &FilterComparisonExpression{
+		Field:    field.value,
+		Operator: operator,
+		Value:    value,
+		Position: field.position,
+	}, nil
+}
+
+func (p *filterParser) parseList() (FilterValue, error) {
+	if token := p.next(); token.kind != filterTokenLeftBracket {
+		return FilterValue{}, p.unexpected(token)
+	}
+
+	var list []FilterValue = make([]FilterValue, 0)
+	for {
+		value, err := p.parseValue()
+		if err != nil {
+			return FilterValue{}, err
+		}
+
+		if value.Kind == FilterValueKindNull {
+			return FilterValue{}, newFilterError(p.tokens[p.current-1].position, "lists cannot contain null")
+		}
+
+		list = append(list, value)
+		if len(list) > MaxFilterListValues {
+			return FilterValue{}, newFilterError(p.tokens[p.current-1].position, fmt.Sprintf("lists cannot have more than %d values", MaxFilterListValues))
+		}
+
+		token := p.next()
+		if token.kind == filterTokenRightBracket {
+			break
+		}
+
+		if token.kind != filterTokenComma {
+			return FilterValue{}, p.unexpected(token)
+		}
+	}
+
+	return FilterValue{Kind: FilterValueKindList, List: list}, nil
+}
+
+var filterRelativeDateRegexp = regexp.MustCompile(`^now(?:([+-])(\d+)([mhdw]))?$`)
+
+func (p *filterParser) parseValue() (FilterValue, error) {
+	token := p.next()
+
+	if token.kind == filterTokenString {
+		return FilterValue{Kind: FilterValueKindString, Raw: token.value}, nil
+	}
+
+	if token.kind != filterTokenWord {
+		return FilterValue{}, p.unexpected(token)
+	}
+
+	if strings.EqualFold(token.value, "null") {
+		return FilterValue{Kind: FilterValueKindNull, Raw: token.value}, nil
+	}
+
+	if number, err := strconv.ParseFloat(token.value, 64); err == nil && !math.IsInf(number, 0) && !math.IsNaN(number) {
+		return FilterValue{Kind: FilterValueKindNumber, Raw: token.value, Number: number}, nil
+	}
+
+	if matches := filterRelativeDateRegexp.FindStringSubmatch(strings.ToLower(token.value)); matches != nil {
+		var date int64 = datetimeutils.EpochNow()
+		if matches[1] != "" {
+			amount, err := strconv.ParseInt(matches[2], 10, 64)
+			if err != nil {
+				return FilterValue{}, newFilterError(token.position, "invalid date "+token.value)
+			}
+
+			units := map[string]int64{"m": 60, "h": 3600, "d": 86400, "w": 604800}
+			offset := amount * units[matches[3]]
+			if matches[1] == "-" {
+				offset = -offset
+			}
+
+			date = date + offset
+		}
+
+		return FilterValue{Kind: FilterValueKindDate, Raw: token.value, Date: date}, nil
+	}
+
+	if parsedTime, err := time.Parse(time.DateOnly, token.value); err == nil {
+		return FilterValue{Kind: FilterValueKindDate, Raw: token.value, Date: datetimeutils.TimeToEpoch(parsedTime)}, nil
+	}
+
+	if datetimeutils.IsValidRFC3339(token.value) {
+		return FilterValue{Kind: FilterValueKindDate, Raw: token.value, Date: datetimeutils.RFC3339ToEpoch(token.value)}, nil
+	}
+
+	return FilterValue{Kind: FilterValueKindString, Raw: token.value}, nil
+}
+
+/*
+ParseFilterExpression parses a filter expression into its syntax tree. An empty expression returns nil.
+*/
+func ParseFilterExpression(input string) (FilterExpression, error) {
+	if len([]rune(input)) > MaxFilterExpressionLength {
+		return nil, newFilterError(MaxFilterExpressionLength, fmt.Sprintf("filter cannot be longer than %d characters", MaxFilterExpressionLength))
+	}
+
+	tokens, err := tokenizeFilterExpression(input)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(tokens) == 1 {
+		return nil, nil
+	}
+
+	parser := &filterParser{tokens: tokens}
+	expression, err := parser.parseOr()
+	if err != nil {
+		return nil, err
+	}
+
+	if token := parser.peek(); token.kind != filterTokenEnd {
+		return nil, parser.unexpected(token)
+	}
+
+	return expression, nil
+}
+
+func resolveFilterValue(comparison *FilterComparisonExpression, fieldType FilterFieldType, value FilterValue) (interface{}, error) {
+	invalidValueError := newFilterError(comparison.Position, fmt.Sprintf("invalid %s value %s for %s", fieldType, value.Raw, comparison.Field))
+
+	switch fieldType {
+	case FilterFieldTypeString:
+		return value.Raw, nil
+	case FilterFieldTypeNumber:
+		if value.Kind != FilterValueKindNumber {
+			return nil, invalidValueError
+		}
+
+		if value.Number == math.Trunc(value.Number) {
+			return int64(value.Number), nil
+		}
+
+		return value.Number, nil
+	case FilterFieldTypeDate:
+		if value.Kind == FilterValueKindNumber && value.Number == math.Trunc(value.Number) {
+			return int64(value.Number), nil
+		}
+
+		if value.Kind != FilterValueKindDate {
+			return nil, invalidValueError
+		}
+
+		return value.Date, nil
+	case FilterFieldTypeIdentity:
+		identity := NewIdentityFromPublic(value.Raw)
+		if value.Kind != FilterValueKindString || identity.Public == "" {
+			return nil, invalidValueError
+		}
+
+		return identity.Internal.String(), nil
+	}
+
+	return nil, invalidValueError
+}
+
+/*
+ResolveFilterExpression validates the expression against the fields it is allowed to filter by and resolves the
+values of each comparison to the type of its field. Identities are resolved to their internal ids and dates to
+epoch seconds.
+*/
+func ResolveFilterExpression(expression FilterExpression, fields map[string]FilterFieldType) error {
+	switch e := expression.(type) {
+	case nil:
+		return nil
+	case FilterAndExpression:
+		if err := ResolveFilterExpression(e.Left, fields); err != nil {
+			return err
+		}
+
+		return ResolveFilterExpression(e.Right, fields)
+	case FilterOrExpression:
+		if err := ResolveFilterExpression(e.Left, fields); err != nil {
+			return err
+		}
+
+		return ResolveFilterExpression(e.Right, fields)
+	case FilterNotExpression:
+		return ResolveFilterExpression(e.Expression, fields)
+	case *FilterComparisonExpression:
+		fieldType, ok := fields[e.Field]
+		if !ok {
+			var keys []string = make([]string, 0, len(fields))
+			for key := range fields {
+				keys = append(keys, key)
+			}
+
+			slices.Sort(keys)
+			return newFilterError(e.Position, fmt.Sprintf("cannot filter by %s, valid fields are: %s", e.Field, strings.Join(keys, ", ")))
+		}
+
+		if !slices.Contains(filterOperatorsByFieldType[fieldType], e.Operator) {
+			return newFilterError(e.Position, fmt.Sprintf("operator %s cannot be used with %s", e.Operator, e.Field))
+		}
+
+		if e.IsNullComparison() {
+			if e.Operator != FilterOperatorEquals && e.Operator != FilterOperatorNotEquals {
+				return newFilterError(e.Position, fmt.Sprintf("%s can only be compared with null using = or !=", e.Field))
+			}
+
+			e.Values = []interface{}{}
+			return nil
+		}
+
+		var values []FilterValue = []FilterValue{e.Value}
+		if e.Value.Kind == FilterValueKindList {
+			values = e.Value.List
+		}
+
+		e.Values = make([]interface{}, 0, len(values))
+		for _, value := range values {
+			resolved, err := resolveFilterValue(e, fieldType, value)
+			if err != nil {
+				return err
+			}
+
+			e.Values = append(e.Values, resolved)
+		}
+
+		return nil
+	}
+
+	return nil
+}
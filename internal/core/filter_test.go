@@ -0,0 +1,214 @@
+package core
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+)
+
+func formatFilterExpression(expression FilterExpression) string {
+	switch e := expression.(type) {
+	case nil:
+		return ""
+	case FilterAndExpression:
+		return fmt.Sprintf("(and %s %s)", formatFilterExpression(e.Left), formatFilterExpression(e.Right))
+	case FilterOrExpression:
+		return fmt.Sprintf("(or %s %s)", formatFilterExpression(e.Left), formatFilterExpression(e.Right))
+	case FilterNotExpression:
+		return fmt.Sprintf("(not %s)", formatFilterExpression(e.Expression))
+	case *FilterComparisonExpression:
+		if e.Value.Kind == FilterValueKindList {
+			var raws []string = make([]string, 0, len(e.Value.List))
+			for _, value := range e.Value.List {
+				raws = append(raws, fmt.Sprintf("%s:%s", value.Kind, value.Raw))
+			}
+
+			return fmt.Sprintf("(%s %s [%s])", e.Operator, e.Field, strings.Join(raws, " "))
+		}
+
+		return fmt.Sprintf("(%s %s %s:%s)", e.Operator, e.Field, e.Value.Kind, e.Value.Raw)
+	}
+
+	return "?"
+}
+
+func TestParseFilterExpression(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		expected string
+	}{
+		{name: "empty", input: "   ", expected: ""},
+		{name: "comparison", input: "priority>=3", expected: "(>= priority number:3)"},
+		{name: "quoted string", input: `title~"needs review"`, expected: "(~ title string:needs review)"},
+		{name: "escaped quote", input: `title="it\"s"`, expected: `(= title string:it"s)`},
+		{name: "null", input: "due = null", expected: "(= due null:null)"},
+		{name: "relative date", input: "due<now+7d", expected: "(< due date:now+7d)"},
+		{name: "date only", input: "due>2024-01-31", expected: "(> due date:2024-01-31)"},
+		{name: "bare word", input: "status=pts_a", expected: "(= status string:pts_a)"},
+		{name: "in list", input: "status in [pts_a, pts_b]", expected: "(in status [string:pts_a string:pts_b])"},
+		{name: "not in list", input: "priority not in [1,2]", expected: "(not in priority [number:1 number:2])"},
+		{name: "and binds tighter than or", input: "a=1 or b=2 and c=3", expected: "(or (= a number:1) (and (= b number:2) (= c number:3)))"},
+		{name: "parentheses", input: "(a=1 or b=2) and c=3", expected: "(and (or (= a number:1) (= b number:2)) (= c number:3))"},
+		{name: "not", input: "not a=1", expected: "(not (= a number:1))"},
+		{name: "keywords ignore case", input: "a=1 AND NOT b!=2", expected: "(and (= a number:1) (not (!= b number:2)))"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			expression, err := ParseFilterExpression(tt.input)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if got := formatFilterExpression(expression); got != tt.expected {
+				t.Errorf("expected %s, got %s", tt.expected, got)
+			}
+		})
+	}
+}
+
+func TestParseFilterExpressionErrors(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   string
+		message string
+	}{
+		{name: "unexpected character", input: "a=1 & b=2", message: "unexpected character &"},
+		{name: "lone bang", input: "a ! 1", message: "unexpected character !"},
+		{name: "unterminated string", input: `a="open`, message: "unterminated string"},
+		{name: "missing value", input: "a=", message: "unexpected end of filter"},
+		{name: "missing operator", input: "a 1", message: "unexpected 1"},
+		{name: "unclosed parenthesis", input: "(a=1", message: "unexpected end of filter"},
+		{name: "trailing token", input: "a=1 b=2", message: "unexpected b"},
+		{name: "in without list", input: "a in 1", message: "unexpected 1"},
+		{name: "null in list", input: "a in [1, null]", message: "lists cannot contain null"},
+		{name: "too long", input: "a=" + strings.Repeat("x", MaxFilterExpressionLength), message: "cannot be longer than"},
+		{name: "too deep", input: strings.Repeat("(", MaxFilterExpressionDepth+1) + "a=1" + strings.Repeat(")", MaxFilterExpressionDepth+1), message: "cannot be nested more than"},
+		{name: "too many nots", input: strings.Repeat("not ", MaxFilterExpressionDepth+1) + "a=1", message: "cannot be nested more than"},
+		{name: "too many comparisons", input: strings.TrimSuffix(strings.Repeat("a=1 or ", MaxFilterExpressionTerms+1), " or "), message: "cannot have more than"},
+		{name: "too many list values", input: "a in [" + strings.TrimSuffix(strings.Repeat("1,", MaxFilterListValues+1), ",") + "]", message: "lists cannot have more than"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := ParseFilterExpression(tt.input)
+			if err == nil {
+				t.Fatal("expected an error")
+			}
+
+			var invalidInputError *InvalidInputError
+			if !errors.As(err, &invalidInputError) {
+				t.Fatalf("expected an invalid input error, got %T", err)
+			}
+
+			if len(invalidInputError.Fields) != 1 || !strings.Contains(invalidInputError.Fields[0].Error, tt.message) {
+				t.Errorf("expected an error containing %q, got %v", tt.message, invalidInputError.Fields)
+			}
+		})
+	}
+}
+
+func TestParseFilterExpressionWithinLimits(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+	}{
+		{name: "deepest nesting", input: strings.Repeat("(", MaxFilterExpressionDepth) + "a=1" + strings.Repeat(")", MaxFilterExpressionDepth)},
+		{name: "most comparisons", input: strings.TrimSuffix(strings.Repeat("a=1 or ", MaxFilterExpressionTerms), " or ")},
+		{name: "longest list", input: "a in [" + strings.TrimSuffix(strings.Repeat("1,", MaxFilterListValues), ",") + "]"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := ParseFilterExpression(tt.input); err != nil {
+				t.Errorf("unexpected error: %v", err)
+			}
+		})
+	}
+}
+
+func TestResolveFilterExpression(t *testing.T) {
+	fields := map[string]FilterFieldType{
+		"title":    FilterFieldTypeString,
+		"priority": FilterFieldTypeNumber,
+		"due":      FilterFieldTypeDate,
+		"status":   FilterFieldTypeIdentity,
+	}
+
+	statusIdentity := NewIdentity("pts")
+
+	tests := []struct {
+		name     string
+		input    string
+		expected []interface{}
+		message  string
+	}{
+		{name: "string", input: "title~bug", expected: []interface{}{"bug"}},
+		{name: "integer", input: "priority=3", expected: []interface{}{int64(3)}},
+		{name: "decimal", input: "priority>2.5", expected: []interface{}{2.5}},
+		{name: "epoch date", input: "due>1700000000", expected: []interface{}{int64(1700000000)}},
+		{name: "null", input: "due=null", expected: []interface{}{}},
+		{name: "identity", input: "status=" + statusIdentity.Public, expected: []interface{}{statusIdentity.Internal.String()}},
+		{name: "list", input: "priority in [1, 2]", expected: []interface{}{int64(1), int64(2)}},
+		{name: "unknown field", input: "owner=1", message: "cannot filter by owner, valid fields are: due, priority, status, title"},
+		{name: "operator not allowed", input: "title>1", message: "operator > cannot be used with title"},
+		{name: "null with ordering operator", input: "due>null", message: "due can only be compared with null using = or !="},
+		{name: "number from word", input: "priority=high", message: "invalid number value high for priority"},
+		{name: "date from word", input: "due<tomorrow", message: "invalid date value tomorrow for due"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			expression, err := ParseFilterExpression(tt.input)
+			if err != nil {
+				t.Fatalf("unexpected parse error: %v", err)
+			}
+
+			err = ResolveFilterExpression(expression, fields)
+			if tt.message != "" {
+				var invalidInputError *InvalidInputError
+				if !errors.As(err, &invalidInputError) || !strings.Contains(invalidInputError.Fields[0].Error, tt.message) {
+					t.Fatalf("expected an error containing %q, got %v", tt.message, err)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			comparison := expression.(*FilterComparisonExpression)
+			if fmt.Sprint(comparison.Values) != fmt.Sprint(tt.expected) {
+				t.Errorf("expected values %v, got %v", tt.expected, comparison.Values)
+			}
+		})
+	}
+}
+
+func TestFilterOperatorNegate(t *testing.T) {
+	tests := []struct {
+		operator FilterOperator
+		expected FilterOperator
+	}{
+		{FilterOperatorEquals, FilterOperatorNotEquals},
+		{FilterOperatorGreaterThan, FilterOperatorLessThanOrEqual},
+		{FilterOperatorGreaterThanOrEqual, FilterOperatorLessThan},
+		{FilterOperatorContains, FilterOperatorNotContains},
+		{FilterOperatorIn, FilterOperatorNotIn},
+	}
+
+	for _, tt := range tests {
+		t.Run(string(tt.operator), func(t *testing.T) {
+			if got := tt.operator.Negate(); got != tt.expected {
+				t.Errorf("expected %s, got %s", tt.expected, got)
+			}
+
+			if got := tt.operator.Negate().Negate(); got != tt.operator {
+				t.Errorf("expected negating twice to return %s, got %s", tt.operator, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,131 @@
+package corehttp
+
+import (
+	"encoding/json"
+	"reflect"
+	"slices"
+	"strings"
+
+	"github.com/gabrielmrtt/taski/internal/core"
+)
+
+type SparseFieldset struct {
+	Fields []string
+}
+
+// dtoFields returns the json names of the attributes of a DTO type
+func dtoFields(dtoType reflect.Type) []string {
+	var fields []string = make([]string, 0)
+
+	for dtoType.Kind() == reflect.Pointer {
+		dtoType = dtoType.Elem()
+	}
+
+	if dtoType.Kind() != reflect.Struct {
+		return fields
+	}
+
+	for i := 0; i < dtoType.NumField(); i++ {
+		name := strings.Split(dtoType.Field(i).Tag.Get("json"), ",")[0]
+		if name == "" || name == "-" {
+			continue
+		}
+
+		fields = append(fields, name)
+	}
+
+	return fields
+}
+
+// GetSparseFieldset parses the comma separated fields query parameter against the attributes of the DTO the
+// endpoint returns. The id is always kept. A missing or empty parameter returns nil, which keeps every attribute.
+func GetSparseFieldset[T any](fields *string) (*SparseFieldset, error) {
+	if fields == nil || strings.TrimSpace(*fields) == "" {
+		return nil, nil
+	}
+
+	var validFields []string = dtoFields(reflect.TypeFor[T]())
+	var fieldset *SparseFieldset = &SparseFieldset{Fields: make([]string, 0)}
+
+	if slices.Contains(validFields, "id") {
+		fieldset.Fields = append(fieldset.Fields, "id")
+	}
+
+	for _, field := range strings.Split(*fields, ",") {
+		field = strings.TrimSpace(field)
+		if field == "" || slices.Contains(fieldset.Fields, field) {
+			continue
+		}
+
+		if !slices.Contains(validFields, field) {
+			return nil, core.NewInvalidInputError("invalid fields", []core.InvalidInputErrorField{
+				{
+					Field: "fields",
+					Error: "unknown field " + field + ", valid fields are: " + strings.Join(validFields, ", "),
+				},
+			})
+		}
+
+		fieldset.Fields = append(fieldset.Fields, field)
+	}
+
+	return fieldset, nil
+}
+
+// Select trims the DTO to the attributes of the fieldset
+func (f *SparseFieldset) Select(dto any) (map[string]json.RawMessage, error) {
+	encoded, err := json.Marshal(dto)
+	if err != nil {
+		return nil, err
+	}
+
+	var attributes map[string]json.RawMessage
+	if err := json.Unmarshal(encoded, &attributes); err != nil {
+		return nil, err
+	}
+
+	var selected map[string]json.RawMessage = make(map[string]json.RawMessage, len(f.Fields))
+	for _, field := range f.Fields {
+		if value, ok := attributes[field]; ok {
+			selected[field] = value
+		}
+	}
+
+	return selected, nil
+}
+
+// SelectFields returns the DTO trimmed to the fieldset, or the DTO itself when there is no fieldset
+func SelectFields[T any](dto *T, fieldset *SparseFieldset) (any, error) {
+	if fieldset == nil {
+		return dto, nil
+	}
+
+	return fieldset.Select(dto)
+}
+
+// SelectPaginationFields returns the paginated DTOs trimmed to the fieldset, or the output itself when there is no
+// fieldset
+func SelectPaginationFields[T any](output *core.PaginationOutput[T], fieldset *SparseFieldset) (any, error) {
+	if fieldset == nil {
+		return output, nil
+	}
+
+	var data []map[string]json.RawMessage = make([]map[string]json.RawMessage, len(output.Data))
+	for i, dto := range output.Data {
+		selected, err := fieldset.Select(dto)
+		if err != nil {
+			return nil, err
+		}
+
+		data[i] = selected
+	}
+
+	return &core.PaginationOutput[map[string]json.RawMessage]{
+		Data:       data,
+		Page:       output.Page,
+		HasMore:    output.HasMore,
+		Total:      output.Total,
+		NextCursor: output.NextCursor,
+		PrevCursor: output.PrevCursor,
+	}, nil
+}
@@ -1,10 +1,12 @@
 package corehttp
 
 import (
+	"strconv"
 	"strings"
 
 	"github.com/gabrielmrtt/taski/internal/core"
 	"github.com/gabrielmrtt/taski/pkg/stringutils"
+	"github.com/gin-gonic/gin"
 )
 
 type HttpRequest[INPUT core.ServiceInput] interface {
@@ -29,3 +31,37 @@ func GetRelationsInput(relations *string) core.RelationsInput {
 
 	return relationsInput
 }
+
+/*
+GetIfMatchRevision returns the revision a client expects a resource to be at from the If-Match header, which holds the
+ETag the resource was read with. There is no expected revision without the header or with *. Weak and unknown entity
+tags never match the resource, so they fail the precondition.
+*/
+func GetIfMatchRevision(gc *gin.Context) (*int64, error) {
+	header := strings.TrimSpace(gc.GetHeader("If-Match"))
+	if header == "" || header == "*" {
+		return nil, nil
+	}
+
+	tags := strings.Split(header, ",")
+	if len(tags) > 1 {
+		return nil, core.NewInvalidInputError("invalid If-Match header", []core.InvalidInputErrorField{
+			{
+				Field: "If-Match",
+				Error: "If-Match must hold a single entity tag",
+			},
+		})
+	}
+
+	tag := strings.TrimSpace(tags[0])
+	if len(tag) < 2 || !strings.HasPrefix(tag, `"`) || !strings.HasSuffix(tag, `"`) {
+		return nil, core.NewPreconditionFailedError("If-Match does not match the resource")
+	}
+
+	revision, err := strconv.ParseInt(tag[1:len(tag)-1], 10, 64)
+	if err != nil {
+		return nil, core.NewPreconditionFailedError("If-Match does not match the resource")
+	}
+
+	return &revision, nil
+}
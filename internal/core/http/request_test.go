@@ -0,0 +1,84 @@
+package corehttp
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gabrielmrtt/taski/internal/core"
+	"github.com/gin-gonic/gin"
+)
+
+func TestGetIfMatchRevision(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	tests := []struct {
+		name     string
+		header   string
+		revision *int64
+		err      error
+	}{
+		{name: "no header"},
+		{name: "any revision", header: "*"},
+		{name: "revision", header: `"3"`, revision: ptr(int64(3))},
+		{name: "revision with spaces", header: ` "12" `, revision: ptr(int64(12))},
+		{name: "weak tag", header: `W/"3"`, err: &core.PreconditionFailedError{}},
+		{name: "unquoted", header: "3", err: &core.PreconditionFailedError{}},
+		{name: "unknown tag", header: `"abc"`, err: &core.PreconditionFailedError{}},
+		{name: "several tags", header: `"3", "4"`, err: &core.InvalidInputError{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gc, _ := gin.CreateTestContext(httptest.NewRecorder())
+			gc.Request = httptest.NewRequest(http.MethodPatch, "/tasks/tsk_1", nil)
+			if tt.header != "" {
+				gc.Request.Header.Set("If-Match", tt.header)
+			}
+
+			revision, err := GetIfMatchRevision(gc)
+
+			switch tt.err.(type) {
+			case nil:
+				if err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+			case *core.PreconditionFailedError:
+				var preconditionFailedError *core.PreconditionFailedError
+				if !errors.As(err, &preconditionFailedError) {
+					t.Fatalf("expected a precondition failed error, got %v", err)
+				}
+			case *core.InvalidInputError:
+				var invalidInputError *core.InvalidInputError
+				if !errors.As(err, &invalidInputError) {
+					t.Fatalf("expected an invalid input error, got %v", err)
+				}
+			}
+
+			if (revision == nil) != (tt.revision == nil) || (revision != nil && *revision != *tt.revision) {
+				t.Errorf("expected revision %v, got %v", tt.revision, revision)
+			}
+		})
+	}
+}
+
+func TestSetETag(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	recorder := httptest.NewRecorder()
+	gc, _ := gin.CreateTestContext(recorder)
+	gc.Request = httptest.NewRequest(http.MethodGet, "/tasks/tsk_1", nil)
+
+	SetETag(gc, 7)
+	gc.Request.Header.Set("If-Match", recorder.Header().Get("ETag"))
+
+	revision, err := GetIfMatchRevision(gc)
+	if err != nil || revision == nil || *revision != 7 {
+		t.Errorf("expected the ETag to round trip to revision 7, got %v, %v", revision, err)
+	}
+}
+
+func ptr[T any](value T) *T {
+	return &value
+}
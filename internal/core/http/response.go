@@ -2,6 +2,7 @@ package corehttp
 
 import (
 	"net/http"
+	"strconv"
 	"time"
 
 	"github.com/gabrielmrtt/taski/internal/core"
@@ -31,6 +32,11 @@ func NewHttpSuccessResponseWithData[T any](gc *gin.Context, status int, data *T)
 	gc.JSON(status, res)
 }
 
+// SetETag sends the revision of the resource in the response as its ETag, for clients to send back in If-Match
+func SetETag(gc *gin.Context, revision int64) {
+	gc.Header("ETag", `"`+strconv.FormatInt(revision, 10)+`"`)
+}
+
 type EmptyHttpSuccessResponse struct {
 	Status    int    `json:"status"`
 	Resource  string `json:"resource"`
@@ -74,6 +80,10 @@ func NewHttpErrorResponse(gc *gin.Context, err error) {
 		status = http.StatusConflict
 		message = e.Error()
 		errors = nil
+	case *core.ConflictError:
+		status = http.StatusConflict
+		message = e.Error()
+		errors = nil
 	case *core.InvalidInputError:
 		status = http.StatusBadRequest
 		message = e.Error()
@@ -82,6 +92,10 @@ func NewHttpErrorResponse(gc *gin.Context, err error) {
 		status = http.StatusForbidden
 		message = e.Error()
 		errors = nil
+	case *core.ForbiddenError:
+		status = http.StatusForbidden
+		message = e.Error()
+		errors = nil
 	case *core.UnauthenticatedError:
 		status = http.StatusUnauthorized
 		message = e.Error()
@@ -94,6 +108,10 @@ func NewHttpErrorResponse(gc *gin.Context, err error) {
 		status = http.StatusNotFound
 		message = e.Error()
 		errors = nil
+	case *core.PreconditionFailedError:
+		status = http.StatusPreconditionFailed
+		message = e.Error()
+		errors = nil
 	default:
 		status = http.StatusInternalServerError
 		message = e.Error()
@@ -18,9 +18,29 @@ type SortInput struct {
 	Direction *SortDirection
 }
 
+/*
+PaginationInput selects offset pagination through Page or keyset pagination through the After and Before cursors.
+WithTotal controls whether the total of items is counted, which is the default only in offset mode. Pages report
+whether there are more items either way.
+*/
 type PaginationInput struct {
-	Page    *int
-	PerPage *int
+	Page      *int
+	PerPage   *int
+	After     *string
+	Before    *string
+	WithTotal *bool
+}
+
+func (p PaginationInput) IsCursor() bool {
+	return p.After != nil || p.Before != nil
+}
+
+func (p PaginationInput) ShouldCount() bool {
+	if p.WithTotal != nil {
+		return *p.WithTotal
+	}
+
+	return !p.IsCursor()
 }
 
 type ComparableFilter[T any] struct {
@@ -1,16 +1,18 @@
 package core
 
 type PaginationOutput[T any] struct {
-	Data    []T  `json:"data"`
-	Page    int  `json:"page"`
-	HasMore bool `json:"hasMore"`
-	Total   int  `json:"total"`
+	Data       []T     `json:"data"`
+	Page       int     `json:"page"`
+	HasMore    bool    `json:"hasMore"`
+	Total      int     `json:"total"`
+	NextCursor *string `json:"nextCursor"`
+	PrevCursor *string `json:"prevCursor"`
 }
 
 func HasMorePages(currentPage int, totalItems int, perPage int) bool {
-	if totalItems <= 0 {
+	if totalItems <= 0 || perPage <= 0 {
 		return false
 	}
 
-	return (totalItems / perPage) > currentPage
+	return currentPage*perPage < totalItems
 }
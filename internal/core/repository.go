@@ -4,6 +4,9 @@ type Transaction interface {
 	Commit() error
 	Rollback() error
 	IsClosed() bool
+	Savepoint(name string) error
+	RollbackToSavepoint(name string) error
+	ReleaseSavepoint(name string) error
 }
 
 type TransactionRepository interface {
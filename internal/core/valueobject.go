@@ -2,11 +2,15 @@ package core
 
 import (
 	"fmt"
+	"html"
 	"math/big"
+	"slices"
 	"strings"
+	"unicode/utf8"
 
 	"github.com/gabrielmrtt/taski/pkg/datetimeutils"
 	"github.com/gabrielmrtt/taski/pkg/encodingutils"
+	"github.com/gabrielmrtt/taski/pkg/markdownutils"
 	"github.com/go-playground/validator/v10"
 	"github.com/google/uuid"
 )
@@ -93,6 +97,22 @@ type Timestamps struct {
 	UpdatedAt *DateTime `json:"updatedAt"`
 }
 
+// InitialRevision is the revision of a resource when it is created, every saved change increments it
+const InitialRevision int64 = 1
+
+/*
+CheckRevision fails with a precondition error when the client changes a resource expecting a revision other than the
+current one, that is when someone else saved the resource since the client read it. A nil expected revision always
+passes.
+*/
+func CheckRevision(expectedRevision *int64, revision int64, resource string) error {
+	if expectedRevision != nil && *expectedRevision != revision {
+		return NewPreconditionFailedError(resource + " was modified since it was read")
+	}
+
+	return nil
+}
+
 type Name struct {
 	Value string
 }
@@ -193,6 +213,120 @@ func IsValidDescription(fl validator.FieldLevel) bool {
 	return err == nil
 }
 
+type ContentFormats string
+
+const (
+	ContentFormatPlainText ContentFormats = "plain_text"
+	ContentFormatMarkdown  ContentFormats = "markdown"
+)
+
+var ContentFormatsArray = []ContentFormats{
+	ContentFormatPlainText,
+	ContentFormatMarkdown,
+}
+
+/*
+DefaultContentFormat is the format of contents written without declaring one.
+*/
+const DefaultContentFormat = ContentFormatMarkdown
+
+/*
+Content is a text written in a declared format. It is stored as written and rendered when read: as sanitized HTML for
+display, and as plain text for search and notifications.
+*/
+type Content struct {
+	Value  string
+	Format ContentFormats
+}
+
+func NewContent(value string, format ContentFormats) (Content, error) {
+	c := Content{Value: value, Format: format}
+
+	if err := c.Validate(); err != nil {
+		return Content{}, err
+	}
+
+	return c, nil
+}
+
+func (c Content) Validate() error {
+	if !slices.Contains(ContentFormatsArray, c.Format) {
+		field := InvalidInputErrorField{
+			Field: "format",
+			Error: "format must be plain_text or markdown",
+		}
+		return NewInvalidInputError("format must be plain_text or markdown", []InvalidInputErrorField{field})
+	}
+
+	if !utf8.ValidString(c.Value) || strings.ContainsRune(c.Value, 0) {
+		field := InvalidInputErrorField{
+			Field: "content",
+			Error: "content must be valid UTF-8 text",
+		}
+		return NewInvalidInputError("content must be valid UTF-8 text", []InvalidInputErrorField{field})
+	}
+
+	return nil
+}
+
+/*
+Html renders the content as HTML that is safe to embed in a page. Plain text is escaped, with blank lines separating
+paragraphs.
+*/
+func (c Content) Html() string {
+	if c.Format == ContentFormatMarkdown {
+		rendered, err := markdownutils.RenderHTML(c.Value)
+		if err == nil {
+			return rendered
+		}
+	}
+
+	var builder strings.Builder
+	for _, paragraph := range strings.Split(strings.ReplaceAll(c.Value, "\r\n", "\n"), "\n\n") {
+		paragraph = strings.Trim(paragraph, "\n")
+		if paragraph == "" {
+			continue
+		}
+
+		builder.WriteString("<p>")
+		builder.WriteString(strings.ReplaceAll(html.EscapeString(paragraph), "\n", "<br>"))
+		builder.WriteString("</p>\n")
+	}
+
+	return builder.String()
+}
+
+func (c Content) PlainText() string {
+	if c.Format == ContentFormatMarkdown {
+		return markdownutils.ExtractText(c.Value)
+	}
+
+	return c.Value
+}
+
+/*
+Excerpt returns the plain text of the content on a single line, cut to maxLength characters.
+*/
+func (c Content) Excerpt(maxLength int) string {
+	excerpt := []rune(strings.Join(strings.Fields(c.PlainText()), " "))
+	if len(excerpt) <= maxLength {
+		return string(excerpt)
+	}
+
+	return strings.TrimSpace(string(excerpt[:maxLength-1])) + "…"
+}
+
+/*
+LinkDestinations returns the URLs linked or embedded in the content. Plain text has none.
+*/
+func (c Content) LinkDestinations() []string {
+	if c.Format == ContentFormatMarkdown {
+		return markdownutils.ExtractLinkDestinations(c.Value)
+	}
+
+	return make([]string, 0)
+}
+
 type Color struct {
 	Value string
 }
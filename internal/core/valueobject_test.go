@@ -0,0 +1,45 @@
+package core
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestCheckRevision(t *testing.T) {
+	current := int64(3)
+	stale := int64(2)
+	ahead := int64(4)
+
+	tests := []struct {
+		name     string
+		expected *int64
+		valid    bool
+	}{
+		{name: "no expected revision", expected: nil, valid: true},
+		{name: "current revision", expected: &current, valid: true},
+		{name: "stale revision", expected: &stale},
+		{name: "revision ahead", expected: &ahead},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := CheckRevision(tt.expected, current, "task")
+			if tt.valid {
+				if err != nil {
+					t.Errorf("unexpected error: %v", err)
+				}
+
+				return
+			}
+
+			var preconditionFailedError *PreconditionFailedError
+			if !errors.As(err, &preconditionFailedError) {
+				t.Fatalf("expected a precondition failed error, got %v", err)
+			}
+
+			if preconditionFailedError.Message != "task was modified since it was read" {
+				t.Errorf("unexpected message %s", preconditionFailedError.Message)
+			}
+		})
+	}
+}
@@ -0,0 +1,20 @@
+package export
+
+/*
+ProjectExportFormatVersion is the version of the project archive layout. It is increased whenever the files of the
+archive or their attributes change in a way an importer has to know about.
+*/
+const ProjectExportFormatVersion = 1
+
+const (
+	ProjectExportManifestFileName              = "manifest.json"
+	ProjectExportProjectFileName               = "project.json"
+	ProjectExportTaskStatusesFileName          = "task-statuses.json"
+	ProjectExportTaskStatusTransitionsFileName = "task-status-transitions.json"
+	ProjectExportTaskCategoriesFileName        = "task-categories.json"
+	ProjectExportTaskCustomFieldsFileName      = "task-custom-fields.json"
+	ProjectExportTasksFileName                 = "tasks.json"
+	ProjectExportDocumentsFileName             = "documents.json"
+	ProjectExportFilesFileName                 = "files.json"
+	ProjectExportFilesDirectory                = "files"
+)
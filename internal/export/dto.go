@@ -0,0 +1,69 @@
+package export
+
+import (
+	"path"
+
+	"github.com/gabrielmrtt/taski/internal/core"
+	"github.com/gabrielmrtt/taski/internal/project"
+	"github.com/gabrielmrtt/taski/internal/storage"
+	"github.com/gabrielmrtt/taski/internal/task"
+)
+
+type ProjectExportManifestDto struct {
+	FormatVersion int      `json:"formatVersion"`
+	ProjectId     string   `json:"projectId"`
+	ExportedById  string   `json:"exportedById"`
+	ExportedAt    string   `json:"exportedAt"`
+	Contents      []string `json:"contents"`
+}
+
+type ProjectExportTaskDto struct {
+	task.TaskDto
+	Comments []*task.TaskCommentDto `json:"comments"`
+	History  []*task.TaskActionDto  `json:"history"`
+}
+
+type ProjectExportDocumentDto struct {
+	Id       string                               `json:"id"`
+	Versions []*project.ProjectDocumentVersionDto `json:"versions"`
+}
+
+type ProjectExportFileDto struct {
+	Id               string  `json:"id"`
+	Name             string  `json:"name"`
+	Path             string  `json:"path"`
+	MimeType         *string `json:"mimeType"`
+	Extension        *string `json:"extension"`
+	UserUploadedById string  `json:"userUploadedById"`
+	UploadedAt       string  `json:"uploadedAt"`
+}
+
+/*
+ProjectExportFilePath returns the path of an uploaded file inside the archive. Files are stored by id so that names
+uploaded more than once do not collide.
+*/
+func ProjectExportFilePath(uploadedFile *storage.UploadedFile) string {
+	var fileName string = uploadedFile.Identity.Public
+	if uploadedFile.FileExtension != nil && *uploadedFile.FileExtension != "" {
+		fileName += "." + *uploadedFile.FileExtension
+	}
+
+	return path.Join(ProjectExportFilesDirectory, fileName)
+}
+
+func ProjectExportFileToDto(uploadedFile *storage.UploadedFile) *ProjectExportFileDto {
+	var name string = ""
+	if uploadedFile.File != nil {
+		name = *uploadedFile.File
+	}
+
+	return &ProjectExportFileDto{
+		Id:               uploadedFile.Identity.Public,
+		Name:             name,
+		Path:             ProjectExportFilePath(uploadedFile),
+		MimeType:         uploadedFile.FileMimeType,
+		Extension:        uploadedFile.FileExtension,
+		UserUploadedById: uploadedFile.UserUploadedByIdentity.Public,
+		UploadedAt:       core.DateTime{Value: uploadedFile.UploadedAt}.ToRFC3339(),
+	}
+}
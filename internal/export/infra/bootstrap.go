@@ -0,0 +1,40 @@
+package exportinfra
+
+import (
+	corehttp "github.com/gabrielmrtt/taski/internal/core/http"
+	exporthttp "github.com/gabrielmrtt/taski/internal/export/infra/http"
+	exportservice "github.com/gabrielmrtt/taski/internal/export/service"
+	projectdatabase "github.com/gabrielmrtt/taski/internal/project/infra/database"
+	storagedatabase "github.com/gabrielmrtt/taski/internal/storage/infra/database"
+	taskdatabase "github.com/gabrielmrtt/taski/internal/task/infra/database"
+	"github.com/gin-gonic/gin"
+	"github.com/uptrace/bun"
+)
+
+type BootstrapInfraOptions struct {
+	RouterGroup  *gin.RouterGroup
+	DbConnection *bun.DB
+}
+
+func BootstrapInfra(options BootstrapInfraOptions) {
+	projectRepository := projectdatabase.NewProjectBunRepository(options.DbConnection)
+	projectTaskStatusRepository := projectdatabase.NewProjectTaskStatusBunRepository(options.DbConnection)
+	projectTaskStatusTransitionRepository := projectdatabase.NewProjectTaskStatusTransitionBunRepository(options.DbConnection)
+	projectTaskCategoryRepository := projectdatabase.NewProjectTaskCategoryBunRepository(options.DbConnection)
+	projectTaskCustomFieldRepository := projectdatabase.NewProjectTaskCustomFieldBunRepository(options.DbConnection)
+	projectDocumentRepository := projectdatabase.NewProjectDocumentBunRepository(options.DbConnection)
+	taskRepository := taskdatabase.NewTaskBunRepository(options.DbConnection)
+	taskCommentRepository := taskdatabase.NewTaskCommentBunRepository(options.DbConnection)
+	taskActionRepository := taskdatabase.NewTaskActionBunRepository(options.DbConnection)
+	uploadedFileRepository := storagedatabase.NewUploadedFileBunRepository(options.DbConnection)
+	storageRepository := storagedatabase.NewLocalStorageRepository()
+
+	exportProjectService := exportservice.NewExportProjectService(projectRepository, projectTaskStatusRepository, projectTaskStatusTransitionRepository, projectTaskCategoryRepository, projectTaskCustomFieldRepository, projectDocumentRepository, taskRepository, taskCommentRepository, taskActionRepository, uploadedFileRepository, storageRepository)
+
+	exportHandler := exporthttp.NewExportHandler(exportProjectService)
+
+	exportHandler.ConfigureRoutes(corehttp.ConfigureRoutesOptions{
+		DbConnection: options.DbConnection,
+		RouterGroup:  options.RouterGroup,
+	})
+}
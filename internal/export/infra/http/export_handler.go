@@ -0,0 +1,100 @@
+package exporthttp
+
+import (
+	"io"
+	"mime"
+	"net/http"
+	"os"
+
+	authhttpmiddlewares "github.com/gabrielmrtt/taski/internal/auth/infra/http/middlewares"
+	"github.com/gabrielmrtt/taski/internal/core"
+	corehttp "github.com/gabrielmrtt/taski/internal/core/http"
+	exportservice "github.com/gabrielmrtt/taski/internal/export/service"
+	organizationhttpmiddlewares "github.com/gabrielmrtt/taski/internal/organization/infra/http/middlewares"
+	projecthttpmiddlewares "github.com/gabrielmrtt/taski/internal/project/infra/http/middlewares"
+	"github.com/gin-gonic/gin"
+)
+
+type ExportHandler struct {
+	ExportProjectService *exportservice.ExportProjectService
+}
+
+func NewExportHandler(
+	exportProjectService *exportservice.ExportProjectService,
+) *ExportHandler {
+	return &ExportHandler{
+		ExportProjectService: exportProjectService,
+	}
+}
+
+// ExportProject godoc
+// @Summary Export a project
+// @Description Downloads a zip archive with the project, its task statuses, transitions, categories and custom fields, its tasks with sub-tasks, comments and history, its document versions and the referenced files. Deleted tasks are left out and deleted comments are kept as tombstones without content or files. The manifest.json file of the archive holds the format version.
+// @Tags Export
+// @Param projectId path string true "Project ID"
+// @Produce application/zip
+// @Success 200 {file} file
+// @Failure 400 {object} corehttp.HttpErrorResponse
+// @Failure 401 {object} corehttp.HttpErrorResponse
+// @Failure 403 {object} corehttp.HttpErrorResponse
+// @Failure 404 {object} corehttp.HttpErrorResponse
+// @Failure 500 {object} corehttp.HttpErrorResponse
+// @Router /project/{projectId}/export [get]
+func (h *ExportHandler) ExportProject(c *gin.Context) {
+	var organizationIdentity *core.Identity = authhttpmiddlewares.GetAuthenticatedUserLastAccessedOrganizationIdentity(c)
+	var authenticatedUserIdentity *core.Identity = authhttpmiddlewares.GetAuthenticatedUserIdentity(c)
+	var input exportservice.ExportProjectInput = exportservice.ExportProjectInput{
+		OrganizationIdentity: *organizationIdentity,
+		ProjectIdentity:      core.NewIdentityFromPublic(c.Param("projectId")),
+		UserExporterIdentity: *authenticatedUserIdentity,
+	}
+
+	projectExport, err := h.ExportProjectService.Execute(input)
+	if err != nil {
+		corehttp.NewHttpErrorResponse(c, err)
+		return
+	}
+
+	// The archive is built in a temporary file first so a failure still answers with an error instead of a truncated zip
+	archive, err := os.CreateTemp("", "taski-export-*.zip")
+	if err != nil {
+		corehttp.NewHttpErrorResponse(c, err)
+		return
+	}
+	defer os.Remove(archive.Name())
+	defer archive.Close()
+
+	if err := h.ExportProjectService.WriteArchive(projectExport, archive); err != nil {
+		corehttp.NewHttpErrorResponse(c, err)
+		return
+	}
+
+	size, err := archive.Seek(0, io.SeekCurrent)
+	if err != nil {
+		corehttp.NewHttpErrorResponse(c, err)
+		return
+	}
+
+	if _, err := archive.Seek(0, io.SeekStart); err != nil {
+		corehttp.NewHttpErrorResponse(c, err)
+		return
+	}
+
+	c.DataFromReader(http.StatusOK, size, "application/zip", archive, map[string]string{
+		"Content-Disposition": mime.FormatMediaType("attachment", map[string]string{"filename": projectExport.FileName}),
+	})
+}
+
+func (h *ExportHandler) ConfigureRoutes(options corehttp.ConfigureRoutesOptions) *gin.RouterGroup {
+	middlewareOptions := corehttp.MiddlewareOptions{
+		DbConnection: options.DbConnection,
+	}
+
+	g := options.RouterGroup.Group("/project/:projectId/export")
+	{
+		g.Use(authhttpmiddlewares.AuthMiddleware(middlewareOptions))
+		g.GET("", organizationhttpmiddlewares.UserMustHavePermission("projects:view", middlewareOptions), organizationhttpmiddlewares.UserMustHavePermission("tasks:view", middlewareOptions), projecthttpmiddlewares.UserMustBeInProject(middlewareOptions), h.ExportProject)
+	}
+
+	return g
+}
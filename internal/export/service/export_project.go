@@ -0,0 +1,434 @@
+package exportservice
+
+import (
+	"archive/zip"
+	"encoding/json"
+	"io"
+
+	"github.com/gabrielmrtt/taski/internal/core"
+	"github.com/gabrielmrtt/taski/internal/export"
+	"github.com/gabrielmrtt/taski/internal/project"
+	projectrepo "github.com/gabrielmrtt/taski/internal/project/repository"
+	"github.com/gabrielmrtt/taski/internal/storage"
+	storagerepo "github.com/gabrielmrtt/taski/internal/storage/repository"
+	"github.com/gabrielmrtt/taski/internal/task"
+	taskrepo "github.com/gabrielmrtt/taski/internal/task/repository"
+)
+
+const projectExportPageSize = 100
+
+type ExportProjectService struct {
+	ProjectRepository                     projectrepo.ProjectRepository
+	ProjectTaskStatusRepository           projectrepo.ProjectTaskStatusRepository
+	ProjectTaskStatusTransitionRepository projectrepo.ProjectTaskStatusTransitionRepository
+	ProjectTaskCategoryRepository         projectrepo.ProjectTaskCategoryRepository
+	ProjectTaskCustomFieldRepository      projectrepo.ProjectTaskCustomFieldRepository
+	ProjectDocumentRepository             projectrepo.ProjectDocumentRepository
+	TaskRepository                        taskrepo.TaskRepository
+	TaskCommentRepository                 taskrepo.TaskCommentRepository
+	TaskActionRepository                  taskrepo.TaskActionRepository
+	UploadedFileRepository                storagerepo.UploadedFileRepository
+	StorageRepository                     storagerepo.StorageRepository
+}
+
+func NewExportProjectService(
+	projectRepository projectrepo.ProjectRepository,
+	projectTaskStatusRepository projectrepo.ProjectTaskStatusRepository,
+	projectTaskStatusTransitionRepository projectrepo.ProjectTaskStatusTransitionRepository,
+	projectTaskCategoryRepository projectrepo.ProjectTaskCategoryRepository,
+	projectTaskCustomFieldRepository projectrepo.ProjectTaskCustomFieldRepository,
+	projectDocumentRepository projectrepo.ProjectDocumentRepository,
+	taskRepository taskrepo.TaskRepository,
+	taskCommentRepository taskrepo.TaskCommentRepository,
+	taskActionRepository taskrepo.TaskActionRepository,
+	uploadedFileRepository storagerepo.UploadedFileRepository,
+	storageRepository storagerepo.StorageRepository,
+) *ExportProjectService {
+	return &ExportProjectService{
+		ProjectRepository:                     projectRepository,
+		ProjectTaskStatusRepository:           projectTaskStatusRepository,
+		ProjectTaskStatusTransitionRepository: projectTaskStatusTransitionRepository,
+		ProjectTaskCategoryRepository:         projectTaskCategoryRepository,
+		ProjectTaskCustomFieldRepository:      projectTaskCustomFieldRepository,
+		ProjectDocumentRepository:             projectDocumentRepository,
+		TaskRepository:                        taskRepository,
+		TaskCommentRepository:                 taskCommentRepository,
+		TaskActionRepository:                  taskActionRepository,
+		UploadedFileRepository:                uploadedFileRepository,
+		StorageRepository:                     storageRepository,
+	}
+}
+
+type ExportProjectInput struct {
+	OrganizationIdentity core.Identity
+	ProjectIdentity      core.Identity
+	UserExporterIdentity core.Identity
+}
+
+/*
+ProjectExport holds the data of an exported project. The content of the referenced files is only read from the
+storage when the archive is written.
+*/
+type ProjectExport struct {
+	FileName              string
+	Manifest              export.ProjectExportManifestDto
+	Project               *project.ProjectDto
+	TaskStatuses          []*project.ProjectTaskStatusDto
+	TaskStatusTransitions []*project.ProjectTaskStatusTransitionDto
+	TaskCategories        []*project.ProjectTaskCategoryDto
+	TaskCustomFields      []*project.ProjectTaskCustomFieldDto
+	Tasks                 []*export.ProjectExportTaskDto
+	Documents             []*export.ProjectExportDocumentDto
+	Files                 []*export.ProjectExportFileDto
+	uploadedFiles         []*storage.UploadedFile
+}
+
+/*
+addFile references an uploaded file in the export. Files referenced more than once are only exported once and files
+that no longer exist are left out.
+*/
+func (s *ExportProjectService) addFile(projectExport *ProjectExport, fileIdentity core.Identity) error {
+	for _, uploadedFile := range projectExport.uploadedFiles {
+		if uploadedFile.Identity.Equals(fileIdentity) {
+			return nil
+		}
+	}
+
+	uploadedFile, err := s.UploadedFileRepository.GetUploadedFileByIdentity(storagerepo.GetUploadedFileByIdentityParams{FileIdentity: fileIdentity})
+	if err != nil {
+		return err
+	}
+
+	if uploadedFile == nil {
+		return nil
+	}
+
+	projectExport.uploadedFiles = append(projectExport.uploadedFiles, uploadedFile)
+	projectExport.Files = append(projectExport.Files, export.ProjectExportFileToDto(uploadedFile))
+
+	return nil
+}
+
+func (s *ExportProjectService) exportTaskCategories(projectExport *ProjectExport, projectIdentity core.Identity) error {
+	var perPage int = projectExportPageSize
+
+	for page := 1; ; page++ {
+		categories, err := s.ProjectTaskCategoryRepository.PaginateProjectTaskCategoryBy(projectrepo.PaginateProjectTaskCategoryParams{
+			Filters:    projectrepo.ProjectTaskCategoryFilters{ProjectIdentity: &projectIdentity},
+			Pagination: core.PaginationInput{Page: &page, PerPage: &perPage},
+		})
+		if err != nil {
+			return err
+		}
+
+		for _, category := range categories.Data {
+			projectExport.TaskCategories = append(projectExport.TaskCategories, project.ProjectTaskCategoryToDto(&category))
+		}
+
+		if len(categories.Data) < perPage {
+			return nil
+		}
+	}
+}
+
+func (s *ExportProjectService) exportTaskComments(projectExport *ProjectExport, taskIdentity core.Identity) ([]*task.TaskCommentDto, error) {
+	var perPage int = projectExportPageSize
+	var sortBy string = "createdAt"
+	var sortDirection core.SortDirection = core.SortDirectionAsc
+	var comments []*task.TaskCommentDto = make([]*task.TaskCommentDto, 0)
+
+	for page := 1; ; page++ {
+		taskComments, err := s.TaskCommentRepository.PaginateTaskCommentsBy(taskrepo.PaginateTaskCommentsParams{
+			Filters:    taskrepo.TaskCommentFilters{TaskIdentity: &taskIdentity},
+			Pagination: core.PaginationInput{Page: &page, PerPage: &perPage},
+			SortInput:  core.SortInput{By: &sortBy, Direction: &sortDirection},
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		for _, taskComment := range taskComments.Data {
+			// Deleted comments stay as tombstones so replies keep their parent, but their files are not exported
+			if !taskComment.IsDeleted() {
+				for _, file := range taskComment.Files {
+					if err := s.addFile(projectExport, file.FileIdentity); err != nil {
+						return nil, err
+					}
+				}
+			}
+
+			comments = append(comments, task.TaskCommentToDto(&taskComment, nil))
+		}
+
+		if len(taskComments.Data) < perPage {
+			return comments, nil
+		}
+	}
+}
+
+func (s *ExportProjectService) exportTaskHistory(taskIdentity core.Identity) ([]*task.TaskActionDto, error) {
+	var perPage int = projectExportPageSize
+	var sortBy string = "createdAt"
+	var sortDirection core.SortDirection = core.SortDirectionAsc
+	var history []*task.TaskActionDto = make([]*task.TaskActionDto, 0)
+
+	for page := 1; ; page++ {
+		taskActions, err := s.TaskActionRepository.PaginateTaskActionsBy(taskrepo.PaginateTaskActionsParams{
+			Filters:    taskrepo.TaskActionFilters{TaskIdentity: &taskIdentity},
+			Pagination: core.PaginationInput{Page: &page, PerPage: &perPage},
+			SortInput:  core.SortInput{By: &sortBy, Direction: &sortDirection},
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		for _, taskAction := range taskActions.Data {
+			history = append(history, task.TaskActionToDto(&taskAction))
+		}
+
+		if len(taskActions.Data) < perPage {
+			return history, nil
+		}
+	}
+}
+
+func (s *ExportProjectService) exportTasks(projectExport *ProjectExport, projectIdentity core.Identity) error {
+	var perPage int = projectExportPageSize
+	var sortBy string = "createdAt"
+	var sortDirection core.SortDirection = core.SortDirectionAsc
+
+	for page := 1; ; page++ {
+		tasks, err := s.TaskRepository.PaginateTasksBy(taskrepo.PaginateTasksParams{
+			Filters:    taskrepo.TaskFilters{ProjectIdentity: &projectIdentity},
+			Pagination: core.PaginationInput{Page: &page, PerPage: &perPage},
+			SortInput:  core.SortInput{By: &sortBy, Direction: &sortDirection},
+		})
+		if err != nil {
+			return err
+		}
+
+		for _, tsk := range tasks.Data {
+			comments, err := s.exportTaskComments(projectExport, tsk.Identity)
+			if err != nil {
+				return err
+			}
+
+			history, err := s.exportTaskHistory(tsk.Identity)
+			if err != nil {
+				return err
+			}
+
+			projectExport.Tasks = append(projectExport.Tasks, &export.ProjectExportTaskDto{
+				TaskDto:  *task.TaskToDto(&tsk),
+				Comments: comments,
+				History:  history,
+			})
+		}
+
+		if len(tasks.Data) < perPage {
+			return nil
+		}
+	}
+}
+
+func (s *ExportProjectService) exportDocuments(projectExport *ProjectExport, projectIdentity core.Identity) error {
+	var perPage int = projectExportPageSize
+	var sortBy string = "createdAt"
+	var sortDirection core.SortDirection = core.SortDirectionAsc
+
+	for page := 1; ; page++ {
+		documents, err := s.ProjectDocumentRepository.PaginateProjectDocumentVersionManagersBy(projectrepo.PaginateProjectDocumentVersionManagersByParams{
+			Filters:    projectrepo.ProjectDocumentVersionManagerFilters{ProjectIdentity: &projectIdentity},
+			Pagination: core.PaginationInput{Page: &page, PerPage: &perPage},
+		})
+		if err != nil {
+			return err
+		}
+
+		for _, document := range documents.Data {
+			versions, err := s.ProjectDocumentRepository.ListProjectDocumentVersionsByProjectDocumentVersionManagerIdentity(projectrepo.ListProjectDocumentVersionsByProjectDocumentVersionManagerIdentityParams{
+				ProjectDocumentVersionManagerIdentity: document.Identity,
+				SortInput:                             core.SortInput{By: &sortBy, Direction: &sortDirection},
+			})
+			if err != nil {
+				return err
+			}
+
+			var versionsDto []*project.ProjectDocumentVersionDto = make([]*project.ProjectDocumentVersionDto, len(versions))
+			for i, version := range versions {
+				for _, file := range version.Document.Files {
+					if err := s.addFile(projectExport, file.FileIdentity); err != nil {
+						return err
+					}
+				}
+
+				versionsDto[i] = project.ProjectDocumentVersionToDto(&version)
+			}
+
+			projectExport.Documents = append(projectExport.Documents, &export.ProjectExportDocumentDto{
+				Id:       document.Identity.Public,
+				Versions: versionsDto,
+			})
+		}
+
+		if len(documents.Data) < perPage {
+			return nil
+		}
+	}
+}
+
+/*
+Execute gathers the data of the project to export. Nothing is written yet, so errors can still be reported before the
+archive is written with WriteArchive.
+*/
+func (s *ExportProjectService) Execute(input ExportProjectInput) (*ProjectExport, error) {
+	prj, err := s.ProjectRepository.GetProjectByIdentity(projectrepo.GetProjectByIdentityParams{
+		ProjectIdentity:      input.ProjectIdentity,
+		OrganizationIdentity: &input.OrganizationIdentity,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if prj == nil {
+		return nil, core.NewNotFoundError("project not found")
+	}
+
+	var projectExport *ProjectExport = &ProjectExport{
+		FileName: "project-" + prj.Identity.Public + "-export.zip",
+		Manifest: export.ProjectExportManifestDto{
+			FormatVersion: export.ProjectExportFormatVersion,
+			ProjectId:     prj.Identity.Public,
+			ExportedById:  input.UserExporterIdentity.Public,
+			ExportedAt:    core.NewDateTime().ToRFC3339(),
+			Contents: []string{
+				export.ProjectExportProjectFileName,
+				export.ProjectExportTaskStatusesFileName,
+				export.ProjectExportTaskStatusTransitionsFileName,
+				export.ProjectExportTaskCategoriesFileName,
+				export.ProjectExportTaskCustomFieldsFileName,
+				export.ProjectExportTasksFileName,
+				export.ProjectExportDocumentsFileName,
+				export.ProjectExportFilesFileName,
+			},
+		},
+		Project:               project.ProjectToDto(prj),
+		TaskStatuses:          make([]*project.ProjectTaskStatusDto, 0),
+		TaskStatusTransitions: make([]*project.ProjectTaskStatusTransitionDto, 0),
+		TaskCategories:        make([]*project.ProjectTaskCategoryDto, 0),
+		TaskCustomFields:      make([]*project.ProjectTaskCustomFieldDto, 0),
+		Tasks:                 make([]*export.ProjectExportTaskDto, 0),
+		Documents:             make([]*export.ProjectExportDocumentDto, 0),
+		Files:                 make([]*export.ProjectExportFileDto, 0),
+		uploadedFiles:         make([]*storage.UploadedFile, 0),
+	}
+
+	statuses, err := s.ProjectTaskStatusRepository.ListProjectTaskStatusesBy(projectrepo.ListProjectTaskStatusesByParams{
+		Filters: projectrepo.ProjectTaskStatusFilters{ProjectIdentity: &prj.Identity},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	for _, status := range statuses {
+		projectExport.TaskStatuses = append(projectExport.TaskStatuses, project.ProjectTaskStatusToDto(&status))
+	}
+
+	transitions, err := s.ProjectTaskStatusTransitionRepository.ListProjectTaskStatusTransitionsBy(projectrepo.ListProjectTaskStatusTransitionsByParams{
+		Filters: projectrepo.ProjectTaskStatusTransitionFilters{ProjectIdentity: &prj.Identity},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	for _, transition := range transitions {
+		projectExport.TaskStatusTransitions = append(projectExport.TaskStatusTransitions, project.ProjectTaskStatusTransitionToDto(&transition))
+	}
+
+	customFields, err := s.ProjectTaskCustomFieldRepository.ListProjectTaskCustomFieldsBy(projectrepo.ListProjectTaskCustomFieldsByParams{
+		Filters: projectrepo.ProjectTaskCustomFieldFilters{ProjectIdentity: &prj.Identity},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	for _, customField := range customFields {
+		projectExport.TaskCustomFields = append(projectExport.TaskCustomFields, project.ProjectTaskCustomFieldToDto(&customField))
+	}
+
+	if err := s.exportTaskCategories(projectExport, prj.Identity); err != nil {
+		return nil, err
+	}
+
+	if err := s.exportTasks(projectExport, prj.Identity); err != nil {
+		return nil, err
+	}
+
+	if err := s.exportDocuments(projectExport, prj.Identity); err != nil {
+		return nil, err
+	}
+
+	return projectExport, nil
+}
+
+func writeProjectExportJson(archive *zip.Writer, name string, value any) error {
+	writer, err := archive.Create(name)
+	if err != nil {
+		return err
+	}
+
+	encoder := json.NewEncoder(writer)
+	encoder.SetIndent("", "  ")
+
+	return encoder.Encode(value)
+}
+
+/*
+WriteArchive writes the export as a zip archive. The manifest holds the format version, the JSON files hold the
+project data and the files directory holds the content of every referenced file.
+*/
+func (s *ExportProjectService) WriteArchive(projectExport *ProjectExport, writer io.Writer) error {
+	var archive *zip.Writer = zip.NewWriter(writer)
+
+	var entries []struct {
+		Name  string
+		Value any
+	} = []struct {
+		Name  string
+		Value any
+	}{
+		{export.ProjectExportManifestFileName, projectExport.Manifest},
+		{export.ProjectExportProjectFileName, projectExport.Project},
+		{export.ProjectExportTaskStatusesFileName, projectExport.TaskStatuses},
+		{export.ProjectExportTaskStatusTransitionsFileName, projectExport.TaskStatusTransitions},
+		{export.ProjectExportTaskCategoriesFileName, projectExport.TaskCategories},
+		{export.ProjectExportTaskCustomFieldsFileName, projectExport.TaskCustomFields},
+		{export.ProjectExportTasksFileName, projectExport.Tasks},
+		{export.ProjectExportDocumentsFileName, projectExport.Documents},
+		{export.ProjectExportFilesFileName, projectExport.Files},
+	}
+
+	for _, entry := range entries {
+		if err := writeProjectExportJson(archive, entry.Name, entry.Value); err != nil {
+			return err
+		}
+	}
+
+	for _, uploadedFile := range projectExport.uploadedFiles {
+		content, err := s.StorageRepository.GetFile(*uploadedFile.FileDirectory, *uploadedFile.File)
+		if err != nil {
+			return err
+		}
+
+		fileWriter, err := archive.Create(export.ProjectExportFilePath(uploadedFile))
+		if err != nil {
+			return err
+		}
+
+		if _, err := fileWriter.Write(content); err != nil {
+			return err
+		}
+	}
+
+	return archive.Close()
+}
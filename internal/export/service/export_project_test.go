@@ -0,0 +1,76 @@
+package exportservice
+
+import (
+	"testing"
+
+	"github.com/gabrielmrtt/taski/internal/core"
+	"github.com/gabrielmrtt/taski/internal/storage"
+	storagerepo "github.com/gabrielmrtt/taski/internal/storage/repository"
+	"github.com/gabrielmrtt/taski/internal/task"
+	taskrepo "github.com/gabrielmrtt/taski/internal/task/repository"
+)
+
+type memoryTaskCommentRepository struct {
+	taskrepo.TaskCommentRepository
+	comments []task.TaskComment
+}
+
+func (r *memoryTaskCommentRepository) PaginateTaskCommentsBy(params taskrepo.PaginateTaskCommentsParams) (*core.PaginationOutput[task.TaskComment], error) {
+	return &core.PaginationOutput[task.TaskComment]{Data: r.comments}, nil
+}
+
+type memoryUploadedFileRepository struct {
+	storagerepo.UploadedFileRepository
+}
+
+func (r *memoryUploadedFileRepository) GetUploadedFileByIdentity(params storagerepo.GetUploadedFileByIdentityParams) (*storage.UploadedFile, error) {
+	return &storage.UploadedFile{Identity: params.FileIdentity}, nil
+}
+
+func TestExportTaskCommentsKeepsDeletedCommentsAsTombstones(t *testing.T) {
+	createdAt := core.NewDateTime()
+	deletedAt := core.NewDateTime()
+	keptFile := core.NewIdentity("fil")
+	deletedFile := core.NewIdentity("fil")
+
+	service := &ExportProjectService{
+		TaskCommentRepository: &memoryTaskCommentRepository{comments: []task.TaskComment{
+			{
+				Identity:   core.NewIdentity("tcm"),
+				Content:    "kept",
+				Files:      []task.TaskCommentFile{{FileIdentity: keptFile}},
+				Timestamps: core.Timestamps{CreatedAt: &createdAt},
+			},
+			{
+				Identity:   core.NewIdentity("tcm"),
+				Content:    "removed by a moderator",
+				Files:      []task.TaskCommentFile{{FileIdentity: deletedFile}},
+				DeletedAt:  &deletedAt,
+				Timestamps: core.Timestamps{CreatedAt: &createdAt},
+			},
+		}},
+		UploadedFileRepository: &memoryUploadedFileRepository{},
+	}
+
+	projectExport := &ProjectExport{}
+	comments, err := service.exportTaskComments(projectExport, core.NewIdentity("tsk"))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(comments) != 2 {
+		t.Fatalf("expected 2 comments, got %d", len(comments))
+	}
+
+	if comments[0].Deleted || comments[0].Content != "kept" {
+		t.Errorf("expected the first comment to be exported as is, got %+v", comments[0])
+	}
+
+	if !comments[1].Deleted || comments[1].Content != "" || len(comments[1].Files) != 0 {
+		t.Errorf("expected the deleted comment to be a tombstone, got %+v", comments[1])
+	}
+
+	if len(projectExport.Files) != 1 || projectExport.Files[0].Id != keptFile.Public {
+		t.Errorf("expected only the file of the kept comment, got %+v", projectExport.Files)
+	}
+}
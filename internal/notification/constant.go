@@ -0,0 +1,30 @@
+package notification
+
+const NotificationIdentityPrefix = "ntf"
+
+type NotificationTypes string
+
+const (
+	NotificationTypeTaskAssigned           NotificationTypes = "task_assigned"
+	NotificationTypeTaskCommentCreated     NotificationTypes = "task_comment_created"
+	NotificationTypeTaskMentioned          NotificationTypes = "task_mentioned"
+	NotificationTypeTaskDueSoon            NotificationTypes = "task_due_soon"
+	NotificationTypeOrganizationInvitation NotificationTypes = "organization_invitation"
+)
+
+var NotificationTypesArray = []NotificationTypes{
+	NotificationTypeTaskAssigned,
+	NotificationTypeTaskCommentCreated,
+	NotificationTypeTaskMentioned,
+	NotificationTypeTaskDueSoon,
+	NotificationTypeOrganizationInvitation,
+}
+
+/*
+NotificationTaskDueSoonWindowSeconds is how long before its due date the assignees of a task are notified.
+*/
+const NotificationTaskDueSoonWindowSeconds = 24 * 60 * 60
+
+const NotificationSchedulerIntervalSeconds = 5 * 60
+
+const NotificationSchedulerBatchSize = 100
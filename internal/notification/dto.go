@@ -0,0 +1,71 @@
+package notification
+
+type NotificationDto struct {
+	Id             string  `json:"id"`
+	Type           string  `json:"type"`
+	Message        string  `json:"message"`
+	OrganizationId *string `json:"organizationId"`
+	ProjectId      *string `json:"projectId"`
+	TaskId         *string `json:"taskId"`
+	UserActorId    *string `json:"userActorId"`
+	Read           bool    `json:"read"`
+	ReadAt         *string `json:"readAt"`
+	CreatedAt      string  `json:"createdAt"`
+}
+
+func NotificationToDto(notification *Notification) *NotificationDto {
+	var organizationId *string = nil
+	if notification.OrganizationIdentity != nil {
+		organizationId = &notification.OrganizationIdentity.Public
+	}
+
+	var projectId *string = nil
+	if notification.ProjectIdentity != nil {
+		projectId = &notification.ProjectIdentity.Public
+	}
+
+	var taskId *string = nil
+	if notification.TaskIdentity != nil {
+		taskId = &notification.TaskIdentity.Public
+	}
+
+	var userActorId *string = nil
+	if notification.UserActorIdentity != nil {
+		userActorId = &notification.UserActorIdentity.Public
+	}
+
+	var readAt *string = nil
+	if notification.ReadAt != nil {
+		readAtString := notification.ReadAt.ToRFC3339()
+		readAt = &readAtString
+	}
+
+	return &NotificationDto{
+		Id:             notification.Identity.Public,
+		Type:           string(notification.Type),
+		Message:        notification.Message,
+		OrganizationId: organizationId,
+		ProjectId:      projectId,
+		TaskId:         taskId,
+		UserActorId:    userActorId,
+		Read:           notification.IsRead(),
+		ReadAt:         readAt,
+		CreatedAt:      notification.CreatedAt.ToRFC3339(),
+	}
+}
+
+type NotificationUnreadCountDto struct {
+	Unread int `json:"unread"`
+}
+
+type NotificationPreferenceDto struct {
+	Type    string `json:"type"`
+	Enabled bool   `json:"enabled"`
+}
+
+func NotificationPreferenceToDto(preference *NotificationPreference) *NotificationPreferenceDto {
+	return &NotificationPreferenceDto{
+		Type:    string(preference.Type),
+		Enabled: preference.Enabled,
+	}
+}
@@ -0,0 +1,97 @@
+package notification
+
+import (
+	"slices"
+
+	"github.com/gabrielmrtt/taski/internal/core"
+)
+
+/*
+Notification is a message of the in-app inbox of an user. The organization, project and task it refers to are kept so
+clients can link to them.
+*/
+type Notification struct {
+	Identity             core.Identity
+	UserIdentity         core.Identity
+	Type                 NotificationTypes
+	Message              string
+	OrganizationIdentity *core.Identity
+	ProjectIdentity      *core.Identity
+	TaskIdentity         *core.Identity
+	UserActorIdentity    *core.Identity
+	DeduplicationKey     *string
+	ReadAt               *core.DateTime
+	CreatedAt            core.DateTime
+}
+
+type NewNotificationInput struct {
+	UserIdentity         core.Identity
+	Type                 NotificationTypes
+	Message              string
+	OrganizationIdentity *core.Identity
+	ProjectIdentity      *core.Identity
+	TaskIdentity         *core.Identity
+	UserActorIdentity    *core.Identity
+	DeduplicationKey     *string
+}
+
+func NewNotification(input NewNotificationInput) *Notification {
+	return &Notification{
+		Identity:             core.NewIdentity(NotificationIdentityPrefix),
+		UserIdentity:         input.UserIdentity,
+		Type:                 input.Type,
+		Message:              input.Message,
+		OrganizationIdentity: input.OrganizationIdentity,
+		ProjectIdentity:      input.ProjectIdentity,
+		TaskIdentity:         input.TaskIdentity,
+		UserActorIdentity:    input.UserActorIdentity,
+		DeduplicationKey:     input.DeduplicationKey,
+		ReadAt:               nil,
+		CreatedAt:            core.NewDateTime(),
+	}
+}
+
+func (n *Notification) MarkAsRead() {
+	if n.IsRead() {
+		return
+	}
+
+	now := core.NewDateTime()
+	n.ReadAt = &now
+}
+
+func (n *Notification) IsRead() bool {
+	return n.ReadAt != nil
+}
+
+/*
+NotificationPreference enables or disables a notification type for an user. Types without a preference are enabled.
+*/
+type NotificationPreference struct {
+	UserIdentity core.Identity
+	Type         NotificationTypes
+	Enabled      bool
+}
+
+type NewNotificationPreferenceInput struct {
+	UserIdentity core.Identity
+	Type         NotificationTypes
+	Enabled      bool
+}
+
+func NewNotificationPreference(input NewNotificationPreferenceInput) (*NotificationPreference, error) {
+	if !slices.Contains(NotificationTypesArray, input.Type) {
+		return nil, core.NewInvalidInputError("invalid notification preference", []core.InvalidInputErrorField{
+			{
+				Field: "type",
+				Error: "invalid notification type",
+			},
+		})
+	}
+
+	return &NotificationPreference{
+		UserIdentity: input.UserIdentity,
+		Type:         input.Type,
+		Enabled:      input.Enabled,
+	}, nil
+}
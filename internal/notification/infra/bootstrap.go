@@ -0,0 +1,73 @@
+package notificationinfra
+
+import (
+	"log"
+	"time"
+
+	coredatabase "github.com/gabrielmrtt/taski/internal/core/database"
+	corehttp "github.com/gabrielmrtt/taski/internal/core/http"
+	"github.com/gabrielmrtt/taski/internal/notification"
+	notificationdatabase "github.com/gabrielmrtt/taski/internal/notification/infra/database"
+	notificationhttp "github.com/gabrielmrtt/taski/internal/notification/infra/http"
+	notificationservice "github.com/gabrielmrtt/taski/internal/notification/service"
+	taskdatabase "github.com/gabrielmrtt/taski/internal/task/infra/database"
+	"github.com/gin-gonic/gin"
+	"github.com/uptrace/bun"
+)
+
+type BootstrapInfraOptions struct {
+	RouterGroup  *gin.RouterGroup
+	DbConnection *bun.DB
+}
+
+func BootstrapInfra(options BootstrapInfraOptions) {
+	notificationRepository := notificationdatabase.NewNotificationBunRepository(options.DbConnection)
+	notificationPreferenceRepository := notificationdatabase.NewNotificationPreferenceBunRepository(options.DbConnection)
+	transactionRepository := coredatabase.NewTransactionBunRepository(options.DbConnection)
+
+	listNotificationsService := notificationservice.NewListNotificationsService(notificationRepository)
+	getUnreadNotificationsCountService := notificationservice.NewGetUnreadNotificationsCountService(notificationRepository)
+	markNotificationAsReadService := notificationservice.NewMarkNotificationAsReadService(notificationRepository, transactionRepository)
+	markAllNotificationsAsReadService := notificationservice.NewMarkAllNotificationsAsReadService(notificationRepository, transactionRepository)
+	listNotificationPreferencesService := notificationservice.NewListNotificationPreferencesService(notificationPreferenceRepository)
+	updateNotificationPreferencesService := notificationservice.NewUpdateNotificationPreferencesService(notificationPreferenceRepository, transactionRepository)
+
+	notificationHandler := notificationhttp.NewNotificationHandler(listNotificationsService, getUnreadNotificationsCountService, markNotificationAsReadService, markAllNotificationsAsReadService, listNotificationPreferencesService, updateNotificationPreferencesService)
+	notificationHandler.ConfigureRoutes(corehttp.ConfigureRoutesOptions{
+		DbConnection: options.DbConnection,
+		RouterGroup:  options.RouterGroup,
+	})
+
+	// The scheduler gets its own repositories so it never shares a transaction with request handlers.
+	notifyTasksDueSoonService := notificationservice.NewNotifyTasksDueSoonService(
+		taskdatabase.NewTaskBunRepository(options.DbConnection),
+		NewNotifyUserService(options.DbConnection),
+		coredatabase.NewTransactionBunRepository(options.DbConnection),
+	)
+
+	go scheduleTaskDueSoonNotifications(notifyTasksDueSoonService)
+}
+
+/*
+NewNotifyUserService builds the service other modules use to notify users from their repositories.
+*/
+func NewNotifyUserService(connection *bun.DB) *notificationservice.NotifyUserService {
+	return notificationservice.NewNotifyUserService(
+		notificationdatabase.NewNotificationBunRepository(connection),
+		notificationdatabase.NewNotificationPreferenceBunRepository(connection),
+	)
+}
+
+func scheduleTaskDueSoonNotifications(notifyTasksDueSoonService *notificationservice.NotifyTasksDueSoonService) {
+	ticker := time.NewTicker(notification.NotificationSchedulerIntervalSeconds * time.Second)
+	defer ticker.Stop()
+
+	for range ticker.C {
+		err := notifyTasksDueSoonService.Execute(notificationservice.NotifyTasksDueSoonInput{
+			BatchSize: notification.NotificationSchedulerBatchSize,
+		})
+		if err != nil {
+			log.Printf("Failed to notify tasks due soon: %v", err)
+		}
+	}
+}
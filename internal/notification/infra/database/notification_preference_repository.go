@@ -0,0 +1,150 @@
+package notificationdatabase
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/gabrielmrtt/taski/internal/core"
+	coredatabase "github.com/gabrielmrtt/taski/internal/core/database"
+	"github.com/gabrielmrtt/taski/internal/notification"
+	notificationrepo "github.com/gabrielmrtt/taski/internal/notification/repository"
+	"github.com/gabrielmrtt/taski/internal/user"
+	"github.com/google/uuid"
+	"github.com/uptrace/bun"
+)
+
+type NotificationPreferenceTable struct {
+	bun.BaseModel `bun:"table:notification_preference,alias:notification_preference"`
+
+	UserInternalId string `bun:"user_internal_id,pk,notnull,type:uuid"`
+	Type           string `bun:"type,pk,notnull,type:varchar(100)"`
+	Enabled        bool   `bun:"enabled,notnull,type:boolean"`
+}
+
+func (t *NotificationPreferenceTable) ToEntity() *notification.NotificationPreference {
+	return &notification.NotificationPreference{
+		UserIdentity: core.NewIdentityFromInternal(uuid.MustParse(t.UserInternalId), user.UserIdentityPrefix),
+		Type:         notification.NotificationTypes(t.Type),
+		Enabled:      t.Enabled,
+	}
+}
+
+func notificationPreferenceToTable(preference *notification.NotificationPreference) *NotificationPreferenceTable {
+	return &NotificationPreferenceTable{
+		UserInternalId: preference.UserIdentity.Internal.String(),
+		Type:           string(preference.Type),
+		Enabled:        preference.Enabled,
+	}
+}
+
+type NotificationPreferenceBunRepository struct {
+	db *bun.DB
+	tx *coredatabase.TransactionBun
+}
+
+func NewNotificationPreferenceBunRepository(connection *bun.DB) *NotificationPreferenceBunRepository {
+	return &NotificationPreferenceBunRepository{db: connection, tx: nil}
+}
+
+func (r *NotificationPreferenceBunRepository) SetTransaction(tx core.Transaction) error {
+	if r.tx != nil && !r.tx.IsClosed() {
+		return nil
+	}
+
+	r.tx = tx.(*coredatabase.TransactionBun)
+	return nil
+}
+
+func (r *NotificationPreferenceBunRepository) ListNotificationPreferences(params notificationrepo.ListNotificationPreferencesParams) ([]notification.NotificationPreference, error) {
+	var preferences []NotificationPreferenceTable = make([]NotificationPreferenceTable, 0)
+	var selectQuery *bun.SelectQuery
+
+	if r.tx != nil && !r.tx.IsClosed() {
+		selectQuery = r.tx.Tx.NewSelect()
+	} else {
+		selectQuery = r.db.NewSelect()
+	}
+
+	selectQuery = selectQuery.Model(&preferences)
+	selectQuery = selectQuery.Where("notification_preference.user_internal_id = ?", params.UserIdentity.Internal.String())
+	err := selectQuery.Scan(context.Background())
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return []notification.NotificationPreference{}, nil
+		}
+
+		return nil, err
+	}
+
+	var preferenceEntities []notification.NotificationPreference = make([]notification.NotificationPreference, 0)
+	for _, preference := range preferences {
+		preferenceEntities = append(preferenceEntities, *preference.ToEntity())
+	}
+
+	return preferenceEntities, nil
+}
+
+func (r *NotificationPreferenceBunRepository) GetNotificationPreference(params notificationrepo.GetNotificationPreferenceParams) (*notification.NotificationPreference, error) {
+	var preference *NotificationPreferenceTable = new(NotificationPreferenceTable)
+	var selectQuery *bun.SelectQuery
+
+	if r.tx != nil && !r.tx.IsClosed() {
+		selectQuery = r.tx.Tx.NewSelect()
+	} else {
+		selectQuery = r.db.NewSelect()
+	}
+
+	selectQuery = selectQuery.Model(preference)
+	selectQuery = selectQuery.Where("notification_preference.user_internal_id = ?", params.UserIdentity.Internal.String())
+	selectQuery = selectQuery.Where("notification_preference.type = ?", string(params.Type))
+
+	err := selectQuery.Scan(context.Background())
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil
+		}
+
+		return nil, err
+	}
+
+	if preference.UserInternalId == "" {
+		return nil, nil
+	}
+
+	return preference.ToEntity(), nil
+}
+
+func (r *NotificationPreferenceBunRepository) SaveNotificationPreference(params notificationrepo.SaveNotificationPreferenceParams) error {
+	var tx bun.Tx
+	var shouldCommit bool = false
+
+	if r.tx != nil && !r.tx.IsClosed() {
+		tx = *r.tx.Tx
+	} else {
+		var err error
+		tx, err = r.db.BeginTx(context.Background(), nil)
+		shouldCommit = true
+
+		if err != nil {
+			return err
+		}
+	}
+
+	_, err := tx.NewInsert().
+		Model(notificationPreferenceToTable(params.NotificationPreference)).
+		On("CONFLICT (user_internal_id, type) DO UPDATE").
+		Set("enabled = EXCLUDED.enabled").
+		Exec(context.Background())
+	if err != nil {
+		return err
+	}
+
+	if shouldCommit {
+		err = tx.Commit()
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,351 @@
+package notificationdatabase
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/gabrielmrtt/taski/internal/core"
+	coredatabase "github.com/gabrielmrtt/taski/internal/core/database"
+	"github.com/gabrielmrtt/taski/internal/notification"
+	notificationrepo "github.com/gabrielmrtt/taski/internal/notification/repository"
+	"github.com/gabrielmrtt/taski/internal/organization"
+	"github.com/gabrielmrtt/taski/internal/project"
+	"github.com/gabrielmrtt/taski/internal/task"
+	"github.com/gabrielmrtt/taski/internal/user"
+	"github.com/google/uuid"
+	"github.com/uptrace/bun"
+)
+
+type NotificationTable struct {
+	bun.BaseModel `bun:"table:notification,alias:notification"`
+
+	InternalId             string  `bun:"internal_id,pk,notnull,type:uuid"`
+	PublicId               string  `bun:"public_id,notnull,type:varchar(510)"`
+	UserInternalId         string  `bun:"user_internal_id,notnull,type:uuid"`
+	Type                   string  `bun:"type,notnull,type:varchar(100)"`
+	Message                string  `bun:"message,notnull,type:varchar(1020)"`
+	OrganizationInternalId *string `bun:"organization_internal_id,type:uuid"`
+	ProjectInternalId      *string `bun:"project_internal_id,type:uuid"`
+	TaskInternalId         *string `bun:"task_internal_id,type:uuid"`
+	UserActorInternalId    *string `bun:"user_actor_internal_id,type:uuid"`
+	DeduplicationKey       *string `bun:"deduplication_key,type:varchar(510)"`
+	ReadAt                 *int64  `bun:"read_at,type:bigint"`
+	CreatedAt              int64   `bun:"created_at,notnull,type:bigint"`
+}
+
+func optionalIdentityFromInternal(internalId *string, prefix string) *core.Identity {
+	if internalId == nil {
+		return nil
+	}
+
+	identity := core.NewIdentityFromInternal(uuid.MustParse(*internalId), prefix)
+	return &identity
+}
+
+func optionalInternalId(identity *core.Identity) *string {
+	if identity == nil {
+		return nil
+	}
+
+	internalId := identity.Internal.String()
+	return &internalId
+}
+
+func (t *NotificationTable) ToEntity() *notification.Notification {
+	var readAt *core.DateTime = nil
+	if t.ReadAt != nil {
+		readAt = &core.DateTime{Value: *t.ReadAt}
+	}
+
+	return &notification.Notification{
+		Identity:             core.NewIdentityFromInternal(uuid.MustParse(t.InternalId), notification.NotificationIdentityPrefix),
+		UserIdentity:         core.NewIdentityFromInternal(uuid.MustParse(t.UserInternalId), user.UserIdentityPrefix),
+		Type:                 notification.NotificationTypes(t.Type),
+		Message:              t.Message,
+		OrganizationIdentity: optionalIdentityFromInternal(t.OrganizationInternalId, organization.OrganizationIdentityPrefix),
+		ProjectIdentity:      optionalIdentityFromInternal(t.ProjectInternalId, project.ProjectIdentityPrefix),
+		TaskIdentity:         optionalIdentityFromInternal(t.TaskInternalId, task.TaskIdentityPrefix),
+		UserActorIdentity:    optionalIdentityFromInternal(t.UserActorInternalId, user.UserIdentityPrefix),
+		DeduplicationKey:     t.DeduplicationKey,
+		ReadAt:               readAt,
+		CreatedAt:            core.DateTime{Value: t.CreatedAt},
+	}
+}
+
+func notificationToTable(ntf *notification.Notification) *NotificationTable {
+	var readAt *int64 = nil
+	if ntf.ReadAt != nil {
+		readAt = &ntf.ReadAt.Value
+	}
+
+	return &NotificationTable{
+		InternalId:             ntf.Identity.Internal.String(),
+		PublicId:               ntf.Identity.Public,
+		UserInternalId:         ntf.UserIdentity.Internal.String(),
+		Type:                   string(ntf.Type),
+		Message:                ntf.Message,
+		OrganizationInternalId: optionalInternalId(ntf.OrganizationIdentity),
+		ProjectInternalId:      optionalInternalId(ntf.ProjectIdentity),
+		TaskInternalId:         optionalInternalId(ntf.TaskIdentity),
+		UserActorInternalId:    optionalInternalId(ntf.UserActorIdentity),
+		DeduplicationKey:       ntf.DeduplicationKey,
+		ReadAt:                 readAt,
+		CreatedAt:              ntf.CreatedAt.Value,
+	}
+}
+
+var notificationSortableFields = coredatabase.SortableFields{
+	Fields: map[string]string{
+		"type":      "notification.type",
+		"readAt":    "notification.read_at",
+		"createdAt": "notification.created_at",
+	},
+	IdColumn: "notification.internal_id",
+}
+
+type NotificationBunRepository struct {
+	db *bun.DB
+	tx *coredatabase.TransactionBun
+}
+
+func NewNotificationBunRepository(connection *bun.DB) *NotificationBunRepository {
+	return &NotificationBunRepository{db: connection, tx: nil}
+}
+
+func (r *NotificationBunRepository) SetTransaction(tx core.Transaction) error {
+	if r.tx != nil && !r.tx.IsClosed() {
+		return nil
+	}
+
+	r.tx = tx.(*coredatabase.TransactionBun)
+	return nil
+}
+
+func (r *NotificationBunRepository) applyFilters(selectQuery *bun.SelectQuery, filters notificationrepo.NotificationFilters) *bun.SelectQuery {
+	selectQuery = selectQuery.Where("notification.user_internal_id = ?", filters.UserIdentity.Internal.String())
+
+	if filters.Type != nil {
+		selectQuery = coredatabase.ApplyComparableFilter(selectQuery, "notification.type", filters.Type)
+	}
+
+	if filters.Read != nil {
+		if *filters.Read {
+			selectQuery = selectQuery.Where("notification.read_at IS NOT NULL")
+		} else {
+			selectQuery = selectQuery.Where("notification.read_at IS NULL")
+		}
+	}
+
+	if filters.CreatedAt != nil {
+		selectQuery = coredatabase.ApplyComparableFilter(selectQuery, "notification.created_at", filters.CreatedAt)
+	}
+
+	return selectQuery
+}
+
+func (r *NotificationBunRepository) GetNotificationByIdentity(params notificationrepo.GetNotificationByIdentityParams) (*notification.Notification, error) {
+	var ntf *NotificationTable = new(NotificationTable)
+	var selectQuery *bun.SelectQuery
+
+	if r.tx != nil && !r.tx.IsClosed() {
+		selectQuery = r.tx.Tx.NewSelect()
+	} else {
+		selectQuery = r.db.NewSelect()
+	}
+
+	selectQuery = selectQuery.Model(ntf)
+	selectQuery = selectQuery.Where("notification.internal_id = ?", params.NotificationIdentity.Internal.String())
+	selectQuery = selectQuery.Where("notification.user_internal_id = ?", params.UserIdentity.Internal.String())
+
+	err := selectQuery.Scan(context.Background())
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil
+		}
+
+		return nil, err
+	}
+
+	if ntf.InternalId == "" {
+		return nil, nil
+	}
+
+	return ntf.ToEntity(), nil
+}
+
+func (r *NotificationBunRepository) PaginateNotificationsBy(params notificationrepo.PaginateNotificationsParams) (*core.PaginationOutput[notification.Notification], error) {
+	var notifications []*NotificationTable = make([]*NotificationTable, 0)
+	var selectQuery *bun.SelectQuery
+	var perPage int = 10
+	var page int = 1
+
+	if params.Pagination.PerPage != nil {
+		perPage = *params.Pagination.PerPage
+	}
+
+	if params.Pagination.Page != nil {
+		page = *params.Pagination.Page
+	}
+
+	if r.tx != nil && !r.tx.IsClosed() {
+		selectQuery = r.tx.Tx.NewSelect()
+	} else {
+		selectQuery = r.db.NewSelect()
+	}
+
+	selectQuery = selectQuery.Model(&notifications)
+	selectQuery = r.applyFilters(selectQuery, params.Filters)
+	countBeforePagination, err := selectQuery.Count(context.Background())
+	if err != nil {
+		return nil, err
+	}
+
+	// The newest notifications come first unless the client sorts by created date itself
+	defaultSortBy := "createdAt"
+	defaultSortDirection := core.SortDirectionDesc
+	selectQuery, err = coredatabase.ApplySort(selectQuery, notificationSortableFields, params.SortInput, core.SortInput{By: &defaultSortBy, Direction: &defaultSortDirection})
+	if err != nil {
+		return nil, err
+	}
+
+	selectQuery = coredatabase.ApplyPagination(selectQuery, params.Pagination)
+	err = selectQuery.Scan(context.Background())
+	if err != nil && err != sql.ErrNoRows {
+		return nil, err
+	}
+
+	var notificationEntities []notification.Notification = make([]notification.Notification, 0)
+	for _, ntf := range notifications {
+		notificationEntities = append(notificationEntities, *ntf.ToEntity())
+	}
+
+	return &core.PaginationOutput[notification.Notification]{
+		Data:    notificationEntities,
+		Page:    page,
+		HasMore: core.HasMorePages(page, countBeforePagination, perPage),
+		Total:   countBeforePagination,
+	}, nil
+}
+
+func (r *NotificationBunRepository) CountUnreadNotifications(params notificationrepo.CountUnreadNotificationsParams) (int, error) {
+	var selectQuery *bun.SelectQuery
+
+	if r.tx != nil && !r.tx.IsClosed() {
+		selectQuery = r.tx.Tx.NewSelect()
+	} else {
+		selectQuery = r.db.NewSelect()
+	}
+
+	selectQuery = selectQuery.Model((*NotificationTable)(nil))
+	selectQuery = selectQuery.Where("notification.user_internal_id = ?", params.UserIdentity.Internal.String())
+	selectQuery = selectQuery.Where("notification.read_at IS NULL")
+
+	return selectQuery.Count(context.Background())
+}
+
+/*
+StoreNotification ignores a notification whose deduplication key was already stored for the same user, so jobs that
+run on several API instances notify only once.
+*/
+func (r *NotificationBunRepository) StoreNotification(params notificationrepo.StoreNotificationParams) error {
+	var tx bun.Tx
+	var shouldCommit bool = false
+
+	if r.tx != nil && !r.tx.IsClosed() {
+		tx = *r.tx.Tx
+	} else {
+		var err error
+		tx, err = r.db.BeginTx(context.Background(), nil)
+		shouldCommit = true
+
+		if err != nil {
+			return err
+		}
+	}
+
+	_, err := tx.NewInsert().Model(notificationToTable(params.Notification)).On("CONFLICT (user_internal_id, deduplication_key) DO NOTHING").Exec(context.Background())
+	if err != nil {
+		return err
+	}
+
+	if shouldCommit {
+		err = tx.Commit()
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (r *NotificationBunRepository) UpdateNotification(params notificationrepo.UpdateNotificationParams) error {
+	var tx bun.Tx
+	var shouldCommit bool = false
+
+	if r.tx != nil && !r.tx.IsClosed() {
+		tx = *r.tx.Tx
+	} else {
+		var err error
+		tx, err = r.db.BeginTx(context.Background(), nil)
+		shouldCommit = true
+
+		if err != nil {
+			return err
+		}
+	}
+
+	_, err := tx.NewUpdate().Model(notificationToTable(params.Notification)).Where("notification.internal_id = ?", params.Notification.Identity.Internal.String()).Exec(context.Background())
+	if err != nil {
+		return err
+	}
+
+	if shouldCommit {
+		err = tx.Commit()
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (r *NotificationBunRepository) MarkAllNotificationsAsRead(params notificationrepo.MarkAllNotificationsAsReadParams) (int, error) {
+	var tx bun.Tx
+	var shouldCommit bool = false
+
+	if r.tx != nil && !r.tx.IsClosed() {
+		tx = *r.tx.Tx
+	} else {
+		var err error
+		tx, err = r.db.BeginTx(context.Background(), nil)
+		shouldCommit = true
+
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	result, err := tx.NewUpdate().
+		Model((*NotificationTable)(nil)).
+		Set("read_at = ?", params.ReadAt.Value).
+		Where("notification.user_internal_id = ?", params.UserIdentity.Internal.String()).
+		Where("notification.read_at IS NULL").
+		Exec(context.Background())
+	if err != nil {
+		return 0, err
+	}
+
+	if shouldCommit {
+		err = tx.Commit()
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	affectedRows, err := result.RowsAffected()
+	if err != nil {
+		return 0, err
+	}
+
+	return int(affectedRows), nil
+}
@@ -0,0 +1,101 @@
+package notificationevents
+
+import (
+	"fmt"
+
+	"github.com/gabrielmrtt/taski/internal/core"
+	"github.com/gabrielmrtt/taski/internal/notification"
+	notificationservice "github.com/gabrielmrtt/taski/internal/notification/service"
+	"github.com/gabrielmrtt/taski/internal/organization"
+	organizationrepo "github.com/gabrielmrtt/taski/internal/organization/repository"
+)
+
+/*
+OrganizationUserRepository notifies an user invited to an organization, including an user invited again after leaving
+or refusing a previous invitation.
+*/
+type OrganizationUserRepository struct {
+	organizationrepo.OrganizationUserRepository
+	OrganizationRepository organizationrepo.OrganizationRepository
+	NotifyUserService      *notificationservice.NotifyUserService
+}
+
+func NewOrganizationUserRepository(
+	organizationUserRepository organizationrepo.OrganizationUserRepository,
+	organizationRepository organizationrepo.OrganizationRepository,
+	notifyUserService *notificationservice.NotifyUserService,
+) *OrganizationUserRepository {
+	return &OrganizationUserRepository{
+		OrganizationUserRepository: organizationUserRepository,
+		OrganizationRepository:     organizationRepository,
+		NotifyUserService:          notifyUserService,
+	}
+}
+
+func (r *OrganizationUserRepository) SetTransaction(tx core.Transaction) error {
+	if err := r.OrganizationUserRepository.SetTransaction(tx); err != nil {
+		return err
+	}
+
+	r.OrganizationRepository.SetTransaction(tx)
+	return r.NotifyUserService.SetTransaction(tx)
+}
+
+func (r *OrganizationUserRepository) notifyInvitation(organizationUser *organization.OrganizationUser) error {
+	org, err := r.OrganizationRepository.GetOrganizationByIdentity(organizationrepo.GetOrganizationByIdentityParams{
+		OrganizationIdentity: organizationUser.OrganizationIdentity,
+	})
+	if err != nil {
+		return err
+	}
+
+	if org == nil {
+		return nil
+	}
+
+	return r.NotifyUserService.Execute(notificationservice.NotifyUserInput{
+		UserIdentity:         organizationUser.User.Identity,
+		Type:                 notification.NotificationTypeOrganizationInvitation,
+		Message:              fmt.Sprintf("You were invited to join organization %q", org.Name),
+		OrganizationIdentity: &org.Identity,
+	})
+}
+
+func (r *OrganizationUserRepository) StoreOrganizationUser(params organizationrepo.StoreOrganizationUserParams) (*organization.OrganizationUser, error) {
+	organizationUser, err := r.OrganizationUserRepository.StoreOrganizationUser(params)
+	if err != nil {
+		return nil, err
+	}
+
+	if organizationUser.Status == organization.OrganizationUserStatusInvited {
+		if err := r.notifyInvitation(organizationUser); err != nil {
+			return nil, err
+		}
+	}
+
+	return organizationUser, nil
+}
+
+func (r *OrganizationUserRepository) UpdateOrganizationUser(params organizationrepo.UpdateOrganizationUserParams) error {
+	if params.OrganizationUser.Status != organization.OrganizationUserStatusInvited {
+		return r.OrganizationUserRepository.UpdateOrganizationUser(params)
+	}
+
+	previousOrganizationUser, err := r.OrganizationUserRepository.GetOrganizationUserByIdentity(organizationrepo.GetOrganizationUserByIdentityParams{
+		OrganizationIdentity: params.OrganizationUser.OrganizationIdentity,
+		UserIdentity:         params.OrganizationUser.User.Identity,
+	})
+	if err != nil {
+		return err
+	}
+
+	if err := r.OrganizationUserRepository.UpdateOrganizationUser(params); err != nil {
+		return err
+	}
+
+	if previousOrganizationUser != nil && previousOrganizationUser.Status == organization.OrganizationUserStatusInvited {
+		return nil
+	}
+
+	return r.notifyInvitation(params.OrganizationUser)
+}
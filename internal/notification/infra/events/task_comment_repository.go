@@ -0,0 +1,130 @@
+package notificationevents
+
+import (
+	"fmt"
+	"slices"
+
+	"github.com/gabrielmrtt/taski/internal/core"
+	"github.com/gabrielmrtt/taski/internal/notification"
+	notificationservice "github.com/gabrielmrtt/taski/internal/notification/service"
+	"github.com/gabrielmrtt/taski/internal/task"
+	taskrepo "github.com/gabrielmrtt/taski/internal/task/repository"
+)
+
+// taskCommentExcerptLength is the maximum length of the comment excerpt shown in comment notifications.
+const taskCommentExcerptLength = 160
+
+/*
+TaskCommentRepository notifies the assignees and the creator of a task when a comment is added to it, and the users
+mentioned in its comments.
+*/
+type TaskCommentRepository struct {
+	taskrepo.TaskCommentRepository
+	TaskRepository    taskrepo.TaskRepository
+	NotifyUserService *notificationservice.NotifyUserService
+}
+
+func NewTaskCommentRepository(
+	taskCommentRepository taskrepo.TaskCommentRepository,
+	taskRepository taskrepo.TaskRepository,
+	notifyUserService *notificationservice.NotifyUserService,
+) *TaskCommentRepository {
+	return &TaskCommentRepository{
+		TaskCommentRepository: taskCommentRepository,
+		TaskRepository:        taskRepository,
+		NotifyUserService:     notifyUserService,
+	}
+}
+
+func (r *TaskCommentRepository) SetTransaction(tx core.Transaction) error {
+	if err := r.TaskCommentRepository.SetTransaction(tx); err != nil {
+		return err
+	}
+
+	r.TaskRepository.SetTransaction(tx)
+	return r.NotifyUserService.SetTransaction(tx)
+}
+
+func (r *TaskCommentRepository) StoreTaskComment(params taskrepo.StoreTaskCommentParams) (*task.TaskComment, error) {
+	taskComment, err := r.TaskCommentRepository.StoreTaskComment(params)
+	if err != nil {
+		return nil, err
+	}
+
+	tsk, err := r.TaskRepository.GetTaskByIdentity(taskrepo.GetTaskByIdentityParams{
+		TaskIdentity: taskComment.TaskIdentity,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if tsk == nil {
+		return taskComment, nil
+	}
+
+	var recipientIdentities []core.Identity = make([]core.Identity, 0)
+	for _, taskUser := range tsk.Users {
+		recipientIdentities = append(recipientIdentities, taskUser.User.Identity)
+	}
+
+	if tsk.UserCreatorIdentity != nil && !slices.Contains(recipientIdentities, *tsk.UserCreatorIdentity) {
+		recipientIdentities = append(recipientIdentities, *tsk.UserCreatorIdentity)
+	}
+
+	var authorIdentity *core.Identity = nil
+	if taskComment.Author != nil {
+		authorIdentity = &taskComment.Author.Identity
+	}
+
+	recipientIdentities = slices.DeleteFunc(recipientIdentities, func(recipientIdentity core.Identity) bool {
+		return slices.ContainsFunc(taskComment.References, func(reference task.TaskReference) bool {
+			return reference.IsUserMention() && reference.UserIdentity.Internal == recipientIdentity.Internal
+		})
+	})
+
+	message := fmt.Sprintf("New comment on task %q: %s", tsk.Name, taskComment.FormattedContent().Excerpt(taskCommentExcerptLength))
+
+	for _, recipientIdentity := range recipientIdentities {
+		err = r.NotifyUserService.Execute(notificationservice.NotifyUserInput{
+			UserIdentity:      recipientIdentity,
+			Type:              notification.NotificationTypeTaskCommentCreated,
+			Message:           message,
+			ProjectIdentity:   &tsk.ProjectIdentity,
+			TaskIdentity:      &tsk.Identity,
+			UserActorIdentity: authorIdentity,
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	err = notifyMentionedUsers(r.NotifyUserService, tsk, taskComment.References, fmt.Sprintf("You were mentioned in a comment on task %q", tsk.Name))
+	if err != nil {
+		return nil, err
+	}
+
+	return taskComment, nil
+}
+
+func (r *TaskCommentRepository) UpdateTaskComment(params taskrepo.UpdateTaskCommentParams) error {
+	if err := r.TaskCommentRepository.UpdateTaskComment(params); err != nil {
+		return err
+	}
+
+	if params.TaskComment.IsDeleted() {
+		return nil
+	}
+
+	tsk, err := r.TaskRepository.GetTaskByIdentity(taskrepo.GetTaskByIdentityParams{
+		TaskIdentity: params.TaskComment.TaskIdentity,
+	})
+	if err != nil {
+		return err
+	}
+
+	if tsk == nil {
+		return nil
+	}
+
+	return notifyMentionedUsers(r.NotifyUserService, tsk, params.TaskComment.References, fmt.Sprintf("You were mentioned in a comment on task %q", tsk.Name))
+}
@@ -0,0 +1,131 @@
+package notificationevents
+
+import (
+	"fmt"
+
+	"github.com/gabrielmrtt/taski/internal/core"
+	"github.com/gabrielmrtt/taski/internal/notification"
+	notificationservice "github.com/gabrielmrtt/taski/internal/notification/service"
+	"github.com/gabrielmrtt/taski/internal/task"
+	taskrepo "github.com/gabrielmrtt/taski/internal/task/repository"
+)
+
+/*
+TaskRepository notifies the users assigned to a task, when the task is created with them or when they are added to it,
+and the users mentioned in its description.
+*/
+type TaskRepository struct {
+	taskrepo.TaskRepository
+	NotifyUserService *notificationservice.NotifyUserService
+}
+
+func NewTaskRepository(
+	taskRepository taskrepo.TaskRepository,
+	notifyUserService *notificationservice.NotifyUserService,
+) *TaskRepository {
+	return &TaskRepository{
+		TaskRepository:    taskRepository,
+		NotifyUserService: notifyUserService,
+	}
+}
+
+func (r *TaskRepository) SetTransaction(tx core.Transaction) error {
+	if err := r.TaskRepository.SetTransaction(tx); err != nil {
+		return err
+	}
+
+	return r.NotifyUserService.SetTransaction(tx)
+}
+
+func (r *TaskRepository) notifyAssignees(tsk *task.Task, taskUsers []*task.TaskUser, userActorIdentity *core.Identity) error {
+	for _, taskUser := range taskUsers {
+		err := r.NotifyUserService.Execute(notificationservice.NotifyUserInput{
+			UserIdentity:      taskUser.User.Identity,
+			Type:              notification.NotificationTypeTaskAssigned,
+			Message:           fmt.Sprintf("You were assigned to task %q", tsk.Name),
+			ProjectIdentity:   &tsk.ProjectIdentity,
+			TaskIdentity:      &tsk.Identity,
+			UserActorIdentity: userActorIdentity,
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+/*
+notifyMentionedUsers notifies the users mentioned in a task description or comment. Notifications are deduplicated by
+reference, so a mention kept across edits is only notified once.
+*/
+func notifyMentionedUsers(notifyUserService *notificationservice.NotifyUserService, tsk *task.Task, references []task.TaskReference, message string) error {
+	for _, reference := range references {
+		if !reference.IsUserMention() {
+			continue
+		}
+
+		deduplicationKey := fmt.Sprintf("%s:%s", notification.NotificationTypeTaskMentioned, reference.Identity.Internal.String())
+		err := notifyUserService.Execute(notificationservice.NotifyUserInput{
+			UserIdentity:      *reference.UserIdentity,
+			Type:              notification.NotificationTypeTaskMentioned,
+			Message:           message,
+			ProjectIdentity:   &tsk.ProjectIdentity,
+			TaskIdentity:      &tsk.Identity,
+			UserActorIdentity: &reference.UserAuthorIdentity,
+			DeduplicationKey:  &deduplicationKey,
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (r *TaskRepository) StoreTask(params taskrepo.StoreTaskParams) (*task.Task, error) {
+	tsk, err := r.TaskRepository.StoreTask(params)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := r.notifyAssignees(tsk, tsk.Users, tsk.UserCreatorIdentity); err != nil {
+		return nil, err
+	}
+
+	if err := notifyMentionedUsers(r.NotifyUserService, tsk, tsk.References, fmt.Sprintf("You were mentioned in task %q", tsk.Name)); err != nil {
+		return nil, err
+	}
+
+	return tsk, nil
+}
+
+func (r *TaskRepository) UpdateTask(params taskrepo.UpdateTaskParams) error {
+	var previousTask *task.Task = nil
+	if len(params.Task.Users) > 0 {
+		var err error
+		previousTask, err = r.TaskRepository.GetTaskByIdentity(taskrepo.GetTaskByIdentityParams{
+			TaskIdentity: params.Task.Identity,
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	if err := r.TaskRepository.UpdateTask(params); err != nil {
+		return err
+	}
+
+	var addedTaskUsers []*task.TaskUser = make([]*task.TaskUser, 0)
+	for _, taskUser := range params.Task.Users {
+		if previousTask == nil || !previousTask.HasUser(taskUser.User.Identity) {
+			addedTaskUsers = append(addedTaskUsers, taskUser)
+		}
+	}
+
+	if err := r.notifyAssignees(params.Task, addedTaskUsers, params.Task.UserEditorIdentity); err != nil {
+		return err
+	}
+
+	return notifyMentionedUsers(r.NotifyUserService, params.Task, params.Task.References, fmt.Sprintf("You were mentioned in task %q", params.Task.Name))
+}
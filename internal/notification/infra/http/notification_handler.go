@@ -0,0 +1,241 @@
+package notificationhttp
+
+import (
+	"net/http"
+
+	authhttpmiddlewares "github.com/gabrielmrtt/taski/internal/auth/infra/http/middlewares"
+	"github.com/gabrielmrtt/taski/internal/core"
+	corehttp "github.com/gabrielmrtt/taski/internal/core/http"
+	"github.com/gabrielmrtt/taski/internal/notification"
+	notificationhttprequests "github.com/gabrielmrtt/taski/internal/notification/infra/http/requests"
+	notificationservice "github.com/gabrielmrtt/taski/internal/notification/service"
+	"github.com/gin-gonic/gin"
+)
+
+type NotificationHandler struct {
+	ListNotificationsService             *notificationservice.ListNotificationsService
+	GetUnreadNotificationsCountService   *notificationservice.GetUnreadNotificationsCountService
+	MarkNotificationAsReadService        *notificationservice.MarkNotificationAsReadService
+	MarkAllNotificationsAsReadService    *notificationservice.MarkAllNotificationsAsReadService
+	ListNotificationPreferencesService   *notificationservice.ListNotificationPreferencesService
+	UpdateNotificationPreferencesService *notificationservice.UpdateNotificationPreferencesService
+}
+
+func NewNotificationHandler(
+	listNotificationsService *notificationservice.ListNotificationsService,
+	getUnreadNotificationsCountService *notificationservice.GetUnreadNotificationsCountService,
+	markNotificationAsReadService *notificationservice.MarkNotificationAsReadService,
+	markAllNotificationsAsReadService *notificationservice.MarkAllNotificationsAsReadService,
+	listNotificationPreferencesService *notificationservice.ListNotificationPreferencesService,
+	updateNotificationPreferencesService *notificationservice.UpdateNotificationPreferencesService,
+) *NotificationHandler {
+	return &NotificationHandler{
+		ListNotificationsService:             listNotificationsService,
+		GetUnreadNotificationsCountService:   getUnreadNotificationsCountService,
+		MarkNotificationAsReadService:        markNotificationAsReadService,
+		MarkAllNotificationsAsReadService:    markAllNotificationsAsReadService,
+		ListNotificationPreferencesService:   listNotificationPreferencesService,
+		UpdateNotificationPreferencesService: updateNotificationPreferencesService,
+	}
+}
+
+type ListNotificationsResponse = corehttp.HttpSuccessResponseWithData[core.PaginationOutput[notification.NotificationDto]]
+
+// ListNotifications godoc
+// @Summary List my notifications
+// @Description Lists the notifications of the authenticated user, newest first unless sorted otherwise.
+// @Tags Notification
+// @Accept json
+// @Param request query notificationhttprequests.ListNotificationsRequest true "Query parameters"
+// @Produce json
+// @Success 200 {object} ListNotificationsResponse
+// @Failure 400 {object} corehttp.HttpErrorResponse
+// @Failure 401 {object} corehttp.HttpErrorResponse
+// @Failure 500 {object} corehttp.HttpErrorResponse
+// @Router /me/notifications [get]
+func (h *NotificationHandler) ListNotifications(c *gin.Context) {
+	var request notificationhttprequests.ListNotificationsRequest
+	var authenticatedUserIdentity *core.Identity = authhttpmiddlewares.GetAuthenticatedUserIdentity(c)
+	var input notificationservice.ListNotificationsInput
+
+	if err := request.FromQuery(c); err != nil {
+		corehttp.NewHttpErrorResponse(c, err)
+		return
+	}
+
+	input = request.ToInput()
+	input.Filters.UserIdentity = *authenticatedUserIdentity
+	response, err := h.ListNotificationsService.Execute(input)
+	if err != nil {
+		corehttp.NewHttpErrorResponse(c, err)
+		return
+	}
+
+	corehttp.NewHttpSuccessResponseWithData(c, http.StatusOK, response)
+}
+
+type GetUnreadNotificationsCountResponse = corehttp.HttpSuccessResponseWithData[notification.NotificationUnreadCountDto]
+
+// GetUnreadNotificationsCount godoc
+// @Summary Count my unread notifications
+// @Description Returns how many notifications of the authenticated user are unread.
+// @Tags Notification
+// @Accept json
+// @Produce json
+// @Success 200 {object} GetUnreadNotificationsCountResponse
+// @Failure 401 {object} corehttp.HttpErrorResponse
+// @Failure 500 {object} corehttp.HttpErrorResponse
+// @Router /me/notifications/unread-count [get]
+func (h *NotificationHandler) GetUnreadNotificationsCount(c *gin.Context) {
+	var authenticatedUserIdentity *core.Identity = authhttpmiddlewares.GetAuthenticatedUserIdentity(c)
+	var input notificationservice.GetUnreadNotificationsCountInput = notificationservice.GetUnreadNotificationsCountInput{
+		UserIdentity: *authenticatedUserIdentity,
+	}
+
+	response, err := h.GetUnreadNotificationsCountService.Execute(input)
+	if err != nil {
+		corehttp.NewHttpErrorResponse(c, err)
+		return
+	}
+
+	corehttp.NewHttpSuccessResponseWithData(c, http.StatusOK, response)
+}
+
+type MarkNotificationAsReadResponse = corehttp.HttpSuccessResponseWithData[notification.NotificationDto]
+
+// MarkNotificationAsRead godoc
+// @Summary Mark a notification as read
+// @Description Marks a notification of the authenticated user as read.
+// @Tags Notification
+// @Accept json
+// @Param notificationId path string true "Notification ID"
+// @Produce json
+// @Success 200 {object} MarkNotificationAsReadResponse
+// @Failure 401 {object} corehttp.HttpErrorResponse
+// @Failure 404 {object} corehttp.HttpErrorResponse
+// @Failure 500 {object} corehttp.HttpErrorResponse
+// @Router /me/notifications/:notificationId/read [patch]
+func (h *NotificationHandler) MarkNotificationAsRead(c *gin.Context) {
+	var authenticatedUserIdentity *core.Identity = authhttpmiddlewares.GetAuthenticatedUserIdentity(c)
+	var input notificationservice.MarkNotificationAsReadInput = notificationservice.MarkNotificationAsReadInput{
+		NotificationIdentity: core.NewIdentityFromPublic(c.Param("notificationId")),
+		UserIdentity:         *authenticatedUserIdentity,
+	}
+
+	response, err := h.MarkNotificationAsReadService.Execute(input)
+	if err != nil {
+		corehttp.NewHttpErrorResponse(c, err)
+		return
+	}
+
+	corehttp.NewHttpSuccessResponseWithData(c, http.StatusOK, response)
+}
+
+type MarkAllNotificationsAsReadResponse = corehttp.EmptyHttpSuccessResponse
+
+// MarkAllNotificationsAsRead godoc
+// @Summary Mark all my notifications as read
+// @Description Marks every unread notification of the authenticated user as read.
+// @Tags Notification
+// @Accept json
+// @Produce json
+// @Success 200 {object} MarkAllNotificationsAsReadResponse
+// @Failure 401 {object} corehttp.HttpErrorResponse
+// @Failure 500 {object} corehttp.HttpErrorResponse
+// @Router /me/notifications/read [patch]
+func (h *NotificationHandler) MarkAllNotificationsAsRead(c *gin.Context) {
+	var authenticatedUserIdentity *core.Identity = authhttpmiddlewares.GetAuthenticatedUserIdentity(c)
+	var input notificationservice.MarkAllNotificationsAsReadInput = notificationservice.MarkAllNotificationsAsReadInput{
+		UserIdentity: *authenticatedUserIdentity,
+	}
+
+	err := h.MarkAllNotificationsAsReadService.Execute(input)
+	if err != nil {
+		corehttp.NewHttpErrorResponse(c, err)
+		return
+	}
+
+	corehttp.NewEmptyHttpSuccessResponse(c, http.StatusOK)
+}
+
+type ListNotificationPreferencesResponse = corehttp.HttpSuccessResponseWithData[[]notification.NotificationPreferenceDto]
+
+// ListNotificationPreferences godoc
+// @Summary List my notification preferences
+// @Description Returns whether each notification type is enabled for the authenticated user. Every type is enabled by default.
+// @Tags Notification
+// @Accept json
+// @Produce json
+// @Success 200 {object} ListNotificationPreferencesResponse
+// @Failure 401 {object} corehttp.HttpErrorResponse
+// @Failure 500 {object} corehttp.HttpErrorResponse
+// @Router /me/notifications/preferences [get]
+func (h *NotificationHandler) ListNotificationPreferences(c *gin.Context) {
+	var authenticatedUserIdentity *core.Identity = authhttpmiddlewares.GetAuthenticatedUserIdentity(c)
+	var input notificationservice.ListNotificationPreferencesInput = notificationservice.ListNotificationPreferencesInput{
+		UserIdentity: *authenticatedUserIdentity,
+	}
+
+	response, err := h.ListNotificationPreferencesService.Execute(input)
+	if err != nil {
+		corehttp.NewHttpErrorResponse(c, err)
+		return
+	}
+
+	corehttp.NewHttpSuccessResponseWithData(c, http.StatusOK, &response)
+}
+
+type UpdateNotificationPreferencesResponse = corehttp.HttpSuccessResponseWithData[[]notification.NotificationPreferenceDto]
+
+// UpdateNotificationPreferences godoc
+// @Summary Update my notification preferences
+// @Description Enables or disables notification types for the authenticated user. Types that are not given are left untouched. Disabled types are not added to the inbox.
+// @Tags Notification
+// @Accept json
+// @Param request body notificationhttprequests.UpdateNotificationPreferencesRequest true "Request body"
+// @Produce json
+// @Success 200 {object} UpdateNotificationPreferencesResponse
+// @Failure 400 {object} corehttp.HttpErrorResponse
+// @Failure 401 {object} corehttp.HttpErrorResponse
+// @Failure 500 {object} corehttp.HttpErrorResponse
+// @Router /me/notifications/preferences [put]
+func (h *NotificationHandler) UpdateNotificationPreferences(c *gin.Context) {
+	var request notificationhttprequests.UpdateNotificationPreferencesRequest
+	var authenticatedUserIdentity *core.Identity = authhttpmiddlewares.GetAuthenticatedUserIdentity(c)
+	var input notificationservice.UpdateNotificationPreferencesInput
+
+	if err := c.ShouldBindJSON(&request); err != nil {
+		corehttp.NewHttpErrorResponse(c, err)
+		return
+	}
+
+	input = request.ToInput()
+	input.UserIdentity = *authenticatedUserIdentity
+	response, err := h.UpdateNotificationPreferencesService.Execute(input)
+	if err != nil {
+		corehttp.NewHttpErrorResponse(c, err)
+		return
+	}
+
+	corehttp.NewHttpSuccessResponseWithData(c, http.StatusOK, &response)
+}
+
+func (h *NotificationHandler) ConfigureRoutes(options corehttp.ConfigureRoutesOptions) *gin.RouterGroup {
+	middlewareOptions := corehttp.MiddlewareOptions{
+		DbConnection: options.DbConnection,
+	}
+
+	g := options.RouterGroup.Group("/me/notifications")
+	{
+		g.Use(authhttpmiddlewares.AuthMiddleware(middlewareOptions))
+
+		g.GET("", h.ListNotifications)
+		g.GET("/unread-count", h.GetUnreadNotificationsCount)
+		g.PATCH("/read", h.MarkAllNotificationsAsRead)
+		g.PATCH("/:notificationId/read", h.MarkNotificationAsRead)
+		g.GET("/preferences", h.ListNotificationPreferences)
+		g.PUT("/preferences", h.UpdateNotificationPreferences)
+	}
+
+	return g
+}
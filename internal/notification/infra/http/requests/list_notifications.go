@@ -0,0 +1,55 @@
+package notificationhttprequests
+
+import (
+	"github.com/gabrielmrtt/taski/internal/core"
+	"github.com/gabrielmrtt/taski/internal/notification"
+	notificationrepo "github.com/gabrielmrtt/taski/internal/notification/repository"
+	notificationservice "github.com/gabrielmrtt/taski/internal/notification/service"
+	"github.com/gin-gonic/gin"
+	"github.com/gorilla/schema"
+)
+
+type ListNotificationsRequest struct {
+	Type          *string `json:"type" schema:"type"`
+	Read          *bool   `json:"read" schema:"read"`
+	Page          *int    `json:"page" schema:"page"`
+	PerPage       *int    `json:"perPage" schema:"perPage"`
+	SortBy        *string `json:"sortBy" schema:"sortBy"`
+	SortDirection *string `json:"sortDirection" schema:"sortDirection"`
+}
+
+func (r *ListNotificationsRequest) FromQuery(ctx *gin.Context) error {
+	schemaDecoder := schema.NewDecoder()
+	schemaDecoder.IgnoreUnknownKeys(true)
+	return schemaDecoder.Decode(r, ctx.Request.URL.Query())
+}
+
+func (r *ListNotificationsRequest) ToInput() notificationservice.ListNotificationsInput {
+	var sortDirection core.SortDirection
+	if r.SortDirection != nil {
+		sortDirection = core.SortDirection(*r.SortDirection)
+	}
+
+	var typeFilter *core.ComparableFilter[notification.NotificationTypes] = nil
+	if r.Type != nil {
+		notificationType := notification.NotificationTypes(*r.Type)
+		typeFilter = &core.ComparableFilter[notification.NotificationTypes]{
+			Equals: &notificationType,
+		}
+	}
+
+	return notificationservice.ListNotificationsInput{
+		Filters: notificationrepo.NotificationFilters{
+			Type: typeFilter,
+			Read: r.Read,
+		},
+		Pagination: core.PaginationInput{
+			Page:    r.Page,
+			PerPage: r.PerPage,
+		},
+		SortInput: core.SortInput{
+			By:        r.SortBy,
+			Direction: &sortDirection,
+		},
+	}
+}
@@ -0,0 +1,29 @@
+package notificationhttprequests
+
+import (
+	"github.com/gabrielmrtt/taski/internal/notification"
+	notificationservice "github.com/gabrielmrtt/taski/internal/notification/service"
+)
+
+type UpdateNotificationPreferenceRequest struct {
+	Type    string `json:"type"`
+	Enabled bool   `json:"enabled"`
+}
+
+type UpdateNotificationPreferencesRequest struct {
+	Preferences []UpdateNotificationPreferenceRequest `json:"preferences"`
+}
+
+func (r *UpdateNotificationPreferencesRequest) ToInput() notificationservice.UpdateNotificationPreferencesInput {
+	var preferences []notificationservice.UpdateNotificationPreferenceInput = make([]notificationservice.UpdateNotificationPreferenceInput, 0)
+	for _, preference := range r.Preferences {
+		preferences = append(preferences, notificationservice.UpdateNotificationPreferenceInput{
+			Type:    notification.NotificationTypes(preference.Type),
+			Enabled: preference.Enabled,
+		})
+	}
+
+	return notificationservice.UpdateNotificationPreferencesInput{
+		Preferences: preferences,
+	}
+}
@@ -0,0 +1,28 @@
+package notificationrepo
+
+import (
+	"github.com/gabrielmrtt/taski/internal/core"
+	"github.com/gabrielmrtt/taski/internal/notification"
+)
+
+type ListNotificationPreferencesParams struct {
+	UserIdentity core.Identity
+}
+
+type GetNotificationPreferenceParams struct {
+	UserIdentity core.Identity
+	Type         notification.NotificationTypes
+}
+
+type SaveNotificationPreferenceParams struct {
+	NotificationPreference *notification.NotificationPreference
+}
+
+type NotificationPreferenceRepository interface {
+	SetTransaction(tx core.Transaction) error
+
+	ListNotificationPreferences(params ListNotificationPreferencesParams) ([]notification.NotificationPreference, error)
+	GetNotificationPreference(params GetNotificationPreferenceParams) (*notification.NotificationPreference, error)
+
+	SaveNotificationPreference(params SaveNotificationPreferenceParams) error
+}
@@ -0,0 +1,53 @@
+package notificationrepo
+
+import (
+	"github.com/gabrielmrtt/taski/internal/core"
+	"github.com/gabrielmrtt/taski/internal/notification"
+)
+
+type NotificationFilters struct {
+	UserIdentity core.Identity
+	Type         *core.ComparableFilter[notification.NotificationTypes]
+	Read         *bool
+	CreatedAt    *core.ComparableFilter[int64]
+}
+
+type GetNotificationByIdentityParams struct {
+	NotificationIdentity core.Identity
+	UserIdentity         core.Identity
+}
+
+type PaginateNotificationsParams struct {
+	Filters    NotificationFilters
+	SortInput  core.SortInput
+	Pagination core.PaginationInput
+}
+
+type CountUnreadNotificationsParams struct {
+	UserIdentity core.Identity
+}
+
+type StoreNotificationParams struct {
+	Notification *notification.Notification
+}
+
+type UpdateNotificationParams struct {
+	Notification *notification.Notification
+}
+
+type MarkAllNotificationsAsReadParams struct {
+	UserIdentity core.Identity
+	ReadAt       core.DateTime
+}
+
+type NotificationRepository interface {
+	SetTransaction(tx core.Transaction) error
+
+	GetNotificationByIdentity(params GetNotificationByIdentityParams) (*notification.Notification, error)
+	PaginateNotificationsBy(params PaginateNotificationsParams) (*core.PaginationOutput[notification.Notification], error)
+	CountUnreadNotifications(params CountUnreadNotificationsParams) (int, error)
+
+	StoreNotification(params StoreNotificationParams) error
+	UpdateNotification(params UpdateNotificationParams) error
+	MarkAllNotificationsAsRead(params MarkAllNotificationsAsReadParams) (int, error)
+}
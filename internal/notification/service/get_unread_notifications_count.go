@@ -0,0 +1,40 @@
+package notificationservice
+
+import (
+	"github.com/gabrielmrtt/taski/internal/core"
+	"github.com/gabrielmrtt/taski/internal/notification"
+	notificationrepo "github.com/gabrielmrtt/taski/internal/notification/repository"
+)
+
+type GetUnreadNotificationsCountService struct {
+	NotificationRepository notificationrepo.NotificationRepository
+}
+
+func NewGetUnreadNotificationsCountService(notificationRepository notificationrepo.NotificationRepository) *GetUnreadNotificationsCountService {
+	return &GetUnreadNotificationsCountService{
+		NotificationRepository: notificationRepository,
+	}
+}
+
+type GetUnreadNotificationsCountInput struct {
+	UserIdentity core.Identity
+}
+
+func (i GetUnreadNotificationsCountInput) Validate() error {
+	return nil
+}
+
+func (s *GetUnreadNotificationsCountService) Execute(input GetUnreadNotificationsCountInput) (*notification.NotificationUnreadCountDto, error) {
+	if err := input.Validate(); err != nil {
+		return nil, err
+	}
+
+	unread, err := s.NotificationRepository.CountUnreadNotifications(notificationrepo.CountUnreadNotificationsParams{
+		UserIdentity: input.UserIdentity,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &notification.NotificationUnreadCountDto{Unread: unread}, nil
+}
@@ -0,0 +1,65 @@
+package notificationservice
+
+import (
+	"github.com/gabrielmrtt/taski/internal/core"
+	"github.com/gabrielmrtt/taski/internal/notification"
+	notificationrepo "github.com/gabrielmrtt/taski/internal/notification/repository"
+)
+
+type ListNotificationPreferencesService struct {
+	NotificationPreferenceRepository notificationrepo.NotificationPreferenceRepository
+}
+
+func NewListNotificationPreferencesService(notificationPreferenceRepository notificationrepo.NotificationPreferenceRepository) *ListNotificationPreferencesService {
+	return &ListNotificationPreferencesService{
+		NotificationPreferenceRepository: notificationPreferenceRepository,
+	}
+}
+
+type ListNotificationPreferencesInput struct {
+	UserIdentity core.Identity
+}
+
+func (i ListNotificationPreferencesInput) Validate() error {
+	return nil
+}
+
+/*
+Execute returns the preference of every notification type, the types the user never changed being enabled.
+*/
+func (s *ListNotificationPreferencesService) Execute(input ListNotificationPreferencesInput) ([]notification.NotificationPreferenceDto, error) {
+	if err := input.Validate(); err != nil {
+		return nil, err
+	}
+
+	return listNotificationPreferences(s.NotificationPreferenceRepository, input.UserIdentity)
+}
+
+func listNotificationPreferences(notificationPreferenceRepository notificationrepo.NotificationPreferenceRepository, userIdentity core.Identity) ([]notification.NotificationPreferenceDto, error) {
+	preferences, err := notificationPreferenceRepository.ListNotificationPreferences(notificationrepo.ListNotificationPreferencesParams{
+		UserIdentity: userIdentity,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var enabledByType map[notification.NotificationTypes]bool = make(map[notification.NotificationTypes]bool)
+	for _, preference := range preferences {
+		enabledByType[preference.Type] = preference.Enabled
+	}
+
+	var preferencesDto []notification.NotificationPreferenceDto = make([]notification.NotificationPreferenceDto, 0)
+	for _, notificationType := range notification.NotificationTypesArray {
+		enabled, ok := enabledByType[notificationType]
+		if !ok {
+			enabled = true
+		}
+
+		preferencesDto = append(preferencesDto, notification.NotificationPreferenceDto{
+			Type:    string(notificationType),
+			Enabled: enabled,
+		})
+	}
+
+	return preferencesDto, nil
+}
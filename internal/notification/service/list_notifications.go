@@ -0,0 +1,54 @@
+package notificationservice
+
+import (
+	"github.com/gabrielmrtt/taski/internal/core"
+	"github.com/gabrielmrtt/taski/internal/notification"
+	notificationrepo "github.com/gabrielmrtt/taski/internal/notification/repository"
+)
+
+type ListNotificationsService struct {
+	NotificationRepository notificationrepo.NotificationRepository
+}
+
+func NewListNotificationsService(notificationRepository notificationrepo.NotificationRepository) *ListNotificationsService {
+	return &ListNotificationsService{
+		NotificationRepository: notificationRepository,
+	}
+}
+
+type ListNotificationsInput struct {
+	Filters    notificationrepo.NotificationFilters
+	SortInput  core.SortInput
+	Pagination core.PaginationInput
+}
+
+func (i ListNotificationsInput) Validate() error {
+	return nil
+}
+
+func (s *ListNotificationsService) Execute(input ListNotificationsInput) (*core.PaginationOutput[notification.NotificationDto], error) {
+	if err := input.Validate(); err != nil {
+		return nil, err
+	}
+
+	notifications, err := s.NotificationRepository.PaginateNotificationsBy(notificationrepo.PaginateNotificationsParams{
+		Filters:    input.Filters,
+		SortInput:  input.SortInput,
+		Pagination: input.Pagination,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var notificationsDto []notification.NotificationDto = make([]notification.NotificationDto, 0)
+	for _, ntf := range notifications.Data {
+		notificationsDto = append(notificationsDto, *notification.NotificationToDto(&ntf))
+	}
+
+	return &core.PaginationOutput[notification.NotificationDto]{
+		Data:    notificationsDto,
+		Page:    notifications.Page,
+		HasMore: notifications.HasMore,
+		Total:   notifications.Total,
+	}, nil
+}
@@ -0,0 +1,59 @@
+package notificationservice
+
+import (
+	"github.com/gabrielmrtt/taski/internal/core"
+	notificationrepo "github.com/gabrielmrtt/taski/internal/notification/repository"
+)
+
+type MarkAllNotificationsAsReadService struct {
+	NotificationRepository notificationrepo.NotificationRepository
+	TransactionRepository  core.TransactionRepository
+}
+
+func NewMarkAllNotificationsAsReadService(
+	notificationRepository notificationrepo.NotificationRepository,
+	transactionRepository core.TransactionRepository,
+) *MarkAllNotificationsAsReadService {
+	return &MarkAllNotificationsAsReadService{
+		NotificationRepository: notificationRepository,
+		TransactionRepository:  transactionRepository,
+	}
+}
+
+type MarkAllNotificationsAsReadInput struct {
+	UserIdentity core.Identity
+}
+
+func (i MarkAllNotificationsAsReadInput) Validate() error {
+	return nil
+}
+
+func (s *MarkAllNotificationsAsReadService) Execute(input MarkAllNotificationsAsReadInput) error {
+	if err := input.Validate(); err != nil {
+		return err
+	}
+
+	tx, err := s.TransactionRepository.BeginTransaction()
+	if err != nil {
+		return err
+	}
+
+	s.NotificationRepository.SetTransaction(tx)
+
+	_, err = s.NotificationRepository.MarkAllNotificationsAsRead(notificationrepo.MarkAllNotificationsAsReadParams{
+		UserIdentity: input.UserIdentity,
+		ReadAt:       core.NewDateTime(),
+	})
+	if err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	return nil
+}
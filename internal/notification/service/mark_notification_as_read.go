@@ -0,0 +1,76 @@
+package notificationservice
+
+import (
+	"github.com/gabrielmrtt/taski/internal/core"
+	"github.com/gabrielmrtt/taski/internal/notification"
+	notificationrepo "github.com/gabrielmrtt/taski/internal/notification/repository"
+)
+
+type MarkNotificationAsReadService struct {
+	NotificationRepository notificationrepo.NotificationRepository
+	TransactionRepository  core.TransactionRepository
+}
+
+func NewMarkNotificationAsReadService(
+	notificationRepository notificationrepo.NotificationRepository,
+	transactionRepository core.TransactionRepository,
+) *MarkNotificationAsReadService {
+	return &MarkNotificationAsReadService{
+		NotificationRepository: notificationRepository,
+		TransactionRepository:  transactionRepository,
+	}
+}
+
+type MarkNotificationAsReadInput struct {
+	NotificationIdentity core.Identity
+	UserIdentity         core.Identity
+}
+
+func (i MarkNotificationAsReadInput) Validate() error {
+	return nil
+}
+
+func (s *MarkNotificationAsReadService) Execute(input MarkNotificationAsReadInput) (*notification.NotificationDto, error) {
+	if err := input.Validate(); err != nil {
+		return nil, err
+	}
+
+	tx, err := s.TransactionRepository.BeginTransaction()
+	if err != nil {
+		return nil, err
+	}
+
+	s.NotificationRepository.SetTransaction(tx)
+
+	ntf, err := s.NotificationRepository.GetNotificationByIdentity(notificationrepo.GetNotificationByIdentityParams{
+		NotificationIdentity: input.NotificationIdentity,
+		UserIdentity:         input.UserIdentity,
+	})
+	if err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+
+	if ntf == nil {
+		tx.Rollback()
+		return nil, core.NewNotFoundError("notification not found")
+	}
+
+	if !ntf.IsRead() {
+		ntf.MarkAsRead()
+
+		err = s.NotificationRepository.UpdateNotification(notificationrepo.UpdateNotificationParams{Notification: ntf})
+		if err != nil {
+			tx.Rollback()
+			return nil, err
+		}
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+
+	return notification.NotificationToDto(ntf), nil
+}
@@ -0,0 +1,128 @@
+package notificationservice
+
+import (
+	"fmt"
+
+	"github.com/gabrielmrtt/taski/internal/core"
+	"github.com/gabrielmrtt/taski/internal/notification"
+	taskrepo "github.com/gabrielmrtt/taski/internal/task/repository"
+)
+
+type NotifyTasksDueSoonService struct {
+	TaskRepository        taskrepo.TaskRepository
+	NotifyUserService     *NotifyUserService
+	TransactionRepository core.TransactionRepository
+}
+
+func NewNotifyTasksDueSoonService(
+	taskRepository taskrepo.TaskRepository,
+	notifyUserService *NotifyUserService,
+	transactionRepository core.TransactionRepository,
+) *NotifyTasksDueSoonService {
+	return &NotifyTasksDueSoonService{
+		TaskRepository:        taskRepository,
+		NotifyUserService:     notifyUserService,
+		TransactionRepository: transactionRepository,
+	}
+}
+
+type NotifyTasksDueSoonInput struct {
+	BatchSize int
+}
+
+func (i NotifyTasksDueSoonInput) Validate() error {
+	var fields []core.InvalidInputErrorField
+
+	if i.BatchSize <= 0 {
+		fields = append(fields, core.InvalidInputErrorField{
+			Field: "batchSize",
+			Error: "batch size must be greater than 0",
+		})
+	}
+
+	if len(fields) > 0 {
+		return core.NewInvalidInputError("invalid input", fields)
+	}
+
+	return nil
+}
+
+/*
+Execute notifies the assignees of the uncompleted tasks due within NotificationTaskDueSoonWindowSeconds. A task is
+notified once per due date, so running it again or on several API instances is harmless and a rescheduled task is
+notified again.
+*/
+func (s *NotifyTasksDueSoonService) Execute(input NotifyTasksDueSoonInput) error {
+	if err := input.Validate(); err != nil {
+		return err
+	}
+
+	now := core.NewDateTime()
+	dueUntil := now.Value + notification.NotificationTaskDueSoonWindowSeconds
+	notCompleted := false
+	page := 1
+
+	tx, err := s.TransactionRepository.BeginTransaction()
+	if err != nil {
+		return err
+	}
+
+	s.TaskRepository.SetTransaction(tx)
+	s.NotifyUserService.SetTransaction(tx)
+
+	for {
+		tasks, err := s.TaskRepository.PaginateTasksBy(taskrepo.PaginateTasksParams{
+			Filters: taskrepo.TaskFilters{
+				DueDate: &core.ComparableFilter[int64]{
+					GreaterThanOrEqual: &now.Value,
+					LessThanOrEqual:    &dueUntil,
+				},
+				CompletedAt: &core.ComparableFilter[int64]{NotNull: &notCompleted},
+			},
+			Pagination: core.PaginationInput{
+				Page:    &page,
+				PerPage: &input.BatchSize,
+			},
+		})
+		if err != nil {
+			tx.Rollback()
+			return err
+		}
+
+		for _, tsk := range tasks.Data {
+			if tsk.IsDeleted() || tsk.DueDate == nil {
+				continue
+			}
+
+			deduplicationKey := fmt.Sprintf("%s:%s:%d", notification.NotificationTypeTaskDueSoon, tsk.Identity.Public, tsk.DueDate.Value)
+			for _, taskUser := range tsk.Users {
+				err = s.NotifyUserService.Execute(NotifyUserInput{
+					UserIdentity:     taskUser.User.Identity,
+					Type:             notification.NotificationTypeTaskDueSoon,
+					Message:          fmt.Sprintf("Task %q is due on %s", tsk.Name, tsk.DueDate.ToRFC3339()),
+					ProjectIdentity:  &tsk.ProjectIdentity,
+					TaskIdentity:     &tsk.Identity,
+					DeduplicationKey: &deduplicationKey,
+				})
+				if err != nil {
+					tx.Rollback()
+					return err
+				}
+			}
+		}
+
+		if !tasks.HasMore {
+			break
+		}
+
+		page++
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,84 @@
+package notificationservice
+
+import (
+	"github.com/gabrielmrtt/taski/internal/core"
+	"github.com/gabrielmrtt/taski/internal/notification"
+	notificationrepo "github.com/gabrielmrtt/taski/internal/notification/repository"
+)
+
+/*
+NotifyUserService adds a notification to the inbox of an user, unless the user disabled its type or caused it. It does
+not open its own transaction: callers set theirs so the notification only exists if the change behind it is committed.
+*/
+type NotifyUserService struct {
+	NotificationRepository           notificationrepo.NotificationRepository
+	NotificationPreferenceRepository notificationrepo.NotificationPreferenceRepository
+}
+
+func NewNotifyUserService(
+	notificationRepository notificationrepo.NotificationRepository,
+	notificationPreferenceRepository notificationrepo.NotificationPreferenceRepository,
+) *NotifyUserService {
+	return &NotifyUserService{
+		NotificationRepository:           notificationRepository,
+		NotificationPreferenceRepository: notificationPreferenceRepository,
+	}
+}
+
+func (s *NotifyUserService) SetTransaction(tx core.Transaction) error {
+	if err := s.NotificationRepository.SetTransaction(tx); err != nil {
+		return err
+	}
+
+	return s.NotificationPreferenceRepository.SetTransaction(tx)
+}
+
+type NotifyUserInput struct {
+	UserIdentity         core.Identity
+	Type                 notification.NotificationTypes
+	Message              string
+	OrganizationIdentity *core.Identity
+	ProjectIdentity      *core.Identity
+	TaskIdentity         *core.Identity
+	UserActorIdentity    *core.Identity
+	DeduplicationKey     *string
+}
+
+func (i NotifyUserInput) Validate() error {
+	return nil
+}
+
+func (s *NotifyUserService) Execute(input NotifyUserInput) error {
+	if err := input.Validate(); err != nil {
+		return err
+	}
+
+	if input.UserActorIdentity != nil && *input.UserActorIdentity == input.UserIdentity {
+		return nil
+	}
+
+	preference, err := s.NotificationPreferenceRepository.GetNotificationPreference(notificationrepo.GetNotificationPreferenceParams{
+		UserIdentity: input.UserIdentity,
+		Type:         input.Type,
+	})
+	if err != nil {
+		return err
+	}
+
+	if preference != nil && !preference.Enabled {
+		return nil
+	}
+
+	return s.NotificationRepository.StoreNotification(notificationrepo.StoreNotificationParams{
+		Notification: notification.NewNotification(notification.NewNotificationInput{
+			UserIdentity:         input.UserIdentity,
+			Type:                 input.Type,
+			Message:              input.Message,
+			OrganizationIdentity: input.OrganizationIdentity,
+			ProjectIdentity:      input.ProjectIdentity,
+			TaskIdentity:         input.TaskIdentity,
+			UserActorIdentity:    input.UserActorIdentity,
+			DeduplicationKey:     input.DeduplicationKey,
+		}),
+	})
+}
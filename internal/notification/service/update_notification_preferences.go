@@ -0,0 +1,110 @@
+package notificationservice
+
+import (
+	"strconv"
+
+	"github.com/gabrielmrtt/taski/internal/core"
+	"github.com/gabrielmrtt/taski/internal/notification"
+	notificationrepo "github.com/gabrielmrtt/taski/internal/notification/repository"
+)
+
+type UpdateNotificationPreferencesService struct {
+	NotificationPreferenceRepository notificationrepo.NotificationPreferenceRepository
+	TransactionRepository            core.TransactionRepository
+}
+
+func NewUpdateNotificationPreferencesService(
+	notificationPreferenceRepository notificationrepo.NotificationPreferenceRepository,
+	transactionRepository core.TransactionRepository,
+) *UpdateNotificationPreferencesService {
+	return &UpdateNotificationPreferencesService{
+		NotificationPreferenceRepository: notificationPreferenceRepository,
+		TransactionRepository:            transactionRepository,
+	}
+}
+
+type UpdateNotificationPreferenceInput struct {
+	Type    notification.NotificationTypes
+	Enabled bool
+}
+
+type UpdateNotificationPreferencesInput struct {
+	UserIdentity core.Identity
+	Preferences  []UpdateNotificationPreferenceInput
+}
+
+func (i UpdateNotificationPreferencesInput) Validate() error {
+	var fields []core.InvalidInputErrorField
+
+	if len(i.Preferences) == 0 {
+		fields = append(fields, core.InvalidInputErrorField{
+			Field: "preferences",
+			Error: "at least one preference is required",
+		})
+	}
+
+	if len(fields) > 0 {
+		return core.NewInvalidInputError("invalid input", fields)
+	}
+
+	return nil
+}
+
+/*
+Execute saves the given preferences, leaving the types that are not given untouched, and returns every preference.
+*/
+func (s *UpdateNotificationPreferencesService) Execute(input UpdateNotificationPreferencesInput) ([]notification.NotificationPreferenceDto, error) {
+	if err := input.Validate(); err != nil {
+		return nil, err
+	}
+
+	var preferences []*notification.NotificationPreference = make([]*notification.NotificationPreference, 0)
+	for index, preferenceInput := range input.Preferences {
+		preference, err := notification.NewNotificationPreference(notification.NewNotificationPreferenceInput{
+			UserIdentity: input.UserIdentity,
+			Type:         preferenceInput.Type,
+			Enabled:      preferenceInput.Enabled,
+		})
+		if err != nil {
+			return nil, core.NewInvalidInputError("invalid input", []core.InvalidInputErrorField{
+				{
+					Field: "preferences[" + strconv.Itoa(index) + "].type",
+					Error: "invalid notification type",
+				},
+			})
+		}
+
+		preferences = append(preferences, preference)
+	}
+
+	tx, err := s.TransactionRepository.BeginTransaction()
+	if err != nil {
+		return nil, err
+	}
+
+	s.NotificationPreferenceRepository.SetTransaction(tx)
+
+	for _, preference := range preferences {
+		err = s.NotificationPreferenceRepository.SaveNotificationPreference(notificationrepo.SaveNotificationPreferenceParams{
+			NotificationPreference: preference,
+		})
+		if err != nil {
+			tx.Rollback()
+			return nil, err
+		}
+	}
+
+	preferencesDto, err := listNotificationPreferences(s.NotificationPreferenceRepository, input.UserIdentity)
+	if err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+
+	return preferencesDto, nil
+}
@@ -3,12 +3,16 @@ package organizationinfra
 import (
 	coredatabase "github.com/gabrielmrtt/taski/internal/core/database"
 	corehttp "github.com/gabrielmrtt/taski/internal/core/http"
+	notificationinfra "github.com/gabrielmrtt/taski/internal/notification/infra"
+	notificationevents "github.com/gabrielmrtt/taski/internal/notification/infra/events"
 	organizationdatabase "github.com/gabrielmrtt/taski/internal/organization/infra/database"
 	organizationhttp "github.com/gabrielmrtt/taski/internal/organization/infra/http"
 	organizationservice "github.com/gabrielmrtt/taski/internal/organization/service"
 	projectdatabase "github.com/gabrielmrtt/taski/internal/project/infra/database"
 	roledatabase "github.com/gabrielmrtt/taski/internal/role/infra/database"
 	userdatabase "github.com/gabrielmrtt/taski/internal/user/infra/database"
+	webhookinfra "github.com/gabrielmrtt/taski/internal/webhook/infra"
+	webhookevents "github.com/gabrielmrtt/taski/internal/webhook/infra/events"
 	workspacedatabase "github.com/gabrielmrtt/taski/internal/workspace/infra/database"
 	"github.com/gin-gonic/gin"
 	"github.com/uptrace/bun"
@@ -21,13 +25,17 @@ type BootstrapInfraOptions struct {
 
 func BootstrapInfra(options BootstrapInfraOptions) {
 	organizationRepository := organizationdatabase.NewOrganizationBunRepository(options.DbConnection)
-	organizationUserRepository := organizationdatabase.NewOrganizationUserBunRepository(options.DbConnection)
 	roleRepository := roledatabase.NewRoleBunRepository(options.DbConnection)
 	userRepository := userdatabase.NewUserBunRepository(options.DbConnection)
 	workspaceRepository := workspacedatabase.NewWorkspaceBunRepository(options.DbConnection)
 	workspaceUserRepository := workspacedatabase.NewWorkspaceUserBunRepository(options.DbConnection)
 	projectRepository := projectdatabase.NewProjectBunRepository(options.DbConnection)
-	projectUserRepository := projectdatabase.NewProjectUserBunRepository(options.DbConnection)
+	publishWebhookEventService := webhookinfra.NewPublishWebhookEventService(options.DbConnection)
+	organizationUserRepository := notificationevents.NewOrganizationUserRepository(
+		webhookevents.NewOrganizationUserRepository(organizationdatabase.NewOrganizationUserBunRepository(options.DbConnection), publishWebhookEventService),
+		organizationRepository, notificationinfra.NewNotifyUserService(options.DbConnection),
+	)
+	projectUserRepository := webhookevents.NewProjectUserRepository(projectdatabase.NewProjectUserBunRepository(options.DbConnection), projectRepository, workspaceRepository, publishWebhookEventService)
 	transactionRepository := coredatabase.NewTransactionBunRepository(options.DbConnection)
 
 	createOrganizationService := organizationservice.NewCreateOrganizationService(organizationRepository, organizationUserRepository, roleRepository, userRepository, transactionRepository)
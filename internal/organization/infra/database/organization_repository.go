@@ -81,6 +81,16 @@ func (o *OrganizationTable) ToEntity() *organization.Organization {
 	}
 }
 
+var organizationSortableFields = coredatabase.SortableFields{
+	Fields: map[string]string{
+		"name":      "organization.name",
+		"status":    "organization.status",
+		"createdAt": "organization.created_at",
+		"updatedAt": "organization.updated_at",
+	},
+	IdColumn: "organization.internal_id",
+}
+
 type OrganizationBunRepository struct {
 	db *bun.DB
 	tx *coredatabase.TransactionBun
@@ -191,7 +201,11 @@ func (r *OrganizationBunRepository) PaginateOrganizationsBy(params organizationr
 		return nil, err
 	}
 
-	selectQuery = coredatabase.ApplySort(selectQuery, params.SortInput)
+	selectQuery, err = coredatabase.ApplySort(selectQuery, organizationSortableFields, params.SortInput)
+	if err != nil {
+		return nil, err
+	}
+
 	selectQuery = coredatabase.ApplyPagination(selectQuery, params.Pagination)
 	err = selectQuery.Scan(context.Background())
 	if err != nil {
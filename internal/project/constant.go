@@ -6,10 +6,17 @@ const ProjectTaskStatusIdentityPrefix = "prs"
 
 const ProjectTaskCategoryIdentityPrefix = "ptc"
 
+const ProjectTaskCustomFieldIdentityPrefix = "pcf"
+
+const ProjectTaskStatusTransitionIdentityPrefix = "pst"
+
 const ProjectDocumentVersionIdentityPrefix = "pdv"
 
 const ProjectDocumentVersionManagerIdentityPrefix = "pdm"
 
+// ProjectDocumentDiffContextLines is the number of unchanged lines shown around the changes of a document diff.
+const ProjectDocumentDiffContextLines = 3
+
 type ProjectStatuses string
 
 const (
@@ -40,6 +47,70 @@ const (
 	ProjectUserStatusRefused  ProjectUserStatuses = "refused"
 )
 
+type ProjectTaskStatusWipLimitPolicies string
+
+const (
+	ProjectTaskStatusWipLimitPolicyReject ProjectTaskStatusWipLimitPolicies = "reject"
+	ProjectTaskStatusWipLimitPolicyWarn   ProjectTaskStatusWipLimitPolicies = "warn"
+)
+
+var ProjectTaskStatusWipLimitPoliciesArray = []ProjectTaskStatusWipLimitPolicies{
+	ProjectTaskStatusWipLimitPolicyReject,
+	ProjectTaskStatusWipLimitPolicyWarn,
+}
+
+type ProjectTaskCustomFieldTypes string
+
+const (
+	ProjectTaskCustomFieldTypeText         ProjectTaskCustomFieldTypes = "text"
+	ProjectTaskCustomFieldTypeNumber       ProjectTaskCustomFieldTypes = "number"
+	ProjectTaskCustomFieldTypeDate         ProjectTaskCustomFieldTypes = "date"
+	ProjectTaskCustomFieldTypeSingleSelect ProjectTaskCustomFieldTypes = "single_select"
+	ProjectTaskCustomFieldTypeMultiSelect  ProjectTaskCustomFieldTypes = "multi_select"
+	ProjectTaskCustomFieldTypeUser         ProjectTaskCustomFieldTypes = "user"
+)
+
+var ProjectTaskCustomFieldTypesArray = []ProjectTaskCustomFieldTypes{
+	ProjectTaskCustomFieldTypeText,
+	ProjectTaskCustomFieldTypeNumber,
+	ProjectTaskCustomFieldTypeDate,
+	ProjectTaskCustomFieldTypeSingleSelect,
+	ProjectTaskCustomFieldTypeMultiSelect,
+	ProjectTaskCustomFieldTypeUser,
+}
+
+type ProjectTaskStatusTransitionRequiredFields string
+
+const (
+	ProjectTaskStatusTransitionRequiredFieldAssignee         ProjectTaskStatusTransitionRequiredFields = "assignee"
+	ProjectTaskStatusTransitionRequiredFieldCategory         ProjectTaskStatusTransitionRequiredFields = "category"
+	ProjectTaskStatusTransitionRequiredFieldDescription      ProjectTaskStatusTransitionRequiredFields = "description"
+	ProjectTaskStatusTransitionRequiredFieldDueDate          ProjectTaskStatusTransitionRequiredFields = "dueDate"
+	ProjectTaskStatusTransitionRequiredFieldEstimatedMinutes ProjectTaskStatusTransitionRequiredFields = "estimatedMinutes"
+)
+
+var ProjectTaskStatusTransitionRequiredFieldsArray = []ProjectTaskStatusTransitionRequiredFields{
+	ProjectTaskStatusTransitionRequiredFieldAssignee,
+	ProjectTaskStatusTransitionRequiredFieldCategory,
+	ProjectTaskStatusTransitionRequiredFieldDescription,
+	ProjectTaskStatusTransitionRequiredFieldDueDate,
+	ProjectTaskStatusTransitionRequiredFieldEstimatedMinutes,
+}
+
+type ProjectDocumentVersionSchemes string
+
+const (
+	ProjectDocumentVersionSchemeSemantic     ProjectDocumentVersionSchemes = "semantic"
+	ProjectDocumentVersionSchemeIncrementing ProjectDocumentVersionSchemes = "incrementing"
+)
+
+var ProjectDocumentVersionSchemesArray = []ProjectDocumentVersionSchemes{
+	ProjectDocumentVersionSchemeSemantic,
+	ProjectDocumentVersionSchemeIncrementing,
+}
+
+const DefaultProjectDocumentVersionScheme = ProjectDocumentVersionSchemeSemantic
+
 var DefaultProjectTaskStatuses = []ProjectTaskStatus{
 	{
 		Name:                     "Pending",
@@ -47,6 +118,7 @@ var DefaultProjectTaskStatuses = []ProjectTaskStatus{
 		ShouldSetTaskToCompleted: false,
 		Order:                    &[]int8{1}[0],
 		IsDefault:                true,
+		WipLimitPolicy:           ProjectTaskStatusWipLimitPolicyReject,
 	},
 	{
 		Name:                     "Ongoing",
@@ -54,6 +126,7 @@ var DefaultProjectTaskStatuses = []ProjectTaskStatus{
 		ShouldSetTaskToCompleted: false,
 		Order:                    &[]int8{2}[0],
 		IsDefault:                false,
+		WipLimitPolicy:           ProjectTaskStatusWipLimitPolicyReject,
 	},
 	{
 		Name:                     "Paused",
@@ -61,6 +134,7 @@ var DefaultProjectTaskStatuses = []ProjectTaskStatus{
 		ShouldSetTaskToCompleted: false,
 		Order:                    nil,
 		IsDefault:                false,
+		WipLimitPolicy:           ProjectTaskStatusWipLimitPolicyReject,
 	},
 	{
 		Name:                     "Completed",
@@ -68,5 +142,6 @@ var DefaultProjectTaskStatuses = []ProjectTaskStatus{
 		ShouldSetTaskToCompleted: true,
 		Order:                    nil,
 		IsDefault:                false,
+		WipLimitPolicy:           ProjectTaskStatusWipLimitPolicyReject,
 	},
 }
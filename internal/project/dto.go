@@ -2,6 +2,7 @@ package project
 
 import (
 	"github.com/gabrielmrtt/taski/internal/user"
+	"github.com/gabrielmrtt/taski/pkg/diffutils"
 )
 
 type ProjectDto struct {
@@ -20,6 +21,7 @@ type ProjectDto struct {
 	Editor        *user.UserDto `json:"editor,omitempty"`
 	CreatedAt     string        `json:"createdAt"`
 	UpdatedAt     *string       `json:"updatedAt"`
+	Revision      int64         `json:"revision"`
 }
 
 func ProjectToDto(project *Project) *ProjectDto {
@@ -78,16 +80,33 @@ func ProjectToDto(project *Project) *ProjectDto {
 		Editor:        editor,
 		CreatedAt:     createdAt,
 		UpdatedAt:     updatedAt,
+		Revision:      project.Revision,
 	}
 }
 
+type ProjectTaskStatusAssigneeWipDto struct {
+	UserId    string `json:"userId"`
+	TaskCount int    `json:"taskCount"`
+	Exceeded  bool   `json:"exceeded"`
+}
+
+type ProjectTaskStatusWipDto struct {
+	TaskCount int                                `json:"taskCount"`
+	Exceeded  bool                               `json:"exceeded"`
+	Assignees []*ProjectTaskStatusAssigneeWipDto `json:"assignees"`
+}
+
 type ProjectTaskStatusDto struct {
-	Id                       string `json:"id"`
-	Name                     string `json:"name"`
-	Color                    string `json:"color"`
-	Order                    *int8  `json:"order"`
-	ShouldSetTaskToCompleted bool   `json:"shouldSetTaskToCompleted"`
-	IsDefault                bool   `json:"isDefault"`
+	Id                       string                   `json:"id"`
+	Name                     string                   `json:"name"`
+	Color                    string                   `json:"color"`
+	Order                    *int8                    `json:"order"`
+	ShouldSetTaskToCompleted bool                     `json:"shouldSetTaskToCompleted"`
+	IsDefault                bool                     `json:"isDefault"`
+	WipLimit                 *int16                   `json:"wipLimit"`
+	WipLimitPerAssignee      *int16                   `json:"wipLimitPerAssignee"`
+	WipLimitPolicy           string                   `json:"wipLimitPolicy"`
+	Wip                      *ProjectTaskStatusWipDto `json:"wip,omitempty"`
 }
 
 func ProjectTaskStatusToDto(projectTaskStatus *ProjectTaskStatus) *ProjectTaskStatusDto {
@@ -98,6 +117,9 @@ func ProjectTaskStatusToDto(projectTaskStatus *ProjectTaskStatus) *ProjectTaskSt
 		Order:                    projectTaskStatus.Order,
 		ShouldSetTaskToCompleted: projectTaskStatus.ShouldSetTaskToCompleted,
 		IsDefault:                projectTaskStatus.IsDefault,
+		WipLimit:                 projectTaskStatus.WipLimit,
+		WipLimitPerAssignee:      projectTaskStatus.WipLimitPerAssignee,
+		WipLimitPolicy:           string(projectTaskStatus.WipLimitPolicy),
 	}
 }
 
@@ -115,13 +137,67 @@ func ProjectTaskCategoryToDto(projectTaskCategory *ProjectTaskCategory) *Project
 	}
 }
 
+type ProjectTaskCustomFieldDto struct {
+	Id         string   `json:"id"`
+	Name       string   `json:"name"`
+	Type       string   `json:"type"`
+	Options    []string `json:"options"`
+	IsRequired bool     `json:"isRequired"`
+}
+
+func ProjectTaskCustomFieldToDto(projectTaskCustomField *ProjectTaskCustomField) *ProjectTaskCustomFieldDto {
+	return &ProjectTaskCustomFieldDto{
+		Id:         projectTaskCustomField.Identity.Public,
+		Name:       projectTaskCustomField.Name,
+		Type:       string(projectTaskCustomField.Type),
+		Options:    projectTaskCustomField.Options,
+		IsRequired: projectTaskCustomField.IsRequired,
+	}
+}
+
+type ProjectTaskStatusTransitionDto struct {
+	Id             string   `json:"id"`
+	FromStatusId   *string  `json:"fromStatusId"`
+	ToStatusId     string   `json:"toStatusId"`
+	RoleIds        []string `json:"roleIds"`
+	RequiredFields []string `json:"requiredFields"`
+}
+
+func ProjectTaskStatusTransitionToDto(projectTaskStatusTransition *ProjectTaskStatusTransition) *ProjectTaskStatusTransitionDto {
+	var fromStatusId *string = nil
+	if projectTaskStatusTransition.FromStatusIdentity != nil {
+		fromStatusId = &projectTaskStatusTransition.FromStatusIdentity.Public
+	}
+
+	var roleIds []string = make([]string, len(projectTaskStatusTransition.RoleIdentities))
+	for i, roleIdentity := range projectTaskStatusTransition.RoleIdentities {
+		roleIds[i] = roleIdentity.Public
+	}
+
+	var requiredFields []string = make([]string, len(projectTaskStatusTransition.RequiredFields))
+	for i, requiredField := range projectTaskStatusTransition.RequiredFields {
+		requiredFields[i] = string(requiredField)
+	}
+
+	return &ProjectTaskStatusTransitionDto{
+		Id:             projectTaskStatusTransition.Identity.Public,
+		FromStatusId:   fromStatusId,
+		ToStatusId:     projectTaskStatusTransition.ToStatusIdentity.Public,
+		RoleIds:        roleIds,
+		RequiredFields: requiredFields,
+	}
+}
+
 type ProjectDocumentVersionDto struct {
 	Id                              string                   `json:"id"`
 	ProjectDocumentVersionManagerId string                   `json:"projectDocumentVersionManagerId"`
 	Version                         string                   `json:"version"`
 	Latest                          bool                     `json:"latest"`
+	RestoredFromVersionId           *string                  `json:"restoredFromVersionId"`
 	Title                           string                   `json:"title"`
 	Content                         string                   `json:"content"`
+	ContentFormat                   string                   `json:"contentFormat"`
+	ContentHtml                     string                   `json:"contentHtml"`
 	Files                           []ProjectDocumentFileDto `json:"files"`
 	UserCreatorId                   string                   `json:"userCreatorId"`
 	UserEditorId                    *string                  `json:"userEditorId"`
@@ -129,6 +205,7 @@ type ProjectDocumentVersionDto struct {
 	Editor                          *user.UserDto            `json:"editor,omitempty"`
 	CreatedAt                       string                   `json:"createdAt"`
 	UpdatedAt                       *string                  `json:"updatedAt"`
+	Revision                        int64                    `json:"revision"`
 }
 
 type ProjectDocumentFileDto struct {
@@ -149,6 +226,11 @@ func ProjectDocumentVersionToDto(projectDocumentVersion *ProjectDocumentVersion)
 		userEditorId = &projectDocumentVersion.UserEditorIdentity.Public
 	}
 
+	var restoredFromVersionId *string = nil
+	if projectDocumentVersion.RestoredFromIdentity != nil {
+		restoredFromVersionId = &projectDocumentVersion.RestoredFromIdentity.Public
+	}
+
 	var updatedAt *string = nil
 	if projectDocumentVersion.Timestamps.UpdatedAt != nil {
 		updatedAtString := projectDocumentVersion.Timestamps.UpdatedAt.ToRFC3339()
@@ -170,8 +252,11 @@ func ProjectDocumentVersionToDto(projectDocumentVersion *ProjectDocumentVersion)
 		ProjectDocumentVersionManagerId: projectDocumentVersion.ProjectDocumentVersionManagerIdentity.Public,
 		Version:                         projectDocumentVersion.Version,
 		Latest:                          projectDocumentVersion.Latest,
+		RestoredFromVersionId:           restoredFromVersionId,
 		Title:                           projectDocumentVersion.Document.Title,
 		Content:                         projectDocumentVersion.Document.Content,
+		ContentFormat:                   string(projectDocumentVersion.Document.ContentFormat),
+		ContentHtml:                     projectDocumentVersion.Document.FormattedContent().Html(),
 		Files:                           files,
 		UserCreatorId:                   projectDocumentVersion.UserCreatorIdentity.Public,
 		UserEditorId:                    userEditorId,
@@ -179,5 +264,119 @@ func ProjectDocumentVersionToDto(projectDocumentVersion *ProjectDocumentVersion)
 		Editor:                          editor,
 		CreatedAt:                       projectDocumentVersion.Timestamps.CreatedAt.ToRFC3339(),
 		UpdatedAt:                       updatedAt,
+		Revision:                        projectDocumentVersion.Revision,
+	}
+}
+
+type ProjectDocumentDiffWordDto struct {
+	Type  string `json:"type"`
+	Value string `json:"value"`
+}
+
+type ProjectDocumentDiffLineDto struct {
+	Type     string                       `json:"type"`
+	FromLine *int                         `json:"fromLine"`
+	ToLine   *int                         `json:"toLine"`
+	Content  string                       `json:"content"`
+	Words    []ProjectDocumentDiffWordDto `json:"words"`
+}
+
+type ProjectDocumentDiffHunkDto struct {
+	FromStart int                          `json:"fromStart"`
+	FromLines int                          `json:"fromLines"`
+	ToStart   int                          `json:"toStart"`
+	ToLines   int                          `json:"toLines"`
+	Lines     []ProjectDocumentDiffLineDto `json:"lines"`
+}
+
+type ProjectDocumentDiffDto struct {
+	Unified string                       `json:"unified"`
+	Hunks   []ProjectDocumentDiffHunkDto `json:"hunks"`
+}
+
+type ProjectDocumentVersionDiffDto struct {
+	FromVersionId string                   `json:"fromVersionId"`
+	FromVersion   string                   `json:"fromVersion"`
+	ToVersionId   string                   `json:"toVersionId"`
+	ToVersion     string                   `json:"toVersion"`
+	Title         ProjectDocumentDiffDto   `json:"title"`
+	Content       ProjectDocumentDiffDto   `json:"content"`
+	AddedFiles    []ProjectDocumentFileDto `json:"addedFiles"`
+	RemovedFiles  []ProjectDocumentFileDto `json:"removedFiles"`
+}
+
+func projectDocumentDiffToDto(fromVersion string, toVersion string, hunks []diffutils.Hunk) ProjectDocumentDiffDto {
+	var hunksDto []ProjectDocumentDiffHunkDto = make([]ProjectDocumentDiffHunkDto, len(hunks))
+	for i, hunk := range hunks {
+		var linesDto []ProjectDocumentDiffLineDto = make([]ProjectDocumentDiffLineDto, len(hunk.Lines))
+		for j, line := range hunk.Lines {
+			var fromLine *int = nil
+			if line.Type != diffutils.OperationInsert {
+				lineNumber := line.FromIndex + 1
+				fromLine = &lineNumber
+			}
+
+			var toLine *int = nil
+			if line.Type != diffutils.OperationDelete {
+				lineNumber := line.ToIndex + 1
+				toLine = &lineNumber
+			}
+
+			var wordsDto []ProjectDocumentDiffWordDto = make([]ProjectDocumentDiffWordDto, len(line.Words))
+			for k, word := range line.Words {
+				wordsDto[k] = ProjectDocumentDiffWordDto{
+					Type:  string(word.Type),
+					Value: word.Value,
+				}
+			}
+
+			linesDto[j] = ProjectDocumentDiffLineDto{
+				Type:     string(line.Type),
+				FromLine: fromLine,
+				ToLine:   toLine,
+				Content:  line.Value,
+				Words:    wordsDto,
+			}
+		}
+
+		hunksDto[i] = ProjectDocumentDiffHunkDto{
+			FromStart: hunk.FromStart,
+			FromLines: hunk.FromLines,
+			ToStart:   hunk.ToStart,
+			ToLines:   hunk.ToLines,
+			Lines:     linesDto,
+		}
+	}
+
+	return ProjectDocumentDiffDto{
+		Unified: diffutils.Unified(fromVersion, toVersion, hunks),
+		Hunks:   hunksDto,
+	}
+}
+
+func projectDocumentFilesToDto(files []ProjectDocumentFile) []ProjectDocumentFileDto {
+	var filesDto []ProjectDocumentFileDto = make([]ProjectDocumentFileDto, len(files))
+	for i, file := range files {
+		filesDto[i] = ProjectDocumentFileDto{
+			FileId: file.FileIdentity.Public,
+		}
+	}
+
+	return filesDto
+}
+
+func ProjectDocumentVersionDiffToDto(projectDocumentVersionDiff *ProjectDocumentVersionDiff) *ProjectDocumentVersionDiffDto {
+	fromVersion := projectDocumentVersionDiff.From.Version
+	toVersion := projectDocumentVersionDiff.To.Version
+
+	return &ProjectDocumentVersionDiffDto{
+		FromVersionId: projectDocumentVersionDiff.From.Identity.Public,
+		FromVersion:   fromVersion,
+		ToVersionId:   projectDocumentVersionDiff.To.Identity.Public,
+		ToVersion:     toVersion,
+		Title:         projectDocumentDiffToDto(fromVersion, toVersion, projectDocumentVersionDiff.TitleHunks),
+		Content:       projectDocumentDiffToDto(fromVersion, toVersion, projectDocumentVersionDiff.ContentHunks),
+		AddedFiles:    projectDocumentFilesToDto(projectDocumentVersionDiff.AddedFiles),
+		RemovedFiles:  projectDocumentFilesToDto(projectDocumentVersionDiff.RemovedFiles),
 	}
 }
@@ -5,6 +5,7 @@ import (
 
 	"github.com/gabrielmrtt/taski/internal/core"
 	"github.com/gabrielmrtt/taski/internal/user"
+	"github.com/gabrielmrtt/taski/pkg/diffutils"
 )
 
 type Project struct {
@@ -21,6 +22,7 @@ type Project struct {
 	EndAt               *core.DateTime
 	Timestamps          core.Timestamps
 	DeletedAt           *core.DateTime
+	Revision            int64
 	Creator             *user.User
 	Editor              *user.User
 }
@@ -84,6 +86,7 @@ func NewProject(input NewProjectInput) (*Project, error) {
 			UpdatedAt: nil,
 		},
 		DeletedAt: nil,
+		Revision:  core.InitialRevision,
 	}, nil
 }
 
@@ -282,6 +285,9 @@ type ProjectTaskStatus struct {
 	Order                    *int8
 	ShouldSetTaskToCompleted bool
 	IsDefault                bool
+	WipLimit                 *int16
+	WipLimitPerAssignee      *int16
+	WipLimitPolicy           ProjectTaskStatusWipLimitPolicies
 	DeletedAt                *core.DateTime
 }
 
@@ -291,6 +297,9 @@ type NewProjectTaskStatusInput struct {
 	Order                    *int8
 	ShouldSetTaskToCompleted bool
 	IsDefault                bool
+	WipLimit                 *int16
+	WipLimitPerAssignee      *int16
+	WipLimitPolicy           ProjectTaskStatusWipLimitPolicies
 	ProjectIdentity          core.Identity
 }
 
@@ -307,7 +316,7 @@ func NewProjectTaskStatus(input NewProjectTaskStatusInput) (*ProjectTaskStatus,
 		return nil, core.NewConflictError("project status should not be set to completed and default at the same time")
 	}
 
-	return &ProjectTaskStatus{
+	projectTaskStatus := &ProjectTaskStatus{
 		Identity:                 core.NewIdentity(ProjectTaskStatusIdentityPrefix),
 		ProjectIdentity:          input.ProjectIdentity,
 		Name:                     input.Name,
@@ -315,8 +324,21 @@ func NewProjectTaskStatus(input NewProjectTaskStatusInput) (*ProjectTaskStatus,
 		Order:                    input.Order,
 		ShouldSetTaskToCompleted: input.ShouldSetTaskToCompleted,
 		IsDefault:                input.IsDefault,
+		WipLimitPolicy:           ProjectTaskStatusWipLimitPolicyReject,
 		DeletedAt:                nil,
-	}, nil
+	}
+
+	if err := projectTaskStatus.ChangeWipLimit(input.WipLimit, input.WipLimitPerAssignee); err != nil {
+		return nil, err
+	}
+
+	if input.WipLimitPolicy != "" {
+		if err := projectTaskStatus.ChangeWipLimitPolicy(input.WipLimitPolicy); err != nil {
+			return nil, err
+		}
+	}
+
+	return projectTaskStatus, nil
 }
 
 func (s *ProjectTaskStatus) ChangeName(name string) error {
@@ -358,6 +380,62 @@ func (s *ProjectTaskStatus) SetIsDefault(v bool) error {
 	return nil
 }
 
+/*
+ChangeWipLimit sets the maximum number of tasks allowed in the status, in total and for each assignee.
+A nil limit removes it.
+*/
+func (s *ProjectTaskStatus) ChangeWipLimit(wipLimit *int16, wipLimitPerAssignee *int16) error {
+	var fields []core.InvalidInputErrorField
+
+	if wipLimit != nil && *wipLimit <= 0 {
+		fields = append(fields, core.InvalidInputErrorField{
+			Field: "wipLimit",
+			Error: "work in progress limit must be greater than zero",
+		})
+	}
+
+	if wipLimitPerAssignee != nil && *wipLimitPerAssignee <= 0 {
+		fields = append(fields, core.InvalidInputErrorField{
+			Field: "wipLimitPerAssignee",
+			Error: "work in progress limit per assignee must be greater than zero",
+		})
+	}
+
+	if len(fields) > 0 {
+		return core.NewInvalidInputError("invalid work in progress limit", fields)
+	}
+
+	s.WipLimit = wipLimit
+	s.WipLimitPerAssignee = wipLimitPerAssignee
+	return nil
+}
+
+func (s *ProjectTaskStatus) ChangeWipLimitPolicy(policy ProjectTaskStatusWipLimitPolicies) error {
+	if !slices.Contains(ProjectTaskStatusWipLimitPoliciesArray, policy) {
+		return core.NewInvalidInputError("invalid work in progress limit policy", []core.InvalidInputErrorField{
+			{
+				Field: "wipLimitPolicy",
+				Error: "work in progress limit policy " + string(policy) + " is not supported",
+			},
+		})
+	}
+
+	s.WipLimitPolicy = policy
+	return nil
+}
+
+func (s *ProjectTaskStatus) HasWipLimit() bool {
+	return s.WipLimit != nil || s.WipLimitPerAssignee != nil
+}
+
+func (s *ProjectTaskStatus) ExceedsWipLimit(taskCount int) bool {
+	return s.WipLimit != nil && taskCount > int(*s.WipLimit)
+}
+
+func (s *ProjectTaskStatus) ExceedsWipLimitPerAssignee(taskCount int) bool {
+	return s.WipLimitPerAssignee != nil && taskCount > int(*s.WipLimitPerAssignee)
+}
+
 func (s *ProjectTaskStatus) Delete() {
 	now := core.NewDateTime()
 	s.DeletedAt = &now
@@ -367,6 +445,103 @@ func (s *ProjectTaskStatus) IsDeleted() bool {
 	return s.DeletedAt != nil
 }
 
+/*
+ProjectTaskStatusTransition allows tasks to move to a status. A nil FromStatusIdentity allows it from any status,
+empty RoleIdentities allows every role and RequiredFields must be filled on the task before the move.
+*/
+type ProjectTaskStatusTransition struct {
+	Identity           core.Identity
+	ProjectIdentity    core.Identity
+	FromStatusIdentity *core.Identity
+	ToStatusIdentity   core.Identity
+	RoleIdentities     []core.Identity
+	RequiredFields     []ProjectTaskStatusTransitionRequiredFields
+}
+
+type NewProjectTaskStatusTransitionInput struct {
+	ProjectIdentity    core.Identity
+	FromStatusIdentity *core.Identity
+	ToStatusIdentity   core.Identity
+	RoleIdentities     []core.Identity
+	RequiredFields     []ProjectTaskStatusTransitionRequiredFields
+}
+
+func NewProjectTaskStatusTransition(input NewProjectTaskStatusTransitionInput) (*ProjectTaskStatusTransition, error) {
+	if input.FromStatusIdentity != nil && input.FromStatusIdentity.Equals(input.ToStatusIdentity) {
+		return nil, core.NewConflictError("a status transition cannot start and end in the same status")
+	}
+
+	transition := &ProjectTaskStatusTransition{
+		Identity:           core.NewIdentity(ProjectTaskStatusTransitionIdentityPrefix),
+		ProjectIdentity:    input.ProjectIdentity,
+		FromStatusIdentity: input.FromStatusIdentity,
+		ToStatusIdentity:   input.ToStatusIdentity,
+		RoleIdentities:     []core.Identity{},
+		RequiredFields:     []ProjectTaskStatusTransitionRequiredFields{},
+	}
+
+	if input.RoleIdentities != nil {
+		transition.ChangeRoles(input.RoleIdentities)
+	}
+
+	if input.RequiredFields != nil {
+		if err := transition.ChangeRequiredFields(input.RequiredFields); err != nil {
+			return nil, err
+		}
+	}
+
+	return transition, nil
+}
+
+func (t *ProjectTaskStatusTransition) ChangeRoles(roleIdentities []core.Identity) {
+	t.RoleIdentities = roleIdentities
+}
+
+func (t *ProjectTaskStatusTransition) ChangeRequiredFields(requiredFields []ProjectTaskStatusTransitionRequiredFields) error {
+	for _, requiredField := range requiredFields {
+		if !slices.Contains(ProjectTaskStatusTransitionRequiredFieldsArray, requiredField) {
+			return core.NewInvalidInputError("invalid required field", []core.InvalidInputErrorField{
+				{
+					Field: "requiredFields",
+					Error: "required field " + string(requiredField) + " is not supported",
+				},
+			})
+		}
+	}
+
+	t.RequiredFields = requiredFields
+	return nil
+}
+
+/*
+Matches reports whether the transition covers a move from one status to another. A transition without a from status applies to any current status.
+*/
+func (t *ProjectTaskStatusTransition) Matches(fromStatusIdentity *core.Identity, toStatusIdentity core.Identity) bool {
+	if !t.ToStatusIdentity.Equals(toStatusIdentity) {
+		return false
+	}
+
+	if t.FromStatusIdentity == nil {
+		return true
+	}
+
+	return fromStatusIdentity != nil && t.FromStatusIdentity.Equals(*fromStatusIdentity)
+}
+
+func (t *ProjectTaskStatusTransition) AllowsRole(roleIdentity core.Identity) bool {
+	if len(t.RoleIdentities) == 0 {
+		return true
+	}
+
+	for _, allowedRoleIdentity := range t.RoleIdentities {
+		if allowedRoleIdentity.Equals(roleIdentity) {
+			return true
+		}
+	}
+
+	return false
+}
+
 type ProjectTaskCategory struct {
 	Identity        core.Identity
 	ProjectIdentity core.Identity
@@ -426,12 +601,184 @@ func (c *ProjectTaskCategory) IsDeleted() bool {
 	return c.DeletedAt != nil
 }
 
+type ProjectTaskCustomField struct {
+	Identity        core.Identity
+	ProjectIdentity core.Identity
+	Name            string
+	Type            ProjectTaskCustomFieldTypes
+	Options         []string
+	IsRequired      bool
+	DeletedAt       *core.DateTime
+}
+
+type NewProjectTaskCustomFieldInput struct {
+	Name            string
+	Type            ProjectTaskCustomFieldTypes
+	Options         []string
+	IsRequired      bool
+	ProjectIdentity core.Identity
+}
+
+func NewProjectTaskCustomField(input NewProjectTaskCustomFieldInput) (*ProjectTaskCustomField, error) {
+	if _, err := core.NewName(input.Name); err != nil {
+		return nil, err
+	}
+
+	if !slices.Contains(ProjectTaskCustomFieldTypesArray, input.Type) {
+		return nil, core.NewInvalidInputError("invalid custom field type", []core.InvalidInputErrorField{
+			{
+				Field: "type",
+				Error: "custom field type is not supported",
+			},
+		})
+	}
+
+	customField := &ProjectTaskCustomField{
+		Identity:        core.NewIdentity(ProjectTaskCustomFieldIdentityPrefix),
+		ProjectIdentity: input.ProjectIdentity,
+		Name:            input.Name,
+		Type:            input.Type,
+		Options:         []string{},
+		IsRequired:      input.IsRequired,
+		DeletedAt:       nil,
+	}
+
+	if err := customField.ChangeOptions(input.Options); err != nil {
+		return nil, err
+	}
+
+	return customField, nil
+}
+
+func (f *ProjectTaskCustomField) ChangeName(name string) error {
+	if _, err := core.NewName(name); err != nil {
+		return err
+	}
+
+	f.Name = name
+	return nil
+}
+
+func (f *ProjectTaskCustomField) ChangeOptions(options []string) error {
+	if !f.IsSelect() {
+		if len(options) > 0 {
+			return core.NewInvalidInputError("options are only allowed for select custom fields", []core.InvalidInputErrorField{
+				{
+					Field: "options",
+					Error: "options are only allowed for select custom fields",
+				},
+			})
+		}
+
+		f.Options = []string{}
+		return nil
+	}
+
+	if len(options) == 0 {
+		return core.NewInvalidInputError("select custom fields must have at least one option", []core.InvalidInputErrorField{
+			{
+				Field: "options",
+				Error: "select custom fields must have at least one option",
+			},
+		})
+	}
+
+	seenOptions := make(map[string]struct{})
+	for _, option := range options {
+		if option == "" || len(option) > 255 {
+			return core.NewInvalidInputError("invalid custom field option", []core.InvalidInputErrorField{
+				{
+					Field: "options",
+					Error: "options must be between 1 and 255 characters",
+				},
+			})
+		}
+
+		if _, ok := seenOptions[option]; ok {
+			return core.NewInvalidInputError("duplicated custom field option", []core.InvalidInputErrorField{
+				{
+					Field: "options",
+					Error: "options must be unique",
+				},
+			})
+		}
+		seenOptions[option] = struct{}{}
+	}
+
+	f.Options = options
+	return nil
+}
+
+func (f *ProjectTaskCustomField) SetIsRequired(v bool) {
+	f.IsRequired = v
+}
+
+func (f *ProjectTaskCustomField) IsSelect() bool {
+	return f.Type == ProjectTaskCustomFieldTypeSingleSelect || f.Type == ProjectTaskCustomFieldTypeMultiSelect
+}
+
+func (f *ProjectTaskCustomField) HasOption(option string) bool {
+	return slices.Contains(f.Options, option)
+}
+
+func (f *ProjectTaskCustomField) Delete() {
+	now := core.NewDateTime()
+	f.DeletedAt = &now
+}
+
+func (f *ProjectTaskCustomField) IsDeleted() bool {
+	return f.DeletedAt != nil
+}
+
 type ProjectDocumentVersionManager struct {
 	Identity        core.Identity
 	ProjectIdentity core.Identity
+	VersionScheme   ProjectDocumentVersionSchemes
 	LatestVersion   *ProjectDocumentVersion
 }
 
+func (m *ProjectDocumentVersionManager) ChangeVersionScheme(versionScheme ProjectDocumentVersionSchemes) error {
+	if !slices.Contains(ProjectDocumentVersionSchemesArray, versionScheme) {
+		return core.NewInvalidInputError("invalid version scheme", []core.InvalidInputErrorField{
+			{
+				Field: "versionScheme",
+				Error: "version scheme " + string(versionScheme) + " is not supported",
+			},
+		})
+	}
+
+	m.VersionScheme = versionScheme
+	return nil
+}
+
+/*
+NextVersion bumps the version of the latest version with the manager's version scheme until it is not one of the
+versions already taken.
+*/
+func (m *ProjectDocumentVersionManager) NextVersion(takenVersions []string) (string, error) {
+	if m.LatestVersion == nil {
+		return "", core.NewConflictError("project document has no latest version to bump")
+	}
+
+	versionScheme := m.VersionScheme
+	if versionScheme == "" {
+		versionScheme = DefaultProjectDocumentVersionScheme
+	}
+
+	version := m.LatestVersion.Version
+	for {
+		bumped, err := versionScheme.Bump(version)
+		if err != nil {
+			return "", err
+		}
+
+		version = bumped
+		if !slices.Contains(takenVersions, version) {
+			return version, nil
+		}
+	}
+}
+
 type ProjectDocumentVersion struct {
 	Identity                              core.Identity
 	ProjectDocumentVersionManagerIdentity core.Identity
@@ -440,16 +787,23 @@ type ProjectDocumentVersion struct {
 	UserCreatorIdentity                   *core.Identity
 	UserEditorIdentity                    *core.Identity
 	Latest                                bool
+	RestoredFromIdentity                  *core.Identity
 	Timestamps                            core.Timestamps
+	Revision                              int64
 	Creator                               *user.User
 	Editor                                *user.User
 }
 
 type ProjectDocument struct {
-	Identity core.Identity
-	Title    string
-	Content  string
-	Files    []ProjectDocumentFile
+	Identity      core.Identity
+	Title         string
+	Content       string
+	ContentFormat core.ContentFormats
+	Files         []ProjectDocumentFile
+}
+
+func (d *ProjectDocument) FormattedContent() core.Content {
+	return core.Content{Value: d.Content, Format: d.ContentFormat}
 }
 
 type ProjectDocumentFile struct {
@@ -462,6 +816,7 @@ type NewProjectDocumentInput struct {
 	ProjectDocumentVersionManagerIdentity core.Identity
 	Title                                 string
 	Content                               string
+	ContentFormat                         core.ContentFormats
 	Version                               string
 	Files                                 []ProjectDocumentFile
 	UserCreatorIdentity                   *core.Identity
@@ -476,13 +831,23 @@ func NewProjectDocument(input NewProjectDocumentInput) (*ProjectDocumentVersion,
 		return nil, err
 	}
 
+	var contentFormat core.ContentFormats = core.DefaultContentFormat
+	if input.ContentFormat != "" {
+		contentFormat = input.ContentFormat
+	}
+
+	if _, err := core.NewContent(input.Content, contentFormat); err != nil {
+		return nil, err
+	}
+
 	now := core.NewDateTime()
 
 	projectDocument := &ProjectDocument{
-		Identity: core.NewIdentityWithoutPublic(),
-		Title:    input.Title,
-		Content:  input.Content,
-		Files:    input.Files,
+		Identity:      core.NewIdentityWithoutPublic(),
+		Title:         input.Title,
+		Content:       input.Content,
+		ContentFormat: contentFormat,
+		Files:         input.Files,
 	}
 
 	projectDocumentVersion := &ProjectDocumentVersion{
@@ -496,6 +861,7 @@ func NewProjectDocument(input NewProjectDocumentInput) (*ProjectDocumentVersion,
 			CreatedAt: &now,
 			UpdatedAt: nil,
 		},
+		Revision: core.InitialRevision,
 	}
 
 	return projectDocumentVersion, nil
@@ -513,12 +879,24 @@ func (v *ProjectDocumentVersion) ChangeTitle(title string, userEditorIdentity *c
 	return nil
 }
 
-func (v *ProjectDocumentVersion) ChangeContent(content string, userEditorIdentity *core.Identity) error {
+/*
+ChangeContent replaces the content of the document. An empty contentFormat keeps the format of the current content.
+*/
+func (v *ProjectDocumentVersion) ChangeContent(content string, contentFormat core.ContentFormats, userEditorIdentity *core.Identity) error {
 	if _, err := NewProjectDocumentContent(content); err != nil {
 		return err
 	}
 
+	if contentFormat == "" {
+		contentFormat = v.Document.ContentFormat
+	}
+
+	if _, err := core.NewContent(content, contentFormat); err != nil {
+		return err
+	}
+
 	v.Document.Content = content
+	v.Document.ContentFormat = contentFormat
 	v.UserEditorIdentity = userEditorIdentity
 	now := core.NewDateTime()
 	v.Timestamps.UpdatedAt = &now
@@ -549,15 +927,77 @@ func (v *ProjectDocumentVersion) IsLatest() bool {
 	return v.Latest
 }
 
+/*
+ProjectDocumentVersionDiff holds what changed from a version of a document to another: the title and content hunks,
+and the files that were added and removed.
+*/
+type ProjectDocumentVersionDiff struct {
+	From         *ProjectDocumentVersion
+	To           *ProjectDocumentVersion
+	TitleHunks   []diffutils.Hunk
+	ContentHunks []diffutils.Hunk
+	AddedFiles   []ProjectDocumentFile
+	RemovedFiles []ProjectDocumentFile
+}
+
+func (v *ProjectDocumentVersion) HasFile(fileIdentity core.Identity) bool {
+	return slices.ContainsFunc(v.Document.Files, func(file ProjectDocumentFile) bool {
+		return file.FileIdentity.Internal == fileIdentity.Internal
+	})
+}
+
+/*
+DiffTo compares the version with a version of the same document. Files are compared by the uploaded file they point to.
+*/
+func (v *ProjectDocumentVersion) DiffTo(to *ProjectDocumentVersion) (*ProjectDocumentVersionDiff, error) {
+	if v.ProjectDocumentVersionManagerIdentity.Internal != to.ProjectDocumentVersionManagerIdentity.Internal {
+		return nil, core.NewConflictError("project document versions belong to different documents")
+	}
+
+	var addedFiles []ProjectDocumentFile = make([]ProjectDocumentFile, 0)
+	for _, file := range to.Document.Files {
+		if !v.HasFile(file.FileIdentity) {
+			addedFiles = append(addedFiles, file)
+		}
+	}
+
+	var removedFiles []ProjectDocumentFile = make([]ProjectDocumentFile, 0)
+	for _, file := range v.Document.Files {
+		if !to.HasFile(file.FileIdentity) {
+			removedFiles = append(removedFiles, file)
+		}
+	}
+
+	return &ProjectDocumentVersionDiff{
+		From:         v,
+		To:           to,
+		TitleHunks:   diffutils.DiffLines(v.Document.Title, to.Document.Title, ProjectDocumentDiffContextLines),
+		ContentHunks: diffutils.DiffLines(v.Document.Content, to.Document.Content, ProjectDocumentDiffContextLines),
+		AddedFiles:   addedFiles,
+		RemovedFiles: removedFiles,
+	}, nil
+}
+
 func (v *ProjectDocumentVersion) NewVersion(version string) *ProjectDocumentVersion {
 	now := core.NewDateTime()
 
+	var files []ProjectDocumentFile = make([]ProjectDocumentFile, len(v.Document.Files))
+	for i, file := range v.Document.Files {
+		files[i] = ProjectDocumentFile{
+			Identity:     core.NewIdentity(ProjectDocumentVersionIdentityPrefix),
+			FileIdentity: file.FileIdentity,
+		}
+	}
+
+	document := v.Document
+	document.Files = files
+
 	v.Latest = false
 	return &ProjectDocumentVersion{
 		Identity:                              core.NewIdentity(ProjectDocumentVersionIdentityPrefix),
 		ProjectDocumentVersionManagerIdentity: v.ProjectDocumentVersionManagerIdentity,
 		Version:                               version,
-		Document:                              v.Document,
+		Document:                              document,
 		UserCreatorIdentity:                   v.UserCreatorIdentity,
 		UserEditorIdentity:                    v.UserEditorIdentity,
 		Latest:                                true,
@@ -565,5 +1005,20 @@ func (v *ProjectDocumentVersion) NewVersion(version string) *ProjectDocumentVers
 			CreatedAt: &now,
 			UpdatedAt: nil,
 		},
+		Revision: core.InitialRevision,
 	}
 }
+
+/*
+Restore returns a new latest version with the title, content and files of v, edited by the restoring user and pointing
+back at v.
+*/
+func (v *ProjectDocumentVersion) Restore(version string, userRestorerIdentity core.Identity) *ProjectDocumentVersion {
+	restoredFromIdentity := v.Identity
+
+	restoredVersion := v.NewVersion(version)
+	restoredVersion.UserEditorIdentity = &userRestorerIdentity
+	restoredVersion.RestoredFromIdentity = &restoredFromIdentity
+
+	return restoredVersion
+}
@@ -0,0 +1,87 @@
+package project
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/gabrielmrtt/taski/internal/core"
+)
+
+func TestProjectDocumentVersionManagerNextVersion(t *testing.T) {
+	tests := []struct {
+		name     string
+		scheme   ProjectDocumentVersionSchemes
+		latest   string
+		taken    []string
+		expected string
+	}{
+		{name: "default scheme", latest: "1.0", taken: []string{"1.0"}, expected: "1.1"},
+		{name: "taken versions are skipped", scheme: ProjectDocumentVersionSchemeSemantic, latest: "1.1", taken: []string{"1.0", "1.1", "1.2"}, expected: "1.3"},
+		{name: "incrementing scheme", scheme: ProjectDocumentVersionSchemeIncrementing, latest: "rev-2", taken: []string{"rev-1", "rev-2"}, expected: "rev-3"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			manager := &ProjectDocumentVersionManager{VersionScheme: tt.scheme, LatestVersion: &ProjectDocumentVersion{Version: tt.latest}}
+
+			got, err := manager.NextVersion(tt.taken)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if got != tt.expected {
+				t.Errorf("expected %q, got %q", tt.expected, got)
+			}
+		})
+	}
+
+	var conflictError *core.ConflictError
+	if _, err := (&ProjectDocumentVersionManager{}).NextVersion(nil); !errors.As(err, &conflictError) {
+		t.Errorf("expected a conflict error without a latest version, got %v", err)
+	}
+}
+
+func TestProjectDocumentVersionRestore(t *testing.T) {
+	creator := core.NewIdentity("usr")
+	restorer := core.NewIdentity("usr")
+	file := core.NewIdentity("fil")
+
+	previous := &ProjectDocumentVersion{
+		Identity:                              core.NewIdentity(ProjectDocumentVersionIdentityPrefix),
+		ProjectDocumentVersionManagerIdentity: core.NewIdentity(ProjectDocumentVersionIdentityPrefix),
+		Version:                               "1.0",
+		Document: ProjectDocument{
+			Title:   "Specification",
+			Content: "first draft",
+			Files:   []ProjectDocumentFile{{Identity: core.NewIdentity(ProjectDocumentVersionIdentityPrefix), FileIdentity: file}},
+		},
+		UserCreatorIdentity: &creator,
+		UserEditorIdentity:  &creator,
+	}
+
+	restored := previous.Restore("1.2", restorer)
+
+	if !restored.IsLatest() || previous.IsLatest() {
+		t.Errorf("expected only the restored version to be the latest, got %t and %t", restored.IsLatest(), previous.IsLatest())
+	}
+
+	if restored.Identity.Equals(previous.Identity) || !restored.ProjectDocumentVersionManagerIdentity.Equals(previous.ProjectDocumentVersionManagerIdentity) {
+		t.Errorf("expected a new version of the same document")
+	}
+
+	if restored.Version != "1.2" || restored.Document.Title != "Specification" || restored.Document.Content != "first draft" {
+		t.Errorf("expected the restored version to copy the document, got %+v", restored)
+	}
+
+	if restored.RestoredFromIdentity == nil || !restored.RestoredFromIdentity.Equals(previous.Identity) {
+		t.Errorf("expected the restored version to point back at %s, got %v", previous.Identity.Public, restored.RestoredFromIdentity)
+	}
+
+	if !restored.UserEditorIdentity.Equals(restorer) || !restored.UserCreatorIdentity.Equals(creator) {
+		t.Errorf("expected the restorer as editor and the original creator, got %v and %v", restored.UserEditorIdentity, restored.UserCreatorIdentity)
+	}
+
+	if len(restored.Document.Files) != 1 || !restored.Document.Files[0].FileIdentity.Equals(file) || restored.Document.Files[0].Identity.Equals(previous.Document.Files[0].Identity) {
+		t.Errorf("expected the files to be copied with new identities, got %+v", restored.Document.Files)
+	}
+}
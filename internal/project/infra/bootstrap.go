@@ -6,8 +6,11 @@ import (
 	projectdatabase "github.com/gabrielmrtt/taski/internal/project/infra/database"
 	projecthttp "github.com/gabrielmrtt/taski/internal/project/infra/http"
 	projectservice "github.com/gabrielmrtt/taski/internal/project/service"
+	roledatabase "github.com/gabrielmrtt/taski/internal/role/infra/database"
 	storagedatabase "github.com/gabrielmrtt/taski/internal/storage/infra/database"
 	userdatabase "github.com/gabrielmrtt/taski/internal/user/infra/database"
+	webhookinfra "github.com/gabrielmrtt/taski/internal/webhook/infra"
+	webhookevents "github.com/gabrielmrtt/taski/internal/webhook/infra/events"
 	workspacedatabase "github.com/gabrielmrtt/taski/internal/workspace/infra/database"
 	"github.com/gin-gonic/gin"
 	"github.com/uptrace/bun"
@@ -19,13 +22,17 @@ type BootstrapInfraOptions struct {
 }
 
 func BootstrapInfra(options BootstrapInfraOptions) {
-	projectRepository := projectdatabase.NewProjectBunRepository(options.DbConnection)
-	projectUserRepository := projectdatabase.NewProjectUserBunRepository(options.DbConnection)
-	userRepository := userdatabase.NewUserBunRepository(options.DbConnection)
 	workspaceRepository := workspacedatabase.NewWorkspaceBunRepository(options.DbConnection)
+	publishWebhookEventService := webhookinfra.NewPublishWebhookEventService(options.DbConnection)
+	projectRepository := webhookevents.NewProjectRepository(projectdatabase.NewProjectBunRepository(options.DbConnection), workspaceRepository, publishWebhookEventService)
+	projectUserRepository := webhookevents.NewProjectUserRepository(projectdatabase.NewProjectUserBunRepository(options.DbConnection), projectRepository, workspaceRepository, publishWebhookEventService)
+	projectTaskStatusTransitionRepository := projectdatabase.NewProjectTaskStatusTransitionBunRepository(options.DbConnection)
+	roleRepository := roledatabase.NewRoleBunRepository(options.DbConnection)
+	userRepository := userdatabase.NewUserBunRepository(options.DbConnection)
 	transactionRepository := coredatabase.NewTransactionBunRepository(options.DbConnection)
 	projectTaskCategoryRepository := projectdatabase.NewProjectTaskCategoryBunRepository(options.DbConnection)
 	projectTaskStatusRepository := projectdatabase.NewProjectTaskStatusBunRepository(options.DbConnection)
+	projectTaskCustomFieldRepository := projectdatabase.NewProjectTaskCustomFieldBunRepository(options.DbConnection)
 	projectDocumentRepository := projectdatabase.NewProjectDocumentBunRepository(options.DbConnection)
 	uploadedFileRepository := storagedatabase.NewUploadedFileBunRepository(options.DbConnection)
 	storageRepository := storagedatabase.NewLocalStorageRepository()
@@ -46,6 +53,16 @@ func BootstrapInfra(options BootstrapInfraOptions) {
 	updateProjectTaskStatusService := projectservice.NewUpdateProjectTaskStatusService(projectRepository, projectTaskStatusRepository, transactionRepository)
 	deleteProjectTaskStatusService := projectservice.NewDeleteProjectTaskStatusService(projectRepository, projectTaskStatusRepository, transactionRepository)
 
+	listProjectTaskStatusTransitionsService := projectservice.NewListProjectTaskStatusTransitionsService(projectRepository, projectTaskStatusTransitionRepository)
+	createProjectTaskStatusTransitionService := projectservice.NewCreateProjectTaskStatusTransitionService(projectRepository, projectTaskStatusRepository, projectTaskStatusTransitionRepository, roleRepository, transactionRepository)
+	updateProjectTaskStatusTransitionService := projectservice.NewUpdateProjectTaskStatusTransitionService(projectRepository, projectTaskStatusTransitionRepository, roleRepository, transactionRepository)
+	deleteProjectTaskStatusTransitionService := projectservice.NewDeleteProjectTaskStatusTransitionService(projectRepository, projectTaskStatusTransitionRepository, transactionRepository)
+
+	listProjectTaskCustomFieldsService := projectservice.NewListProjectTaskCustomFieldsService(projectTaskCustomFieldRepository)
+	createProjectTaskCustomFieldService := projectservice.NewCreateProjectTaskCustomFieldService(projectRepository, projectTaskCustomFieldRepository, transactionRepository)
+	updateProjectTaskCustomFieldService := projectservice.NewUpdateProjectTaskCustomFieldService(projectRepository, projectTaskCustomFieldRepository, transactionRepository)
+	deleteProjectTaskCustomFieldService := projectservice.NewDeleteProjectTaskCustomFieldService(projectRepository, projectTaskCustomFieldRepository, transactionRepository)
+
 	listProjectDocumentsService := projectservice.NewListProjectDocumentsService(projectRepository, projectDocumentRepository)
 	listProjectDocumentVersionsService := projectservice.NewListProjectDocumentVersionsService(projectRepository, projectDocumentRepository)
 	getProjectDocumentVersionService := projectservice.NewGetProjectDocumentVersionService(projectRepository, projectDocumentRepository)
@@ -53,6 +70,8 @@ func BootstrapInfra(options BootstrapInfraOptions) {
 	updateProjectDocumentService := projectservice.NewUpdateProjectDocumentService(projectRepository, projectDocumentRepository, uploadedFileRepository, storageRepository, transactionRepository)
 	deleteProjectDocumentService := projectservice.NewDeleteProjectDocumentService(projectRepository, projectDocumentRepository, uploadedFileRepository, storageRepository, transactionRepository)
 	deleteProjectDocumentVersionService := projectservice.NewDeleteProjectDocumentVersionService(projectRepository, projectDocumentRepository, uploadedFileRepository, storageRepository, transactionRepository)
+	diffProjectDocumentVersionsService := projectservice.NewDiffProjectDocumentVersionsService(projectRepository, projectDocumentRepository)
+	restoreProjectDocumentVersionService := projectservice.NewRestoreProjectDocumentVersionService(projectRepository, projectDocumentRepository, transactionRepository)
 
 	configureRoutesOptions := corehttp.ConfigureRoutesOptions{
 		DbConnection: options.DbConnection,
@@ -68,6 +87,12 @@ func BootstrapInfra(options BootstrapInfraOptions) {
 	projectTaskStatusController := projecthttp.NewProjectTaskStatusHandler(listProjectTaskStatusesService, createProjectTaskStatusService, updateProjectTaskStatusService, deleteProjectTaskStatusService)
 	projectTaskStatusController.ConfigureRoutes(configureRoutesOptions)
 
-	projectDocumentController := projecthttp.NewProjectDocumentHandler(getProjectDocumentVersionService, listProjectDocumentsService, listProjectDocumentVersionsService, createProjectDocumentService, updateProjectDocumentService, deleteProjectDocumentService, deleteProjectDocumentVersionService)
+	projectTaskStatusTransitionController := projecthttp.NewProjectTaskStatusTransitionHandler(listProjectTaskStatusTransitionsService, createProjectTaskStatusTransitionService, updateProjectTaskStatusTransitionService, deleteProjectTaskStatusTransitionService)
+	projectTaskStatusTransitionController.ConfigureRoutes(configureRoutesOptions)
+
+	projectTaskCustomFieldController := projecthttp.NewProjectTaskCustomFieldHandler(listProjectTaskCustomFieldsService, createProjectTaskCustomFieldService, updateProjectTaskCustomFieldService, deleteProjectTaskCustomFieldService)
+	projectTaskCustomFieldController.ConfigureRoutes(configureRoutesOptions)
+
+	projectDocumentController := projecthttp.NewProjectDocumentHandler(getProjectDocumentVersionService, listProjectDocumentsService, listProjectDocumentVersionsService, createProjectDocumentService, updateProjectDocumentService, deleteProjectDocumentService, deleteProjectDocumentVersionService, diffProjectDocumentVersionsService, restoreProjectDocumentVersionService)
 	projectDocumentController.ConfigureRoutes(configureRoutesOptions)
 }
@@ -22,6 +22,7 @@ type ProjectDocumentVersionManagerTable struct {
 	InternalId        string `bun:"internal_id,pk,notnull,type:uuid"`
 	PublicId          string `bun:"public_id,notnull,type:varchar(510)"`
 	ProjectInternalId string `bun:"project_internal_id,notnull,type:uuid"`
+	VersionScheme     string `bun:"version_scheme,notnull,type:varchar(50)"`
 
 	Project       *ProjectTable                `bun:"rel:has-one,join:project_internal_id=internal_id"`
 	LatestVersion *ProjectDocumentVersionTable `bun:"rel:has-one,join:internal_id=project_document_version_manager_internal_id"`
@@ -36,6 +37,7 @@ func (p *ProjectDocumentVersionManagerTable) ToEntity() *project.ProjectDocument
 	return &project.ProjectDocumentVersionManager{
 		Identity:        core.NewIdentityFromInternal(uuid.MustParse(p.InternalId), project.ProjectDocumentVersionManagerIdentityPrefix),
 		ProjectIdentity: core.NewIdentityFromInternal(uuid.MustParse(p.ProjectInternalId), project.ProjectIdentityPrefix),
+		VersionScheme:   project.ProjectDocumentVersionSchemes(p.VersionScheme),
 		LatestVersion:   latestVersion,
 	}
 }
@@ -49,11 +51,15 @@ type ProjectDocumentVersionTable struct {
 	Version                                 string  `bun:"version,notnull,type:varchar(255)"`
 	Title                                   string  `bun:"title,notnull,type:varchar(255)"`
 	Content                                 string  `bun:"content,notnull,type:text"`
+	ContentFormat                           string  `bun:"content_format,notnull,type:varchar(50)"`
+	ContentText                             string  `bun:"content_text,notnull,type:text"`
 	UserCreatorInternalId                   string  `bun:"user_creator_internal_id,notnull,type:uuid"`
 	UserEditorInternalId                    *string `bun:"user_editor_internal_id,type:uuid"`
 	Latest                                  bool    `bun:"latest,notnull,type:boolean"`
+	RestoredFromVersionInternalId           *string `bun:"restored_from_version_internal_id,type:uuid"`
 	CreatedAt                               int64   `bun:"created_at,notnull,type:bigint"`
 	UpdatedAt                               *int64  `bun:"updated_at,type:bigint"`
+	Revision                                int64   `bun:"revision,notnull,type:bigint"`
 
 	ProjectDocumentVersionManager *ProjectDocumentVersionManagerTable `bun:"rel:has-one,join:project_document_version_manager_internal_id=internal_id"`
 	ProjectDocumentFiles          []*ProjectDocumentFileTable         `bun:"rel:has-many,join:internal_id=project_document_version_internal_id"`
@@ -88,6 +94,12 @@ func (p *ProjectDocumentVersionTable) ToEntity() *project.ProjectDocumentVersion
 		userEditorIdentity = &identity
 	}
 
+	var restoredFromIdentity *core.Identity = nil
+	if p.RestoredFromVersionInternalId != nil {
+		identity := core.NewIdentityFromInternal(uuid.MustParse(*p.RestoredFromVersionInternalId), project.ProjectDocumentVersionIdentityPrefix)
+		restoredFromIdentity = &identity
+	}
+
 	var files []project.ProjectDocumentFile = make([]project.ProjectDocumentFile, len(p.ProjectDocumentFiles))
 	for i, file := range p.ProjectDocumentFiles {
 		files[i] = *file.ToEntity()
@@ -118,23 +130,64 @@ func (p *ProjectDocumentVersionTable) ToEntity() *project.ProjectDocumentVersion
 		ProjectDocumentVersionManagerIdentity: core.NewIdentityFromInternal(uuid.MustParse(p.ProjectDocumentVersionManagerInternalId), project.ProjectDocumentVersionManagerIdentityPrefix),
 		Version:                               p.Version,
 		Document: project.ProjectDocument{
-			Identity: core.NewIdentityWithoutPublic(),
-			Title:    p.Title,
-			Content:  p.Content,
-			Files:    files,
+			Identity:      core.NewIdentityWithoutPublic(),
+			Title:         p.Title,
+			Content:       p.Content,
+			ContentFormat: core.ContentFormats(p.ContentFormat),
+			Files:         files,
 		},
-		UserCreatorIdentity: &userCreatorIdentity,
-		UserEditorIdentity:  userEditorIdentity,
-		Latest:              p.Latest,
+		UserCreatorIdentity:  &userCreatorIdentity,
+		UserEditorIdentity:   userEditorIdentity,
+		Latest:               p.Latest,
+		RestoredFromIdentity: restoredFromIdentity,
 		Timestamps: core.Timestamps{
 			CreatedAt: createdAt,
 			UpdatedAt: updatedAt,
 		},
-		Creator: creator,
-		Editor:  editor,
+		Revision: p.Revision,
+		Creator:  creator,
+		Editor:   editor,
 	}
 }
 
+var projectDocumentVersionManagerSortableFields = coredatabase.SortableFields{
+	Fields: map[string]string{
+		"title":     "(SELECT latest_version.title FROM project_document_version AS latest_version WHERE latest_version.project_document_version_manager_internal_id = project_document_version_manager.internal_id AND latest_version.latest = TRUE)",
+		"updatedAt": "(SELECT latest_version.created_at FROM project_document_version AS latest_version WHERE latest_version.project_document_version_manager_internal_id = project_document_version_manager.internal_id AND latest_version.latest = TRUE)",
+	},
+	IdColumn: "project_document_version_manager.internal_id",
+}
+
+var projectDocumentVersionSortableFields = coredatabase.SortableFields{
+	Fields: map[string]string{
+		"title":     "project_document_version.title",
+		"version":   "project_document_version.version",
+		"createdAt": "project_document_version.created_at",
+		"updatedAt": "project_document_version.updated_at",
+	},
+	IdColumn: "project_document_version.internal_id",
+}
+
+var projectDocumentVersionManagerIncludableRelations = coredatabase.IncludableRelations{
+	Relations: []string{
+		"Project",
+		"LatestVersion.ProjectDocumentFiles",
+		"LatestVersion.Creator",
+		"LatestVersion.Editor",
+	},
+	MaxDepth: 2,
+}
+
+var projectDocumentVersionIncludableRelations = coredatabase.IncludableRelations{
+	Relations: []string{
+		"ProjectDocumentVersionManager",
+		"ProjectDocumentFiles",
+		"Creator",
+		"Editor",
+	},
+	MaxDepth: 1,
+}
+
 type ProjectDocumentBunRepository struct {
 	db *bun.DB
 	tx *coredatabase.TransactionBun
@@ -195,13 +248,25 @@ func (r *ProjectDocumentBunRepository) GetProjectDocumentVersionManagerBy(params
 		selectQuery = r.db.NewSelect()
 	}
 
+	if params.ForUpdate {
+		err := r.lockProjectDocumentVersionManager(params.ProjectDocumentVersionManagerIdentity)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	selectQuery = selectQuery.Model(projectDocumentVersionManager)
-	selectQuery = selectQuery.Relation("LatestVersion.ProjectDocumentFiles", func(q *bun.SelectQuery) *bun.SelectQuery {
-		return q.Where("latest = ?", true)
+	selectQuery = selectQuery.Relation("LatestVersion", func(q *bun.SelectQuery) *bun.SelectQuery {
+		return q.Where("latest_version.latest = ?", true)
 	})
-	selectQuery = coredatabase.ApplyRelations(selectQuery, params.RelationsInput)
-	selectQuery = selectQuery.Where("internal_id = ?", params.ProjectDocumentVersionManagerIdentity.Internal.String())
-	err := selectQuery.Scan(context.Background())
+	selectQuery = selectQuery.Relation("LatestVersion.ProjectDocumentFiles")
+	selectQuery, err := coredatabase.ApplyRelations(selectQuery, projectDocumentVersionManagerIncludableRelations, params.RelationsInput)
+	if err != nil {
+		return nil, err
+	}
+
+	selectQuery = selectQuery.Where("project_document_version_manager.internal_id = ?", params.ProjectDocumentVersionManagerIdentity.Internal.String())
+	err = selectQuery.Scan(context.Background())
 	if err != nil {
 		if err == sql.ErrNoRows {
 			return nil, nil
@@ -228,9 +293,18 @@ func (r *ProjectDocumentBunRepository) GetProjectDocumentVersionBy(params projec
 	}
 
 	selectQuery = selectQuery.Model(projectDocumentVersion)
-	selectQuery = coredatabase.ApplyRelations(selectQuery, params.RelationsInput)
-	selectQuery = selectQuery.Where("internal_id = ?", params.ProjectDocumentVersionIdentity.Internal.String())
-	err := selectQuery.Scan(context.Background())
+	selectQuery = selectQuery.Relation("ProjectDocumentFiles")
+	selectQuery, err := coredatabase.ApplyRelations(selectQuery, projectDocumentVersionIncludableRelations, params.RelationsInput)
+	if err != nil {
+		return nil, err
+	}
+
+	selectQuery = selectQuery.Where("project_document_version.internal_id = ?", params.ProjectDocumentVersionIdentity.Internal.String())
+
+	if params.ProjectDocumentVersionManagerIdentity != nil {
+		selectQuery = selectQuery.Where("project_document_version.project_document_version_manager_internal_id = ?", params.ProjectDocumentVersionManagerIdentity.Internal.String())
+	}
+	err = selectQuery.Scan(context.Background())
 	if err != nil {
 		if err == sql.ErrNoRows {
 			return nil, nil
@@ -257,9 +331,19 @@ func (r *ProjectDocumentBunRepository) ListProjectDocumentVersionsByProjectDocum
 	}
 
 	selectQuery = selectQuery.Model(&projectDocumentVersions)
-	selectQuery = coredatabase.ApplyRelations(selectQuery, params.RelationsInput)
-	selectQuery = selectQuery.Where("project_document_version_manager_internal_id = ?", params.ProjectDocumentVersionManagerIdentity.Internal.String())
-	err := selectQuery.Scan(context.Background())
+	selectQuery = selectQuery.Relation("ProjectDocumentFiles")
+	selectQuery, err := coredatabase.ApplyRelations(selectQuery, projectDocumentVersionIncludableRelations, params.RelationsInput)
+	if err != nil {
+		return nil, err
+	}
+
+	selectQuery = selectQuery.Where("project_document_version.project_document_version_manager_internal_id = ?", params.ProjectDocumentVersionManagerIdentity.Internal.String())
+	selectQuery, err = coredatabase.ApplySort(selectQuery, projectDocumentVersionSortableFields, params.SortInput)
+	if err != nil {
+		return nil, err
+	}
+
+	err = selectQuery.Scan(context.Background())
 	if err != nil {
 		return nil, err
 	}
@@ -296,14 +380,22 @@ func (r *ProjectDocumentBunRepository) PaginateProjectDocumentVersionManagersBy(
 	selectQuery = selectQuery.Relation("LatestVersion", func(q *bun.SelectQuery) *bun.SelectQuery {
 		return q.Where("latest = ?", true)
 	})
-	selectQuery = coredatabase.ApplyRelations(selectQuery, params.RelationsInput)
+	selectQuery, err := coredatabase.ApplyRelations(selectQuery, projectDocumentVersionManagerIncludableRelations, params.RelationsInput)
+	if err != nil {
+		return nil, err
+	}
+
 	selectQuery = r.applyProjectDocumentVersionManagerFilters(selectQuery, params.Filters)
 	countBeforePagination, err := selectQuery.Count(context.Background())
 	if err != nil {
 		return nil, err
 	}
 
-	selectQuery = coredatabase.ApplySort(selectQuery, params.SortInput)
+	selectQuery, err = coredatabase.ApplySort(selectQuery, projectDocumentVersionManagerSortableFields, params.SortInput)
+	if err != nil {
+		return nil, err
+	}
+
 	selectQuery = coredatabase.ApplyPagination(selectQuery, params.Pagination)
 	err = selectQuery.Scan(context.Background())
 	if err != nil {
@@ -345,14 +437,22 @@ func (r *ProjectDocumentBunRepository) PaginateProjectDocumentVersionsBy(params
 
 	selectQuery = selectQuery.Model(&projectDocumentVersions)
 	selectQuery = selectQuery.Relation("ProjectDocumentFiles")
-	selectQuery = coredatabase.ApplyRelations(selectQuery, params.RelationsInput)
+	selectQuery, err := coredatabase.ApplyRelations(selectQuery, projectDocumentVersionIncludableRelations, params.RelationsInput)
+	if err != nil {
+		return nil, err
+	}
+
 	selectQuery = r.applyProjectDocumentVersionFilters(selectQuery, params.Filters)
 	countBeforePagination, err := selectQuery.Count(context.Background())
 	if err != nil {
 		return nil, err
 	}
 
-	selectQuery = coredatabase.ApplySort(selectQuery, params.SortInput)
+	selectQuery, err = coredatabase.ApplySort(selectQuery, projectDocumentVersionSortableFields, params.SortInput)
+	if err != nil {
+		return nil, err
+	}
+
 	selectQuery = coredatabase.ApplyPagination(selectQuery, params.Pagination)
 	err = selectQuery.Scan(context.Background())
 	if err != nil {
@@ -392,6 +492,7 @@ func (r *ProjectDocumentBunRepository) StoreProjectDocumentVersionManager(params
 		InternalId:        params.ProjectDocumentVersionManager.Identity.Internal.String(),
 		PublicId:          params.ProjectDocumentVersionManager.Identity.Public,
 		ProjectInternalId: params.ProjectDocumentVersionManager.ProjectIdentity.Internal.String(),
+		VersionScheme:     string(params.ProjectDocumentVersionManager.VersionScheme),
 	}
 
 	_, err := tx.NewInsert().Model(projectDocumentVersionManagerTable).Exec(context.Background())
@@ -409,6 +510,42 @@ func (r *ProjectDocumentBunRepository) StoreProjectDocumentVersionManager(params
 	return params.ProjectDocumentVersionManager, nil
 }
 
+func (r *ProjectDocumentBunRepository) UpdateProjectDocumentVersionManager(params projectrepo.UpdateProjectDocumentVersionManagerParams) error {
+	var tx bun.Tx
+	var shouldCommit bool = false
+
+	if r.tx != nil && !r.tx.IsClosed() {
+		tx = *r.tx.Tx
+	} else {
+		var err error
+		tx, err = r.db.BeginTx(context.Background(), nil)
+		shouldCommit = true
+
+		if err != nil {
+			return err
+		}
+	}
+
+	projectDocumentVersionManagerTable := &ProjectDocumentVersionManagerTable{
+		InternalId:    params.ProjectDocumentVersionManager.Identity.Internal.String(),
+		VersionScheme: string(params.ProjectDocumentVersionManager.VersionScheme),
+	}
+
+	_, err := tx.NewUpdate().Model(projectDocumentVersionManagerTable).Column("version_scheme").Where("internal_id = ?", projectDocumentVersionManagerTable.InternalId).Exec(context.Background())
+	if err != nil {
+		return err
+	}
+
+	if shouldCommit {
+		err = tx.Commit()
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 func (r *ProjectDocumentBunRepository) DeleteProjectDocumentVersionManager(params projectrepo.DeleteProjectDocumentVersionManagerParams) error {
 	var tx bun.Tx
 	var shouldCommit bool = false
@@ -462,6 +599,12 @@ func (r *ProjectDocumentBunRepository) StoreProjectDocumentVersion(params projec
 		userEditorInternalId = &identity
 	}
 
+	var restoredFromVersionInternalId *string
+	if params.ProjectDocumentVersion.RestoredFromIdentity != nil {
+		identity := params.ProjectDocumentVersion.RestoredFromIdentity.Internal.String()
+		restoredFromVersionInternalId = &identity
+	}
+
 	var createdAt *int64 = nil
 	if params.ProjectDocumentVersion.Timestamps.CreatedAt != nil {
 		createdAt = &params.ProjectDocumentVersion.Timestamps.CreatedAt.Value
@@ -479,11 +622,22 @@ func (r *ProjectDocumentBunRepository) StoreProjectDocumentVersion(params projec
 		Version:                                 params.ProjectDocumentVersion.Version,
 		Title:                                   params.ProjectDocumentVersion.Document.Title,
 		Content:                                 params.ProjectDocumentVersion.Document.Content,
+		ContentFormat:                           string(params.ProjectDocumentVersion.Document.ContentFormat),
+		ContentText:                             params.ProjectDocumentVersion.Document.FormattedContent().PlainText(),
 		UserCreatorInternalId:                   params.ProjectDocumentVersion.UserCreatorIdentity.Internal.String(),
 		UserEditorInternalId:                    userEditorInternalId,
 		Latest:                                  params.ProjectDocumentVersion.Latest,
+		RestoredFromVersionInternalId:           restoredFromVersionInternalId,
 		CreatedAt:                               *createdAt,
 		UpdatedAt:                               updatedAt,
+		Revision:                                params.ProjectDocumentVersion.Revision,
+	}
+
+	if params.ProjectDocumentVersion.Latest {
+		err := r.unsetOtherLatestProjectDocumentVersions(tx, params.ProjectDocumentVersion)
+		if err != nil {
+			return nil, err
+		}
 	}
 
 	_, err := tx.NewInsert().Model(projectDocumentVersionTable).Exec(context.Background())
@@ -548,15 +702,32 @@ func (r *ProjectDocumentBunRepository) UpdateProjectDocumentVersion(params proje
 		Version:                                 params.ProjectDocumentVersion.Version,
 		Title:                                   params.ProjectDocumentVersion.Document.Title,
 		Content:                                 params.ProjectDocumentVersion.Document.Content,
+		ContentFormat:                           string(params.ProjectDocumentVersion.Document.ContentFormat),
+		ContentText:                             params.ProjectDocumentVersion.Document.FormattedContent().PlainText(),
 		UserEditorInternalId:                    userEditorInternalId,
+		Latest:                                  params.ProjectDocumentVersion.Latest,
 		UpdatedAt:                               updatedAt,
+		Revision:                                params.ProjectDocumentVersion.Revision + 1,
+	}
+
+	if params.ProjectDocumentVersion.Latest {
+		err := r.unsetOtherLatestProjectDocumentVersions(tx, params.ProjectDocumentVersion)
+		if err != nil {
+			return err
+		}
 	}
 
-	_, err := tx.NewUpdate().Model(projectDocumentVersionTable).Where("internal_id = ?", params.ProjectDocumentVersion.Identity.Internal.String()).Exec(context.Background())
+	updateQuery := tx.NewUpdate().
+		Model(projectDocumentVersionTable).
+		Column("version", "title", "content", "content_format", "content_text", "user_editor_internal_id", "latest", "updated_at", "revision").
+		Where("internal_id = ?", params.ProjectDocumentVersion.Identity.Internal.String())
+	err := coredatabase.ExecRevisionedUpdate(updateQuery, "revision", params.ProjectDocumentVersion.Revision, "project document version")
 	if err != nil {
 		return err
 	}
 
+	params.ProjectDocumentVersion.Revision++
+
 	_, err = tx.NewDelete().Model(&ProjectDocumentFileTable{}).Where("project_document_version_internal_id = ?", params.ProjectDocumentVersion.Identity.Internal.String()).Exec(context.Background())
 	if err != nil {
 		return err
@@ -615,3 +786,43 @@ func (r *ProjectDocumentBunRepository) DeleteProjectDocumentVersion(params proje
 
 	return nil
 }
+
+/*
+lockProjectDocumentVersionManager locks the manager row until the transaction ends, so versions of a document are
+created, restored and deleted one at a time and always see the latest version left by the previous one.
+*/
+func (r *ProjectDocumentBunRepository) lockProjectDocumentVersionManager(projectDocumentVersionManagerIdentity core.Identity) error {
+	var selectQuery *bun.SelectQuery
+
+	if r.tx != nil && !r.tx.IsClosed() {
+		selectQuery = r.tx.Tx.NewSelect()
+	} else {
+		selectQuery = r.db.NewSelect()
+	}
+
+	_, err := selectQuery.
+		Model((*ProjectDocumentVersionManagerTable)(nil)).
+		Column("internal_id").
+		Where("internal_id = ?", projectDocumentVersionManagerIdentity.Internal.String()).
+		For("UPDATE").
+		Exec(context.Background())
+
+	return err
+}
+
+/*
+unsetOtherLatestProjectDocumentVersions clears the latest flag of the other versions of the document before a version is
+stored as the latest one, as a document has a single latest version.
+*/
+func (r *ProjectDocumentBunRepository) unsetOtherLatestProjectDocumentVersions(tx bun.Tx, projectDocumentVersion *project.ProjectDocumentVersion) error {
+	_, err := tx.NewUpdate().
+		Model((*ProjectDocumentVersionTable)(nil)).
+		Set("latest = ?", false).
+		Set("revision = revision + 1").
+		Where("project_document_version_manager_internal_id = ?", projectDocumentVersion.ProjectDocumentVersionManagerIdentity.Internal.String()).
+		Where("internal_id != ?", projectDocumentVersion.Identity.Internal.String()).
+		Where("latest = ?", true).
+		Exec(context.Background())
+
+	return err
+}
@@ -34,6 +34,7 @@ type ProjectTable struct {
 	CreatedAt             int64   `bun:"created_at,notnull,type:bigint"`
 	UpdatedAt             *int64  `bun:"updated_at,type:bigint"`
 	DeletedAt             *int64  `bun:"deleted_at,type:bigint"`
+	Revision              int64   `bun:"revision,notnull,type:bigint"`
 
 	Workspace *workspacedatabase.WorkspaceTable `bun:"rel:has-one,join:workspace_internal_id=internal_id"`
 	Creator   *userdatabase.UserTable           `bun:"rel:has-one,join:user_creator_internal_id=internal_id"`
@@ -101,11 +102,34 @@ func (p *ProjectTable) ToEntity() *project.Project {
 			UpdatedAt: updatedAt,
 		},
 		DeletedAt: deletedAt,
+		Revision:  p.Revision,
 		Creator:   creator,
 		Editor:    editor,
 	}
 }
 
+var projectSortableFields = coredatabase.SortableFields{
+	Fields: map[string]string{
+		"name":      "project.name",
+		"status":    "project.status",
+		"priority":  "project.priority_level",
+		"startAt":   "project.start_at",
+		"endAt":     "project.end_at",
+		"createdAt": "project.created_at",
+		"updatedAt": "project.updated_at",
+	},
+	IdColumn: "project.internal_id",
+}
+
+var projectIncludableRelations = coredatabase.IncludableRelations{
+	Relations: []string{
+		"Workspace",
+		"Creator",
+		"Editor",
+	},
+	MaxDepth: 1,
+}
+
 type ProjectBunRepository struct {
 	db *bun.DB
 	tx *coredatabase.TransactionBun
@@ -125,6 +149,10 @@ func (r *ProjectBunRepository) SetTransaction(tx core.Transaction) error {
 }
 
 func (r *ProjectBunRepository) applyFilters(selectQuery *bun.SelectQuery, filters projectrepo.ProjectFilters) *bun.SelectQuery {
+	if filters.OrganizationIdentity != nil {
+		selectQuery = selectQuery.Where("project.workspace_internal_id IN (SELECT workspace.internal_id FROM workspace WHERE workspace.organization_internal_id = ?)", filters.OrganizationIdentity.Internal.String())
+	}
+
 	if filters.WorkspaceIdentity != nil {
 		selectQuery = selectQuery.Where("project.workspace_internal_id = ?", filters.WorkspaceIdentity.Internal.String())
 		if filters.AuthenticatedUserIdentity != nil {
@@ -182,7 +210,11 @@ func (r *ProjectBunRepository) GetProjectByIdentity(params projectrepo.GetProjec
 	}
 
 	selectQuery = selectQuery.Model(project)
-	selectQuery = coredatabase.ApplyRelations(selectQuery, params.RelationsInput)
+	selectQuery, err := coredatabase.ApplyRelations(selectQuery, projectIncludableRelations, params.RelationsInput)
+	if err != nil {
+		return nil, err
+	}
+
 	selectQuery = selectQuery.Where("project.internal_id = ?", params.ProjectIdentity.Internal.String())
 	if params.WorkspaceIdentity != nil {
 		selectQuery = selectQuery.Where("project.workspace_internal_id = ?", params.WorkspaceIdentity.Internal.String())
@@ -192,7 +224,7 @@ func (r *ProjectBunRepository) GetProjectByIdentity(params projectrepo.GetProjec
 		selectQuery = selectQuery.Where("project.workspace_internal_id IN (SELECT workspace.internal_id FROM workspace WHERE workspace.organization_internal_id = ?)", params.OrganizationIdentity.Internal.String())
 	}
 
-	err := selectQuery.Scan(context.Background())
+	err = selectQuery.Scan(context.Background())
 	if err != nil {
 		if err == sql.ErrNoRows {
 			return nil, nil
@@ -229,7 +261,11 @@ func (r *ProjectBunRepository) PaginateProjectsBy(params projectrepo.PaginatePro
 	}
 
 	selectQuery = selectQuery.Model(&projects)
-	selectQuery = coredatabase.ApplyRelations(selectQuery, params.RelationsInput)
+	selectQuery, err := coredatabase.ApplyRelations(selectQuery, projectIncludableRelations, params.RelationsInput)
+	if err != nil {
+		return nil, err
+	}
+
 	selectQuery = r.applyFilters(selectQuery, params.Filters)
 
 	if !params.ShowDeleted {
@@ -241,7 +277,11 @@ func (r *ProjectBunRepository) PaginateProjectsBy(params projectrepo.PaginatePro
 		return nil, err
 	}
 
-	selectQuery = coredatabase.ApplySort(selectQuery, params.SortInput)
+	selectQuery, err = coredatabase.ApplySort(selectQuery, projectSortableFields, params.SortInput)
+	if err != nil {
+		return nil, err
+	}
+
 	selectQuery = coredatabase.ApplyPagination(selectQuery, params.Pagination)
 	err = selectQuery.Scan(context.Background())
 	if err != nil {
@@ -333,6 +373,7 @@ func (r *ProjectBunRepository) StoreProject(params projectrepo.StoreProjectParam
 		CreatedAt:             *createdAt,
 		UpdatedAt:             updatedAt,
 		DeletedAt:             deletedAt,
+		Revision:              params.Project.Revision,
 	}).Exec(context.Background())
 	if err != nil {
 		if err == sql.ErrNoRows {
@@ -407,17 +448,20 @@ func (r *ProjectBunRepository) UpdateProject(params projectrepo.UpdateProjectPar
 		WorkspaceInternalId:   params.Project.WorkspaceIdentity.Internal.String(),
 		UserCreatorInternalId: params.Project.UserCreatorIdentity.Internal.String(),
 		UserEditorInternalId:  userEditorInternalId,
+		CreatedAt:             params.Project.Timestamps.CreatedAt.Value,
 		UpdatedAt:             updatedAt,
 		DeletedAt:             deletedAt,
+		Revision:              params.Project.Revision + 1,
 	}
 
-	_, err := tx.NewUpdate().Model(projectTable).Where("project.internal_id = ?", params.Project.Identity.Internal.String()).Exec(context.Background())
+	updateQuery := tx.NewUpdate().Model(projectTable).Where("project.internal_id = ?", params.Project.Identity.Internal.String())
+	err := coredatabase.ExecRevisionedUpdate(updateQuery, "project.revision", params.Project.Revision, "project")
 	if err != nil {
-		if err == sql.ErrNoRows {
-			return nil
-		}
+		return err
 	}
 
+	params.Project.Revision++
+
 	if shouldCommit {
 		err = tx.Commit()
 		if err != nil {
@@ -34,6 +34,20 @@ func (p *ProjectTaskCategoryTable) ToEntity() *project.ProjectTaskCategory {
 	}
 }
 
+var projectTaskCategorySortableFields = coredatabase.SortableFields{
+	Fields: map[string]string{
+		"name": "project_task_category.name",
+	},
+	IdColumn: "project_task_category.internal_id",
+}
+
+var projectTaskCategoryIncludableRelations = coredatabase.IncludableRelations{
+	Relations: []string{
+		"Project",
+	},
+	MaxDepth: 1,
+}
+
 type ProjectTaskCategoryBunRepository struct {
 	db *bun.DB
 	tx *coredatabase.TransactionBun
@@ -75,9 +89,13 @@ func (r *ProjectTaskCategoryBunRepository) GetProjectTaskCategoryByIdentity(para
 	}
 
 	selectQuery = selectQuery.Model(projectTaskCategory)
-	selectQuery = coredatabase.ApplyRelations(selectQuery, params.RelationsInput)
+	selectQuery, err := coredatabase.ApplyRelations(selectQuery, projectTaskCategoryIncludableRelations, params.RelationsInput)
+	if err != nil {
+		return nil, err
+	}
+
 	selectQuery = selectQuery.Where("project_task_category.internal_id = ?", params.ProjectTaskCategoryIdentity.Internal.String())
-	err := selectQuery.Scan(context.Background())
+	err = selectQuery.Scan(context.Background())
 	if err != nil {
 		if err == sql.ErrNoRows {
 			return nil, nil
@@ -106,7 +124,11 @@ func (r *ProjectTaskCategoryBunRepository) PaginateProjectTaskCategoryBy(params
 	}
 
 	selectQuery = selectQuery.Model(&projectTaskCategories)
-	selectQuery = coredatabase.ApplyRelations(selectQuery, params.RelationsInput)
+	selectQuery, err := coredatabase.ApplyRelations(selectQuery, projectTaskCategoryIncludableRelations, params.RelationsInput)
+	if err != nil {
+		return nil, err
+	}
+
 	selectQuery = r.applyFilters(selectQuery, params.Filters)
 
 	if !params.ShowDeleted {
@@ -118,7 +140,11 @@ func (r *ProjectTaskCategoryBunRepository) PaginateProjectTaskCategoryBy(params
 		return nil, err
 	}
 
-	selectQuery = coredatabase.ApplySort(selectQuery, params.SortInput)
+	selectQuery, err = coredatabase.ApplySort(selectQuery, projectTaskCategorySortableFields, params.SortInput)
+	if err != nil {
+		return nil, err
+	}
+
 	selectQuery = coredatabase.ApplyPagination(selectQuery, params.Pagination)
 	err = selectQuery.Scan(context.Background())
 	if err != nil {
@@ -0,0 +1,365 @@
+package projectdatabase
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/gabrielmrtt/taski/internal/core"
+	coredatabase "github.com/gabrielmrtt/taski/internal/core/database"
+	"github.com/gabrielmrtt/taski/internal/project"
+	projectrepo "github.com/gabrielmrtt/taski/internal/project/repository"
+	"github.com/google/uuid"
+	"github.com/uptrace/bun"
+)
+
+type ProjectTaskCustomFieldTable struct {
+	bun.BaseModel `bun:"table:project_task_custom_field,alias:project_task_custom_field"`
+
+	InternalId        string   `bun:"internal_id,pk,notnull,type:uuid"`
+	PublicId          string   `bun:"public_id,notnull,type:varchar(510)"`
+	Name              string   `bun:"name,notnull,type:varchar(255)"`
+	Type              string   `bun:"type,notnull,type:varchar(100)"`
+	Options           []string `bun:"options,notnull,type:jsonb"`
+	IsRequired        bool     `bun:"is_required,notnull,type:boolean"`
+	DeletedAt         *int64   `bun:"deleted_at,type:bigint"`
+	ProjectInternalId string   `bun:"project_internal_id,notnull,type:uuid"`
+
+	Project *ProjectTable `bun:"rel:has-one,join:project_internal_id=internal_id"`
+}
+
+func (p *ProjectTaskCustomFieldTable) ToEntity() *project.ProjectTaskCustomField {
+	var options []string = make([]string, 0)
+	if p.Options != nil {
+		options = p.Options
+	}
+
+	var deletedAt *core.DateTime = nil
+	if p.DeletedAt != nil {
+		deletedAt = &core.DateTime{Value: *p.DeletedAt}
+	}
+
+	return &project.ProjectTaskCustomField{
+		Identity:        core.NewIdentityFromInternal(uuid.MustParse(p.InternalId), project.ProjectTaskCustomFieldIdentityPrefix),
+		ProjectIdentity: core.NewIdentityFromInternal(uuid.MustParse(p.ProjectInternalId), project.ProjectIdentityPrefix),
+		Name:            p.Name,
+		Type:            project.ProjectTaskCustomFieldTypes(p.Type),
+		Options:         options,
+		IsRequired:      p.IsRequired,
+		DeletedAt:       deletedAt,
+	}
+}
+
+var projectTaskCustomFieldSortableFields = coredatabase.SortableFields{
+	Fields: map[string]string{
+		"name": "project_task_custom_field.name",
+		"type": "project_task_custom_field.type",
+	},
+	IdColumn: "project_task_custom_field.internal_id",
+}
+
+var projectTaskCustomFieldIncludableRelations = coredatabase.IncludableRelations{
+	Relations: []string{
+		"Project",
+	},
+	MaxDepth: 1,
+}
+
+type ProjectTaskCustomFieldBunRepository struct {
+	db *bun.DB
+	tx *coredatabase.TransactionBun
+}
+
+func NewProjectTaskCustomFieldBunRepository(connection *bun.DB) *ProjectTaskCustomFieldBunRepository {
+	return &ProjectTaskCustomFieldBunRepository{db: connection, tx: nil}
+}
+
+func (r *ProjectTaskCustomFieldBunRepository) SetTransaction(tx core.Transaction) error {
+	if r.tx != nil && !r.tx.IsClosed() {
+		return nil
+	}
+
+	r.tx = tx.(*coredatabase.TransactionBun)
+	return nil
+}
+
+func (r *ProjectTaskCustomFieldBunRepository) applyFilters(selectQuery *bun.SelectQuery, filters projectrepo.ProjectTaskCustomFieldFilters) *bun.SelectQuery {
+	if filters.ProjectIdentity != nil {
+		selectQuery = selectQuery.Where("project_task_custom_field.project_internal_id = ?", filters.ProjectIdentity.Internal.String())
+	}
+
+	if filters.Name != nil {
+		selectQuery = coredatabase.ApplyComparableFilter(selectQuery, "project_task_custom_field.name", filters.Name)
+	}
+
+	if filters.Type != nil {
+		selectQuery = coredatabase.ApplyComparableFilter(selectQuery, "project_task_custom_field.type", filters.Type)
+	}
+
+	if filters.IsRequired != nil {
+		selectQuery = selectQuery.Where("project_task_custom_field.is_required = ?", *filters.IsRequired)
+	}
+
+	return selectQuery
+}
+
+func (r *ProjectTaskCustomFieldBunRepository) GetProjectTaskCustomFieldByIdentity(params projectrepo.GetProjectTaskCustomFieldByIdentityParams) (*project.ProjectTaskCustomField, error) {
+	var projectTaskCustomField *ProjectTaskCustomFieldTable = new(ProjectTaskCustomFieldTable)
+	var selectQuery *bun.SelectQuery
+
+	if r.tx != nil && !r.tx.IsClosed() {
+		selectQuery = r.tx.Tx.NewSelect()
+	} else {
+		selectQuery = r.db.NewSelect()
+	}
+
+	selectQuery = selectQuery.Model(projectTaskCustomField)
+	selectQuery, err := coredatabase.ApplyRelations(selectQuery, projectTaskCustomFieldIncludableRelations, params.RelationsInput)
+	if err != nil {
+		return nil, err
+	}
+
+	selectQuery = selectQuery.Where("project_task_custom_field.internal_id = ?", params.ProjectTaskCustomFieldIdentity.Internal.String())
+
+	if params.ProjectIdentity != nil {
+		selectQuery = selectQuery.Where("project_task_custom_field.project_internal_id = ?", params.ProjectIdentity.Internal.String())
+	}
+
+	err = selectQuery.Scan(context.Background())
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil
+		}
+
+		return nil, err
+	}
+
+	if projectTaskCustomField.InternalId == "" {
+		return nil, nil
+	}
+
+	return projectTaskCustomField.ToEntity(), nil
+}
+
+func (r *ProjectTaskCustomFieldBunRepository) ListProjectTaskCustomFieldsBy(params projectrepo.ListProjectTaskCustomFieldsByParams) ([]project.ProjectTaskCustomField, error) {
+	var projectTaskCustomFields []ProjectTaskCustomFieldTable = make([]ProjectTaskCustomFieldTable, 0)
+	var selectQuery *bun.SelectQuery
+
+	if r.tx != nil && !r.tx.IsClosed() {
+		selectQuery = r.tx.Tx.NewSelect()
+	} else {
+		selectQuery = r.db.NewSelect()
+	}
+
+	selectQuery = selectQuery.Model(&projectTaskCustomFields)
+	selectQuery, err := coredatabase.ApplyRelations(selectQuery, projectTaskCustomFieldIncludableRelations, params.RelationsInput)
+	if err != nil {
+		return nil, err
+	}
+
+	selectQuery = r.applyFilters(selectQuery, params.Filters)
+
+	if !params.ShowDeleted {
+		selectQuery = selectQuery.Where("project_task_custom_field.deleted_at IS NULL")
+	}
+
+	err = selectQuery.Scan(context.Background())
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return []project.ProjectTaskCustomField{}, nil
+		}
+
+		return nil, err
+	}
+
+	var projectTaskCustomFieldEntities []project.ProjectTaskCustomField = make([]project.ProjectTaskCustomField, 0)
+	for _, projectTaskCustomField := range projectTaskCustomFields {
+		projectTaskCustomFieldEntities = append(projectTaskCustomFieldEntities, *projectTaskCustomField.ToEntity())
+	}
+
+	return projectTaskCustomFieldEntities, nil
+}
+
+func (r *ProjectTaskCustomFieldBunRepository) PaginateProjectTaskCustomFieldsBy(params projectrepo.PaginateProjectTaskCustomFieldsParams) (*core.PaginationOutput[project.ProjectTaskCustomField], error) {
+	var projectTaskCustomFields []ProjectTaskCustomFieldTable = make([]ProjectTaskCustomFieldTable, 0)
+	var selectQuery *bun.SelectQuery
+	var perPage int = 10
+	var page int = 1
+
+	if params.Pagination.PerPage != nil {
+		perPage = *params.Pagination.PerPage
+	}
+
+	if params.Pagination.Page != nil {
+		page = *params.Pagination.Page
+	}
+
+	if r.tx != nil && !r.tx.IsClosed() {
+		selectQuery = r.tx.Tx.NewSelect()
+	} else {
+		selectQuery = r.db.NewSelect()
+	}
+
+	selectQuery = selectQuery.Model(&projectTaskCustomFields)
+	selectQuery, err := coredatabase.ApplyRelations(selectQuery, projectTaskCustomFieldIncludableRelations, params.RelationsInput)
+	if err != nil {
+		return nil, err
+	}
+
+	selectQuery = r.applyFilters(selectQuery, params.Filters)
+
+	if !params.ShowDeleted {
+		selectQuery = selectQuery.Where("project_task_custom_field.deleted_at IS NULL")
+	}
+
+	countBeforePagination, err := selectQuery.Count(context.Background())
+	if err != nil {
+		return nil, err
+	}
+
+	selectQuery, err = coredatabase.ApplySort(selectQuery, projectTaskCustomFieldSortableFields, params.SortInput)
+	if err != nil {
+		return nil, err
+	}
+
+	selectQuery = coredatabase.ApplyPagination(selectQuery, params.Pagination)
+	err = selectQuery.Scan(context.Background())
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return &core.PaginationOutput[project.ProjectTaskCustomField]{
+				Data:    []project.ProjectTaskCustomField{},
+				Page:    page,
+				HasMore: false,
+				Total:   0,
+			}, nil
+		}
+
+		return nil, err
+	}
+
+	var projectTaskCustomFieldEntities []project.ProjectTaskCustomField = make([]project.ProjectTaskCustomField, 0)
+	for _, projectTaskCustomField := range projectTaskCustomFields {
+		projectTaskCustomFieldEntities = append(projectTaskCustomFieldEntities, *projectTaskCustomField.ToEntity())
+	}
+
+	return &core.PaginationOutput[project.ProjectTaskCustomField]{
+		Data:    projectTaskCustomFieldEntities,
+		Page:    page,
+		HasMore: core.HasMorePages(page, countBeforePagination, perPage),
+		Total:   countBeforePagination,
+	}, nil
+}
+
+func (r *ProjectTaskCustomFieldBunRepository) StoreProjectTaskCustomField(params projectrepo.StoreProjectTaskCustomFieldParams) (*project.ProjectTaskCustomField, error) {
+	var tx bun.Tx
+	var shouldCommit bool = false
+
+	if r.tx != nil && !r.tx.IsClosed() {
+		tx = *r.tx.Tx
+	} else {
+		var err error
+		tx, err = r.db.BeginTx(context.Background(), nil)
+		shouldCommit = true
+
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	_, err := tx.NewInsert().Model(&ProjectTaskCustomFieldTable{
+		InternalId:        params.ProjectTaskCustomField.Identity.Internal.String(),
+		PublicId:          params.ProjectTaskCustomField.Identity.Public,
+		Name:              params.ProjectTaskCustomField.Name,
+		Type:              string(params.ProjectTaskCustomField.Type),
+		Options:           params.ProjectTaskCustomField.Options,
+		IsRequired:        params.ProjectTaskCustomField.IsRequired,
+		ProjectInternalId: params.ProjectTaskCustomField.ProjectIdentity.Internal.String(),
+	}).Exec(context.Background())
+	if err != nil {
+		return nil, err
+	}
+
+	if shouldCommit {
+		err = tx.Commit()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return params.ProjectTaskCustomField, nil
+}
+
+func (r *ProjectTaskCustomFieldBunRepository) UpdateProjectTaskCustomField(params projectrepo.UpdateProjectTaskCustomFieldParams) error {
+	var tx bun.Tx
+	var shouldCommit bool = false
+
+	if r.tx != nil && !r.tx.IsClosed() {
+		tx = *r.tx.Tx
+	} else {
+		var err error
+		tx, err = r.db.BeginTx(context.Background(), nil)
+		shouldCommit = true
+
+		if err != nil {
+			return err
+		}
+	}
+
+	var deletedAt *int64 = nil
+	if params.ProjectTaskCustomField.DeletedAt != nil {
+		deletedAt = &params.ProjectTaskCustomField.DeletedAt.Value
+	}
+
+	_, err := tx.NewUpdate().Model(&ProjectTaskCustomFieldTable{
+		InternalId:        params.ProjectTaskCustomField.Identity.Internal.String(),
+		PublicId:          params.ProjectTaskCustomField.Identity.Public,
+		ProjectInternalId: params.ProjectTaskCustomField.ProjectIdentity.Internal.String(),
+		Name:              params.ProjectTaskCustomField.Name,
+		Type:              string(params.ProjectTaskCustomField.Type),
+		Options:           params.ProjectTaskCustomField.Options,
+		IsRequired:        params.ProjectTaskCustomField.IsRequired,
+		DeletedAt:         deletedAt,
+	}).Where("project_task_custom_field.internal_id = ?", params.ProjectTaskCustomField.Identity.Internal.String()).Exec(context.Background())
+	if err != nil {
+		return err
+	}
+
+	if shouldCommit {
+		err = tx.Commit()
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (r *ProjectTaskCustomFieldBunRepository) DeleteProjectTaskCustomField(params projectrepo.DeleteProjectTaskCustomFieldParams) error {
+	var tx bun.Tx
+	var shouldCommit bool = false
+
+	if r.tx != nil && !r.tx.IsClosed() {
+		tx = *r.tx.Tx
+	} else {
+		var err error
+		tx, err = r.db.BeginTx(context.Background(), nil)
+		shouldCommit = true
+
+		if err != nil {
+			return err
+		}
+	}
+
+	_, err := tx.NewDelete().Model(&ProjectTaskCustomFieldTable{}).Where("project_task_custom_field.internal_id = ?", params.ProjectTaskCustomFieldIdentity.Internal.String()).Exec(context.Background())
+	if err != nil {
+		return err
+	}
+
+	if shouldCommit {
+		err = tx.Commit()
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -8,6 +8,7 @@ import (
 	coredatabase "github.com/gabrielmrtt/taski/internal/core/database"
 	"github.com/gabrielmrtt/taski/internal/project"
 	projectrepo "github.com/gabrielmrtt/taski/internal/project/repository"
+	"github.com/gabrielmrtt/taski/internal/user"
 	"github.com/google/uuid"
 	"github.com/uptrace/bun"
 )
@@ -22,6 +23,9 @@ type ProjectTaskStatusTable struct {
 	StatusOrder              *int8  `bun:"status_order,type:int8"`
 	ShouldSetTaskToCompleted bool   `bun:"should_set_task_to_completed,notnull,type:boolean"`
 	IsDefault                bool   `bun:"is_default,notnull,type:boolean"`
+	WipLimit                 *int16 `bun:"wip_limit,type:smallint"`
+	WipLimitPerAssignee      *int16 `bun:"wip_limit_per_assignee,type:smallint"`
+	WipLimitPolicy           string `bun:"wip_limit_policy,notnull,type:varchar(255)"`
 	ProjectInternalId        string `bun:"project_internal_id,notnull,type:uuid"`
 	DeletedAt                *int64 `bun:"deleted_at,type:bigint"`
 
@@ -37,9 +41,33 @@ func (p *ProjectTaskStatusTable) ToEntity() *project.ProjectTaskStatus {
 		Order:                    p.StatusOrder,
 		ShouldSetTaskToCompleted: p.ShouldSetTaskToCompleted,
 		IsDefault:                p.IsDefault,
+		WipLimit:                 p.WipLimit,
+		WipLimitPerAssignee:      p.WipLimitPerAssignee,
+		WipLimitPolicy:           project.ProjectTaskStatusWipLimitPolicies(p.WipLimitPolicy),
 	}
 }
 
+type projectTaskStatusTaskCountTable struct {
+	ProjectTaskStatusInternalId string  `bun:"project_task_status_internal_id"`
+	UserInternalId              *string `bun:"user_internal_id"`
+	TaskCount                   int     `bun:"task_count"`
+}
+
+var projectTaskStatusSortableFields = coredatabase.SortableFields{
+	Fields: map[string]string{
+		"name":  "project_task_status.name",
+		"order": "project_task_status.status_order",
+	},
+	IdColumn: "project_task_status.internal_id",
+}
+
+var projectTaskStatusIncludableRelations = coredatabase.IncludableRelations{
+	Relations: []string{
+		"Project",
+	},
+	MaxDepth: 1,
+}
+
 type ProjectTaskStatusBunRepository struct {
 	db *bun.DB
 	tx *coredatabase.TransactionBun
@@ -132,14 +160,18 @@ func (r *ProjectTaskStatusBunRepository) GetTaskStatusByOrder(params projectrepo
 	}
 
 	selectQuery = selectQuery.Model(projectTaskStatus)
-	selectQuery = coredatabase.ApplyRelations(selectQuery, params.RelationsInput)
+	selectQuery, err := coredatabase.ApplyRelations(selectQuery, projectTaskStatusIncludableRelations, params.RelationsInput)
+	if err != nil {
+		return nil, err
+	}
+
 	selectQuery = selectQuery.Where("project_task_status.status_order = ?", params.Order)
 
 	if params.ProjectIdentity != nil {
 		selectQuery = selectQuery.Where("project_task_status.project_internal_id = ?", params.ProjectIdentity.Internal.String())
 	}
 
-	err := selectQuery.Scan(context.Background())
+	err = selectQuery.Scan(context.Background())
 	if err != nil {
 		if err == sql.ErrNoRows {
 			return nil, nil
@@ -166,7 +198,10 @@ func (r *ProjectTaskStatusBunRepository) GetProjectTaskStatusByIdentity(params p
 	}
 
 	selectQuery = selectQuery.Model(projectTaskStatus)
-	selectQuery = coredatabase.ApplyRelations(selectQuery, params.RelationsInput)
+	selectQuery, err := coredatabase.ApplyRelations(selectQuery, projectTaskStatusIncludableRelations, params.RelationsInput)
+	if err != nil {
+		return nil, err
+	}
 
 	if params.ProjectIdentity != nil {
 		selectQuery = selectQuery.Where("project_task_status.project_internal_id = ?", params.ProjectIdentity.Internal.String())
@@ -184,7 +219,7 @@ func (r *ProjectTaskStatusBunRepository) GetProjectTaskStatusByIdentity(params p
 		selectQuery = selectQuery.Where("project_task_status.should_set_task_to_completed = ?", params.ShouldSetTaskToCompleted)
 	}
 
-	err := selectQuery.Scan(context.Background())
+	err = selectQuery.Scan(context.Background())
 	if err != nil {
 		if err == sql.ErrNoRows {
 			return nil, nil
@@ -211,9 +246,13 @@ func (r *ProjectTaskStatusBunRepository) ListProjectTaskStatusesBy(params projec
 	}
 
 	selectQuery = selectQuery.Model(&projectTaskStatuses)
-	selectQuery = coredatabase.ApplyRelations(selectQuery, params.RelationsInput)
+	selectQuery, err := coredatabase.ApplyRelations(selectQuery, projectTaskStatusIncludableRelations, params.RelationsInput)
+	if err != nil {
+		return nil, err
+	}
+
 	selectQuery = r.applyFilters(selectQuery, params.Filters)
-	err := selectQuery.Scan(context.Background())
+	err = selectQuery.Scan(context.Background())
 	if err != nil {
 		return nil, err
 	}
@@ -239,7 +278,11 @@ func (r *ProjectTaskStatusBunRepository) PaginateProjectTaskStatusesBy(params pr
 	}
 
 	selectQuery = selectQuery.Model(&projectTaskStatuses)
-	selectQuery = coredatabase.ApplyRelations(selectQuery, params.RelationsInput)
+	selectQuery, err := coredatabase.ApplyRelations(selectQuery, projectTaskStatusIncludableRelations, params.RelationsInput)
+	if err != nil {
+		return nil, err
+	}
+
 	selectQuery = r.applyFilters(selectQuery, params.Filters)
 
 	if !params.ShowDeleted {
@@ -251,7 +294,11 @@ func (r *ProjectTaskStatusBunRepository) PaginateProjectTaskStatusesBy(params pr
 		return nil, err
 	}
 
-	selectQuery = coredatabase.ApplySort(selectQuery, params.SortInput)
+	selectQuery, err = coredatabase.ApplySort(selectQuery, projectTaskStatusSortableFields, params.SortInput)
+	if err != nil {
+		return nil, err
+	}
+
 	selectQuery = coredatabase.ApplyPagination(selectQuery, params.Pagination)
 	err = selectQuery.Scan(context.Background())
 	if err != nil {
@@ -273,6 +320,64 @@ func (r *ProjectTaskStatusBunRepository) PaginateProjectTaskStatusesBy(params pr
 	}, nil
 }
 
+func (r *ProjectTaskStatusBunRepository) CountProjectTaskStatusTasks(params projectrepo.CountProjectTaskStatusTasksParams) ([]projectrepo.ProjectTaskStatusTaskCount, error) {
+	var rows []projectTaskStatusTaskCountTable = make([]projectTaskStatusTaskCountTable, 0)
+	var selectQuery *bun.SelectQuery
+
+	if r.tx != nil && !r.tx.IsClosed() {
+		selectQuery = r.tx.Tx.NewSelect()
+	} else {
+		selectQuery = r.db.NewSelect()
+	}
+
+	selectQuery = selectQuery.
+		TableExpr("task").
+		ColumnExpr("task.project_task_status_internal_id AS project_task_status_internal_id").
+		ColumnExpr("COUNT(DISTINCT task.internal_id) AS task_count").
+		Where("task.project_internal_id = ?", params.ProjectIdentity.Internal.String()).
+		Where("task.project_task_status_internal_id IS NOT NULL").
+		Where("task.deleted_at IS NULL")
+
+	if params.ProjectTaskStatusIdentity != nil {
+		selectQuery = selectQuery.Where("task.project_task_status_internal_id = ?", params.ProjectTaskStatusIdentity.Internal.String())
+	}
+
+	if params.ExcludeTaskIdentity != nil {
+		selectQuery = selectQuery.Where("task.internal_id <> ?", params.ExcludeTaskIdentity.Internal.String())
+	}
+
+	if params.ByAssignee {
+		selectQuery = selectQuery.
+			ColumnExpr("task_user.user_internal_id AS user_internal_id").
+			Join("INNER JOIN task_user ON task_user.task_internal_id = task.internal_id").
+			GroupExpr("task.project_task_status_internal_id, task_user.user_internal_id")
+	} else {
+		selectQuery = selectQuery.GroupExpr("task.project_task_status_internal_id")
+	}
+
+	err := selectQuery.Scan(context.Background(), &rows)
+	if err != nil && err != sql.ErrNoRows {
+		return nil, err
+	}
+
+	var taskCounts []projectrepo.ProjectTaskStatusTaskCount = make([]projectrepo.ProjectTaskStatusTaskCount, 0)
+	for _, row := range rows {
+		var userIdentity *core.Identity = nil
+		if row.UserInternalId != nil {
+			identity := core.NewIdentityFromInternal(uuid.MustParse(*row.UserInternalId), user.UserIdentityPrefix)
+			userIdentity = &identity
+		}
+
+		taskCounts = append(taskCounts, projectrepo.ProjectTaskStatusTaskCount{
+			ProjectTaskStatusIdentity: core.NewIdentityFromInternal(uuid.MustParse(row.ProjectTaskStatusInternalId), project.ProjectTaskStatusIdentityPrefix),
+			UserIdentity:              userIdentity,
+			TaskCount:                 row.TaskCount,
+		})
+	}
+
+	return taskCounts, nil
+}
+
 func (r *ProjectTaskStatusBunRepository) StoreProjectTaskStatus(params projectrepo.StoreProjectTaskStatusParams) (*project.ProjectTaskStatus, error) {
 	var tx bun.Tx
 	var shouldCommit bool = false
@@ -297,6 +402,9 @@ func (r *ProjectTaskStatusBunRepository) StoreProjectTaskStatus(params projectre
 		StatusOrder:              params.ProjectTaskStatus.Order,
 		ShouldSetTaskToCompleted: params.ProjectTaskStatus.ShouldSetTaskToCompleted,
 		IsDefault:                params.ProjectTaskStatus.IsDefault,
+		WipLimit:                 params.ProjectTaskStatus.WipLimit,
+		WipLimitPerAssignee:      params.ProjectTaskStatus.WipLimitPerAssignee,
+		WipLimitPolicy:           string(params.ProjectTaskStatus.WipLimitPolicy),
 		ProjectInternalId:        params.ProjectTaskStatus.ProjectIdentity.Internal.String(),
 	}).Exec(context.Background())
 	if err != nil {
@@ -336,6 +444,9 @@ func (r *ProjectTaskStatusBunRepository) UpdateProjectTaskStatus(params projectr
 		StatusOrder:              params.ProjectTaskStatus.Order,
 		ShouldSetTaskToCompleted: params.ProjectTaskStatus.ShouldSetTaskToCompleted,
 		IsDefault:                params.ProjectTaskStatus.IsDefault,
+		WipLimit:                 params.ProjectTaskStatus.WipLimit,
+		WipLimitPerAssignee:      params.ProjectTaskStatus.WipLimitPerAssignee,
+		WipLimitPolicy:           string(params.ProjectTaskStatus.WipLimitPolicy),
 	}).Where("project_task_status.internal_id = ?", params.ProjectTaskStatus.Identity.Internal.String()).Exec(context.Background())
 	if err != nil {
 		if err == sql.ErrNoRows {
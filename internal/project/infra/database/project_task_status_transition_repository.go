@@ -0,0 +1,272 @@
+package projectdatabase
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/gabrielmrtt/taski/internal/core"
+	coredatabase "github.com/gabrielmrtt/taski/internal/core/database"
+	"github.com/gabrielmrtt/taski/internal/project"
+	projectrepo "github.com/gabrielmrtt/taski/internal/project/repository"
+	"github.com/gabrielmrtt/taski/internal/role"
+	"github.com/google/uuid"
+	"github.com/uptrace/bun"
+)
+
+type ProjectTaskStatusTransitionTable struct {
+	bun.BaseModel `bun:"table:project_task_status_transition,alias:project_task_status_transition"`
+
+	InternalId           string   `bun:"internal_id,pk,notnull,type:uuid"`
+	PublicId             string   `bun:"public_id,notnull,type:varchar(510)"`
+	ProjectInternalId    string   `bun:"project_internal_id,notnull,type:uuid"`
+	FromStatusInternalId *string  `bun:"from_status_internal_id,type:uuid"`
+	ToStatusInternalId   string   `bun:"to_status_internal_id,notnull,type:uuid"`
+	RoleInternalIds      []string `bun:"role_internal_ids,notnull,type:jsonb"`
+	RequiredFields       []string `bun:"required_fields,notnull,type:jsonb"`
+
+	Project *ProjectTable `bun:"rel:has-one,join:project_internal_id=internal_id"`
+}
+
+func (t *ProjectTaskStatusTransitionTable) ToEntity() *project.ProjectTaskStatusTransition {
+	var fromStatusIdentity *core.Identity = nil
+	if t.FromStatusInternalId != nil {
+		identity := core.NewIdentityFromInternal(uuid.MustParse(*t.FromStatusInternalId), project.ProjectTaskStatusIdentityPrefix)
+		fromStatusIdentity = &identity
+	}
+
+	var roleIdentities []core.Identity = make([]core.Identity, 0)
+	for _, roleInternalId := range t.RoleInternalIds {
+		roleIdentities = append(roleIdentities, core.NewIdentityFromInternal(uuid.MustParse(roleInternalId), role.RoleIdentityPrefix))
+	}
+
+	var requiredFields []project.ProjectTaskStatusTransitionRequiredFields = make([]project.ProjectTaskStatusTransitionRequiredFields, 0)
+	for _, requiredField := range t.RequiredFields {
+		requiredFields = append(requiredFields, project.ProjectTaskStatusTransitionRequiredFields(requiredField))
+	}
+
+	return &project.ProjectTaskStatusTransition{
+		Identity:           core.NewIdentityFromInternal(uuid.MustParse(t.InternalId), project.ProjectTaskStatusTransitionIdentityPrefix),
+		ProjectIdentity:    core.NewIdentityFromInternal(uuid.MustParse(t.ProjectInternalId), project.ProjectIdentityPrefix),
+		FromStatusIdentity: fromStatusIdentity,
+		ToStatusIdentity:   core.NewIdentityFromInternal(uuid.MustParse(t.ToStatusInternalId), project.ProjectTaskStatusIdentityPrefix),
+		RoleIdentities:     roleIdentities,
+		RequiredFields:     requiredFields,
+	}
+}
+
+func projectTaskStatusTransitionToTable(transition *project.ProjectTaskStatusTransition) *ProjectTaskStatusTransitionTable {
+	var fromStatusInternalId *string = nil
+	if transition.FromStatusIdentity != nil {
+		internalId := transition.FromStatusIdentity.Internal.String()
+		fromStatusInternalId = &internalId
+	}
+
+	var roleInternalIds []string = make([]string, 0)
+	for _, roleIdentity := range transition.RoleIdentities {
+		roleInternalIds = append(roleInternalIds, roleIdentity.Internal.String())
+	}
+
+	var requiredFields []string = make([]string, 0)
+	for _, requiredField := range transition.RequiredFields {
+		requiredFields = append(requiredFields, string(requiredField))
+	}
+
+	return &ProjectTaskStatusTransitionTable{
+		InternalId:           transition.Identity.Internal.String(),
+		PublicId:             transition.Identity.Public,
+		ProjectInternalId:    transition.ProjectIdentity.Internal.String(),
+		FromStatusInternalId: fromStatusInternalId,
+		ToStatusInternalId:   transition.ToStatusIdentity.Internal.String(),
+		RoleInternalIds:      roleInternalIds,
+		RequiredFields:       requiredFields,
+	}
+}
+
+type ProjectTaskStatusTransitionBunRepository struct {
+	db *bun.DB
+	tx *coredatabase.TransactionBun
+}
+
+func NewProjectTaskStatusTransitionBunRepository(connection *bun.DB) *ProjectTaskStatusTransitionBunRepository {
+	return &ProjectTaskStatusTransitionBunRepository{db: connection, tx: nil}
+}
+
+func (r *ProjectTaskStatusTransitionBunRepository) SetTransaction(tx core.Transaction) error {
+	if r.tx != nil && !r.tx.IsClosed() {
+		return nil
+	}
+
+	r.tx = tx.(*coredatabase.TransactionBun)
+	return nil
+}
+
+func (r *ProjectTaskStatusTransitionBunRepository) applyFilters(selectQuery *bun.SelectQuery, filters projectrepo.ProjectTaskStatusTransitionFilters) *bun.SelectQuery {
+	if filters.ProjectIdentity != nil {
+		selectQuery = selectQuery.Where("project_task_status_transition.project_internal_id = ?", filters.ProjectIdentity.Internal.String())
+	}
+
+	if filters.FromStatusIdentity != nil {
+		selectQuery = selectQuery.Where("project_task_status_transition.from_status_internal_id = ?", filters.FromStatusIdentity.Internal.String())
+	}
+
+	if filters.ToStatusIdentity != nil {
+		selectQuery = selectQuery.Where("project_task_status_transition.to_status_internal_id = ?", filters.ToStatusIdentity.Internal.String())
+	}
+
+	return selectQuery
+}
+
+func (r *ProjectTaskStatusTransitionBunRepository) GetProjectTaskStatusTransitionByIdentity(params projectrepo.GetProjectTaskStatusTransitionByIdentityParams) (*project.ProjectTaskStatusTransition, error) {
+	var projectTaskStatusTransition *ProjectTaskStatusTransitionTable = new(ProjectTaskStatusTransitionTable)
+	var selectQuery *bun.SelectQuery
+
+	if r.tx != nil && !r.tx.IsClosed() {
+		selectQuery = r.tx.Tx.NewSelect()
+	} else {
+		selectQuery = r.db.NewSelect()
+	}
+
+	selectQuery = selectQuery.Model(projectTaskStatusTransition)
+	selectQuery = selectQuery.Where("project_task_status_transition.internal_id = ?", params.ProjectTaskStatusTransitionIdentity.Internal.String())
+
+	if params.ProjectIdentity != nil {
+		selectQuery = selectQuery.Where("project_task_status_transition.project_internal_id = ?", params.ProjectIdentity.Internal.String())
+	}
+
+	err := selectQuery.Scan(context.Background())
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil
+		}
+
+		return nil, err
+	}
+
+	if projectTaskStatusTransition.InternalId == "" {
+		return nil, nil
+	}
+
+	return projectTaskStatusTransition.ToEntity(), nil
+}
+
+func (r *ProjectTaskStatusTransitionBunRepository) ListProjectTaskStatusTransitionsBy(params projectrepo.ListProjectTaskStatusTransitionsByParams) ([]project.ProjectTaskStatusTransition, error) {
+	var projectTaskStatusTransitions []ProjectTaskStatusTransitionTable = make([]ProjectTaskStatusTransitionTable, 0)
+	var selectQuery *bun.SelectQuery
+
+	if r.tx != nil && !r.tx.IsClosed() {
+		selectQuery = r.tx.Tx.NewSelect()
+	} else {
+		selectQuery = r.db.NewSelect()
+	}
+
+	selectQuery = selectQuery.Model(&projectTaskStatusTransitions)
+	selectQuery = r.applyFilters(selectQuery, params.Filters)
+	err := selectQuery.Scan(context.Background())
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return []project.ProjectTaskStatusTransition{}, nil
+		}
+
+		return nil, err
+	}
+
+	var projectTaskStatusTransitionEntities []project.ProjectTaskStatusTransition = make([]project.ProjectTaskStatusTransition, 0)
+	for _, projectTaskStatusTransition := range projectTaskStatusTransitions {
+		projectTaskStatusTransitionEntities = append(projectTaskStatusTransitionEntities, *projectTaskStatusTransition.ToEntity())
+	}
+
+	return projectTaskStatusTransitionEntities, nil
+}
+
+func (r *ProjectTaskStatusTransitionBunRepository) StoreProjectTaskStatusTransition(params projectrepo.StoreProjectTaskStatusTransitionParams) (*project.ProjectTaskStatusTransition, error) {
+	var tx bun.Tx
+	var shouldCommit bool = false
+
+	if r.tx != nil && !r.tx.IsClosed() {
+		tx = *r.tx.Tx
+	} else {
+		var err error
+		tx, err = r.db.BeginTx(context.Background(), nil)
+		shouldCommit = true
+
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	_, err := tx.NewInsert().Model(projectTaskStatusTransitionToTable(params.ProjectTaskStatusTransition)).Exec(context.Background())
+	if err != nil {
+		return nil, err
+	}
+
+	if shouldCommit {
+		err = tx.Commit()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return params.ProjectTaskStatusTransition, nil
+}
+
+func (r *ProjectTaskStatusTransitionBunRepository) UpdateProjectTaskStatusTransition(params projectrepo.UpdateProjectTaskStatusTransitionParams) error {
+	var tx bun.Tx
+	var shouldCommit bool = false
+
+	if r.tx != nil && !r.tx.IsClosed() {
+		tx = *r.tx.Tx
+	} else {
+		var err error
+		tx, err = r.db.BeginTx(context.Background(), nil)
+		shouldCommit = true
+
+		if err != nil {
+			return err
+		}
+	}
+
+	_, err := tx.NewUpdate().Model(projectTaskStatusTransitionToTable(params.ProjectTaskStatusTransition)).Where("project_task_status_transition.internal_id = ?", params.ProjectTaskStatusTransition.Identity.Internal.String()).Exec(context.Background())
+	if err != nil {
+		return err
+	}
+
+	if shouldCommit {
+		err = tx.Commit()
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (r *ProjectTaskStatusTransitionBunRepository) DeleteProjectTaskStatusTransition(params projectrepo.DeleteProjectTaskStatusTransitionParams) error {
+	var tx bun.Tx
+	var shouldCommit bool = false
+
+	if r.tx != nil && !r.tx.IsClosed() {
+		tx = *r.tx.Tx
+	} else {
+		var err error
+		tx, err = r.db.BeginTx(context.Background(), nil)
+		shouldCommit = true
+
+		if err != nil {
+			return err
+		}
+	}
+
+	_, err := tx.NewDelete().Model(&ProjectTaskStatusTransitionTable{}).Where("project_task_status_transition.internal_id = ?", params.ProjectTaskStatusTransitionIdentity.Internal.String()).Exec(context.Background())
+	if err != nil {
+		return err
+	}
+
+	if shouldCommit {
+		err = tx.Commit()
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -32,6 +32,14 @@ func (p *ProjectUserTable) ToEntity() *project.ProjectUser {
 	}
 }
 
+var projectUserIncludableRelations = coredatabase.IncludableRelations{
+	Relations: []string{
+		"Project",
+		"User",
+	},
+	MaxDepth: 1,
+}
+
 type ProjectUserBunRepository struct {
 	db *bun.DB
 	tx *coredatabase.TransactionBun
@@ -76,10 +84,14 @@ func (r *ProjectUserBunRepository) GetProjectUserByIdentity(params projectrepo.G
 
 	selectQuery = selectQuery.Model(projectUser)
 	selectQuery = selectQuery.Relation("User")
-	selectQuery = coredatabase.ApplyRelations(selectQuery, params.RelationsInput)
+	selectQuery, err := coredatabase.ApplyRelations(selectQuery, projectUserIncludableRelations, params.RelationsInput)
+	if err != nil {
+		return nil, err
+	}
+
 	selectQuery = selectQuery.Where("project_user.project_internal_id = ? and project_user.user_internal_id = ?", params.ProjectIdentity.Internal.String(), params.UserIdentity.Internal.String())
 
-	err := selectQuery.Scan(context.Background())
+	err = selectQuery.Scan(context.Background())
 	if err != nil {
 		if err == sql.ErrNoRows {
 			return nil, nil
@@ -106,10 +118,14 @@ func (r *ProjectUserBunRepository) GetProjectUsersByUserIdentity(params projectr
 	}
 
 	selectQuery = selectQuery.Model(&projectUsers)
-	selectQuery = coredatabase.ApplyRelations(selectQuery, params.RelationsInput)
+	selectQuery, err := coredatabase.ApplyRelations(selectQuery, projectUserIncludableRelations, params.RelationsInput)
+	if err != nil {
+		return nil, err
+	}
+
 	selectQuery = selectQuery.Where("project_user.user_internal_id = ?", params.UserIdentity.Internal.String())
 
-	err := selectQuery.Scan(context.Background())
+	err = selectQuery.Scan(context.Background())
 	if err != nil {
 		if err == sql.ErrNoRows {
 			return []project.ProjectUser{}, nil
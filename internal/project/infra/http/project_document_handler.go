@@ -15,13 +15,15 @@ import (
 )
 
 type ProjectDocumentHandler struct {
-	GetProjectDocumentVersionService    *projectservice.GetProjectDocumentVersionService
-	ListProjectDocumentsService         *projectservice.ListProjectDocumentsService
-	ListProjectDocumentVersionsService  *projectservice.ListProjectDocumentVersionsService
-	CreateProjectDocumentService        *projectservice.CreateProjectDocumentService
-	UpdateProjectDocumentService        *projectservice.UpdateProjectDocumentService
-	DeleteProjectDocumentService        *projectservice.DeleteProjectDocumentService
-	DeleteProjectDocumentVersionService *projectservice.DeleteProjectDocumentVersionService
+	GetProjectDocumentVersionService     *projectservice.GetProjectDocumentVersionService
+	ListProjectDocumentsService          *projectservice.ListProjectDocumentsService
+	ListProjectDocumentVersionsService   *projectservice.ListProjectDocumentVersionsService
+	CreateProjectDocumentService         *projectservice.CreateProjectDocumentService
+	UpdateProjectDocumentService         *projectservice.UpdateProjectDocumentService
+	DeleteProjectDocumentService         *projectservice.DeleteProjectDocumentService
+	DeleteProjectDocumentVersionService  *projectservice.DeleteProjectDocumentVersionService
+	DiffProjectDocumentVersionsService   *projectservice.DiffProjectDocumentVersionsService
+	RestoreProjectDocumentVersionService *projectservice.RestoreProjectDocumentVersionService
 }
 
 func NewProjectDocumentHandler(
@@ -32,15 +34,19 @@ func NewProjectDocumentHandler(
 	updateProjectDocumentService *projectservice.UpdateProjectDocumentService,
 	deleteProjectDocumentService *projectservice.DeleteProjectDocumentService,
 	deleteProjectDocumentVersionService *projectservice.DeleteProjectDocumentVersionService,
+	diffProjectDocumentVersionsService *projectservice.DiffProjectDocumentVersionsService,
+	restoreProjectDocumentVersionService *projectservice.RestoreProjectDocumentVersionService,
 ) *ProjectDocumentHandler {
 	return &ProjectDocumentHandler{
-		GetProjectDocumentVersionService:    getProjectDocumentVersionService,
-		ListProjectDocumentsService:         listProjectDocumentsService,
-		ListProjectDocumentVersionsService:  listProjectDocumentVersionsService,
-		CreateProjectDocumentService:        createProjectDocumentService,
-		UpdateProjectDocumentService:        updateProjectDocumentService,
-		DeleteProjectDocumentService:        deleteProjectDocumentService,
-		DeleteProjectDocumentVersionService: deleteProjectDocumentVersionService,
+		GetProjectDocumentVersionService:     getProjectDocumentVersionService,
+		ListProjectDocumentsService:          listProjectDocumentsService,
+		ListProjectDocumentVersionsService:   listProjectDocumentVersionsService,
+		CreateProjectDocumentService:         createProjectDocumentService,
+		UpdateProjectDocumentService:         updateProjectDocumentService,
+		DeleteProjectDocumentService:         deleteProjectDocumentService,
+		DeleteProjectDocumentVersionService:  deleteProjectDocumentVersionService,
+		DiffProjectDocumentVersionsService:   diffProjectDocumentVersionsService,
+		RestoreProjectDocumentVersionService: restoreProjectDocumentVersionService,
 	}
 }
 
@@ -132,6 +138,7 @@ type GetProjectDocumentVersionResponse = corehttp.HttpSuccessResponseWithData[pr
 // @Param documentVersionId path string true "Document Version ID"
 // @Produce json
 // @Success 200 {object} GetProjectDocumentVersionResponse
+// @Header 200 {string} ETag "Revision of the project document version"
 // @Failure 400 {object} corehttp.HttpErrorResponse
 // @Failure 401 {object} corehttp.HttpErrorResponse
 // @Failure 403 {object} corehttp.HttpErrorResponse
@@ -160,6 +167,49 @@ func (c *ProjectDocumentHandler) GetProjectDocumentVersion(ctx *gin.Context) {
 		return
 	}
 
+	corehttp.SetETag(ctx, response.Revision)
+
+	corehttp.NewHttpSuccessResponseWithData(ctx, http.StatusOK, response)
+}
+
+type DiffProjectDocumentVersionsResponse = corehttp.HttpSuccessResponseWithData[project.ProjectDocumentVersionDiffDto]
+
+// DiffProjectDocumentVersions godoc
+// @Summary Diff project document versions
+// @Description Returns the line and word level changes of the title and content between two versions of a project document, and the files added and removed.
+// @Tags Project Document
+// @Accept json
+// @Param projectId path string true "Project ID"
+// @Param documentVersionManagerId path string true "Document Version Manager ID"
+// @Param request query projecthttprequests.DiffProjectDocumentVersionsRequest true "Query parameters"
+// @Produce json
+// @Success 200 {object} DiffProjectDocumentVersionsResponse
+// @Failure 400 {object} corehttp.HttpErrorResponse
+// @Failure 401 {object} corehttp.HttpErrorResponse
+// @Failure 403 {object} corehttp.HttpErrorResponse
+// @Failure 404 {object} corehttp.HttpErrorResponse
+// @Failure 500 {object} corehttp.HttpErrorResponse
+// @Router /project/:projectId/document/:documentVersionManagerId/diff [get]
+func (c *ProjectDocumentHandler) DiffProjectDocumentVersions(ctx *gin.Context) {
+	var request projecthttprequests.DiffProjectDocumentVersionsRequest
+	var projectIdentity core.Identity = core.NewIdentityFromPublic(ctx.Param("projectId"))
+	var documentVersionManagerIdentity core.Identity = core.NewIdentityFromPublic(ctx.Param("documentVersionManagerId"))
+
+	if err := request.FromQuery(ctx); err != nil {
+		corehttp.NewHttpErrorResponse(ctx, err)
+		return
+	}
+
+	input := request.ToInput()
+	input.ProjectIdentity = projectIdentity
+	input.ProjectDocumentVersionManagerIdentity = documentVersionManagerIdentity
+
+	response, err := c.DiffProjectDocumentVersionsService.Execute(input)
+	if err != nil {
+		corehttp.NewHttpErrorResponse(ctx, err)
+		return
+	}
+
 	corehttp.NewHttpSuccessResponseWithData(ctx, http.StatusOK, response)
 }
 
@@ -214,16 +264,20 @@ type UpdateProjectDocumentResponse = corehttp.EmptyHttpSuccessResponse
 // @Param documentVersionManagerId path string true "Document Version Manager ID"
 // @Param documentVersionId path string true "Document Version ID"
 // @Param request body projecthttprequests.UpdateProjectDocumentRequest true "Request body"
+// @Param If-Match header string false "ETag the project document version was read with"
 // @Produce json
 // @Success 200 {object} UpdateProjectDocumentResponse
+// @Header 200 {string} ETag "Revision of the saved project document version"
 // @Failure 400 {object} corehttp.HttpErrorResponse
 // @Failure 401 {object} corehttp.HttpErrorResponse
 // @Failure 403 {object} corehttp.HttpErrorResponse
 // @Failure 404 {object} corehttp.HttpErrorResponse
+// @Failure 412 {object} corehttp.HttpErrorResponse
 // @Failure 500 {object} corehttp.HttpErrorResponse
 // @Router /project/:projectId/document/:documentVersionManagerId/version/:documentVersionId [put]
 func (c *ProjectDocumentHandler) UpdateProjectDocument(ctx *gin.Context) {
 	var request projecthttprequests.UpdateProjectDocumentRequest
+	var authenticatedUserIdentity *core.Identity = authhttpmiddlewares.GetAuthenticatedUserIdentity(ctx)
 	var projectIdentity core.Identity = core.NewIdentityFromPublic(ctx.Param("projectId"))
 	var documentVersionManagerIdentity core.Identity = core.NewIdentityFromPublic(ctx.Param("documentVersionManagerId"))
 	var documentVersionIdentity core.Identity = core.NewIdentityFromPublic(ctx.Param("documentVersionId"))
@@ -234,10 +288,18 @@ func (c *ProjectDocumentHandler) UpdateProjectDocument(ctx *gin.Context) {
 		return
 	}
 
+	expectedRevision, err := corehttp.GetIfMatchRevision(ctx)
+	if err != nil {
+		corehttp.NewHttpErrorResponse(ctx, err)
+		return
+	}
+
 	input = request.ToInput()
 	input.ProjectIdentity = projectIdentity
 	input.ProjectDocumentVersionManagerIdentity = documentVersionManagerIdentity
 	input.ProjectDocumentVersionIdentity = documentVersionIdentity
+	input.UserEditorIdentity = *authenticatedUserIdentity
+	input.ExpectedRevision = expectedRevision
 
 	response, err := c.UpdateProjectDocumentService.Execute(input)
 	if err != nil {
@@ -245,6 +307,8 @@ func (c *ProjectDocumentHandler) UpdateProjectDocument(ctx *gin.Context) {
 		return
 	}
 
+	corehttp.SetETag(ctx, response.Revision)
+
 	corehttp.NewHttpSuccessResponseWithData(ctx, http.StatusOK, response)
 }
 
@@ -291,24 +355,34 @@ type DeleteProjectDocumentVersionResponse = corehttp.EmptyHttpSuccessResponse
 // @Param projectId path string true "Project ID"
 // @Param documentVersionManagerId path string true "Document Version Manager ID"
 // @Param documentVersionId path string true "Document Version ID"
+// @Param If-Match header string false "ETag the project document version was read with"
 // @Produce json
 // @Success 200 {object} DeleteProjectDocumentVersionResponse
 // @Failure 400 {object} corehttp.HttpErrorResponse
 // @Failure 401 {object} corehttp.HttpErrorResponse
 // @Failure 403 {object} corehttp.HttpErrorResponse
+// @Failure 412 {object} corehttp.HttpErrorResponse
 // @Failure 500 {object} corehttp.HttpErrorResponse
 // @Router /project/:projectId/document/:documentVersionManagerId/version/:documentVersionId [delete]
 func (c *ProjectDocumentHandler) DeleteProjectDocumentVersion(ctx *gin.Context) {
 	var projectIdentity core.Identity = core.NewIdentityFromPublic(ctx.Param("projectId"))
 	var documentVersionManagerIdentity core.Identity = core.NewIdentityFromPublic(ctx.Param("documentVersionManagerId"))
 	var documentVersionIdentity core.Identity = core.NewIdentityFromPublic(ctx.Param("documentVersionId"))
+
+	expectedRevision, err := corehttp.GetIfMatchRevision(ctx)
+	if err != nil {
+		corehttp.NewHttpErrorResponse(ctx, err)
+		return
+	}
+
 	var input projectservice.DeleteProjectDocumentVersionInput = projectservice.DeleteProjectDocumentVersionInput{
 		ProjectIdentity:                       projectIdentity,
 		ProjectDocumentVersionManagerIdentity: documentVersionManagerIdentity,
 		ProjectDocumentVersionIdentity:        documentVersionIdentity,
+		ExpectedRevision:                      expectedRevision,
 	}
 
-	err := c.DeleteProjectDocumentVersionService.Execute(input)
+	err = c.DeleteProjectDocumentVersionService.Execute(input)
 	if err != nil {
 		corehttp.NewHttpErrorResponse(ctx, err)
 		return
@@ -317,6 +391,56 @@ func (c *ProjectDocumentHandler) DeleteProjectDocumentVersion(ctx *gin.Context)
 	corehttp.NewEmptyHttpSuccessResponse(ctx, http.StatusOK)
 }
 
+type RestoreProjectDocumentVersionResponse = corehttp.HttpSuccessResponseWithData[project.ProjectDocumentVersionDto]
+
+// RestoreProjectDocumentVersion godoc
+// @Summary Restore a project document version
+// @Description Creates a new latest version of a project document from a previous version. The version is bumped with the document version scheme unless given.
+// @Tags Project Document
+// @Accept json
+// @Param projectId path string true "Project ID"
+// @Param documentVersionManagerId path string true "Document Version Manager ID"
+// @Param documentVersionId path string true "Document Version ID"
+// @Param request body projecthttprequests.RestoreProjectDocumentVersionRequest false "Request body"
+// @Produce json
+// @Success 200 {object} RestoreProjectDocumentVersionResponse
+// @Failure 400 {object} corehttp.HttpErrorResponse
+// @Failure 401 {object} corehttp.HttpErrorResponse
+// @Failure 403 {object} corehttp.HttpErrorResponse
+// @Failure 404 {object} corehttp.HttpErrorResponse
+// @Failure 409 {object} corehttp.HttpErrorResponse
+// @Failure 500 {object} corehttp.HttpErrorResponse
+// @Router /project/:projectId/document/:documentVersionManagerId/version/:documentVersionId/restore [post]
+func (c *ProjectDocumentHandler) RestoreProjectDocumentVersion(ctx *gin.Context) {
+	var request projecthttprequests.RestoreProjectDocumentVersionRequest
+	var authenticatedUserIdentity *core.Identity = authhttpmiddlewares.GetAuthenticatedUserIdentity(ctx)
+	var projectIdentity core.Identity = core.NewIdentityFromPublic(ctx.Param("projectId"))
+	var documentVersionManagerIdentity core.Identity = core.NewIdentityFromPublic(ctx.Param("documentVersionManagerId"))
+	var documentVersionIdentity core.Identity = core.NewIdentityFromPublic(ctx.Param("documentVersionId"))
+	var input projectservice.RestoreProjectDocumentVersionInput
+
+	if ctx.Request.ContentLength > 0 {
+		if err := ctx.ShouldBindJSON(&request); err != nil {
+			corehttp.NewHttpErrorResponse(ctx, err)
+			return
+		}
+	}
+
+	input = request.ToInput()
+	input.ProjectIdentity = projectIdentity
+	input.ProjectDocumentVersionManagerIdentity = documentVersionManagerIdentity
+	input.ProjectDocumentVersionIdentity = documentVersionIdentity
+	input.UserRestorerIdentity = *authenticatedUserIdentity
+
+	response, err := c.RestoreProjectDocumentVersionService.Execute(input)
+	if err != nil {
+		corehttp.NewHttpErrorResponse(ctx, err)
+		return
+	}
+
+	corehttp.NewHttpSuccessResponseWithData(ctx, http.StatusOK, response)
+}
+
 func (c *ProjectDocumentHandler) ConfigureRoutes(options corehttp.ConfigureRoutesOptions) *gin.RouterGroup {
 	middlewareOptions := corehttp.MiddlewareOptions{
 		DbConnection: options.DbConnection,
@@ -330,8 +454,10 @@ func (c *ProjectDocumentHandler) ConfigureRoutes(options corehttp.ConfigureRoute
 		g.GET("", organizationhttpmiddlewares.UserMustHavePermission("projects:view", middlewareOptions), c.ListProjectDocuments)
 		g.GET("/:documentVersionManagerId", organizationhttpmiddlewares.UserMustHavePermission("projects:view", middlewareOptions), c.ListProjectDocumentVersions)
 		g.GET("/:documentVersionManagerId/version/:documentVersionId", organizationhttpmiddlewares.UserMustHavePermission("projects:view", middlewareOptions), c.GetProjectDocumentVersion)
+		g.GET("/:documentVersionManagerId/diff", organizationhttpmiddlewares.UserMustHavePermission("projects:view", middlewareOptions), c.DiffProjectDocumentVersions)
 		g.POST("", organizationhttpmiddlewares.UserMustHavePermission("projects:update", middlewareOptions), c.CreateProjectDocument)
 		g.PUT("/:documentVersionManagerId/version/:documentVersionId", organizationhttpmiddlewares.UserMustHavePermission("projects:update", middlewareOptions), c.UpdateProjectDocument)
+		g.POST("/:documentVersionManagerId/version/:documentVersionId/restore", organizationhttpmiddlewares.UserMustHavePermission("projects:update", middlewareOptions), c.RestoreProjectDocumentVersion)
 		g.DELETE("/:documentVersionManagerId", organizationhttpmiddlewares.UserMustHavePermission("projects:update", middlewareOptions), c.DeleteProjectDocument)
 		g.DELETE("/:documentVersionManagerId/version/:documentVersionId", organizationhttpmiddlewares.UserMustHavePermission("projects:update", middlewareOptions), c.DeleteProjectDocumentVersion)
 	}
@@ -87,6 +87,7 @@ type GetProjectResponse = corehttp.HttpSuccessResponseWithData[project.ProjectDt
 // @Param projectId path string true "Project ID"
 // @Produce json
 // @Success 200 {object} GetProjectResponse
+// @Header 200 {string} ETag "Revision of the project"
 // @Failure 400 {object} corehttp.HttpErrorResponse
 // @Failure 401 {object} corehttp.HttpErrorResponse
 // @Failure 403 {object} corehttp.HttpErrorResponse
@@ -113,6 +114,8 @@ func (c *ProjectHandler) GetProject(ctx *gin.Context) {
 		return
 	}
 
+	corehttp.SetETag(ctx, response.Revision)
+
 	corehttp.NewHttpSuccessResponseWithData(ctx, http.StatusOK, response)
 }
 
@@ -164,12 +167,14 @@ type UpdateProjectResponse = corehttp.EmptyHttpSuccessResponse
 // @Accept json
 // @Param projectId path string true "Project ID"
 // @Param request body projecthttprequests.UpdateProjectRequest true "Request body"
+// @Param If-Match header string false "ETag the project was read with"
 // @Produce json
 // @Success 200 {object} UpdateProjectResponse
 // @Failure 400 {object} corehttp.HttpErrorResponse
 // @Failure 401 {object} corehttp.HttpErrorResponse
 // @Failure 403 {object} corehttp.HttpErrorResponse
 // @Failure 404 {object} corehttp.HttpErrorResponse
+// @Failure 412 {object} corehttp.HttpErrorResponse
 // @Failure 500 {object} corehttp.HttpErrorResponse
 // @Router /project/:projectId [put]
 func (c *ProjectHandler) UpdateProject(ctx *gin.Context) {
@@ -184,12 +189,19 @@ func (c *ProjectHandler) UpdateProject(ctx *gin.Context) {
 		return
 	}
 
+	expectedRevision, err := corehttp.GetIfMatchRevision(ctx)
+	if err != nil {
+		corehttp.NewHttpErrorResponse(ctx, err)
+		return
+	}
+
 	input = request.ToInput()
 	input.OrganizationIdentity = *organizationIdentity
 	input.UserEditorIdentity = *authenticatedUserIdentity
 	input.ProjectIdentity = projectIdentity
+	input.ExpectedRevision = expectedRevision
 
-	err := c.UpdateProjectService.Execute(input)
+	err = c.UpdateProjectService.Execute(input)
 	if err != nil {
 		corehttp.NewHttpErrorResponse(ctx, err)
 		return
@@ -206,23 +218,33 @@ type DeleteProjectResponse = corehttp.EmptyHttpSuccessResponse
 // @Tags Project
 // @Accept json
 // @Param projectId path string true "Project ID"
+// @Param If-Match header string false "ETag the project was read with"
 // @Produce json
 // @Success 200 {object} DeleteProjectResponse
 // @Failure 400 {object} corehttp.HttpErrorResponse
 // @Failure 401 {object} corehttp.HttpErrorResponse
 // @Failure 403 {object} corehttp.HttpErrorResponse
 // @Failure 404 {object} corehttp.HttpErrorResponse
+// @Failure 412 {object} corehttp.HttpErrorResponse
 // @Failure 500 {object} corehttp.HttpErrorResponse
 // @Router /project/:projectId [delete]
 func (c *ProjectHandler) DeleteProject(ctx *gin.Context) {
 	var organizationIdentity *core.Identity = authhttpmiddlewares.GetAuthenticatedUserLastAccessedOrganizationIdentity(ctx)
 	var projectIdentity core.Identity = core.NewIdentityFromPublic(ctx.Param("projectId"))
+
+	expectedRevision, err := corehttp.GetIfMatchRevision(ctx)
+	if err != nil {
+		corehttp.NewHttpErrorResponse(ctx, err)
+		return
+	}
+
 	var input projectservice.DeleteProjectInput = projectservice.DeleteProjectInput{
 		OrganizationIdentity: *organizationIdentity,
 		ProjectIdentity:      projectIdentity,
+		ExpectedRevision:     expectedRevision,
 	}
 
-	err := c.DeleteProjectService.Execute(input)
+	err = c.DeleteProjectService.Execute(input)
 	if err != nil {
 		corehttp.NewHttpErrorResponse(ctx, err)
 		return
@@ -0,0 +1,209 @@
+package projecthttp
+
+import (
+	"net/http"
+
+	authhttpmiddlewares "github.com/gabrielmrtt/taski/internal/auth/infra/http/middlewares"
+	"github.com/gabrielmrtt/taski/internal/core"
+	corehttp "github.com/gabrielmrtt/taski/internal/core/http"
+	organizationhttpmiddlewares "github.com/gabrielmrtt/taski/internal/organization/infra/http/middlewares"
+	"github.com/gabrielmrtt/taski/internal/project"
+	projecthttpmiddlewares "github.com/gabrielmrtt/taski/internal/project/infra/http/middlewares"
+	projecthttprequests "github.com/gabrielmrtt/taski/internal/project/infra/http/requests"
+	projectservice "github.com/gabrielmrtt/taski/internal/project/service"
+	"github.com/gin-gonic/gin"
+)
+
+type ProjectTaskCustomFieldHandler struct {
+	ListProjectTaskCustomFieldsService  *projectservice.ListProjectTaskCustomFieldsService
+	CreateProjectTaskCustomFieldService *projectservice.CreateProjectTaskCustomFieldService
+	UpdateProjectTaskCustomFieldService *projectservice.UpdateProjectTaskCustomFieldService
+	DeleteProjectTaskCustomFieldService *projectservice.DeleteProjectTaskCustomFieldService
+}
+
+func NewProjectTaskCustomFieldHandler(
+	listProjectTaskCustomFieldsService *projectservice.ListProjectTaskCustomFieldsService,
+	createProjectTaskCustomFieldService *projectservice.CreateProjectTaskCustomFieldService,
+	updateProjectTaskCustomFieldService *projectservice.UpdateProjectTaskCustomFieldService,
+	deleteProjectTaskCustomFieldService *projectservice.DeleteProjectTaskCustomFieldService,
+) *ProjectTaskCustomFieldHandler {
+	return &ProjectTaskCustomFieldHandler{
+		ListProjectTaskCustomFieldsService:  listProjectTaskCustomFieldsService,
+		CreateProjectTaskCustomFieldService: createProjectTaskCustomFieldService,
+		UpdateProjectTaskCustomFieldService: updateProjectTaskCustomFieldService,
+		DeleteProjectTaskCustomFieldService: deleteProjectTaskCustomFieldService,
+	}
+}
+
+type ListProjectTaskCustomFieldsResponse = corehttp.HttpSuccessResponseWithData[project.ProjectTaskCustomFieldDto]
+
+// ListProjectTaskCustomFields godoc
+// @Summary List project task custom fields
+// @Description Returns all project task custom fields.
+// @Tags Project Task Custom Field
+// @Accept json
+// @Param request query projecthttprequests.ListProjectTaskCustomFieldsRequest true "Query parameters"
+// @Produce json
+// @Success 200 {object} ListProjectTaskCustomFieldsResponse
+// @Failure 400 {object} corehttp.HttpErrorResponse
+// @Failure 401 {object} corehttp.HttpErrorResponse
+// @Failure 403 {object} corehttp.HttpErrorResponse
+// @Failure 500 {object} corehttp.HttpErrorResponse
+// @Router /project/:projectId/task-custom-field [get]
+func (c *ProjectTaskCustomFieldHandler) ListProjectTaskCustomFields(ctx *gin.Context) {
+	var request projecthttprequests.ListProjectTaskCustomFieldsRequest
+	var projectIdentity core.Identity = core.NewIdentityFromPublic(ctx.Param("projectId"))
+	var input projectservice.ListProjectTaskCustomFieldsInput
+
+	if err := request.FromQuery(ctx); err != nil {
+		corehttp.NewHttpErrorResponse(ctx, err)
+		return
+	}
+
+	input = request.ToInput()
+	input.Filters.ProjectIdentity = &projectIdentity
+
+	response, err := c.ListProjectTaskCustomFieldsService.Execute(input)
+	if err != nil {
+		corehttp.NewHttpErrorResponse(ctx, err)
+		return
+	}
+
+	corehttp.NewHttpSuccessResponseWithData(ctx, http.StatusOK, response)
+}
+
+type CreateProjectTaskCustomFieldResponse = corehttp.HttpSuccessResponseWithData[project.ProjectTaskCustomFieldDto]
+
+// CreateProjectTaskCustomField godoc
+// @Summary Create a project task custom field
+// @Description Creates a new project task custom field.
+// @Tags Project Task Custom Field
+// @Accept json
+// @Param request body projecthttprequests.CreateProjectTaskCustomFieldRequest true "Request body"
+// @Produce json
+// @Success 200 {object} CreateProjectTaskCustomFieldResponse
+// @Failure 400 {object} corehttp.HttpErrorResponse
+// @Failure 401 {object} corehttp.HttpErrorResponse
+// @Failure 403 {object} corehttp.HttpErrorResponse
+// @Failure 500 {object} corehttp.HttpErrorResponse
+// @Router /project/:projectId/task-custom-field [post]
+func (c *ProjectTaskCustomFieldHandler) CreateProjectTaskCustomField(ctx *gin.Context) {
+	var request projecthttprequests.CreateProjectTaskCustomFieldRequest
+	var organizationIdentity *core.Identity = authhttpmiddlewares.GetAuthenticatedUserLastAccessedOrganizationIdentity(ctx)
+	var projectIdentity core.Identity = core.NewIdentityFromPublic(ctx.Param("projectId"))
+	var input projectservice.CreateProjectTaskCustomFieldInput
+
+	if err := ctx.ShouldBindJSON(&request); err != nil {
+		corehttp.NewHttpErrorResponse(ctx, err)
+		return
+	}
+
+	input = request.ToInput()
+	input.OrganizationIdentity = *organizationIdentity
+	input.ProjectIdentity = projectIdentity
+
+	response, err := c.CreateProjectTaskCustomFieldService.Execute(input)
+	if err != nil {
+		corehttp.NewHttpErrorResponse(ctx, err)
+		return
+	}
+
+	corehttp.NewHttpSuccessResponseWithData(ctx, http.StatusOK, response)
+}
+
+type UpdateProjectTaskCustomFieldResponse = corehttp.EmptyHttpSuccessResponse
+
+// UpdateProjectTaskCustomField godoc
+// @Summary Update a project task custom field
+// @Description Updates an existing project task custom field.
+// @Tags Project Task Custom Field
+// @Accept json
+// @Param projectId path string true "Project ID"
+// @Param taskCustomFieldId path string true "Task Custom Field ID"
+// @Param request body projecthttprequests.UpdateProjectTaskCustomFieldRequest true "Request body"
+// @Produce json
+// @Success 200 {object} UpdateProjectTaskCustomFieldResponse
+// @Failure 400 {object} corehttp.HttpErrorResponse
+// @Failure 401 {object} corehttp.HttpErrorResponse
+// @Failure 403 {object} corehttp.HttpErrorResponse
+// @Failure 500 {object} corehttp.HttpErrorResponse
+// @Router /project/:projectId/task-custom-field/:taskCustomFieldId [put]
+func (c *ProjectTaskCustomFieldHandler) UpdateProjectTaskCustomField(ctx *gin.Context) {
+	var request projecthttprequests.UpdateProjectTaskCustomFieldRequest
+	var organizationIdentity *core.Identity = authhttpmiddlewares.GetAuthenticatedUserLastAccessedOrganizationIdentity(ctx)
+	var projectIdentity core.Identity = core.NewIdentityFromPublic(ctx.Param("projectId"))
+	var taskCustomFieldIdentity core.Identity = core.NewIdentityFromPublic(ctx.Param("taskCustomFieldId"))
+	var input projectservice.UpdateProjectTaskCustomFieldInput
+
+	if err := ctx.ShouldBindJSON(&request); err != nil {
+		corehttp.NewHttpErrorResponse(ctx, err)
+		return
+	}
+
+	input = request.ToInput()
+	input.OrganizationIdentity = *organizationIdentity
+	input.ProjectIdentity = projectIdentity
+	input.ProjectTaskCustomFieldIdentity = taskCustomFieldIdentity
+
+	err := c.UpdateProjectTaskCustomFieldService.Execute(input)
+	if err != nil {
+		corehttp.NewHttpErrorResponse(ctx, err)
+		return
+	}
+
+	corehttp.NewEmptyHttpSuccessResponse(ctx, http.StatusOK)
+}
+
+type DeleteProjectTaskCustomFieldResponse = corehttp.EmptyHttpSuccessResponse
+
+// DeleteProjectTaskCustomField godoc
+// @Summary Delete a project task custom field
+// @Description Deletes an existing project task custom field.
+// @Tags Project Task Custom Field
+// @Accept json
+// @Param projectId path string true "Project ID"
+// @Param taskCustomFieldId path string true "Task Custom Field ID"
+// @Produce json
+// @Success 200 {object} DeleteProjectTaskCustomFieldResponse
+// @Failure 400 {object} corehttp.HttpErrorResponse
+// @Failure 401 {object} corehttp.HttpErrorResponse
+// @Failure 403 {object} corehttp.HttpErrorResponse
+// @Failure 500 {object} corehttp.HttpErrorResponse
+// @Router /project/:projectId/task-custom-field/:taskCustomFieldId [delete]
+func (c *ProjectTaskCustomFieldHandler) DeleteProjectTaskCustomField(ctx *gin.Context) {
+	var organizationIdentity *core.Identity = authhttpmiddlewares.GetAuthenticatedUserLastAccessedOrganizationIdentity(ctx)
+	var projectIdentity core.Identity = core.NewIdentityFromPublic(ctx.Param("projectId"))
+	var taskCustomFieldIdentity core.Identity = core.NewIdentityFromPublic(ctx.Param("taskCustomFieldId"))
+	var input projectservice.DeleteProjectTaskCustomFieldInput = projectservice.DeleteProjectTaskCustomFieldInput{
+		OrganizationIdentity:           *organizationIdentity,
+		ProjectIdentity:                projectIdentity,
+		ProjectTaskCustomFieldIdentity: taskCustomFieldIdentity,
+	}
+
+	err := c.DeleteProjectTaskCustomFieldService.Execute(input)
+	if err != nil {
+		corehttp.NewHttpErrorResponse(ctx, err)
+		return
+	}
+
+	corehttp.NewEmptyHttpSuccessResponse(ctx, http.StatusOK)
+}
+
+func (c *ProjectTaskCustomFieldHandler) ConfigureRoutes(options corehttp.ConfigureRoutesOptions) *gin.RouterGroup {
+	middlewareOptions := corehttp.MiddlewareOptions{
+		DbConnection: options.DbConnection,
+	}
+
+	g := options.RouterGroup.Group("/project/:projectId/task-custom-field")
+	{
+		g.Use(authhttpmiddlewares.AuthMiddleware(middlewareOptions))
+		g.Use(projecthttpmiddlewares.UserMustBeInProject(middlewareOptions))
+
+		g.GET("", organizationhttpmiddlewares.UserMustHavePermission("projects:view", middlewareOptions), c.ListProjectTaskCustomFields)
+		g.POST("", organizationhttpmiddlewares.UserMustHavePermission("projects:update", middlewareOptions), c.CreateProjectTaskCustomField)
+		g.PUT("/:taskCustomFieldId", organizationhttpmiddlewares.UserMustHavePermission("projects:update", middlewareOptions), c.UpdateProjectTaskCustomField)
+		g.DELETE("/:taskCustomFieldId", organizationhttpmiddlewares.UserMustHavePermission("projects:update", middlewareOptions), c.DeleteProjectTaskCustomField)
+	}
+
+	return g
+}
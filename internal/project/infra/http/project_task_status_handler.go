@@ -39,7 +39,7 @@ type ListProjectTaskStatusesResponse = corehttp.HttpSuccessResponseWithData[proj
 
 // ListProjectTaskStatuses godoc
 // @Summary List project task statuses
-// @Description Returns all project task statuses.
+// @Description Returns all project task statuses. With withWip, each status also returns its current task counts against its work in progress limits.
 // @Tags Project Task Status
 // @Accept json
 // @Param request query projecthttprequests.ListProjectTaskStatusesRequest true "Query parameters"
@@ -115,7 +115,7 @@ type UpdateProjectTaskStatusResponse = corehttp.EmptyHttpSuccessResponse
 
 // UpdateProjectTaskStatus godoc
 // @Summary Update a project task status
-// @Description Updates an existing project task status.
+// @Description Updates an existing project task status. A work in progress limit set to zero is removed.
 // @Tags Project Task Status
 // @Accept json
 // @Param projectId path string true "Project ID"
@@ -0,0 +1,208 @@
+package projecthttp
+
+import (
+	"net/http"
+
+	authhttpmiddlewares "github.com/gabrielmrtt/taski/internal/auth/infra/http/middlewares"
+	"github.com/gabrielmrtt/taski/internal/core"
+	corehttp "github.com/gabrielmrtt/taski/internal/core/http"
+	organizationhttpmiddlewares "github.com/gabrielmrtt/taski/internal/organization/infra/http/middlewares"
+	"github.com/gabrielmrtt/taski/internal/project"
+	projecthttpmiddlewares "github.com/gabrielmrtt/taski/internal/project/infra/http/middlewares"
+	projecthttprequests "github.com/gabrielmrtt/taski/internal/project/infra/http/requests"
+	projectservice "github.com/gabrielmrtt/taski/internal/project/service"
+	"github.com/gin-gonic/gin"
+)
+
+type ProjectTaskStatusTransitionHandler struct {
+	ListProjectTaskStatusTransitionsService  *projectservice.ListProjectTaskStatusTransitionsService
+	CreateProjectTaskStatusTransitionService *projectservice.CreateProjectTaskStatusTransitionService
+	UpdateProjectTaskStatusTransitionService *projectservice.UpdateProjectTaskStatusTransitionService
+	DeleteProjectTaskStatusTransitionService *projectservice.DeleteProjectTaskStatusTransitionService
+}
+
+func NewProjectTaskStatusTransitionHandler(
+	listProjectTaskStatusTransitionsService *projectservice.ListProjectTaskStatusTransitionsService,
+	createProjectTaskStatusTransitionService *projectservice.CreateProjectTaskStatusTransitionService,
+	updateProjectTaskStatusTransitionService *projectservice.UpdateProjectTaskStatusTransitionService,
+	deleteProjectTaskStatusTransitionService *projectservice.DeleteProjectTaskStatusTransitionService,
+) *ProjectTaskStatusTransitionHandler {
+	return &ProjectTaskStatusTransitionHandler{
+		ListProjectTaskStatusTransitionsService:  listProjectTaskStatusTransitionsService,
+		CreateProjectTaskStatusTransitionService: createProjectTaskStatusTransitionService,
+		UpdateProjectTaskStatusTransitionService: updateProjectTaskStatusTransitionService,
+		DeleteProjectTaskStatusTransitionService: deleteProjectTaskStatusTransitionService,
+	}
+}
+
+type ListProjectTaskStatusTransitionsResponse = corehttp.HttpSuccessResponseWithData[[]project.ProjectTaskStatusTransitionDto]
+
+// ListProjectTaskStatusTransitions godoc
+// @Summary List project task status transitions
+// @Description Returns the status transitions configured for a project. A project without transitions allows every move.
+// @Tags Project Task Status Transition
+// @Accept json
+// @Param projectId path string true "Project ID"
+// @Produce json
+// @Success 200 {object} ListProjectTaskStatusTransitionsResponse
+// @Failure 400 {object} corehttp.HttpErrorResponse
+// @Failure 401 {object} corehttp.HttpErrorResponse
+// @Failure 403 {object} corehttp.HttpErrorResponse
+// @Failure 404 {object} corehttp.HttpErrorResponse
+// @Failure 500 {object} corehttp.HttpErrorResponse
+// @Router /project/:projectId/task-status-transition [get]
+func (c *ProjectTaskStatusTransitionHandler) ListProjectTaskStatusTransitions(ctx *gin.Context) {
+	var organizationIdentity *core.Identity = authhttpmiddlewares.GetAuthenticatedUserLastAccessedOrganizationIdentity(ctx)
+	var projectIdentity core.Identity = core.NewIdentityFromPublic(ctx.Param("projectId"))
+	var input projectservice.ListProjectTaskStatusTransitionsInput = projectservice.ListProjectTaskStatusTransitionsInput{
+		OrganizationIdentity: *organizationIdentity,
+		ProjectIdentity:      projectIdentity,
+	}
+
+	response, err := c.ListProjectTaskStatusTransitionsService.Execute(input)
+	if err != nil {
+		corehttp.NewHttpErrorResponse(ctx, err)
+		return
+	}
+
+	corehttp.NewHttpSuccessResponseWithData(ctx, http.StatusOK, &response)
+}
+
+type CreateProjectTaskStatusTransitionResponse = corehttp.HttpSuccessResponseWithData[project.ProjectTaskStatusTransitionDto]
+
+// CreateProjectTaskStatusTransition godoc
+// @Summary Create a project task status transition
+// @Description Allows tasks to move to a status, optionally only from one status, for some roles and with required fields.
+// @Tags Project Task Status Transition
+// @Accept json
+// @Param projectId path string true "Project ID"
+// @Param request body projecthttprequests.CreateProjectTaskStatusTransitionRequest true "Request body"
+// @Produce json
+// @Success 200 {object} CreateProjectTaskStatusTransitionResponse
+// @Failure 400 {object} corehttp.HttpErrorResponse
+// @Failure 401 {object} corehttp.HttpErrorResponse
+// @Failure 403 {object} corehttp.HttpErrorResponse
+// @Failure 404 {object} corehttp.HttpErrorResponse
+// @Failure 409 {object} corehttp.HttpErrorResponse
+// @Failure 500 {object} corehttp.HttpErrorResponse
+// @Router /project/:projectId/task-status-transition [post]
+func (c *ProjectTaskStatusTransitionHandler) CreateProjectTaskStatusTransition(ctx *gin.Context) {
+	var request projecthttprequests.CreateProjectTaskStatusTransitionRequest
+	var organizationIdentity *core.Identity = authhttpmiddlewares.GetAuthenticatedUserLastAccessedOrganizationIdentity(ctx)
+	var projectIdentity core.Identity = core.NewIdentityFromPublic(ctx.Param("projectId"))
+	var input projectservice.CreateProjectTaskStatusTransitionInput
+
+	if err := ctx.ShouldBindJSON(&request); err != nil {
+		corehttp.NewHttpErrorResponse(ctx, err)
+		return
+	}
+
+	input = request.ToInput()
+	input.OrganizationIdentity = *organizationIdentity
+	input.ProjectIdentity = projectIdentity
+
+	response, err := c.CreateProjectTaskStatusTransitionService.Execute(input)
+	if err != nil {
+		corehttp.NewHttpErrorResponse(ctx, err)
+		return
+	}
+
+	corehttp.NewHttpSuccessResponseWithData(ctx, http.StatusOK, response)
+}
+
+type UpdateProjectTaskStatusTransitionResponse = corehttp.EmptyHttpSuccessResponse
+
+// UpdateProjectTaskStatusTransition godoc
+// @Summary Update a project task status transition
+// @Description Updates the roles and required fields of a project task status transition.
+// @Tags Project Task Status Transition
+// @Accept json
+// @Param projectId path string true "Project ID"
+// @Param taskStatusTransitionId path string true "Task Status Transition ID"
+// @Param request body projecthttprequests.UpdateProjectTaskStatusTransitionRequest true "Request body"
+// @Produce json
+// @Success 200 {object} UpdateProjectTaskStatusTransitionResponse
+// @Failure 400 {object} corehttp.HttpErrorResponse
+// @Failure 401 {object} corehttp.HttpErrorResponse
+// @Failure 403 {object} corehttp.HttpErrorResponse
+// @Failure 404 {object} corehttp.HttpErrorResponse
+// @Failure 500 {object} corehttp.HttpErrorResponse
+// @Router /project/:projectId/task-status-transition/:taskStatusTransitionId [put]
+func (c *ProjectTaskStatusTransitionHandler) UpdateProjectTaskStatusTransition(ctx *gin.Context) {
+	var request projecthttprequests.UpdateProjectTaskStatusTransitionRequest
+	var organizationIdentity *core.Identity = authhttpmiddlewares.GetAuthenticatedUserLastAccessedOrganizationIdentity(ctx)
+	var projectIdentity core.Identity = core.NewIdentityFromPublic(ctx.Param("projectId"))
+	var taskStatusTransitionIdentity core.Identity = core.NewIdentityFromPublic(ctx.Param("taskStatusTransitionId"))
+	var input projectservice.UpdateProjectTaskStatusTransitionInput
+
+	if err := ctx.ShouldBindJSON(&request); err != nil {
+		corehttp.NewHttpErrorResponse(ctx, err)
+		return
+	}
+
+	input = request.ToInput()
+	input.OrganizationIdentity = *organizationIdentity
+	input.ProjectIdentity = projectIdentity
+	input.ProjectTaskStatusTransitionIdentity = taskStatusTransitionIdentity
+
+	err := c.UpdateProjectTaskStatusTransitionService.Execute(input)
+	if err != nil {
+		corehttp.NewHttpErrorResponse(ctx, err)
+		return
+	}
+
+	corehttp.NewEmptyHttpSuccessResponse(ctx, http.StatusOK)
+}
+
+type DeleteProjectTaskStatusTransitionResponse = corehttp.EmptyHttpSuccessResponse
+
+// DeleteProjectTaskStatusTransition godoc
+// @Summary Delete a project task status transition
+// @Description Deletes a project task status transition.
+// @Tags Project Task Status Transition
+// @Accept json
+// @Param projectId path string true "Project ID"
+// @Param taskStatusTransitionId path string true "Task Status Transition ID"
+// @Produce json
+// @Success 200 {object} DeleteProjectTaskStatusTransitionResponse
+// @Failure 400 {object} corehttp.HttpErrorResponse
+// @Failure 401 {object} corehttp.HttpErrorResponse
+// @Failure 403 {object} corehttp.HttpErrorResponse
+// @Failure 404 {object} corehttp.HttpErrorResponse
+// @Failure 500 {object} corehttp.HttpErrorResponse
+// @Router /project/:projectId/task-status-transition/:taskStatusTransitionId [delete]
+func (c *ProjectTaskStatusTransitionHandler) DeleteProjectTaskStatusTransition(ctx *gin.Context) {
+	var organizationIdentity *core.Identity = authhttpmiddlewares.GetAuthenticatedUserLastAccessedOrganizationIdentity(ctx)
+	var input projectservice.DeleteProjectTaskStatusTransitionInput = projectservice.DeleteProjectTaskStatusTransitionInput{
+		OrganizationIdentity:                *organizationIdentity,
+		ProjectIdentity:                     core.NewIdentityFromPublic(ctx.Param("projectId")),
+		ProjectTaskStatusTransitionIdentity: core.NewIdentityFromPublic(ctx.Param("taskStatusTransitionId")),
+	}
+
+	err := c.DeleteProjectTaskStatusTransitionService.Execute(input)
+	if err != nil {
+		corehttp.NewHttpErrorResponse(ctx, err)
+		return
+	}
+
+	corehttp.NewEmptyHttpSuccessResponse(ctx, http.StatusOK)
+}
+
+func (c *ProjectTaskStatusTransitionHandler) ConfigureRoutes(options corehttp.ConfigureRoutesOptions) *gin.RouterGroup {
+	middlewareOptions := corehttp.MiddlewareOptions{
+		DbConnection: options.DbConnection,
+	}
+
+	g := options.RouterGroup.Group("/project/:projectId/task-status-transition")
+	{
+		g.Use(authhttpmiddlewares.AuthMiddleware(middlewareOptions))
+		g.Use(projecthttpmiddlewares.UserMustBeInProject(middlewareOptions))
+
+		g.GET("", organizationhttpmiddlewares.UserMustHavePermission("projects:view", middlewareOptions), c.ListProjectTaskStatusTransitions)
+		g.POST("", organizationhttpmiddlewares.UserMustHavePermission("projects:update", middlewareOptions), c.CreateProjectTaskStatusTransition)
+		g.PUT("/:taskStatusTransitionId", organizationhttpmiddlewares.UserMustHavePermission("projects:update", middlewareOptions), c.UpdateProjectTaskStatusTransition)
+		g.DELETE("/:taskStatusTransitionId", organizationhttpmiddlewares.UserMustHavePermission("projects:update", middlewareOptions), c.DeleteProjectTaskStatusTransition)
+	}
+
+	return g
+}
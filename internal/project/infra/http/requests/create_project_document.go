@@ -5,14 +5,17 @@ import (
 	"mime/multipart"
 
 	"github.com/gabrielmrtt/taski/internal/core"
+	"github.com/gabrielmrtt/taski/internal/project"
 	projectservice "github.com/gabrielmrtt/taski/internal/project/service"
 )
 
 type CreateProjectDocumentRequest struct {
-	Title   string                  `form:"title"`
-	Content string                  `form:"content"`
-	Version string                  `form:"version"`
-	Files   []*multipart.FileHeader `form:"files"`
+	Title         string                  `form:"title"`
+	Content       string                  `form:"content"`
+	ContentFormat string                  `form:"contentFormat"`
+	Version       string                  `form:"version"`
+	VersionScheme string                  `form:"versionScheme"`
+	Files         []*multipart.FileHeader `form:"files"`
 }
 
 func (r *CreateProjectDocumentRequest) ToInput() projectservice.CreateProjectDocumentInput {
@@ -38,9 +41,11 @@ func (r *CreateProjectDocumentRequest) ToInput() projectservice.CreateProjectDoc
 	}
 
 	return projectservice.CreateProjectDocumentInput{
-		Title:   r.Title,
-		Content: r.Content,
-		Version: r.Version,
-		Files:   files,
+		Title:         r.Title,
+		Content:       r.Content,
+		ContentFormat: core.ContentFormats(r.ContentFormat),
+		Version:       r.Version,
+		VersionScheme: project.ProjectDocumentVersionSchemes(r.VersionScheme),
+		Files:         files,
 	}
 }
@@ -0,0 +1,22 @@
+package projecthttprequests
+
+import (
+	"github.com/gabrielmrtt/taski/internal/project"
+	projectservice "github.com/gabrielmrtt/taski/internal/project/service"
+)
+
+type CreateProjectTaskCustomFieldRequest struct {
+	Name       string   `json:"name"`
+	Type       string   `json:"type"`
+	Options    []string `json:"options"`
+	IsRequired bool     `json:"isRequired"`
+}
+
+func (r *CreateProjectTaskCustomFieldRequest) ToInput() projectservice.CreateProjectTaskCustomFieldInput {
+	return projectservice.CreateProjectTaskCustomFieldInput{
+		Name:       r.Name,
+		Type:       project.ProjectTaskCustomFieldTypes(r.Type),
+		Options:    r.Options,
+		IsRequired: r.IsRequired,
+	}
+}
@@ -1,21 +1,36 @@
 package projecthttprequests
 
-import projectservice "github.com/gabrielmrtt/taski/internal/project/service"
+import (
+	"github.com/gabrielmrtt/taski/internal/project"
+	projectservice "github.com/gabrielmrtt/taski/internal/project/service"
+)
 
 type CreateProjectTaskStatusRequest struct {
-	Name                     string `json:"name"`
-	Color                    string `json:"color"`
-	ShouldSetTaskToCompleted bool   `json:"shouldSetTaskToCompleted"`
-	IsDefault                bool   `json:"isDefault"`
-	ShouldUseOrder           bool   `json:"shouldUseOrder"`
+	Name                     string  `json:"name"`
+	Color                    string  `json:"color"`
+	ShouldSetTaskToCompleted bool    `json:"shouldSetTaskToCompleted"`
+	IsDefault                bool    `json:"isDefault"`
+	ShouldUseOrder           bool    `json:"shouldUseOrder"`
+	WipLimit                 *int16  `json:"wipLimit"`
+	WipLimitPerAssignee      *int16  `json:"wipLimitPerAssignee"`
+	WipLimitPolicy           *string `json:"wipLimitPolicy"`
 }
 
 func (r *CreateProjectTaskStatusRequest) ToInput() projectservice.CreateProjectTaskStatusInput {
+	var wipLimitPolicy *project.ProjectTaskStatusWipLimitPolicies = nil
+	if r.WipLimitPolicy != nil {
+		policy := project.ProjectTaskStatusWipLimitPolicies(*r.WipLimitPolicy)
+		wipLimitPolicy = &policy
+	}
+
 	return projectservice.CreateProjectTaskStatusInput{
 		Name:                     r.Name,
 		Color:                    r.Color,
 		ShouldSetTaskToCompleted: r.ShouldSetTaskToCompleted,
 		IsDefault:                r.IsDefault,
 		ShouldUseOrder:           r.ShouldUseOrder,
+		WipLimit:                 r.WipLimit,
+		WipLimitPerAssignee:      r.WipLimitPerAssignee,
+		WipLimitPolicy:           wipLimitPolicy,
 	}
 }
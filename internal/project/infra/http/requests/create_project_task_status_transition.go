@@ -0,0 +1,39 @@
+package projecthttprequests
+
+import (
+	"github.com/gabrielmrtt/taski/internal/core"
+	"github.com/gabrielmrtt/taski/internal/project"
+	projectservice "github.com/gabrielmrtt/taski/internal/project/service"
+)
+
+type CreateProjectTaskStatusTransitionRequest struct {
+	FromStatusId   *string  `json:"fromStatusId"`
+	ToStatusId     string   `json:"toStatusId"`
+	RoleIds        []string `json:"roleIds"`
+	RequiredFields []string `json:"requiredFields"`
+}
+
+func (r *CreateProjectTaskStatusTransitionRequest) ToInput() projectservice.CreateProjectTaskStatusTransitionInput {
+	var fromStatusIdentity *core.Identity = nil
+	if r.FromStatusId != nil {
+		identity := core.NewIdentityFromPublic(*r.FromStatusId)
+		fromStatusIdentity = &identity
+	}
+
+	var roleIdentities []core.Identity = make([]core.Identity, 0)
+	for _, roleId := range r.RoleIds {
+		roleIdentities = append(roleIdentities, core.NewIdentityFromPublic(roleId))
+	}
+
+	var requiredFields []project.ProjectTaskStatusTransitionRequiredFields = make([]project.ProjectTaskStatusTransitionRequiredFields, 0)
+	for _, requiredField := range r.RequiredFields {
+		requiredFields = append(requiredFields, project.ProjectTaskStatusTransitionRequiredFields(requiredField))
+	}
+
+	return projectservice.CreateProjectTaskStatusTransitionInput{
+		FromStatusIdentity: fromStatusIdentity,
+		ToStatusIdentity:   core.NewIdentityFromPublic(r.ToStatusId),
+		RoleIdentities:     roleIdentities,
+		RequiredFields:     requiredFields,
+	}
+}
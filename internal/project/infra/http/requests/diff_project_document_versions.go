@@ -0,0 +1,36 @@
+package projecthttprequests
+
+import (
+	"github.com/gabrielmrtt/taski/internal/core"
+	projectservice "github.com/gabrielmrtt/taski/internal/project/service"
+	"github.com/gin-gonic/gin"
+	"github.com/gorilla/schema"
+)
+
+type DiffProjectDocumentVersionsRequest struct {
+	From string `json:"from" schema:"from"`
+	To   string `json:"to" schema:"to"`
+}
+
+func (r *DiffProjectDocumentVersionsRequest) FromQuery(ctx *gin.Context) error {
+	schemaDecoder := schema.NewDecoder()
+	schemaDecoder.IgnoreUnknownKeys(true)
+	return schemaDecoder.Decode(r, ctx.Request.URL.Query())
+}
+
+func (r *DiffProjectDocumentVersionsRequest) ToInput() projectservice.DiffProjectDocumentVersionsInput {
+	var fromIdentity core.Identity
+	if r.From != "" {
+		fromIdentity = core.NewIdentityFromPublic(r.From)
+	}
+
+	var toIdentity core.Identity
+	if r.To != "" {
+		toIdentity = core.NewIdentityFromPublic(r.To)
+	}
+
+	return projectservice.DiffProjectDocumentVersionsInput{
+		FromProjectDocumentVersionIdentity: fromIdentity,
+		ToProjectDocumentVersionIdentity:   toIdentity,
+	}
+}
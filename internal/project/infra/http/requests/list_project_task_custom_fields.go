@@ -0,0 +1,65 @@
+package projecthttprequests
+
+import (
+	"github.com/gabrielmrtt/taski/internal/core"
+	corehttp "github.com/gabrielmrtt/taski/internal/core/http"
+	"github.com/gabrielmrtt/taski/internal/project"
+	projectrepo "github.com/gabrielmrtt/taski/internal/project/repository"
+	projectservice "github.com/gabrielmrtt/taski/internal/project/service"
+	"github.com/gin-gonic/gin"
+	"github.com/gorilla/schema"
+)
+
+type ListProjectTaskCustomFieldsRequest struct {
+	Name          *string `json:"name"`
+	Type          *string `json:"type"`
+	Page          *int    `json:"page"`
+	PerPage       *int    `json:"perPage"`
+	SortBy        *string `json:"sortBy"`
+	SortDirection *string `json:"sortDirection"`
+	Relations     *string `json:"relations"`
+}
+
+func (r *ListProjectTaskCustomFieldsRequest) FromQuery(ctx *gin.Context) error {
+	schemaDecoder := schema.NewDecoder()
+	schemaDecoder.IgnoreUnknownKeys(true)
+	return schemaDecoder.Decode(r, ctx.Request.URL.Query())
+}
+
+func (r *ListProjectTaskCustomFieldsRequest) ToInput() projectservice.ListProjectTaskCustomFieldsInput {
+	var sortDirection core.SortDirection
+	if r.SortDirection != nil {
+		sortDirection = core.SortDirection(*r.SortDirection)
+	}
+
+	var nameFilter *core.ComparableFilter[string] = nil
+	if r.Name != nil {
+		nameFilter = &core.ComparableFilter[string]{
+			Like: r.Name,
+		}
+	}
+
+	var typeFilter *core.ComparableFilter[project.ProjectTaskCustomFieldTypes] = nil
+	if r.Type != nil {
+		customFieldType := project.ProjectTaskCustomFieldTypes(*r.Type)
+		typeFilter = &core.ComparableFilter[project.ProjectTaskCustomFieldTypes]{
+			Equals: &customFieldType,
+		}
+	}
+
+	return projectservice.ListProjectTaskCustomFieldsInput{
+		Filters: projectrepo.ProjectTaskCustomFieldFilters{
+			Name: nameFilter,
+			Type: typeFilter,
+		},
+		Pagination: core.PaginationInput{
+			Page:    r.Page,
+			PerPage: r.PerPage,
+		},
+		SortInput: core.SortInput{
+			By:        r.SortBy,
+			Direction: &sortDirection,
+		},
+		RelationsInput: corehttp.GetRelationsInput(r.Relations),
+	}
+}
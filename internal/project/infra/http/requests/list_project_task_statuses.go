@@ -16,6 +16,7 @@ type ListProjectTaskStatusesRequest struct {
 	SortBy        *string `json:"sortBy"`
 	SortDirection *string `json:"sortDirection"`
 	Relations     *string `json:"relations"`
+	WithWip       *bool   `json:"withWip"`
 }
 
 func (r *ListProjectTaskStatusesRequest) FromQuery(ctx *gin.Context) error {
@@ -50,5 +51,6 @@ func (r *ListProjectTaskStatusesRequest) ToInput() projectservice.ListProjectTas
 			Direction: &sortDirection,
 		},
 		RelationsInput: corehttp.GetRelationsInput(r.Relations),
+		WithWip:        r.WithWip != nil && *r.WithWip,
 	}
 }
@@ -0,0 +1,13 @@
+package projecthttprequests
+
+import projectservice "github.com/gabrielmrtt/taski/internal/project/service"
+
+type RestoreProjectDocumentVersionRequest struct {
+	Version *string `json:"version"`
+}
+
+func (r *RestoreProjectDocumentVersionRequest) ToInput() projectservice.RestoreProjectDocumentVersionInput {
+	return projectservice.RestoreProjectDocumentVersionInput{
+		Version: r.Version,
+	}
+}
@@ -5,14 +5,17 @@ import (
 	"mime/multipart"
 
 	"github.com/gabrielmrtt/taski/internal/core"
+	"github.com/gabrielmrtt/taski/internal/project"
 	projectservice "github.com/gabrielmrtt/taski/internal/project/service"
 )
 
 type UpdateProjectDocumentRequest struct {
-	Title   *string                `json:"title"`
-	Content *string                `json:"content"`
-	Version *string                `json:"version"`
-	Files   []multipart.FileHeader `form:"files"`
+	Title         *string                `json:"title"`
+	Content       *string                `json:"content"`
+	ContentFormat *string                `json:"contentFormat"`
+	Version       *string                `json:"version"`
+	VersionScheme *string                `json:"versionScheme"`
+	Files         []multipart.FileHeader `form:"files"`
 }
 
 func (r *UpdateProjectDocumentRequest) ToInput() projectservice.UpdateProjectDocumentInput {
@@ -37,10 +40,24 @@ func (r *UpdateProjectDocumentRequest) ToInput() projectservice.UpdateProjectDoc
 		}
 	}
 
+	var contentFormat *core.ContentFormats = nil
+	if r.ContentFormat != nil {
+		f := core.ContentFormats(*r.ContentFormat)
+		contentFormat = &f
+	}
+
+	var versionScheme *project.ProjectDocumentVersionSchemes = nil
+	if r.VersionScheme != nil {
+		s := project.ProjectDocumentVersionSchemes(*r.VersionScheme)
+		versionScheme = &s
+	}
+
 	return projectservice.UpdateProjectDocumentInput{
-		Title:   r.Title,
-		Content: r.Content,
-		Version: r.Version,
-		Files:   files,
+		Title:         r.Title,
+		Content:       r.Content,
+		ContentFormat: contentFormat,
+		Version:       r.Version,
+		VersionScheme: versionScheme,
+		Files:         files,
 	}
 }
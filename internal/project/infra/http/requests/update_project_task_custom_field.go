@@ -0,0 +1,17 @@
+package projecthttprequests
+
+import projectservice "github.com/gabrielmrtt/taski/internal/project/service"
+
+type UpdateProjectTaskCustomFieldRequest struct {
+	Name       *string   `json:"name"`
+	Options    *[]string `json:"options"`
+	IsRequired *bool     `json:"isRequired"`
+}
+
+func (r *UpdateProjectTaskCustomFieldRequest) ToInput() projectservice.UpdateProjectTaskCustomFieldInput {
+	return projectservice.UpdateProjectTaskCustomFieldInput{
+		Name:       r.Name,
+		Options:    r.Options,
+		IsRequired: r.IsRequired,
+	}
+}
@@ -1,6 +1,9 @@
 package projecthttprequests
 
-import projectservice "github.com/gabrielmrtt/taski/internal/project/service"
+import (
+	"github.com/gabrielmrtt/taski/internal/project"
+	projectservice "github.com/gabrielmrtt/taski/internal/project/service"
+)
 
 type UpdateProjectTaskStatusRequest struct {
 	Name                     *string `json:"name"`
@@ -8,14 +11,26 @@ type UpdateProjectTaskStatusRequest struct {
 	ShouldSetTaskToCompleted *bool   `json:"shouldSetTaskToCompleted"`
 	IsDefault                *bool   `json:"isDefault"`
 	Order                    *int8   `json:"order"`
+	WipLimit                 *int16  `json:"wipLimit"`
+	WipLimitPerAssignee      *int16  `json:"wipLimitPerAssignee"`
+	WipLimitPolicy           *string `json:"wipLimitPolicy"`
 }
 
 func (r *UpdateProjectTaskStatusRequest) ToInput() projectservice.UpdateProjectTaskStatusInput {
+	var wipLimitPolicy *project.ProjectTaskStatusWipLimitPolicies = nil
+	if r.WipLimitPolicy != nil {
+		policy := project.ProjectTaskStatusWipLimitPolicies(*r.WipLimitPolicy)
+		wipLimitPolicy = &policy
+	}
+
 	return projectservice.UpdateProjectTaskStatusInput{
 		Name:                     r.Name,
 		Color:                    r.Color,
 		ShouldSetTaskToCompleted: r.ShouldSetTaskToCompleted,
 		IsDefault:                r.IsDefault,
 		Order:                    r.Order,
+		WipLimit:                 r.WipLimit,
+		WipLimitPerAssignee:      r.WipLimitPerAssignee,
+		WipLimitPolicy:           wipLimitPolicy,
 	}
 }
@@ -0,0 +1,37 @@
+package projecthttprequests
+
+import (
+	"github.com/gabrielmrtt/taski/internal/core"
+	"github.com/gabrielmrtt/taski/internal/project"
+	projectservice "github.com/gabrielmrtt/taski/internal/project/service"
+)
+
+type UpdateProjectTaskStatusTransitionRequest struct {
+	RoleIds        *[]string `json:"roleIds"`
+	RequiredFields *[]string `json:"requiredFields"`
+}
+
+func (r *UpdateProjectTaskStatusTransitionRequest) ToInput() projectservice.UpdateProjectTaskStatusTransitionInput {
+	var roleIdentities *[]core.Identity = nil
+	if r.RoleIds != nil {
+		identities := make([]core.Identity, 0)
+		for _, roleId := range *r.RoleIds {
+			identities = append(identities, core.NewIdentityFromPublic(roleId))
+		}
+		roleIdentities = &identities
+	}
+
+	var requiredFields *[]project.ProjectTaskStatusTransitionRequiredFields = nil
+	if r.RequiredFields != nil {
+		fields := make([]project.ProjectTaskStatusTransitionRequiredFields, 0)
+		for _, requiredField := range *r.RequiredFields {
+			fields = append(fields, project.ProjectTaskStatusTransitionRequiredFields(requiredField))
+		}
+		requiredFields = &fields
+	}
+
+	return projectservice.UpdateProjectTaskStatusTransitionInput{
+		RoleIdentities: roleIdentities,
+		RequiredFields: requiredFields,
+	}
+}
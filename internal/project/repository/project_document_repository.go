@@ -41,12 +41,17 @@ type GetProjectDocumentVersionByParams struct {
 type GetProjectDocumentVersionManagerByParams struct {
 	ProjectDocumentVersionManagerIdentity core.Identity
 	RelationsInput                        core.RelationsInput
+	ForUpdate                             bool
 }
 
 type StoreProjectDocumentVersionManagerParams struct {
 	ProjectDocumentVersionManager *project.ProjectDocumentVersionManager
 }
 
+type UpdateProjectDocumentVersionManagerParams struct {
+	ProjectDocumentVersionManager *project.ProjectDocumentVersionManager
+}
+
 type StoreProjectDocumentVersionParams struct {
 	ProjectDocumentVersion *project.ProjectDocumentVersion
 }
@@ -79,6 +84,7 @@ type ProjectDocumentRepository interface {
 
 	StoreProjectDocumentVersionManager(params StoreProjectDocumentVersionManagerParams) (*project.ProjectDocumentVersionManager, error)
 	GetProjectDocumentVersionManagerBy(params GetProjectDocumentVersionManagerByParams) (*project.ProjectDocumentVersionManager, error)
+	UpdateProjectDocumentVersionManager(params UpdateProjectDocumentVersionManagerParams) error
 	DeleteProjectDocumentVersionManager(params DeleteProjectDocumentVersionManagerParams) error
 
 	StoreProjectDocumentVersion(params StoreProjectDocumentVersionParams) (*project.ProjectDocumentVersion, error)
@@ -0,0 +1,57 @@
+package projectrepo
+
+import (
+	"github.com/gabrielmrtt/taski/internal/core"
+	"github.com/gabrielmrtt/taski/internal/project"
+)
+
+type ProjectTaskCustomFieldFilters struct {
+	ProjectIdentity *core.Identity
+	Name            *core.ComparableFilter[string]
+	Type            *core.ComparableFilter[project.ProjectTaskCustomFieldTypes]
+	IsRequired      *bool
+}
+
+type GetProjectTaskCustomFieldByIdentityParams struct {
+	ProjectTaskCustomFieldIdentity core.Identity
+	ProjectIdentity                *core.Identity
+	RelationsInput                 core.RelationsInput
+}
+
+type ListProjectTaskCustomFieldsByParams struct {
+	ShowDeleted    bool
+	Filters        ProjectTaskCustomFieldFilters
+	RelationsInput core.RelationsInput
+}
+
+type PaginateProjectTaskCustomFieldsParams struct {
+	ShowDeleted    bool
+	Filters        ProjectTaskCustomFieldFilters
+	SortInput      core.SortInput
+	Pagination     core.PaginationInput
+	RelationsInput core.RelationsInput
+}
+
+type StoreProjectTaskCustomFieldParams struct {
+	ProjectTaskCustomField *project.ProjectTaskCustomField
+}
+
+type UpdateProjectTaskCustomFieldParams struct {
+	ProjectTaskCustomField *project.ProjectTaskCustomField
+}
+
+type DeleteProjectTaskCustomFieldParams struct {
+	ProjectTaskCustomFieldIdentity core.Identity
+}
+
+type ProjectTaskCustomFieldRepository interface {
+	SetTransaction(tx core.Transaction) error
+
+	GetProjectTaskCustomFieldByIdentity(params GetProjectTaskCustomFieldByIdentityParams) (*project.ProjectTaskCustomField, error)
+	ListProjectTaskCustomFieldsBy(params ListProjectTaskCustomFieldsByParams) ([]project.ProjectTaskCustomField, error)
+	PaginateProjectTaskCustomFieldsBy(params PaginateProjectTaskCustomFieldsParams) (*core.PaginationOutput[project.ProjectTaskCustomField], error)
+
+	StoreProjectTaskCustomField(params StoreProjectTaskCustomFieldParams) (*project.ProjectTaskCustomField, error)
+	UpdateProjectTaskCustomField(params UpdateProjectTaskCustomFieldParams) error
+	DeleteProjectTaskCustomField(params DeleteProjectTaskCustomFieldParams) error
+}
@@ -57,6 +57,19 @@ type GetTaskStatusByOrderParams struct {
 	RelationsInput  core.RelationsInput
 }
 
+type CountProjectTaskStatusTasksParams struct {
+	ProjectIdentity           core.Identity
+	ProjectTaskStatusIdentity *core.Identity
+	ExcludeTaskIdentity       *core.Identity
+	ByAssignee                bool
+}
+
+type ProjectTaskStatusTaskCount struct {
+	ProjectTaskStatusIdentity core.Identity
+	UserIdentity              *core.Identity
+	TaskCount                 int
+}
+
 type ProjectTaskStatusRepository interface {
 	SetTransaction(tx core.Transaction) error
 
@@ -66,6 +79,7 @@ type ProjectTaskStatusRepository interface {
 	GetProjectTaskStatusByIdentity(params GetProjectTaskStatusByIdentityParams) (*project.ProjectTaskStatus, error)
 	ListProjectTaskStatusesBy(params ListProjectTaskStatusesByParams) ([]project.ProjectTaskStatus, error)
 	PaginateProjectTaskStatusesBy(params PaginateProjectTaskStatusesParams) (*core.PaginationOutput[project.ProjectTaskStatus], error)
+	CountProjectTaskStatusTasks(params CountProjectTaskStatusTasksParams) ([]ProjectTaskStatusTaskCount, error)
 
 	StoreProjectTaskStatus(params StoreProjectTaskStatusParams) (*project.ProjectTaskStatus, error)
 	UpdateProjectTaskStatus(params UpdateProjectTaskStatusParams) error
@@ -0,0 +1,44 @@
+package projectrepo
+
+import (
+	"github.com/gabrielmrtt/taski/internal/core"
+	"github.com/gabrielmrtt/taski/internal/project"
+)
+
+type ProjectTaskStatusTransitionFilters struct {
+	ProjectIdentity    *core.Identity
+	FromStatusIdentity *core.Identity
+	ToStatusIdentity   *core.Identity
+}
+
+type GetProjectTaskStatusTransitionByIdentityParams struct {
+	ProjectTaskStatusTransitionIdentity core.Identity
+	ProjectIdentity                     *core.Identity
+}
+
+type ListProjectTaskStatusTransitionsByParams struct {
+	Filters ProjectTaskStatusTransitionFilters
+}
+
+type StoreProjectTaskStatusTransitionParams struct {
+	ProjectTaskStatusTransition *project.ProjectTaskStatusTransition
+}
+
+type UpdateProjectTaskStatusTransitionParams struct {
+	ProjectTaskStatusTransition *project.ProjectTaskStatusTransition
+}
+
+type DeleteProjectTaskStatusTransitionParams struct {
+	ProjectTaskStatusTransitionIdentity core.Identity
+}
+
+type ProjectTaskStatusTransitionRepository interface {
+	SetTransaction(tx core.Transaction) error
+
+	GetProjectTaskStatusTransitionByIdentity(params GetProjectTaskStatusTransitionByIdentityParams) (*project.ProjectTaskStatusTransition, error)
+	ListProjectTaskStatusTransitionsBy(params ListProjectTaskStatusTransitionsByParams) ([]project.ProjectTaskStatusTransition, error)
+
+	StoreProjectTaskStatusTransition(params StoreProjectTaskStatusTransitionParams) (*project.ProjectTaskStatusTransition, error)
+	UpdateProjectTaskStatusTransition(params UpdateProjectTaskStatusTransitionParams) error
+	DeleteProjectTaskStatusTransition(params DeleteProjectTaskStatusTransitionParams) error
+}
@@ -1,6 +1,8 @@
 package projectservice
 
 import (
+	"slices"
+
 	"github.com/gabrielmrtt/taski/internal/core"
 	"github.com/gabrielmrtt/taski/internal/project"
 	projectrepo "github.com/gabrielmrtt/taski/internal/project/repository"
@@ -36,7 +38,9 @@ type CreateProjectDocumentInput struct {
 	ProjectIdentity     core.Identity
 	Title               string
 	Content             string
+	ContentFormat       core.ContentFormats
 	Version             string
+	VersionScheme       project.ProjectDocumentVersionSchemes
 	Files               []core.FileInput
 	UserCreatorIdentity core.Identity
 }
@@ -58,6 +62,22 @@ func (i CreateProjectDocumentInput) Validate() error {
 		})
 	}
 
+	if i.ContentFormat != "" {
+		if _, err := core.NewContent(i.Content, i.ContentFormat); err != nil {
+			fields = append(fields, core.InvalidInputErrorField{
+				Field: "content format",
+				Error: err.Error(),
+			})
+		}
+	}
+
+	if i.VersionScheme != "" && !slices.Contains(project.ProjectDocumentVersionSchemesArray, i.VersionScheme) {
+		fields = append(fields, core.InvalidInputErrorField{
+			Field: "versionScheme",
+			Error: "version scheme is not supported",
+		})
+	}
+
 	if len(fields) > 0 {
 		return core.NewInvalidInputError("invalid input", fields)
 	}
@@ -91,9 +111,15 @@ func (s *CreateProjectDocumentService) Execute(input CreateProjectDocumentInput)
 		return nil, core.NewNotFoundError("project not found")
 	}
 
+	var versionScheme project.ProjectDocumentVersionSchemes = project.DefaultProjectDocumentVersionScheme
+	if input.VersionScheme != "" {
+		versionScheme = input.VersionScheme
+	}
+
 	projectDocumentVersionManager := &project.ProjectDocumentVersionManager{
 		Identity:        core.NewIdentity(project.ProjectDocumentVersionManagerIdentityPrefix),
 		ProjectIdentity: input.ProjectIdentity,
+		VersionScheme:   versionScheme,
 		LatestVersion:   nil,
 	}
 
@@ -123,6 +149,7 @@ func (s *CreateProjectDocumentService) Execute(input CreateProjectDocumentInput)
 		ProjectDocumentVersionManagerIdentity: projectDocumentVersionManager.Identity,
 		Title:                                 input.Title,
 		Content:                               input.Content,
+		ContentFormat:                         input.ContentFormat,
 		Version:                               input.Version,
 		Files:                                 files,
 		UserCreatorIdentity:                   &input.UserCreatorIdentity,
@@ -132,6 +159,17 @@ func (s *CreateProjectDocumentService) Execute(input CreateProjectDocumentInput)
 		return nil, err
 	}
 
+	checkEmbeddedFilesService := storageservice.NewCheckEmbeddedFilesService(s.UploadedFileRepository)
+	err = checkEmbeddedFilesService.Execute(storageservice.CheckEmbeddedFilesInput{
+		Field:        "content",
+		Content:      projectDocumentVersion.Document.FormattedContent(),
+		UserIdentity: input.UserCreatorIdentity,
+	})
+	if err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+
 	projectDocumentVersionManager.LatestVersion = projectDocumentVersion
 
 	_, err = s.ProjectDocumentRepository.StoreProjectDocumentVersionManager(projectrepo.StoreProjectDocumentVersionManagerParams{
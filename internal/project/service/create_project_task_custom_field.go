@@ -0,0 +1,114 @@
+package projectservice
+
+import (
+	"slices"
+
+	"github.com/gabrielmrtt/taski/internal/core"
+	"github.com/gabrielmrtt/taski/internal/project"
+	projectrepo "github.com/gabrielmrtt/taski/internal/project/repository"
+)
+
+type CreateProjectTaskCustomFieldService struct {
+	ProjectRepository                projectrepo.ProjectRepository
+	ProjectTaskCustomFieldRepository projectrepo.ProjectTaskCustomFieldRepository
+	TransactionRepository            core.TransactionRepository
+}
+
+func NewCreateProjectTaskCustomFieldService(
+	projectRepository projectrepo.ProjectRepository,
+	projectTaskCustomFieldRepository projectrepo.ProjectTaskCustomFieldRepository,
+	transactionRepository core.TransactionRepository,
+) *CreateProjectTaskCustomFieldService {
+	return &CreateProjectTaskCustomFieldService{
+		ProjectRepository:                projectRepository,
+		ProjectTaskCustomFieldRepository: projectTaskCustomFieldRepository,
+		TransactionRepository:            transactionRepository,
+	}
+}
+
+type CreateProjectTaskCustomFieldInput struct {
+	OrganizationIdentity core.Identity
+	ProjectIdentity      core.Identity
+	Name                 string
+	Type                 project.ProjectTaskCustomFieldTypes
+	Options              []string
+	IsRequired           bool
+}
+
+func (i CreateProjectTaskCustomFieldInput) Validate() error {
+	var fields []core.InvalidInputErrorField
+
+	if _, err := core.NewName(i.Name); err != nil {
+		fields = append(fields, core.InvalidInputErrorField{
+			Field: "name",
+			Error: err.Error(),
+		})
+	}
+
+	if !slices.Contains(project.ProjectTaskCustomFieldTypesArray, i.Type) {
+		fields = append(fields, core.InvalidInputErrorField{
+			Field: "type",
+			Error: "custom field type is not supported",
+		})
+	}
+
+	if len(fields) > 0 {
+		return core.NewInvalidInputError("invalid input", fields)
+	}
+
+	return nil
+}
+
+func (s *CreateProjectTaskCustomFieldService) Execute(input CreateProjectTaskCustomFieldInput) (*project.ProjectTaskCustomFieldDto, error) {
+	if err := input.Validate(); err != nil {
+		return nil, err
+	}
+
+	tx, err := s.TransactionRepository.BeginTransaction()
+	if err != nil {
+		return nil, err
+	}
+
+	s.ProjectRepository.SetTransaction(tx)
+	s.ProjectTaskCustomFieldRepository.SetTransaction(tx)
+
+	prj, err := s.ProjectRepository.GetProjectByIdentity(projectrepo.GetProjectByIdentityParams{
+		ProjectIdentity:      input.ProjectIdentity,
+		OrganizationIdentity: &input.OrganizationIdentity,
+	})
+	if err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+
+	if prj == nil {
+		tx.Rollback()
+		return nil, core.NewNotFoundError("project not found")
+	}
+
+	projectTaskCustomField, err := project.NewProjectTaskCustomField(project.NewProjectTaskCustomFieldInput{
+		ProjectIdentity: prj.Identity,
+		Name:            input.Name,
+		Type:            input.Type,
+		Options:         input.Options,
+		IsRequired:      input.IsRequired,
+	})
+	if err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+
+	projectTaskCustomField, err = s.ProjectTaskCustomFieldRepository.StoreProjectTaskCustomField(projectrepo.StoreProjectTaskCustomFieldParams{ProjectTaskCustomField: projectTaskCustomField})
+	if err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+
+	return project.ProjectTaskCustomFieldToDto(projectTaskCustomField), nil
+}
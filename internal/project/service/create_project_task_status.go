@@ -1,6 +1,8 @@
 package projectservice
 
 import (
+	"slices"
+
 	"github.com/gabrielmrtt/taski/internal/core"
 	"github.com/gabrielmrtt/taski/internal/project"
 	projectrepo "github.com/gabrielmrtt/taski/internal/project/repository"
@@ -32,6 +34,9 @@ type CreateProjectTaskStatusInput struct {
 	ShouldSetTaskToCompleted bool
 	IsDefault                bool
 	ShouldUseOrder           bool
+	WipLimit                 *int16
+	WipLimitPerAssignee      *int16
+	WipLimitPolicy           *project.ProjectTaskStatusWipLimitPolicies
 }
 
 func (i CreateProjectTaskStatusInput) Validate() error {
@@ -58,6 +63,27 @@ func (i CreateProjectTaskStatusInput) Validate() error {
 		})
 	}
 
+	if i.WipLimit != nil && *i.WipLimit <= 0 {
+		fields = append(fields, core.InvalidInputErrorField{
+			Field: "wip_limit",
+			Error: "wip limit must be greater than zero",
+		})
+	}
+
+	if i.WipLimitPerAssignee != nil && *i.WipLimitPerAssignee <= 0 {
+		fields = append(fields, core.InvalidInputErrorField{
+			Field: "wip_limit_per_assignee",
+			Error: "wip limit per assignee must be greater than zero",
+		})
+	}
+
+	if i.WipLimitPolicy != nil && !slices.Contains(project.ProjectTaskStatusWipLimitPoliciesArray, *i.WipLimitPolicy) {
+		fields = append(fields, core.InvalidInputErrorField{
+			Field: "wip_limit_policy",
+			Error: "wip limit policy must be reject or warn",
+		})
+	}
+
 	if len(fields) > 0 {
 		return core.NewInvalidInputError("invalid input", fields)
 	}
@@ -108,6 +134,11 @@ func (s *CreateProjectTaskStatusService) Execute(input CreateProjectTaskStatusIn
 		order = &lastOrder
 	}
 
+	var wipLimitPolicy project.ProjectTaskStatusWipLimitPolicies = project.ProjectTaskStatusWipLimitPolicyReject
+	if input.WipLimitPolicy != nil {
+		wipLimitPolicy = *input.WipLimitPolicy
+	}
+
 	projectTaskStatus, err := project.NewProjectTaskStatus(project.NewProjectTaskStatusInput{
 		ProjectIdentity:          prj.Identity,
 		Name:                     input.Name,
@@ -115,6 +146,9 @@ func (s *CreateProjectTaskStatusService) Execute(input CreateProjectTaskStatusIn
 		Order:                    order,
 		ShouldSetTaskToCompleted: input.ShouldSetTaskToCompleted,
 		IsDefault:                input.IsDefault,
+		WipLimit:                 input.WipLimit,
+		WipLimitPerAssignee:      input.WipLimitPerAssignee,
+		WipLimitPolicy:           wipLimitPolicy,
 	})
 	if err != nil {
 		tx.Rollback()
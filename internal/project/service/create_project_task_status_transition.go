@@ -0,0 +1,193 @@
+package projectservice
+
+import (
+	"slices"
+
+	"github.com/gabrielmrtt/taski/internal/core"
+	"github.com/gabrielmrtt/taski/internal/project"
+	projectrepo "github.com/gabrielmrtt/taski/internal/project/repository"
+	rolerepo "github.com/gabrielmrtt/taski/internal/role/repository"
+)
+
+type CreateProjectTaskStatusTransitionService struct {
+	ProjectRepository                     projectrepo.ProjectRepository
+	ProjectTaskStatusRepository           projectrepo.ProjectTaskStatusRepository
+	ProjectTaskStatusTransitionRepository projectrepo.ProjectTaskStatusTransitionRepository
+	RoleRepository                        rolerepo.RoleRepository
+	TransactionRepository                 core.TransactionRepository
+}
+
+func NewCreateProjectTaskStatusTransitionService(
+	projectRepository projectrepo.ProjectRepository,
+	projectTaskStatusRepository projectrepo.ProjectTaskStatusRepository,
+	projectTaskStatusTransitionRepository projectrepo.ProjectTaskStatusTransitionRepository,
+	roleRepository rolerepo.RoleRepository,
+	transactionRepository core.TransactionRepository,
+) *CreateProjectTaskStatusTransitionService {
+	return &CreateProjectTaskStatusTransitionService{
+		ProjectRepository:                     projectRepository,
+		ProjectTaskStatusRepository:           projectTaskStatusRepository,
+		ProjectTaskStatusTransitionRepository: projectTaskStatusTransitionRepository,
+		RoleRepository:                        roleRepository,
+		TransactionRepository:                 transactionRepository,
+	}
+}
+
+type CreateProjectTaskStatusTransitionInput struct {
+	OrganizationIdentity core.Identity
+	ProjectIdentity      core.Identity
+	FromStatusIdentity   *core.Identity
+	ToStatusIdentity     core.Identity
+	RoleIdentities       []core.Identity
+	RequiredFields       []project.ProjectTaskStatusTransitionRequiredFields
+}
+
+func (i CreateProjectTaskStatusTransitionInput) Validate() error {
+	var fields []core.InvalidInputErrorField
+
+	for _, requiredField := range i.RequiredFields {
+		if !slices.Contains(project.ProjectTaskStatusTransitionRequiredFieldsArray, requiredField) {
+			fields = append(fields, core.InvalidInputErrorField{
+				Field: "requiredFields",
+				Error: "required field " + string(requiredField) + " is not supported",
+			})
+		}
+	}
+
+	if len(fields) > 0 {
+		return core.NewInvalidInputError("invalid input", fields)
+	}
+
+	return nil
+}
+
+/*
+validateProjectTaskStatusTransitionRoles checks that every role is a system role or belongs to the organization.
+*/
+func validateProjectTaskStatusTransitionRoles(roleRepository rolerepo.RoleRepository, organizationIdentity core.Identity, roleIdentities []core.Identity) error {
+	for _, roleIdentity := range roleIdentities {
+		rol, err := roleRepository.GetRoleByIdentity(rolerepo.GetRoleByIdentityParams{RoleIdentity: roleIdentity})
+		if err != nil {
+			return err
+		}
+
+		if rol == nil || (rol.OrganizationIdentity != nil && !rol.OrganizationIdentity.Equals(organizationIdentity)) {
+			return core.NewNotFoundError("role not found")
+		}
+	}
+
+	return nil
+}
+
+func (s *CreateProjectTaskStatusTransitionService) getProjectTaskStatus(projectIdentity core.Identity, statusIdentity core.Identity) error {
+	projectTaskStatus, err := s.ProjectTaskStatusRepository.GetProjectTaskStatusByIdentity(projectrepo.GetProjectTaskStatusByIdentityParams{
+		ProjectTaskStatusIdentity: &statusIdentity,
+		ProjectIdentity:           &projectIdentity,
+	})
+	if err != nil {
+		return err
+	}
+
+	if projectTaskStatus == nil || projectTaskStatus.IsDeleted() {
+		return core.NewNotFoundError("project task status not found")
+	}
+
+	return nil
+}
+
+func (s *CreateProjectTaskStatusTransitionService) Execute(input CreateProjectTaskStatusTransitionInput) (*project.ProjectTaskStatusTransitionDto, error) {
+	if err := input.Validate(); err != nil {
+		return nil, err
+	}
+
+	tx, err := s.TransactionRepository.BeginTransaction()
+	if err != nil {
+		return nil, err
+	}
+
+	s.ProjectRepository.SetTransaction(tx)
+	s.ProjectTaskStatusRepository.SetTransaction(tx)
+	s.ProjectTaskStatusTransitionRepository.SetTransaction(tx)
+	s.RoleRepository.SetTransaction(tx)
+
+	prj, err := s.ProjectRepository.GetProjectByIdentity(projectrepo.GetProjectByIdentityParams{
+		ProjectIdentity:      input.ProjectIdentity,
+		OrganizationIdentity: &input.OrganizationIdentity,
+	})
+	if err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+
+	if prj == nil {
+		tx.Rollback()
+		return nil, core.NewNotFoundError("project not found")
+	}
+
+	if input.FromStatusIdentity != nil {
+		err = s.getProjectTaskStatus(prj.Identity, *input.FromStatusIdentity)
+		if err != nil {
+			tx.Rollback()
+			return nil, err
+		}
+	}
+
+	err = s.getProjectTaskStatus(prj.Identity, input.ToStatusIdentity)
+	if err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+
+	err = validateProjectTaskStatusTransitionRoles(s.RoleRepository, input.OrganizationIdentity, input.RoleIdentities)
+	if err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+
+	existingTransitions, err := s.ProjectTaskStatusTransitionRepository.ListProjectTaskStatusTransitionsBy(projectrepo.ListProjectTaskStatusTransitionsByParams{
+		Filters: projectrepo.ProjectTaskStatusTransitionFilters{
+			ProjectIdentity:  &prj.Identity,
+			ToStatusIdentity: &input.ToStatusIdentity,
+		},
+	})
+	if err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+
+	for _, existingTransition := range existingTransitions {
+		sameFrom := (existingTransition.FromStatusIdentity == nil && input.FromStatusIdentity == nil) ||
+			(existingTransition.FromStatusIdentity != nil && input.FromStatusIdentity != nil && existingTransition.FromStatusIdentity.Equals(*input.FromStatusIdentity))
+
+		if sameFrom {
+			tx.Rollback()
+			return nil, core.NewAlreadyExistsError("project task status transition already exists")
+		}
+	}
+
+	projectTaskStatusTransition, err := project.NewProjectTaskStatusTransition(project.NewProjectTaskStatusTransitionInput{
+		ProjectIdentity:    prj.Identity,
+		FromStatusIdentity: input.FromStatusIdentity,
+		ToStatusIdentity:   input.ToStatusIdentity,
+		RoleIdentities:     input.RoleIdentities,
+		RequiredFields:     input.RequiredFields,
+	})
+	if err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+
+	projectTaskStatusTransition, err = s.ProjectTaskStatusTransitionRepository.StoreProjectTaskStatusTransition(projectrepo.StoreProjectTaskStatusTransitionParams{ProjectTaskStatusTransition: projectTaskStatusTransition})
+	if err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+
+	return project.ProjectTaskStatusTransitionToDto(projectTaskStatusTransition), nil
+}
@@ -23,6 +23,7 @@ func NewDeleteProjectService(
 type DeleteProjectInput struct {
 	OrganizationIdentity core.Identity
 	ProjectIdentity      core.Identity
+	ExpectedRevision     *int64
 }
 
 func (i DeleteProjectInput) Validate() error {
@@ -53,6 +54,11 @@ func (s *DeleteProjectService) Execute(input DeleteProjectInput) error {
 		return core.NewNotFoundError("project not found")
 	}
 
+	err = core.CheckRevision(input.ExpectedRevision, project.Revision, "project")
+	if err != nil {
+		return err
+	}
+
 	project.Delete()
 
 	err = s.ProjectRepository.DeleteProject(projectrepo.DeleteProjectParams{ProjectIdentity: input.ProjectIdentity})
@@ -1,6 +1,8 @@
 package projectservice
 
 import (
+	"slices"
+
 	"github.com/gabrielmrtt/taski/internal/core"
 	projectrepo "github.com/gabrielmrtt/taski/internal/project/repository"
 	storagerepo "github.com/gabrielmrtt/taski/internal/storage/repository"
@@ -68,6 +70,7 @@ func (s *DeleteProjectDocumentService) Execute(input DeleteProjectDocumentInput)
 
 	projectDocumentVersionManager, err := s.ProjectDocumentRepository.GetProjectDocumentVersionManagerBy(projectrepo.GetProjectDocumentVersionManagerByParams{
 		ProjectDocumentVersionManagerIdentity: input.ProjectDocumentVersionManagerIdentity,
+		ForUpdate:                             true,
 	})
 	if err != nil {
 		tx.Rollback()
@@ -88,6 +91,7 @@ func (s *DeleteProjectDocumentService) Execute(input DeleteProjectDocumentInput)
 	}
 
 	deleteFileService := storageservice.NewDeleteFileByIdentityService(s.UploadedFileRepository, s.StorageRepository)
+	var deletedFileIdentities []core.Identity = make([]core.Identity, 0)
 
 	for _, version := range versions {
 		err = s.ProjectDocumentRepository.DeleteProjectDocumentVersion(projectrepo.DeleteProjectDocumentVersionParams{ProjectDocumentVersionIdentity: version.Identity})
@@ -97,11 +101,19 @@ func (s *DeleteProjectDocumentService) Execute(input DeleteProjectDocumentInput)
 		}
 
 		for _, file := range version.Document.Files {
+			if slices.ContainsFunc(deletedFileIdentities, func(identity core.Identity) bool {
+				return identity.Internal == file.FileIdentity.Internal
+			}) {
+				continue
+			}
+
 			err = deleteFileService.Execute(file.FileIdentity)
 			if err != nil {
 				tx.Rollback()
 				return err
 			}
+
+			deletedFileIdentities = append(deletedFileIdentities, file.FileIdentity)
 		}
 	}
 
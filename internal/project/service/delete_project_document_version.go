@@ -1,7 +1,10 @@
 package projectservice
 
 import (
+	"slices"
+
 	"github.com/gabrielmrtt/taski/internal/core"
+	"github.com/gabrielmrtt/taski/internal/project"
 	projectrepo "github.com/gabrielmrtt/taski/internal/project/repository"
 	storagerepo "github.com/gabrielmrtt/taski/internal/storage/repository"
 	storageservice "github.com/gabrielmrtt/taski/internal/storage/service"
@@ -35,6 +38,7 @@ type DeleteProjectDocumentVersionInput struct {
 	ProjectIdentity                       core.Identity
 	ProjectDocumentVersionManagerIdentity core.Identity
 	ProjectDocumentVersionIdentity        core.Identity
+	ExpectedRevision                      *int64
 }
 
 func (i DeleteProjectDocumentVersionInput) Validate() error {
@@ -67,34 +71,41 @@ func (s *DeleteProjectDocumentVersionService) Execute(input DeleteProjectDocumen
 		return core.NewNotFoundError("project not found")
 	}
 
-	projectDocumentVersion, err := s.ProjectDocumentRepository.GetProjectDocumentVersionBy(projectrepo.GetProjectDocumentVersionByParams{
-		ProjectDocumentVersionManagerIdentity: &input.ProjectDocumentVersionManagerIdentity,
-		ProjectDocumentVersionIdentity:        input.ProjectDocumentVersionIdentity,
+	projectDocumentVersionManager, err := s.ProjectDocumentRepository.GetProjectDocumentVersionManagerBy(projectrepo.GetProjectDocumentVersionManagerByParams{
+		ProjectDocumentVersionManagerIdentity: input.ProjectDocumentVersionManagerIdentity,
+		ForUpdate:                             true,
 	})
 	if err != nil {
 		tx.Rollback()
 		return err
 	}
 
-	if projectDocumentVersion == nil {
+	if projectDocumentVersionManager == nil || projectDocumentVersionManager.ProjectIdentity.Internal != input.ProjectIdentity.Internal {
 		tx.Rollback()
-		return core.NewNotFoundError("project document version not found")
+		return core.NewNotFoundError("project document version manager not found")
 	}
 
-	projectDocumentVersionManager, err := s.ProjectDocumentRepository.GetProjectDocumentVersionManagerBy(projectrepo.GetProjectDocumentVersionManagerByParams{
-		ProjectDocumentVersionManagerIdentity: projectDocumentVersion.ProjectDocumentVersionManagerIdentity,
+	projectDocumentVersion, err := s.ProjectDocumentRepository.GetProjectDocumentVersionBy(projectrepo.GetProjectDocumentVersionByParams{
+		ProjectDocumentVersionManagerIdentity: &projectDocumentVersionManager.Identity,
+		ProjectDocumentVersionIdentity:        input.ProjectDocumentVersionIdentity,
 	})
 	if err != nil {
 		tx.Rollback()
 		return err
 	}
 
-	if projectDocumentVersionManager == nil {
+	if projectDocumentVersion == nil {
+		tx.Rollback()
+		return core.NewNotFoundError("project document version not found")
+	}
+
+	err = core.CheckRevision(input.ExpectedRevision, projectDocumentVersion.Revision, "project document version")
+	if err != nil {
 		tx.Rollback()
-		return core.NewNotFoundError("project document version manager not found")
+		return err
 	}
 
-	sortBy := "created_at"
+	sortBy := "createdAt"
 	sortDirection := core.SortDirectionDesc
 
 	versions, err := s.ProjectDocumentRepository.ListProjectDocumentVersionsByProjectDocumentVersionManagerIdentity(projectrepo.ListProjectDocumentVersionsByProjectDocumentVersionManagerIdentityParams{
@@ -109,14 +120,6 @@ func (s *DeleteProjectDocumentVersionService) Execute(input DeleteProjectDocumen
 		return err
 	}
 
-	if len(versions) == 0 {
-		err = s.ProjectDocumentRepository.DeleteProjectDocumentVersionManager(projectrepo.DeleteProjectDocumentVersionManagerParams{ProjectDocumentVersionManagerIdentity: projectDocumentVersionManager.Identity})
-		if err != nil {
-			tx.Rollback()
-			return err
-		}
-	}
-
 	err = s.ProjectDocumentRepository.DeleteProjectDocumentVersion(projectrepo.DeleteProjectDocumentVersionParams{ProjectDocumentVersionIdentity: projectDocumentVersion.Identity})
 	if err != nil {
 		tx.Rollback()
@@ -126,6 +129,10 @@ func (s *DeleteProjectDocumentVersionService) Execute(input DeleteProjectDocumen
 	deleteFileService := storageservice.NewDeleteFileByIdentityService(s.UploadedFileRepository, s.StorageRepository)
 
 	for _, file := range projectDocumentVersion.Document.Files {
+		if isProjectDocumentFileSharedWithOtherVersions(versions, projectDocumentVersion.Identity, file.FileIdentity) {
+			continue
+		}
+
 		err = deleteFileService.Execute(file.FileIdentity)
 		if err != nil {
 			tx.Rollback()
@@ -133,16 +140,27 @@ func (s *DeleteProjectDocumentVersionService) Execute(input DeleteProjectDocumen
 		}
 	}
 
-	latestVersion := versions[0]
+	remainingVersions := slices.DeleteFunc(versions, func(version project.ProjectDocumentVersion) bool {
+		return version.Identity.Internal == projectDocumentVersion.Identity.Internal
+	})
 
-	latestVersion.Latest = true
+	if len(remainingVersions) == 0 {
+		err = s.ProjectDocumentRepository.DeleteProjectDocumentVersionManager(projectrepo.DeleteProjectDocumentVersionManagerParams{ProjectDocumentVersionManagerIdentity: projectDocumentVersionManager.Identity})
+		if err != nil {
+			tx.Rollback()
+			return err
+		}
+	} else if projectDocumentVersion.IsLatest() {
+		latestVersion := remainingVersions[0]
+		latestVersion.Latest = true
 
-	err = s.ProjectDocumentRepository.UpdateProjectDocumentVersion(projectrepo.UpdateProjectDocumentVersionParams{
-		ProjectDocumentVersion: &latestVersion,
-	})
-	if err != nil {
-		tx.Rollback()
-		return err
+		err = s.ProjectDocumentRepository.UpdateProjectDocumentVersion(projectrepo.UpdateProjectDocumentVersionParams{
+			ProjectDocumentVersion: &latestVersion,
+		})
+		if err != nil {
+			tx.Rollback()
+			return err
+		}
 	}
 
 	err = tx.Commit()
@@ -153,3 +171,13 @@ func (s *DeleteProjectDocumentVersionService) Execute(input DeleteProjectDocumen
 
 	return nil
 }
+
+/*
+isProjectDocumentFileSharedWithOtherVersions tells whether a version other than the given one still has the file, as
+new and restored versions keep the files of the version they come from.
+*/
+func isProjectDocumentFileSharedWithOtherVersions(versions []project.ProjectDocumentVersion, projectDocumentVersionIdentity core.Identity, fileIdentity core.Identity) bool {
+	return slices.ContainsFunc(versions, func(version project.ProjectDocumentVersion) bool {
+		return version.Identity.Internal != projectDocumentVersionIdentity.Internal && version.HasFile(fileIdentity)
+	})
+}
@@ -0,0 +1,78 @@
+package projectservice
+
+import (
+	"github.com/gabrielmrtt/taski/internal/core"
+	projectrepo "github.com/gabrielmrtt/taski/internal/project/repository"
+)
+
+type DeleteProjectTaskCustomFieldService struct {
+	ProjectRepository                projectrepo.ProjectRepository
+	ProjectTaskCustomFieldRepository projectrepo.ProjectTaskCustomFieldRepository
+	TransactionRepository            core.TransactionRepository
+}
+
+func NewDeleteProjectTaskCustomFieldService(
+	projectRepository projectrepo.ProjectRepository,
+	projectTaskCustomFieldRepository projectrepo.ProjectTaskCustomFieldRepository,
+	transactionRepository core.TransactionRepository,
+) *DeleteProjectTaskCustomFieldService {
+	return &DeleteProjectTaskCustomFieldService{
+		ProjectRepository:                projectRepository,
+		ProjectTaskCustomFieldRepository: projectTaskCustomFieldRepository,
+		TransactionRepository:            transactionRepository,
+	}
+}
+
+type DeleteProjectTaskCustomFieldInput struct {
+	OrganizationIdentity           core.Identity
+	ProjectIdentity                core.Identity
+	ProjectTaskCustomFieldIdentity core.Identity
+}
+
+func (i DeleteProjectTaskCustomFieldInput) Validate() error {
+	return nil
+}
+
+func (s *DeleteProjectTaskCustomFieldService) Execute(input DeleteProjectTaskCustomFieldInput) error {
+	if err := input.Validate(); err != nil {
+		return err
+	}
+
+	tx, err := s.TransactionRepository.BeginTransaction()
+	if err != nil {
+		return err
+	}
+
+	s.ProjectRepository.SetTransaction(tx)
+	s.ProjectTaskCustomFieldRepository.SetTransaction(tx)
+
+	projectTaskCustomField, err := s.ProjectTaskCustomFieldRepository.GetProjectTaskCustomFieldByIdentity(projectrepo.GetProjectTaskCustomFieldByIdentityParams{
+		ProjectTaskCustomFieldIdentity: input.ProjectTaskCustomFieldIdentity,
+		ProjectIdentity:                &input.ProjectIdentity,
+	})
+	if err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	if projectTaskCustomField == nil || projectTaskCustomField.IsDeleted() {
+		tx.Rollback()
+		return core.NewNotFoundError("project task custom field not found")
+	}
+
+	projectTaskCustomField.Delete()
+
+	err = s.ProjectTaskCustomFieldRepository.UpdateProjectTaskCustomField(projectrepo.UpdateProjectTaskCustomFieldParams{ProjectTaskCustomField: projectTaskCustomField})
+	if err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,92 @@
+package projectservice
+
+import (
+	"github.com/gabrielmrtt/taski/internal/core"
+	projectrepo "github.com/gabrielmrtt/taski/internal/project/repository"
+)
+
+type DeleteProjectTaskStatusTransitionService struct {
+	ProjectRepository                     projectrepo.ProjectRepository
+	ProjectTaskStatusTransitionRepository projectrepo.ProjectTaskStatusTransitionRepository
+	TransactionRepository                 core.TransactionRepository
+}
+
+func NewDeleteProjectTaskStatusTransitionService(
+	projectRepository projectrepo.ProjectRepository,
+	projectTaskStatusTransitionRepository projectrepo.ProjectTaskStatusTransitionRepository,
+	transactionRepository core.TransactionRepository,
+) *DeleteProjectTaskStatusTransitionService {
+	return &DeleteProjectTaskStatusTransitionService{
+		ProjectRepository:                     projectRepository,
+		ProjectTaskStatusTransitionRepository: projectTaskStatusTransitionRepository,
+		TransactionRepository:                 transactionRepository,
+	}
+}
+
+type DeleteProjectTaskStatusTransitionInput struct {
+	OrganizationIdentity                core.Identity
+	ProjectIdentity                     core.Identity
+	ProjectTaskStatusTransitionIdentity core.Identity
+}
+
+func (i DeleteProjectTaskStatusTransitionInput) Validate() error {
+	return nil
+}
+
+func (s *DeleteProjectTaskStatusTransitionService) Execute(input DeleteProjectTaskStatusTransitionInput) error {
+	if err := input.Validate(); err != nil {
+		return err
+	}
+
+	tx, err := s.TransactionRepository.BeginTransaction()
+	if err != nil {
+		return err
+	}
+
+	s.ProjectRepository.SetTransaction(tx)
+	s.ProjectTaskStatusTransitionRepository.SetTransaction(tx)
+
+	prj, err := s.ProjectRepository.GetProjectByIdentity(projectrepo.GetProjectByIdentityParams{
+		ProjectIdentity:      input.ProjectIdentity,
+		OrganizationIdentity: &input.OrganizationIdentity,
+	})
+	if err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	if prj == nil {
+		tx.Rollback()
+		return core.NewNotFoundError("project not found")
+	}
+
+	projectTaskStatusTransition, err := s.ProjectTaskStatusTransitionRepository.GetProjectTaskStatusTransitionByIdentity(projectrepo.GetProjectTaskStatusTransitionByIdentityParams{
+		ProjectTaskStatusTransitionIdentity: input.ProjectTaskStatusTransitionIdentity,
+		ProjectIdentity:                     &prj.Identity,
+	})
+	if err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	if projectTaskStatusTransition == nil {
+		tx.Rollback()
+		return core.NewNotFoundError("project task status transition not found")
+	}
+
+	err = s.ProjectTaskStatusTransitionRepository.DeleteProjectTaskStatusTransition(projectrepo.DeleteProjectTaskStatusTransitionParams{
+		ProjectTaskStatusTransitionIdentity: projectTaskStatusTransition.Identity,
+	})
+	if err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	return nil
+}
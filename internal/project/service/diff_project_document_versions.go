@@ -0,0 +1,112 @@
+package projectservice
+
+import (
+	"github.com/gabrielmrtt/taski/internal/core"
+	"github.com/gabrielmrtt/taski/internal/project"
+	projectrepo "github.com/gabrielmrtt/taski/internal/project/repository"
+)
+
+type DiffProjectDocumentVersionsService struct {
+	ProjectRepository         projectrepo.ProjectRepository
+	ProjectDocumentRepository projectrepo.ProjectDocumentRepository
+}
+
+func NewDiffProjectDocumentVersionsService(
+	projectRepository projectrepo.ProjectRepository,
+	projectDocumentRepository projectrepo.ProjectDocumentRepository,
+) *DiffProjectDocumentVersionsService {
+	return &DiffProjectDocumentVersionsService{
+		ProjectRepository:         projectRepository,
+		ProjectDocumentRepository: projectDocumentRepository,
+	}
+}
+
+type DiffProjectDocumentVersionsInput struct {
+	ProjectIdentity                       core.Identity
+	ProjectDocumentVersionManagerIdentity core.Identity
+	FromProjectDocumentVersionIdentity    core.Identity
+	ToProjectDocumentVersionIdentity      core.Identity
+}
+
+func (i DiffProjectDocumentVersionsInput) Validate() error {
+	var fields []core.InvalidInputErrorField
+
+	if i.FromProjectDocumentVersionIdentity.IsEmpty() {
+		fields = append(fields, core.InvalidInputErrorField{
+			Field: "from",
+			Error: "from must be a project document version id",
+		})
+	}
+
+	if i.ToProjectDocumentVersionIdentity.IsEmpty() {
+		fields = append(fields, core.InvalidInputErrorField{
+			Field: "to",
+			Error: "to must be a project document version id",
+		})
+	}
+
+	if len(fields) > 0 {
+		return core.NewInvalidInputError("invalid input", fields)
+	}
+
+	return nil
+}
+
+func (s *DiffProjectDocumentVersionsService) Execute(input DiffProjectDocumentVersionsInput) (*project.ProjectDocumentVersionDiffDto, error) {
+	if err := input.Validate(); err != nil {
+		return nil, err
+	}
+
+	prj, err := s.ProjectRepository.GetProjectByIdentity(projectrepo.GetProjectByIdentityParams{
+		ProjectIdentity: input.ProjectIdentity,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if prj == nil {
+		return nil, core.NewNotFoundError("project not found")
+	}
+
+	projectDocumentVersionManager, err := s.ProjectDocumentRepository.GetProjectDocumentVersionManagerBy(projectrepo.GetProjectDocumentVersionManagerByParams{
+		ProjectDocumentVersionManagerIdentity: input.ProjectDocumentVersionManagerIdentity,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if projectDocumentVersionManager == nil || projectDocumentVersionManager.ProjectIdentity.Internal != prj.Identity.Internal {
+		return nil, core.NewNotFoundError("project document not found")
+	}
+
+	fromProjectDocumentVersion, err := s.ProjectDocumentRepository.GetProjectDocumentVersionBy(projectrepo.GetProjectDocumentVersionByParams{
+		ProjectDocumentVersionManagerIdentity: &input.ProjectDocumentVersionManagerIdentity,
+		ProjectDocumentVersionIdentity:        input.FromProjectDocumentVersionIdentity,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if fromProjectDocumentVersion == nil {
+		return nil, core.NewNotFoundError("project document version not found")
+	}
+
+	toProjectDocumentVersion, err := s.ProjectDocumentRepository.GetProjectDocumentVersionBy(projectrepo.GetProjectDocumentVersionByParams{
+		ProjectDocumentVersionManagerIdentity: &input.ProjectDocumentVersionManagerIdentity,
+		ProjectDocumentVersionIdentity:        input.ToProjectDocumentVersionIdentity,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if toProjectDocumentVersion == nil {
+		return nil, core.NewNotFoundError("project document version not found")
+	}
+
+	projectDocumentVersionDiff, err := fromProjectDocumentVersion.DiffTo(toProjectDocumentVersion)
+	if err != nil {
+		return nil, err
+	}
+
+	return project.ProjectDocumentVersionDiffToDto(projectDocumentVersionDiff), nil
+}
@@ -0,0 +1,59 @@
+package projectservice
+
+import (
+	"github.com/gabrielmrtt/taski/internal/core"
+	"github.com/gabrielmrtt/taski/internal/project"
+	projectrepo "github.com/gabrielmrtt/taski/internal/project/repository"
+)
+
+type ListProjectTaskCustomFieldsService struct {
+	ProjectTaskCustomFieldRepository projectrepo.ProjectTaskCustomFieldRepository
+}
+
+func NewListProjectTaskCustomFieldsService(
+	projectTaskCustomFieldRepository projectrepo.ProjectTaskCustomFieldRepository,
+) *ListProjectTaskCustomFieldsService {
+	return &ListProjectTaskCustomFieldsService{
+		ProjectTaskCustomFieldRepository: projectTaskCustomFieldRepository,
+	}
+}
+
+type ListProjectTaskCustomFieldsInput struct {
+	Filters        projectrepo.ProjectTaskCustomFieldFilters
+	SortInput      core.SortInput
+	Pagination     core.PaginationInput
+	RelationsInput core.RelationsInput
+}
+
+func (i ListProjectTaskCustomFieldsInput) Validate() error {
+	return nil
+}
+
+func (s *ListProjectTaskCustomFieldsService) Execute(input ListProjectTaskCustomFieldsInput) (*core.PaginationOutput[project.ProjectTaskCustomFieldDto], error) {
+	if err := input.Validate(); err != nil {
+		return nil, err
+	}
+
+	projectTaskCustomFields, err := s.ProjectTaskCustomFieldRepository.PaginateProjectTaskCustomFieldsBy(projectrepo.PaginateProjectTaskCustomFieldsParams{
+		Filters:        input.Filters,
+		SortInput:      input.SortInput,
+		Pagination:     input.Pagination,
+		ShowDeleted:    false,
+		RelationsInput: input.RelationsInput,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var projectTaskCustomFieldsDto []project.ProjectTaskCustomFieldDto = make([]project.ProjectTaskCustomFieldDto, 0)
+	for _, projectTaskCustomField := range projectTaskCustomFields.Data {
+		projectTaskCustomFieldsDto = append(projectTaskCustomFieldsDto, *project.ProjectTaskCustomFieldToDto(&projectTaskCustomField))
+	}
+
+	return &core.PaginationOutput[project.ProjectTaskCustomFieldDto]{
+		Data:    projectTaskCustomFieldsDto,
+		Page:    projectTaskCustomFields.Page,
+		HasMore: projectTaskCustomFields.HasMore,
+		Total:   projectTaskCustomFields.Total,
+	}, nil
+}
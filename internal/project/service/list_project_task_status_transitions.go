@@ -0,0 +1,65 @@
+package projectservice
+
+import (
+	"github.com/gabrielmrtt/taski/internal/core"
+	"github.com/gabrielmrtt/taski/internal/project"
+	projectrepo "github.com/gabrielmrtt/taski/internal/project/repository"
+)
+
+type ListProjectTaskStatusTransitionsService struct {
+	ProjectRepository                     projectrepo.ProjectRepository
+	ProjectTaskStatusTransitionRepository projectrepo.ProjectTaskStatusTransitionRepository
+}
+
+func NewListProjectTaskStatusTransitionsService(
+	projectRepository projectrepo.ProjectRepository,
+	projectTaskStatusTransitionRepository projectrepo.ProjectTaskStatusTransitionRepository,
+) *ListProjectTaskStatusTransitionsService {
+	return &ListProjectTaskStatusTransitionsService{
+		ProjectRepository:                     projectRepository,
+		ProjectTaskStatusTransitionRepository: projectTaskStatusTransitionRepository,
+	}
+}
+
+type ListProjectTaskStatusTransitionsInput struct {
+	OrganizationIdentity core.Identity
+	ProjectIdentity      core.Identity
+}
+
+func (i ListProjectTaskStatusTransitionsInput) Validate() error {
+	return nil
+}
+
+func (s *ListProjectTaskStatusTransitionsService) Execute(input ListProjectTaskStatusTransitionsInput) ([]project.ProjectTaskStatusTransitionDto, error) {
+	if err := input.Validate(); err != nil {
+		return nil, err
+	}
+
+	prj, err := s.ProjectRepository.GetProjectByIdentity(projectrepo.GetProjectByIdentityParams{
+		ProjectIdentity:      input.ProjectIdentity,
+		OrganizationIdentity: &input.OrganizationIdentity,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if prj == nil {
+		return nil, core.NewNotFoundError("project not found")
+	}
+
+	projectTaskStatusTransitions, err := s.ProjectTaskStatusTransitionRepository.ListProjectTaskStatusTransitionsBy(projectrepo.ListProjectTaskStatusTransitionsByParams{
+		Filters: projectrepo.ProjectTaskStatusTransitionFilters{
+			ProjectIdentity: &prj.Identity,
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var projectTaskStatusTransitionsDto []project.ProjectTaskStatusTransitionDto = make([]project.ProjectTaskStatusTransitionDto, 0)
+	for _, projectTaskStatusTransition := range projectTaskStatusTransitions {
+		projectTaskStatusTransitionsDto = append(projectTaskStatusTransitionsDto, *project.ProjectTaskStatusTransitionToDto(&projectTaskStatusTransition))
+	}
+
+	return projectTaskStatusTransitionsDto, nil
+}
@@ -23,6 +23,7 @@ type ListProjectTaskStatusesInput struct {
 	SortInput      core.SortInput
 	Pagination     core.PaginationInput
 	RelationsInput core.RelationsInput
+	WithWip        bool
 }
 
 func (i ListProjectTaskStatusesInput) Validate() error {
@@ -50,6 +51,13 @@ func (s *ListProjectTaskStatusesService) Execute(input ListProjectTaskStatusesIn
 		projectTaskStatusesDto = append(projectTaskStatusesDto, *project.ProjectTaskStatusToDto(&projectTaskStatus))
 	}
 
+	if input.WithWip && input.Filters.ProjectIdentity != nil {
+		err = s.fillWip(*input.Filters.ProjectIdentity, projectTaskStatuses.Data, projectTaskStatusesDto)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	return &core.PaginationOutput[project.ProjectTaskStatusDto]{
 		Data:    projectTaskStatusesDto,
 		Page:    projectTaskStatuses.Page,
@@ -57,3 +65,54 @@ func (s *ListProjectTaskStatusesService) Execute(input ListProjectTaskStatusesIn
 		Total:   projectTaskStatuses.Total,
 	}, nil
 }
+
+/*
+fillWip sets the current task counts of each status, in total and per assignee, next to its work in progress limits.
+*/
+func (s *ListProjectTaskStatusesService) fillWip(projectIdentity core.Identity, projectTaskStatuses []project.ProjectTaskStatus, projectTaskStatusesDto []project.ProjectTaskStatusDto) error {
+	taskCounts, err := s.ProjectTaskStatusRepository.CountProjectTaskStatusTasks(projectrepo.CountProjectTaskStatusTasksParams{
+		ProjectIdentity: projectIdentity,
+	})
+	if err != nil {
+		return err
+	}
+
+	assigneeTaskCounts, err := s.ProjectTaskStatusRepository.CountProjectTaskStatusTasks(projectrepo.CountProjectTaskStatusTasksParams{
+		ProjectIdentity: projectIdentity,
+		ByAssignee:      true,
+	})
+	if err != nil {
+		return err
+	}
+
+	for i, projectTaskStatus := range projectTaskStatuses {
+		wip := &project.ProjectTaskStatusWipDto{
+			TaskCount: 0,
+			Assignees: make([]*project.ProjectTaskStatusAssigneeWipDto, 0),
+		}
+
+		for _, taskCount := range taskCounts {
+			if taskCount.ProjectTaskStatusIdentity.Equals(projectTaskStatus.Identity) {
+				wip.TaskCount = taskCount.TaskCount
+			}
+		}
+
+		wip.Exceeded = projectTaskStatus.ExceedsWipLimit(wip.TaskCount)
+
+		for _, taskCount := range assigneeTaskCounts {
+			if !taskCount.ProjectTaskStatusIdentity.Equals(projectTaskStatus.Identity) || taskCount.UserIdentity == nil {
+				continue
+			}
+
+			wip.Assignees = append(wip.Assignees, &project.ProjectTaskStatusAssigneeWipDto{
+				UserId:    taskCount.UserIdentity.Public,
+				TaskCount: taskCount.TaskCount,
+				Exceeded:  projectTaskStatus.ExceedsWipLimitPerAssignee(taskCount.TaskCount),
+			})
+		}
+
+		projectTaskStatusesDto[i].Wip = wip
+	}
+
+	return nil
+}
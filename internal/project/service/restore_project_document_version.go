@@ -0,0 +1,155 @@
+package projectservice
+
+import (
+	"slices"
+	"strings"
+
+	"github.com/gabrielmrtt/taski/internal/core"
+	"github.com/gabrielmrtt/taski/internal/project"
+	projectrepo "github.com/gabrielmrtt/taski/internal/project/repository"
+)
+
+type RestoreProjectDocumentVersionService struct {
+	ProjectRepository         projectrepo.ProjectRepository
+	ProjectDocumentRepository projectrepo.ProjectDocumentRepository
+	TransactionRepository     core.TransactionRepository
+}
+
+func NewRestoreProjectDocumentVersionService(
+	projectRepository projectrepo.ProjectRepository,
+	projectDocumentRepository projectrepo.ProjectDocumentRepository,
+	transactionRepository core.TransactionRepository,
+) *RestoreProjectDocumentVersionService {
+	return &RestoreProjectDocumentVersionService{
+		ProjectRepository:         projectRepository,
+		ProjectDocumentRepository: projectDocumentRepository,
+		TransactionRepository:     transactionRepository,
+	}
+}
+
+type RestoreProjectDocumentVersionInput struct {
+	ProjectIdentity                       core.Identity
+	ProjectDocumentVersionManagerIdentity core.Identity
+	ProjectDocumentVersionIdentity        core.Identity
+	Version                               *string
+	UserRestorerIdentity                  core.Identity
+}
+
+func (i RestoreProjectDocumentVersionInput) Validate() error {
+	var fields []core.InvalidInputErrorField
+
+	if i.Version != nil && strings.TrimSpace(*i.Version) == "" {
+		fields = append(fields, core.InvalidInputErrorField{
+			Field: "version",
+			Error: "version cannot be empty",
+		})
+	}
+
+	if len(fields) > 0 {
+		return core.NewInvalidInputError("invalid input", fields)
+	}
+
+	return nil
+}
+
+func (s *RestoreProjectDocumentVersionService) Execute(input RestoreProjectDocumentVersionInput) (*project.ProjectDocumentVersionDto, error) {
+	if err := input.Validate(); err != nil {
+		return nil, err
+	}
+
+	tx, err := s.TransactionRepository.BeginTransaction()
+	if err != nil {
+		return nil, err
+	}
+
+	s.ProjectRepository.SetTransaction(tx)
+	s.ProjectDocumentRepository.SetTransaction(tx)
+
+	prj, err := s.ProjectRepository.GetProjectByIdentity(projectrepo.GetProjectByIdentityParams{
+		ProjectIdentity: input.ProjectIdentity,
+	})
+	if err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+
+	if prj == nil {
+		tx.Rollback()
+		return nil, core.NewNotFoundError("project not found")
+	}
+
+	projectDocumentVersionManager, err := s.ProjectDocumentRepository.GetProjectDocumentVersionManagerBy(projectrepo.GetProjectDocumentVersionManagerByParams{
+		ProjectDocumentVersionManagerIdentity: input.ProjectDocumentVersionManagerIdentity,
+		ForUpdate:                             true,
+	})
+	if err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+
+	if projectDocumentVersionManager == nil || projectDocumentVersionManager.ProjectIdentity.Internal != input.ProjectIdentity.Internal {
+		tx.Rollback()
+		return nil, core.NewNotFoundError("project document version manager not found")
+	}
+
+	projectDocumentVersion, err := s.ProjectDocumentRepository.GetProjectDocumentVersionBy(projectrepo.GetProjectDocumentVersionByParams{
+		ProjectDocumentVersionManagerIdentity: &projectDocumentVersionManager.Identity,
+		ProjectDocumentVersionIdentity:        input.ProjectDocumentVersionIdentity,
+	})
+	if err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+
+	if projectDocumentVersion == nil {
+		tx.Rollback()
+		return nil, core.NewNotFoundError("project document version not found")
+	}
+
+	versions, err := s.ProjectDocumentRepository.ListProjectDocumentVersionsByProjectDocumentVersionManagerIdentity(projectrepo.ListProjectDocumentVersionsByProjectDocumentVersionManagerIdentityParams{
+		ProjectDocumentVersionManagerIdentity: projectDocumentVersionManager.Identity,
+	})
+	if err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+
+	var takenVersions []string = make([]string, len(versions))
+	for i, version := range versions {
+		takenVersions[i] = version.Version
+	}
+
+	var version string
+	if input.Version != nil {
+		version = strings.TrimSpace(*input.Version)
+
+		if slices.Contains(takenVersions, version) {
+			tx.Rollback()
+			return nil, core.NewConflictError("project document version " + version + " already exists")
+		}
+	} else {
+		version, err = projectDocumentVersionManager.NextVersion(takenVersions)
+		if err != nil {
+			tx.Rollback()
+			return nil, err
+		}
+	}
+
+	restoredProjectDocumentVersion := projectDocumentVersion.Restore(version, input.UserRestorerIdentity)
+
+	_, err = s.ProjectDocumentRepository.StoreProjectDocumentVersion(projectrepo.StoreProjectDocumentVersionParams{
+		ProjectDocumentVersion: restoredProjectDocumentVersion,
+	})
+	if err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+
+	return project.ProjectDocumentVersionToDto(restoredProjectDocumentVersion), nil
+}
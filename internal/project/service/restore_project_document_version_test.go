@@ -0,0 +1,206 @@
+package projectservice
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/gabrielmrtt/taski/internal/core"
+	"github.com/gabrielmrtt/taski/internal/project"
+	projectrepo "github.com/gabrielmrtt/taski/internal/project/repository"
+)
+
+type memoryTransaction struct {
+	committed  bool
+	rolledBack bool
+}
+
+func (t *memoryTransaction) Commit() error {
+	t.committed = true
+	return nil
+}
+
+func (t *memoryTransaction) Rollback() error {
+	t.rolledBack = true
+	return nil
+}
+
+func (t *memoryTransaction) IsClosed() bool                        { return t.committed || t.rolledBack }
+func (t *memoryTransaction) Savepoint(name string) error           { return nil }
+func (t *memoryTransaction) RollbackToSavepoint(name string) error { return nil }
+func (t *memoryTransaction) ReleaseSavepoint(name string) error    { return nil }
+
+type memoryTransactionRepository struct {
+	transactions []*memoryTransaction
+}
+
+func (r *memoryTransactionRepository) BeginTransaction() (core.Transaction, error) {
+	tx := &memoryTransaction{}
+	r.transactions = append(r.transactions, tx)
+	return tx, nil
+}
+
+func (r *memoryTransactionRepository) last() *memoryTransaction {
+	return r.transactions[len(r.transactions)-1]
+}
+
+type memoryProjectRepository struct {
+	projectrepo.ProjectRepository
+	projects []*project.Project
+}
+
+func (r *memoryProjectRepository) SetTransaction(tx core.Transaction) error { return nil }
+
+func (r *memoryProjectRepository) GetProjectByIdentity(params projectrepo.GetProjectByIdentityParams) (*project.Project, error) {
+	for _, prj := range r.projects {
+		if prj.Identity.Equals(params.ProjectIdentity) {
+			return prj, nil
+		}
+	}
+
+	return nil, nil
+}
+
+// memoryProjectDocumentRepository keeps a single latest version per document the way the database does
+type memoryProjectDocumentRepository struct {
+	projectrepo.ProjectDocumentRepository
+	manager  project.ProjectDocumentVersionManager
+	versions []*project.ProjectDocumentVersion
+}
+
+func (r *memoryProjectDocumentRepository) SetTransaction(tx core.Transaction) error { return nil }
+
+func (r *memoryProjectDocumentRepository) GetProjectDocumentVersionManagerBy(params projectrepo.GetProjectDocumentVersionManagerByParams) (*project.ProjectDocumentVersionManager, error) {
+	if !r.manager.Identity.Equals(params.ProjectDocumentVersionManagerIdentity) {
+		return nil, nil
+	}
+
+	manager := r.manager
+	for _, version := range r.versions {
+		if version.IsLatest() {
+			manager.LatestVersion = version
+		}
+	}
+
+	return &manager, nil
+}
+
+func (r *memoryProjectDocumentRepository) GetProjectDocumentVersionBy(params projectrepo.GetProjectDocumentVersionByParams) (*project.ProjectDocumentVersion, error) {
+	for _, version := range r.versions {
+		if version.Identity.Equals(params.ProjectDocumentVersionIdentity) {
+			return version, nil
+		}
+	}
+
+	return nil, nil
+}
+
+func (r *memoryProjectDocumentRepository) ListProjectDocumentVersionsByProjectDocumentVersionManagerIdentity(params projectrepo.ListProjectDocumentVersionsByProjectDocumentVersionManagerIdentityParams) ([]project.ProjectDocumentVersion, error) {
+	var versions []project.ProjectDocumentVersion = make([]project.ProjectDocumentVersion, 0)
+	for _, version := range r.versions {
+		versions = append(versions, *version)
+	}
+
+	return versions, nil
+}
+
+func (r *memoryProjectDocumentRepository) StoreProjectDocumentVersion(params projectrepo.StoreProjectDocumentVersionParams) (*project.ProjectDocumentVersion, error) {
+	if params.ProjectDocumentVersion.IsLatest() {
+		for _, version := range r.versions {
+			version.Latest = false
+		}
+	}
+
+	r.versions = append(r.versions, params.ProjectDocumentVersion)
+	return params.ProjectDocumentVersion, nil
+}
+
+func TestRestoreProjectDocumentVersion(t *testing.T) {
+	explicit := func(version string) *string { return &version }
+
+	tests := []struct {
+		name     string
+		version  *string
+		expected string
+		conflict bool
+	}{
+		{name: "bumps the latest version", expected: "1.2"},
+		{name: "explicit version", version: explicit(" final "), expected: "final"},
+		{name: "explicit version already taken", version: explicit("1.0"), conflict: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			prj := &project.Project{Identity: core.NewIdentity("prj")}
+			manager := project.ProjectDocumentVersionManager{
+				Identity:        core.NewIdentity("pdm"),
+				ProjectIdentity: prj.Identity,
+				VersionScheme:   project.ProjectDocumentVersionSchemeSemantic,
+			}
+			creator := core.NewIdentity("usr")
+
+			first := &project.ProjectDocumentVersion{
+				Identity:                              core.NewIdentity(project.ProjectDocumentVersionIdentityPrefix),
+				ProjectDocumentVersionManagerIdentity: manager.Identity,
+				Version:                               "1.0",
+				Document:                              project.ProjectDocument{Title: "Specification", Content: "first draft"},
+				UserCreatorIdentity:                   &creator,
+			}
+			second := &project.ProjectDocumentVersion{
+				Identity:                              core.NewIdentity(project.ProjectDocumentVersionIdentityPrefix),
+				ProjectDocumentVersionManagerIdentity: manager.Identity,
+				Version:                               "1.1",
+				Document:                              project.ProjectDocument{Title: "Specification", Content: "second draft"},
+				UserCreatorIdentity:                   &creator,
+				Latest:                                true,
+			}
+
+			documentRepository := &memoryProjectDocumentRepository{manager: manager, versions: []*project.ProjectDocumentVersion{first, second}}
+			transactionRepository := &memoryTransactionRepository{}
+			service := NewRestoreProjectDocumentVersionService(&memoryProjectRepository{projects: []*project.Project{prj}}, documentRepository, transactionRepository)
+
+			restored, err := service.Execute(RestoreProjectDocumentVersionInput{
+				ProjectIdentity:                       prj.Identity,
+				ProjectDocumentVersionManagerIdentity: manager.Identity,
+				ProjectDocumentVersionIdentity:        first.Identity,
+				Version:                               tt.version,
+				UserRestorerIdentity:                  core.NewIdentity("usr"),
+			})
+
+			if tt.conflict {
+				var conflictError *core.ConflictError
+				if !errors.As(err, &conflictError) {
+					t.Fatalf("expected a conflict error, got %v", err)
+				}
+
+				if !transactionRepository.last().rolledBack || len(documentRepository.versions) != 2 || !second.IsLatest() {
+					t.Errorf("expected the versions to be left untouched")
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if !transactionRepository.last().committed {
+				t.Fatal("expected the transaction to be committed")
+			}
+
+			if restored.Version != tt.expected || restored.Content != "first draft" {
+				t.Errorf("expected version %q with the restored content, got %q with %q", tt.expected, restored.Version, restored.Content)
+			}
+
+			var latest []string
+			for _, version := range documentRepository.versions {
+				if version.IsLatest() {
+					latest = append(latest, version.Version)
+				}
+			}
+
+			if len(latest) != 1 || latest[0] != tt.expected {
+				t.Errorf("expected %q to be the only latest version, got %v", tt.expected, latest)
+			}
+		})
+	}
+}
@@ -33,6 +33,7 @@ type UpdateProjectInput struct {
 	PriorityLevel        *project.ProjectPriorityLevels
 	StartAt              *core.DateTime
 	EndAt                *core.DateTime
+	ExpectedRevision     *int64
 }
 
 func (i UpdateProjectInput) Validate() error {
@@ -100,6 +101,11 @@ func (s *UpdateProjectService) Execute(input UpdateProjectInput) error {
 		return core.NewNotFoundError("project not found")
 	}
 
+	err = core.CheckRevision(input.ExpectedRevision, project.Revision, "project")
+	if err != nil {
+		return err
+	}
+
 	if input.Name != nil {
 		err = project.ChangeName(*input.Name, &input.UserEditorIdentity)
 		if err != nil {
@@ -1,6 +1,8 @@
 package projectservice
 
 import (
+	"slices"
+
 	"github.com/gabrielmrtt/taski/internal/core"
 	"github.com/gabrielmrtt/taski/internal/project"
 	projectrepo "github.com/gabrielmrtt/taski/internal/project/repository"
@@ -37,10 +39,13 @@ type UpdateProjectDocumentInput struct {
 	ProjectDocumentVersionManagerIdentity core.Identity
 	ProjectDocumentVersionIdentity        core.Identity
 	Version                               *string
+	VersionScheme                         *project.ProjectDocumentVersionSchemes
 	Title                                 *string
 	Content                               *string
+	ContentFormat                         *core.ContentFormats
 	Files                                 []core.FileInput
 	UserEditorIdentity                    core.Identity
+	ExpectedRevision                      *int64
 }
 
 func (i UpdateProjectDocumentInput) Validate() error {
@@ -66,6 +71,22 @@ func (i UpdateProjectDocumentInput) Validate() error {
 		}
 	}
 
+	if i.ContentFormat != nil {
+		if _, err := core.NewContent("", *i.ContentFormat); err != nil {
+			fields = append(fields, core.InvalidInputErrorField{
+				Field: "content format",
+				Error: err.Error(),
+			})
+		}
+	}
+
+	if i.VersionScheme != nil && !slices.Contains(project.ProjectDocumentVersionSchemesArray, *i.VersionScheme) {
+		fields = append(fields, core.InvalidInputErrorField{
+			Field: "versionScheme",
+			Error: "version scheme is not supported",
+		})
+	}
+
 	if len(fields) > 0 {
 		return core.NewInvalidInputError("invalid input", fields)
 	}
@@ -99,6 +120,36 @@ func (s *UpdateProjectDocumentService) Execute(input UpdateProjectDocumentInput)
 		return nil, core.NewNotFoundError("project not found")
 	}
 
+	projectDocumentVersionManager, err := s.ProjectDocumentRepository.GetProjectDocumentVersionManagerBy(projectrepo.GetProjectDocumentVersionManagerByParams{
+		ProjectDocumentVersionManagerIdentity: input.ProjectDocumentVersionManagerIdentity,
+		ForUpdate:                             true,
+	})
+	if err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+
+	if projectDocumentVersionManager == nil || projectDocumentVersionManager.ProjectIdentity.Internal != input.ProjectIdentity.Internal {
+		tx.Rollback()
+		return nil, core.NewNotFoundError("project document version manager not found")
+	}
+
+	if input.VersionScheme != nil {
+		err = projectDocumentVersionManager.ChangeVersionScheme(*input.VersionScheme)
+		if err != nil {
+			tx.Rollback()
+			return nil, err
+		}
+
+		err = s.ProjectDocumentRepository.UpdateProjectDocumentVersionManager(projectrepo.UpdateProjectDocumentVersionManagerParams{
+			ProjectDocumentVersionManager: projectDocumentVersionManager,
+		})
+		if err != nil {
+			tx.Rollback()
+			return nil, err
+		}
+	}
+
 	projectDocumentVersion, err := s.ProjectDocumentRepository.GetProjectDocumentVersionBy(projectrepo.GetProjectDocumentVersionByParams{
 		ProjectDocumentVersionManagerIdentity: &input.ProjectDocumentVersionManagerIdentity,
 		ProjectDocumentVersionIdentity:        input.ProjectDocumentVersionIdentity,
@@ -113,6 +164,12 @@ func (s *UpdateProjectDocumentService) Execute(input UpdateProjectDocumentInput)
 		return nil, core.NewNotFoundError("project document version not found")
 	}
 
+	err = core.CheckRevision(input.ExpectedRevision, projectDocumentVersion.Revision, "project document version")
+	if err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+
 	if input.Version != nil {
 		projectDocumentVersion = projectDocumentVersion.NewVersion(*input.Version)
 	}
@@ -125,8 +182,32 @@ func (s *UpdateProjectDocumentService) Execute(input UpdateProjectDocumentInput)
 		}
 	}
 
-	if input.Content != nil {
-		err = projectDocumentVersion.ChangeContent(*input.Content, &input.UserEditorIdentity)
+	if input.Content != nil || input.ContentFormat != nil {
+		previousContent := projectDocumentVersion.Document.FormattedContent()
+
+		content := projectDocumentVersion.Document.Content
+		if input.Content != nil {
+			content = *input.Content
+		}
+
+		var contentFormat core.ContentFormats = ""
+		if input.ContentFormat != nil {
+			contentFormat = *input.ContentFormat
+		}
+
+		err = projectDocumentVersion.ChangeContent(content, contentFormat, &input.UserEditorIdentity)
+		if err != nil {
+			tx.Rollback()
+			return nil, err
+		}
+
+		checkEmbeddedFilesService := storageservice.NewCheckEmbeddedFilesService(s.UploadedFileRepository)
+		err = checkEmbeddedFilesService.Execute(storageservice.CheckEmbeddedFilesInput{
+			Field:           "content",
+			Content:         projectDocumentVersion.Document.FormattedContent(),
+			PreviousContent: &previousContent,
+			UserIdentity:    input.UserEditorIdentity,
+		})
 		if err != nil {
 			tx.Rollback()
 			return nil, err
@@ -138,7 +219,19 @@ func (s *UpdateProjectDocumentService) Execute(input UpdateProjectDocumentInput)
 		deleteFileService := storageservice.NewDeleteFileByIdentityService(s.UploadedFileRepository, s.StorageRepository)
 
 		if input.Version == nil {
+			versions, err := s.ProjectDocumentRepository.ListProjectDocumentVersionsByProjectDocumentVersionManagerIdentity(projectrepo.ListProjectDocumentVersionsByProjectDocumentVersionManagerIdentityParams{
+				ProjectDocumentVersionManagerIdentity: projectDocumentVersionManager.Identity,
+			})
+			if err != nil {
+				tx.Rollback()
+				return nil, err
+			}
+
 			for _, file := range projectDocumentVersion.Document.Files {
+				if isProjectDocumentFileSharedWithOtherVersions(versions, projectDocumentVersion.Identity, file.FileIdentity) {
+					continue
+				}
+
 				err = deleteFileService.Execute(file.FileIdentity)
 				if err != nil {
 					tx.Rollback()
@@ -0,0 +1,129 @@
+package projectservice
+
+import (
+	"github.com/gabrielmrtt/taski/internal/core"
+	projectrepo "github.com/gabrielmrtt/taski/internal/project/repository"
+)
+
+type UpdateProjectTaskCustomFieldService struct {
+	ProjectRepository                projectrepo.ProjectRepository
+	ProjectTaskCustomFieldRepository projectrepo.ProjectTaskCustomFieldRepository
+	TransactionRepository            core.TransactionRepository
+}
+
+func NewUpdateProjectTaskCustomFieldService(
+	projectRepository projectrepo.ProjectRepository,
+	projectTaskCustomFieldRepository projectrepo.ProjectTaskCustomFieldRepository,
+	transactionRepository core.TransactionRepository,
+) *UpdateProjectTaskCustomFieldService {
+	return &UpdateProjectTaskCustomFieldService{
+		ProjectRepository:                projectRepository,
+		ProjectTaskCustomFieldRepository: projectTaskCustomFieldRepository,
+		TransactionRepository:            transactionRepository,
+	}
+}
+
+type UpdateProjectTaskCustomFieldInput struct {
+	OrganizationIdentity           core.Identity
+	ProjectIdentity                core.Identity
+	ProjectTaskCustomFieldIdentity core.Identity
+	Name                           *string
+	Options                        *[]string
+	IsRequired                     *bool
+}
+
+func (i UpdateProjectTaskCustomFieldInput) Validate() error {
+	var fields []core.InvalidInputErrorField
+
+	if i.Name != nil {
+		_, err := core.NewName(*i.Name)
+		if err != nil {
+			fields = append(fields, core.InvalidInputErrorField{
+				Field: "name",
+				Error: err.Error(),
+			})
+		}
+	}
+
+	if len(fields) > 0 {
+		return core.NewInvalidInputError("invalid input", fields)
+	}
+
+	return nil
+}
+
+func (s *UpdateProjectTaskCustomFieldService) Execute(input UpdateProjectTaskCustomFieldInput) error {
+	if err := input.Validate(); err != nil {
+		return err
+	}
+
+	tx, err := s.TransactionRepository.BeginTransaction()
+	if err != nil {
+		return err
+	}
+
+	s.ProjectRepository.SetTransaction(tx)
+	s.ProjectTaskCustomFieldRepository.SetTransaction(tx)
+
+	prj, err := s.ProjectRepository.GetProjectByIdentity(projectrepo.GetProjectByIdentityParams{
+		ProjectIdentity:      input.ProjectIdentity,
+		OrganizationIdentity: &input.OrganizationIdentity,
+	})
+	if err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	if prj == nil {
+		tx.Rollback()
+		return core.NewNotFoundError("project not found")
+	}
+
+	projectTaskCustomField, err := s.ProjectTaskCustomFieldRepository.GetProjectTaskCustomFieldByIdentity(projectrepo.GetProjectTaskCustomFieldByIdentityParams{
+		ProjectTaskCustomFieldIdentity: input.ProjectTaskCustomFieldIdentity,
+		ProjectIdentity:                &input.ProjectIdentity,
+	})
+	if err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	if projectTaskCustomField == nil || projectTaskCustomField.IsDeleted() {
+		tx.Rollback()
+		return core.NewNotFoundError("project task custom field not found")
+	}
+
+	if input.Name != nil {
+		err = projectTaskCustomField.ChangeName(*input.Name)
+		if err != nil {
+			tx.Rollback()
+			return err
+		}
+	}
+
+	if input.Options != nil {
+		err = projectTaskCustomField.ChangeOptions(*input.Options)
+		if err != nil {
+			tx.Rollback()
+			return err
+		}
+	}
+
+	if input.IsRequired != nil {
+		projectTaskCustomField.SetIsRequired(*input.IsRequired)
+	}
+
+	err = s.ProjectTaskCustomFieldRepository.UpdateProjectTaskCustomField(projectrepo.UpdateProjectTaskCustomFieldParams{ProjectTaskCustomField: projectTaskCustomField})
+	if err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	return nil
+}
@@ -1,7 +1,10 @@
 package projectservice
 
 import (
+	"slices"
+
 	"github.com/gabrielmrtt/taski/internal/core"
+	"github.com/gabrielmrtt/taski/internal/project"
 	projectrepo "github.com/gabrielmrtt/taski/internal/project/repository"
 )
 
@@ -32,6 +35,9 @@ type UpdateProjectTaskStatusInput struct {
 	Order                     *int8
 	ShouldSetTaskToCompleted  *bool
 	IsDefault                 *bool
+	WipLimit                  *int16
+	WipLimitPerAssignee       *int16
+	WipLimitPolicy            *project.ProjectTaskStatusWipLimitPolicies
 }
 
 func (i UpdateProjectTaskStatusInput) Validate() error {
@@ -68,6 +74,27 @@ func (i UpdateProjectTaskStatusInput) Validate() error {
 		}
 	}
 
+	if i.WipLimit != nil && *i.WipLimit < 0 {
+		fields = append(fields, core.InvalidInputErrorField{
+			Field: "wip_limit",
+			Error: "wip limit cannot be negative",
+		})
+	}
+
+	if i.WipLimitPerAssignee != nil && *i.WipLimitPerAssignee < 0 {
+		fields = append(fields, core.InvalidInputErrorField{
+			Field: "wip_limit_per_assignee",
+			Error: "wip limit per assignee cannot be negative",
+		})
+	}
+
+	if i.WipLimitPolicy != nil && !slices.Contains(project.ProjectTaskStatusWipLimitPoliciesArray, *i.WipLimitPolicy) {
+		fields = append(fields, core.InvalidInputErrorField{
+			Field: "wip_limit_policy",
+			Error: "wip limit policy must be reject or warn",
+		})
+	}
+
 	if len(fields) > 0 {
 		return core.NewInvalidInputError("invalid input", fields)
 	}
@@ -132,6 +159,22 @@ func (s *UpdateProjectTaskStatusService) Execute(input UpdateProjectTaskStatusIn
 		}
 	}
 
+	if input.WipLimit != nil || input.WipLimitPerAssignee != nil {
+		err = projectTaskStatus.ChangeWipLimit(resolveWipLimit(projectTaskStatus.WipLimit, input.WipLimit), resolveWipLimit(projectTaskStatus.WipLimitPerAssignee, input.WipLimitPerAssignee))
+		if err != nil {
+			tx.Rollback()
+			return err
+		}
+	}
+
+	if input.WipLimitPolicy != nil {
+		err = projectTaskStatus.ChangeWipLimitPolicy(*input.WipLimitPolicy)
+		if err != nil {
+			tx.Rollback()
+			return err
+		}
+	}
+
 	if input.Order != nil {
 		err = projectTaskStatus.ChangeOrder(*input.Order)
 		if err != nil {
@@ -187,3 +230,18 @@ func (s *UpdateProjectTaskStatusService) Execute(input UpdateProjectTaskStatusIn
 
 	return nil
 }
+
+/*
+resolveWipLimit keeps the current limit when no value is given and removes it when the value is zero.
+*/
+func resolveWipLimit(current *int16, value *int16) *int16 {
+	if value == nil {
+		return current
+	}
+
+	if *value == 0 {
+		return nil
+	}
+
+	return value
+}
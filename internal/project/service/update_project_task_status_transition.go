@@ -0,0 +1,135 @@
+package projectservice
+
+import (
+	"slices"
+
+	"github.com/gabrielmrtt/taski/internal/core"
+	"github.com/gabrielmrtt/taski/internal/project"
+	projectrepo "github.com/gabrielmrtt/taski/internal/project/repository"
+	rolerepo "github.com/gabrielmrtt/taski/internal/role/repository"
+)
+
+type UpdateProjectTaskStatusTransitionService struct {
+	ProjectRepository                     projectrepo.ProjectRepository
+	ProjectTaskStatusTransitionRepository projectrepo.ProjectTaskStatusTransitionRepository
+	RoleRepository                        rolerepo.RoleRepository
+	TransactionRepository                 core.TransactionRepository
+}
+
+func NewUpdateProjectTaskStatusTransitionService(
+	projectRepository projectrepo.ProjectRepository,
+	projectTaskStatusTransitionRepository projectrepo.ProjectTaskStatusTransitionRepository,
+	roleRepository rolerepo.RoleRepository,
+	transactionRepository core.TransactionRepository,
+) *UpdateProjectTaskStatusTransitionService {
+	return &UpdateProjectTaskStatusTransitionService{
+		ProjectRepository:                     projectRepository,
+		ProjectTaskStatusTransitionRepository: projectTaskStatusTransitionRepository,
+		RoleRepository:                        roleRepository,
+		TransactionRepository:                 transactionRepository,
+	}
+}
+
+type UpdateProjectTaskStatusTransitionInput struct {
+	OrganizationIdentity                core.Identity
+	ProjectIdentity                     core.Identity
+	ProjectTaskStatusTransitionIdentity core.Identity
+	RoleIdentities                      *[]core.Identity
+	RequiredFields                      *[]project.ProjectTaskStatusTransitionRequiredFields
+}
+
+func (i UpdateProjectTaskStatusTransitionInput) Validate() error {
+	var fields []core.InvalidInputErrorField
+
+	if i.RequiredFields != nil {
+		for _, requiredField := range *i.RequiredFields {
+			if !slices.Contains(project.ProjectTaskStatusTransitionRequiredFieldsArray, requiredField) {
+				fields = append(fields, core.InvalidInputErrorField{
+					Field: "requiredFields",
+					Error: "required field " + string(requiredField) + " is not supported",
+				})
+			}
+		}
+	}
+
+	if len(fields) > 0 {
+		return core.NewInvalidInputError("invalid input", fields)
+	}
+
+	return nil
+}
+
+func (s *UpdateProjectTaskStatusTransitionService) Execute(input UpdateProjectTaskStatusTransitionInput) error {
+	if err := input.Validate(); err != nil {
+		return err
+	}
+
+	tx, err := s.TransactionRepository.BeginTransaction()
+	if err != nil {
+		return err
+	}
+
+	s.ProjectRepository.SetTransaction(tx)
+	s.ProjectTaskStatusTransitionRepository.SetTransaction(tx)
+	s.RoleRepository.SetTransaction(tx)
+
+	prj, err := s.ProjectRepository.GetProjectByIdentity(projectrepo.GetProjectByIdentityParams{
+		ProjectIdentity:      input.ProjectIdentity,
+		OrganizationIdentity: &input.OrganizationIdentity,
+	})
+	if err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	if prj == nil {
+		tx.Rollback()
+		return core.NewNotFoundError("project not found")
+	}
+
+	projectTaskStatusTransition, err := s.ProjectTaskStatusTransitionRepository.GetProjectTaskStatusTransitionByIdentity(projectrepo.GetProjectTaskStatusTransitionByIdentityParams{
+		ProjectTaskStatusTransitionIdentity: input.ProjectTaskStatusTransitionIdentity,
+		ProjectIdentity:                     &prj.Identity,
+	})
+	if err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	if projectTaskStatusTransition == nil {
+		tx.Rollback()
+		return core.NewNotFoundError("project task status transition not found")
+	}
+
+	if input.RoleIdentities != nil {
+		err = validateProjectTaskStatusTransitionRoles(s.RoleRepository, input.OrganizationIdentity, *input.RoleIdentities)
+		if err != nil {
+			tx.Rollback()
+			return err
+		}
+
+		projectTaskStatusTransition.ChangeRoles(*input.RoleIdentities)
+	}
+
+	if input.RequiredFields != nil {
+		err = projectTaskStatusTransition.ChangeRequiredFields(*input.RequiredFields)
+		if err != nil {
+			tx.Rollback()
+			return err
+		}
+	}
+
+	err = s.ProjectTaskStatusTransitionRepository.UpdateProjectTaskStatusTransition(projectrepo.UpdateProjectTaskStatusTransitionParams{ProjectTaskStatusTransition: projectTaskStatusTransition})
+	if err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	return nil
+}
@@ -1,6 +1,8 @@
 package project
 
 import (
+	"regexp"
+	"strconv"
 	"strings"
 
 	"github.com/gabrielmrtt/taski/internal/core"
@@ -79,3 +81,61 @@ func (c ProjectDocumentContent) Validate() error {
 
 	return nil
 }
+
+var semanticVersionRegex = regexp.MustCompile(`^(v?)(\d+)(?:\.(\d+))?(?:\.(\d+))?$`)
+
+var incrementingVersionRegex = regexp.MustCompile(`^(.*?)(\d+)$`)
+
+/*
+Bump returns the version that follows version in the scheme. Semantic versions have their minor component bumped and
+their patch reset, or their major bumped when they have no minor. Incrementing versions have their trailing number
+bumped, keeping any prefix and zero padding.
+*/
+func (s ProjectDocumentVersionSchemes) Bump(version string) (string, error) {
+	switch s {
+	case ProjectDocumentVersionSchemeSemantic:
+		matches := semanticVersionRegex.FindStringSubmatch(version)
+		if matches == nil {
+			break
+		}
+
+		if matches[3] == "" {
+			return matches[1] + bumpVersionNumber(matches[2]), nil
+		}
+
+		bumped := matches[1] + matches[2] + "." + bumpVersionNumber(matches[3])
+		if matches[4] != "" {
+			bumped += ".0"
+		}
+
+		return bumped, nil
+	case ProjectDocumentVersionSchemeIncrementing:
+		matches := incrementingVersionRegex.FindStringSubmatch(version)
+		if matches == nil {
+			break
+		}
+
+		return matches[1] + bumpVersionNumber(matches[2]), nil
+	}
+
+	return "", core.NewInvalidInputError("version cannot be bumped", []core.InvalidInputErrorField{
+		{
+			Field: "version",
+			Error: "version " + version + " does not follow the " + string(s) + " version scheme, an explicit version is required",
+		},
+	})
+}
+
+func bumpVersionNumber(number string) string {
+	value, err := strconv.ParseUint(number, 10, 64)
+	if err != nil {
+		return number + "1"
+	}
+
+	bumped := strconv.FormatUint(value+1, 10)
+	if len(bumped) < len(number) {
+		bumped = strings.Repeat("0", len(number)-len(bumped)) + bumped
+	}
+
+	return bumped
+}
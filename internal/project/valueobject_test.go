@@ -0,0 +1,53 @@
+package project
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/gabrielmrtt/taski/internal/core"
+)
+
+func TestProjectDocumentVersionSchemeBump(t *testing.T) {
+	tests := []struct {
+		name     string
+		scheme   ProjectDocumentVersionSchemes
+		version  string
+		expected string
+		invalid  bool
+	}{
+		{name: "semantic major", scheme: ProjectDocumentVersionSchemeSemantic, version: "1", expected: "2"},
+		{name: "semantic minor", scheme: ProjectDocumentVersionSchemeSemantic, version: "1.0", expected: "1.1"},
+		{name: "semantic patch is reset", scheme: ProjectDocumentVersionSchemeSemantic, version: "1.2.3", expected: "1.3.0"},
+		{name: "semantic prefix is kept", scheme: ProjectDocumentVersionSchemeSemantic, version: "v2", expected: "v3"},
+		{name: "semantic padding is kept", scheme: ProjectDocumentVersionSchemeSemantic, version: "1.09", expected: "1.10"},
+		{name: "semantic free text", scheme: ProjectDocumentVersionSchemeSemantic, version: "draft", invalid: true},
+		{name: "incrementing number", scheme: ProjectDocumentVersionSchemeIncrementing, version: "3", expected: "4"},
+		{name: "incrementing prefix and padding are kept", scheme: ProjectDocumentVersionSchemeIncrementing, version: "rev-009", expected: "rev-010"},
+		{name: "incrementing trailing number only", scheme: ProjectDocumentVersionSchemeIncrementing, version: "v1.9", expected: "v1.10"},
+		{name: "incrementing without number", scheme: ProjectDocumentVersionSchemeIncrementing, version: "draft", invalid: true},
+		{name: "unknown scheme", scheme: "calendar", version: "1", invalid: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.scheme.Bump(tt.version)
+
+			if tt.invalid {
+				var invalidInputError *core.InvalidInputError
+				if !errors.As(err, &invalidInputError) {
+					t.Fatalf("expected an invalid input error, got %q and %v", got, err)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if got != tt.expected {
+				t.Errorf("expected %q, got %q", tt.expected, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,16 @@
+package realtime
+
+/*
+RealtimeSubscription receives the events matching its filter until it is closed. Events is closed when the
+subscription ends, either by Close or because the subscriber fell too far behind. SetFilter replaces the filter of
+an open subscription, so a subscriber whose access changed stops receiving the events it can no longer see.
+*/
+type RealtimeSubscription interface {
+	Events() <-chan RealtimeEventDto
+	SetFilter(filter RealtimeSubscriptionFilter)
+	Close()
+}
+
+type RealtimeBroker interface {
+	Subscribe(filter RealtimeSubscriptionFilter) RealtimeSubscription
+}
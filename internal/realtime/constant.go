@@ -0,0 +1,14 @@
+package realtime
+
+const RealtimeEventIdentityPrefix = "rte"
+
+/*
+RealtimeNotificationChannel is the Postgres LISTEN/NOTIFY channel realtime events are fanned out through, so every
+API replica receives the events committed by the others.
+*/
+const RealtimeNotificationChannel = "taski_realtime_events"
+
+const (
+	RealtimeHeartbeatSeconds     = 15
+	RealtimeSubscriberBufferSize = 64
+)
@@ -0,0 +1,38 @@
+package realtime
+
+type RealtimeEventDto struct {
+	Id             string  `json:"id"`
+	Type           string  `json:"type"`
+	OrganizationId string  `json:"organizationId"`
+	ProjectId      *string `json:"projectId"`
+	TaskId         *string `json:"taskId"`
+	UserId         *string `json:"userId"`
+	CreatedAt      string  `json:"createdAt"`
+}
+
+func RealtimeEventToDto(realtimeEvent *RealtimeEvent) *RealtimeEventDto {
+	var projectId *string = nil
+	if realtimeEvent.ProjectIdentity != nil {
+		projectId = &realtimeEvent.ProjectIdentity.Public
+	}
+
+	var taskId *string = nil
+	if realtimeEvent.TaskIdentity != nil {
+		taskId = &realtimeEvent.TaskIdentity.Public
+	}
+
+	var userId *string = nil
+	if realtimeEvent.UserIdentity != nil {
+		userId = &realtimeEvent.UserIdentity.Public
+	}
+
+	return &RealtimeEventDto{
+		Id:             realtimeEvent.Identity.Public,
+		Type:           realtimeEvent.Type,
+		OrganizationId: realtimeEvent.OrganizationIdentity.Public,
+		ProjectId:      projectId,
+		TaskId:         taskId,
+		UserId:         userId,
+		CreatedAt:      realtimeEvent.CreatedAt.ToRFC3339(),
+	}
+}
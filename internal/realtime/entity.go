@@ -0,0 +1,62 @@
+package realtime
+
+import (
+	"slices"
+
+	"github.com/gabrielmrtt/taski/internal/core"
+)
+
+/*
+RealtimeEvent tells connected clients that something changed. It only carries identifiers, since Postgres limits
+notification payloads to 8000 bytes; clients fetch what they need to refresh.
+*/
+type RealtimeEvent struct {
+	Identity             core.Identity
+	Type                 string
+	OrganizationIdentity core.Identity
+	ProjectIdentity      *core.Identity
+	TaskIdentity         *core.Identity
+	UserIdentity         *core.Identity
+	CreatedAt            core.DateTime
+}
+
+type NewRealtimeEventInput struct {
+	Type                 string
+	OrganizationIdentity core.Identity
+	ProjectIdentity      *core.Identity
+	TaskIdentity         *core.Identity
+	UserIdentity         *core.Identity
+}
+
+func NewRealtimeEvent(input NewRealtimeEventInput) *RealtimeEvent {
+	return &RealtimeEvent{
+		Identity:             core.NewIdentity(RealtimeEventIdentityPrefix),
+		Type:                 input.Type,
+		OrganizationIdentity: input.OrganizationIdentity,
+		ProjectIdentity:      input.ProjectIdentity,
+		TaskIdentity:         input.TaskIdentity,
+		UserIdentity:         input.UserIdentity,
+		CreatedAt:            core.NewDateTime(),
+	}
+}
+
+/*
+RealtimeSubscriptionFilter selects the events of an organization a subscriber receives. Events of a project are only
+received when the project is listed, so subscribers never see projects they are not members of.
+*/
+type RealtimeSubscriptionFilter struct {
+	OrganizationId string
+	ProjectIds     []string
+}
+
+func (f RealtimeSubscriptionFilter) Matches(event *RealtimeEventDto) bool {
+	if event.OrganizationId != f.OrganizationId {
+		return false
+	}
+
+	if event.ProjectId == nil {
+		return true
+	}
+
+	return slices.Contains(f.ProjectIds, *event.ProjectId)
+}
@@ -0,0 +1,38 @@
+package realtimeinfra
+
+import (
+	"log"
+
+	corehttp "github.com/gabrielmrtt/taski/internal/core/http"
+	organizationdatabase "github.com/gabrielmrtt/taski/internal/organization/infra/database"
+	projectdatabase "github.com/gabrielmrtt/taski/internal/project/infra/database"
+	realtimebroker "github.com/gabrielmrtt/taski/internal/realtime/infra/broker"
+	realtimehttp "github.com/gabrielmrtt/taski/internal/realtime/infra/http"
+	realtimeservice "github.com/gabrielmrtt/taski/internal/realtime/service"
+	"github.com/gin-gonic/gin"
+	"github.com/uptrace/bun"
+)
+
+type BootstrapInfraOptions struct {
+	RouterGroup  *gin.RouterGroup
+	DbConnection *bun.DB
+}
+
+func BootstrapInfra(options BootstrapInfraOptions) {
+	organizationUserRepository := organizationdatabase.NewOrganizationUserBunRepository(options.DbConnection)
+	projectRepository := projectdatabase.NewProjectBunRepository(options.DbConnection)
+	projectUserRepository := projectdatabase.NewProjectUserBunRepository(options.DbConnection)
+
+	realtimeBroker := realtimebroker.NewPostgresRealtimeBroker(options.DbConnection)
+	if err := realtimeBroker.Start(); err != nil {
+		log.Printf("Failed to start realtime broker: %v", err)
+	}
+
+	subscribeToRealtimeEventsService := realtimeservice.NewSubscribeToRealtimeEventsService(organizationUserRepository, projectRepository, projectUserRepository, realtimeBroker)
+
+	realtimeHandler := realtimehttp.NewRealtimeHandler(subscribeToRealtimeEventsService)
+	realtimeHandler.ConfigureRoutes(corehttp.ConfigureRoutesOptions{
+		DbConnection: options.DbConnection,
+		RouterGroup:  options.RouterGroup,
+	})
+}
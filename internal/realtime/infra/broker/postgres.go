@@ -0,0 +1,129 @@
+package realtimebroker
+
+import (
+	"context"
+	"encoding/json"
+	"log"
+	"sync"
+
+	"github.com/gabrielmrtt/taski/internal/realtime"
+	"github.com/uptrace/bun"
+	"github.com/uptrace/bun/driver/pgdriver"
+)
+
+/*
+PostgresRealtimeBroker holds a single LISTEN connection per API instance and fans the notifications out to the
+subscriptions of that instance.
+*/
+type PostgresRealtimeBroker struct {
+	listener      *pgdriver.Listener
+	mutex         sync.RWMutex
+	subscriptions map[*postgresRealtimeSubscription]struct{}
+}
+
+func NewPostgresRealtimeBroker(connection *bun.DB) *PostgresRealtimeBroker {
+	return &PostgresRealtimeBroker{
+		listener:      pgdriver.NewListener(connection),
+		subscriptions: make(map[*postgresRealtimeSubscription]struct{}),
+	}
+}
+
+/*
+Start listens to the notification channel and dispatches notifications until the listener is closed. The listener
+reconnects and listens again on its own when the connection drops.
+*/
+func (b *PostgresRealtimeBroker) Start() error {
+	if err := b.listener.Listen(context.Background(), realtime.RealtimeNotificationChannel); err != nil {
+		return err
+	}
+
+	go func() {
+		for notification := range b.listener.CreateChannel() {
+			var event realtime.RealtimeEventDto
+			if err := json.Unmarshal([]byte(notification.Payload), &event); err != nil {
+				log.Printf("Failed to decode realtime event: %v", err)
+				continue
+			}
+
+			b.dispatch(&event)
+		}
+	}()
+
+	return nil
+}
+
+func (b *PostgresRealtimeBroker) Close() error {
+	return b.listener.Close()
+}
+
+func (b *PostgresRealtimeBroker) Subscribe(filter realtime.RealtimeSubscriptionFilter) realtime.RealtimeSubscription {
+	subscription := &postgresRealtimeSubscription{
+		broker: b,
+		filter: filter,
+		events: make(chan realtime.RealtimeEventDto, realtime.RealtimeSubscriberBufferSize),
+	}
+
+	b.mutex.Lock()
+	b.subscriptions[subscription] = struct{}{}
+	b.mutex.Unlock()
+
+	return subscription
+}
+
+func (b *PostgresRealtimeBroker) dispatch(event *realtime.RealtimeEventDto) {
+	var slowSubscriptions []*postgresRealtimeSubscription
+
+	b.mutex.RLock()
+	for subscription := range b.subscriptions {
+		if !subscription.filter.Matches(event) {
+			continue
+		}
+
+		select {
+		case subscription.events <- *event:
+		default:
+			slowSubscriptions = append(slowSubscriptions, subscription)
+		}
+	}
+	b.mutex.RUnlock()
+
+	// A subscriber that cannot keep up is disconnected rather than silently missing events, so its client reconnects
+	// and reloads.
+	for _, subscription := range slowSubscriptions {
+		subscription.Close()
+	}
+}
+
+func (b *PostgresRealtimeBroker) unsubscribe(subscription *postgresRealtimeSubscription) {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+
+	if _, ok := b.subscriptions[subscription]; !ok {
+		return
+	}
+
+	delete(b.subscriptions, subscription)
+	close(subscription.events)
+}
+
+type postgresRealtimeSubscription struct {
+	broker *PostgresRealtimeBroker
+	filter realtime.RealtimeSubscriptionFilter
+	events chan realtime.RealtimeEventDto
+}
+
+func (s *postgresRealtimeSubscription) Events() <-chan realtime.RealtimeEventDto {
+	return s.events
+}
+
+// SetFilter takes the broker lock so the filter never changes while dispatch reads it.
+func (s *postgresRealtimeSubscription) SetFilter(filter realtime.RealtimeSubscriptionFilter) {
+	s.broker.mutex.Lock()
+	defer s.broker.mutex.Unlock()
+
+	s.filter = filter
+}
+
+func (s *postgresRealtimeSubscription) Close() {
+	s.broker.unsubscribe(s)
+}
@@ -0,0 +1,51 @@
+package realtimedatabase
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/gabrielmrtt/taski/internal/core"
+	coredatabase "github.com/gabrielmrtt/taski/internal/core/database"
+	"github.com/gabrielmrtt/taski/internal/realtime"
+	realtimerepo "github.com/gabrielmrtt/taski/internal/realtime/repository"
+	"github.com/uptrace/bun"
+)
+
+type RealtimeEventBunRepository struct {
+	db *bun.DB
+	tx *coredatabase.TransactionBun
+}
+
+func NewRealtimeEventBunRepository(connection *bun.DB) *RealtimeEventBunRepository {
+	return &RealtimeEventBunRepository{db: connection, tx: nil}
+}
+
+func (r *RealtimeEventBunRepository) SetTransaction(tx core.Transaction) error {
+	if r.tx != nil && !r.tx.IsClosed() {
+		return nil
+	}
+
+	r.tx = tx.(*coredatabase.TransactionBun)
+	return nil
+}
+
+/*
+PublishRealtimeEvent sends the event with pg_notify. Inside a transaction Postgres only delivers it once the
+transaction commits, and drops it on rollback.
+*/
+func (r *RealtimeEventBunRepository) PublishRealtimeEvent(params realtimerepo.PublishRealtimeEventParams) error {
+	payload, err := json.Marshal(realtime.RealtimeEventToDto(params.RealtimeEvent))
+	if err != nil {
+		return err
+	}
+
+	var rawQuery *bun.RawQuery
+	if r.tx != nil && !r.tx.IsClosed() {
+		rawQuery = r.tx.Tx.NewRaw("SELECT pg_notify(?, ?)", realtime.RealtimeNotificationChannel, string(payload))
+	} else {
+		rawQuery = r.db.NewRaw("SELECT pg_notify(?, ?)", realtime.RealtimeNotificationChannel, string(payload))
+	}
+
+	_, err = rawQuery.Exec(context.Background())
+	return err
+}
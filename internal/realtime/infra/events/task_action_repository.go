@@ -0,0 +1,110 @@
+package realtimeevents
+
+import (
+	"github.com/gabrielmrtt/taski/internal/core"
+	projectrepo "github.com/gabrielmrtt/taski/internal/project/repository"
+	"github.com/gabrielmrtt/taski/internal/realtime"
+	realtimerepo "github.com/gabrielmrtt/taski/internal/realtime/repository"
+	"github.com/gabrielmrtt/taski/internal/task"
+	taskrepo "github.com/gabrielmrtt/taski/internal/task/repository"
+	workspacerepo "github.com/gabrielmrtt/taski/internal/workspace/repository"
+)
+
+/*
+TaskActionRepository publishes a realtime event for every task action stored. Task, comment, status and time entry
+changes all register an action, with the action type as event type.
+*/
+type TaskActionRepository struct {
+	taskrepo.TaskActionRepository
+	TaskRepository          taskrepo.TaskRepository
+	ProjectRepository       projectrepo.ProjectRepository
+	WorkspaceRepository     workspacerepo.WorkspaceRepository
+	RealtimeEventRepository realtimerepo.RealtimeEventRepository
+}
+
+func NewTaskActionRepository(
+	taskActionRepository taskrepo.TaskActionRepository,
+	taskRepository taskrepo.TaskRepository,
+	projectRepository projectrepo.ProjectRepository,
+	workspaceRepository workspacerepo.WorkspaceRepository,
+	realtimeEventRepository realtimerepo.RealtimeEventRepository,
+) *TaskActionRepository {
+	return &TaskActionRepository{
+		TaskActionRepository:    taskActionRepository,
+		TaskRepository:          taskRepository,
+		ProjectRepository:       projectRepository,
+		WorkspaceRepository:     workspaceRepository,
+		RealtimeEventRepository: realtimeEventRepository,
+	}
+}
+
+func (r *TaskActionRepository) SetTransaction(tx core.Transaction) error {
+	if err := r.TaskActionRepository.SetTransaction(tx); err != nil {
+		return err
+	}
+
+	r.TaskRepository.SetTransaction(tx)
+	r.ProjectRepository.SetTransaction(tx)
+	r.WorkspaceRepository.SetTransaction(tx)
+	return r.RealtimeEventRepository.SetTransaction(tx)
+}
+
+func (r *TaskActionRepository) StoreTaskAction(params taskrepo.StoreTaskActionParams) (*task.TaskAction, error) {
+	taskAction, err := r.TaskActionRepository.StoreTaskAction(params)
+	if err != nil {
+		return nil, err
+	}
+
+	tsk, err := r.TaskRepository.GetTaskByIdentity(taskrepo.GetTaskByIdentityParams{
+		TaskIdentity: taskAction.TaskIdentity,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if tsk == nil {
+		return taskAction, nil
+	}
+
+	prj, err := r.ProjectRepository.GetProjectByIdentity(projectrepo.GetProjectByIdentityParams{
+		ProjectIdentity: tsk.ProjectIdentity,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if prj == nil {
+		return taskAction, nil
+	}
+
+	wrk, err := r.WorkspaceRepository.GetWorkspaceByIdentity(workspacerepo.GetWorkspaceByIdentityParams{
+		WorkspaceIdentity: prj.WorkspaceIdentity,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if wrk == nil {
+		return taskAction, nil
+	}
+
+	var userIdentity *core.Identity = nil
+	if taskAction.User != nil {
+		userIdentity = &taskAction.User.Identity
+	}
+
+	err = r.RealtimeEventRepository.PublishRealtimeEvent(realtimerepo.PublishRealtimeEventParams{
+		RealtimeEvent: realtime.NewRealtimeEvent(realtime.NewRealtimeEventInput{
+			Type:                 string(taskAction.Type),
+			OrganizationIdentity: wrk.OrganizationIdentity,
+			ProjectIdentity:      &prj.Identity,
+			TaskIdentity:         &tsk.Identity,
+			UserIdentity:         userIdentity,
+		}),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return taskAction, nil
+}
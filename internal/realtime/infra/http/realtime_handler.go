@@ -0,0 +1,147 @@
+package realtimehttp
+
+import (
+	"net/http"
+	"time"
+
+	authhttpmiddlewares "github.com/gabrielmrtt/taski/internal/auth/infra/http/middlewares"
+	"github.com/gabrielmrtt/taski/internal/core"
+	corehttp "github.com/gabrielmrtt/taski/internal/core/http"
+	organizationhttpmiddlewares "github.com/gabrielmrtt/taski/internal/organization/infra/http/middlewares"
+	projecthttpmiddlewares "github.com/gabrielmrtt/taski/internal/project/infra/http/middlewares"
+	"github.com/gabrielmrtt/taski/internal/realtime"
+	realtimeservice "github.com/gabrielmrtt/taski/internal/realtime/service"
+	"github.com/gin-gonic/gin"
+)
+
+type RealtimeHandler struct {
+	SubscribeToRealtimeEventsService *realtimeservice.SubscribeToRealtimeEventsService
+}
+
+func NewRealtimeHandler(
+	subscribeToRealtimeEventsService *realtimeservice.SubscribeToRealtimeEventsService,
+) *RealtimeHandler {
+	return &RealtimeHandler{
+		SubscribeToRealtimeEventsService: subscribeToRealtimeEventsService,
+	}
+}
+
+// StreamProjectEvents godoc
+// @Summary Stream project events
+// @Description Streams the task, comment and status events of a project as Server-Sent Events, as soon as they are committed. Each event is named after its type and carries a RealtimeEventDto as data.
+// @Tags Realtime
+// @Param projectId path string true "Project ID"
+// @Produce text/event-stream
+// @Success 200 {object} realtime.RealtimeEventDto
+// @Failure 401 {object} corehttp.HttpErrorResponse
+// @Failure 403 {object} corehttp.HttpErrorResponse
+// @Failure 404 {object} corehttp.HttpErrorResponse
+// @Failure 500 {object} corehttp.HttpErrorResponse
+// @Router /realtime/project/{projectId} [get]
+func (h *RealtimeHandler) StreamProjectEvents(c *gin.Context) {
+	var organizationIdentity *core.Identity = authhttpmiddlewares.GetAuthenticatedUserLastAccessedOrganizationIdentity(c)
+	var userIdentity *core.Identity = authhttpmiddlewares.GetAuthenticatedUserIdentity(c)
+	var projectIdentity core.Identity = core.NewIdentityFromPublic(c.Param("projectId"))
+
+	input := realtimeservice.SubscribeToRealtimeEventsInput{
+		OrganizationIdentity: *organizationIdentity,
+		UserIdentity:         *userIdentity,
+		ProjectIdentity:      &projectIdentity,
+	}
+
+	subscription, err := h.SubscribeToRealtimeEventsService.Execute(input)
+	if err != nil {
+		corehttp.NewHttpErrorResponse(c, err)
+		return
+	}
+
+	h.stream(c, input, subscription)
+}
+
+// StreamOrganizationEvents godoc
+// @Summary Stream organization events
+// @Description Streams the events of every project of the authenticated user's organization the user is a member of, as Server-Sent Events. Memberships and the tasks:view permission are checked again on every heartbeat: projects the user joins or leaves are added or removed, and the stream ends when the user loses access.
+// @Tags Realtime
+// @Produce text/event-stream
+// @Success 200 {object} realtime.RealtimeEventDto
+// @Failure 401 {object} corehttp.HttpErrorResponse
+// @Failure 403 {object} corehttp.HttpErrorResponse
+// @Failure 500 {object} corehttp.HttpErrorResponse
+// @Router /realtime/organization [get]
+func (h *RealtimeHandler) StreamOrganizationEvents(c *gin.Context) {
+	var organizationIdentity *core.Identity = authhttpmiddlewares.GetAuthenticatedUserLastAccessedOrganizationIdentity(c)
+	var userIdentity *core.Identity = authhttpmiddlewares.GetAuthenticatedUserIdentity(c)
+
+	input := realtimeservice.SubscribeToRealtimeEventsInput{
+		OrganizationIdentity: *organizationIdentity,
+		UserIdentity:         *userIdentity,
+		ProjectIdentity:      nil,
+	}
+
+	subscription, err := h.SubscribeToRealtimeEventsService.Execute(input)
+	if err != nil {
+		corehttp.NewHttpErrorResponse(c, err)
+		return
+	}
+
+	h.stream(c, input, subscription)
+}
+
+/*
+stream writes the subscription events until the client disconnects, the broker drops the subscription or the user
+loses access. Heartbeat comments keep proxies from closing idle connections, and every heartbeat checks the
+memberships and permission of the user again.
+*/
+func (h *RealtimeHandler) stream(c *gin.Context, input realtimeservice.SubscribeToRealtimeEventsInput, subscription realtime.RealtimeSubscription) {
+	defer subscription.Close()
+
+	c.Header("Content-Type", "text/event-stream")
+	c.Header("Cache-Control", "no-cache")
+	c.Header("Connection", "keep-alive")
+	c.Header("X-Accel-Buffering", "no")
+	c.Status(http.StatusOK)
+	c.Writer.Flush()
+
+	heartbeat := time.NewTicker(realtime.RealtimeHeartbeatSeconds * time.Second)
+	defer heartbeat.Stop()
+
+	for {
+		select {
+		case <-c.Request.Context().Done():
+			return
+		case <-heartbeat.C:
+			if err := h.SubscribeToRealtimeEventsService.Refresh(input, subscription); err != nil {
+				return
+			}
+
+			if _, err := c.Writer.WriteString(": heartbeat\n\n"); err != nil {
+				return
+			}
+
+			c.Writer.Flush()
+		case event, ok := <-subscription.Events():
+			if !ok {
+				return
+			}
+
+			c.SSEvent(event.Type, event)
+			c.Writer.Flush()
+		}
+	}
+}
+
+func (h *RealtimeHandler) ConfigureRoutes(options corehttp.ConfigureRoutesOptions) *gin.RouterGroup {
+	middlewareOptions := corehttp.MiddlewareOptions{
+		DbConnection: options.DbConnection,
+	}
+
+	g := options.RouterGroup.Group("/realtime")
+	{
+		g.Use(authhttpmiddlewares.AuthMiddleware(middlewareOptions))
+
+		g.GET("/project/:projectId", organizationhttpmiddlewares.UserMustHavePermission("tasks:view", middlewareOptions), projecthttpmiddlewares.UserMustBeInProject(middlewareOptions), h.StreamProjectEvents)
+		g.GET("/organization", organizationhttpmiddlewares.UserMustHavePermission("tasks:view", middlewareOptions), h.StreamOrganizationEvents)
+	}
+
+	return g
+}
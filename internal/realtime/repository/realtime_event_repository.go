@@ -0,0 +1,16 @@
+package realtimerepo
+
+import (
+	"github.com/gabrielmrtt/taski/internal/core"
+	"github.com/gabrielmrtt/taski/internal/realtime"
+)
+
+type PublishRealtimeEventParams struct {
+	RealtimeEvent *realtime.RealtimeEvent
+}
+
+type RealtimeEventRepository interface {
+	SetTransaction(tx core.Transaction) error
+
+	PublishRealtimeEvent(params PublishRealtimeEventParams) error
+}
@@ -0,0 +1,145 @@
+package realtimeservice
+
+import (
+	"github.com/gabrielmrtt/taski/internal/core"
+	organizationrepo "github.com/gabrielmrtt/taski/internal/organization/repository"
+	projectrepo "github.com/gabrielmrtt/taski/internal/project/repository"
+	"github.com/gabrielmrtt/taski/internal/realtime"
+	"github.com/gabrielmrtt/taski/internal/role"
+)
+
+type SubscribeToRealtimeEventsService struct {
+	OrganizationUserRepository organizationrepo.OrganizationUserRepository
+	ProjectRepository          projectrepo.ProjectRepository
+	ProjectUserRepository      projectrepo.ProjectUserRepository
+	RealtimeBroker             realtime.RealtimeBroker
+}
+
+func NewSubscribeToRealtimeEventsService(
+	organizationUserRepository organizationrepo.OrganizationUserRepository,
+	projectRepository projectrepo.ProjectRepository,
+	projectUserRepository projectrepo.ProjectUserRepository,
+	realtimeBroker realtime.RealtimeBroker,
+) *SubscribeToRealtimeEventsService {
+	return &SubscribeToRealtimeEventsService{
+		OrganizationUserRepository: organizationUserRepository,
+		ProjectRepository:          projectRepository,
+		ProjectUserRepository:      projectUserRepository,
+		RealtimeBroker:             realtimeBroker,
+	}
+}
+
+type SubscribeToRealtimeEventsInput struct {
+	OrganizationIdentity core.Identity
+	UserIdentity         core.Identity
+	ProjectIdentity      *core.Identity
+}
+
+func (i SubscribeToRealtimeEventsInput) Validate() error {
+	return nil
+}
+
+/*
+Execute subscribes to the events of a project, or to the events of every project of the organization the user is an
+active member of when no project is given. Access is checked again by Refresh while the subscription is open.
+*/
+func (s *SubscribeToRealtimeEventsService) Execute(input SubscribeToRealtimeEventsInput) (realtime.RealtimeSubscription, error) {
+	if err := input.Validate(); err != nil {
+		return nil, err
+	}
+
+	filter, err := s.resolveFilter(input)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.RealtimeBroker.Subscribe(filter), nil
+}
+
+/*
+Refresh resolves the access of the subscriber again and narrows or widens the subscription to the projects the user
+is currently an active member of. It returns an error when the user can no longer view the events, in which case the
+caller must close the subscription.
+*/
+func (s *SubscribeToRealtimeEventsService) Refresh(input SubscribeToRealtimeEventsInput, subscription realtime.RealtimeSubscription) error {
+	if err := input.Validate(); err != nil {
+		return err
+	}
+
+	filter, err := s.resolveFilter(input)
+	if err != nil {
+		return err
+	}
+
+	subscription.SetFilter(filter)
+
+	return nil
+}
+
+func (s *SubscribeToRealtimeEventsService) resolveFilter(input SubscribeToRealtimeEventsInput) (realtime.RealtimeSubscriptionFilter, error) {
+	var filter realtime.RealtimeSubscriptionFilter = realtime.RealtimeSubscriptionFilter{
+		OrganizationId: input.OrganizationIdentity.Public,
+		ProjectIds:     make([]string, 0),
+	}
+
+	orgUser, err := s.OrganizationUserRepository.GetOrganizationUserByIdentity(organizationrepo.GetOrganizationUserByIdentityParams{
+		OrganizationIdentity: input.OrganizationIdentity,
+		UserIdentity:         input.UserIdentity,
+	})
+	if err != nil {
+		return filter, err
+	}
+
+	if orgUser == nil || !orgUser.IsActive() {
+		return filter, core.NewUnauthorizedError("you're not part of this organization")
+	}
+
+	if !orgUser.CanExecuteAction(role.TasksView) {
+		return filter, core.NewUnauthorizedError("you can't execute this action")
+	}
+
+	if input.ProjectIdentity != nil {
+		prj, err := s.ProjectRepository.GetProjectByIdentity(projectrepo.GetProjectByIdentityParams{
+			ProjectIdentity:      *input.ProjectIdentity,
+			OrganizationIdentity: &input.OrganizationIdentity,
+		})
+		if err != nil {
+			return filter, err
+		}
+
+		if prj == nil || prj.IsDeleted() {
+			return filter, core.NewNotFoundError("project not found")
+		}
+
+		projectUser, err := s.ProjectUserRepository.GetProjectUserByIdentity(projectrepo.GetProjectUserByIdentityParams{
+			ProjectIdentity: prj.Identity,
+			UserIdentity:    input.UserIdentity,
+		})
+		if err != nil {
+			return filter, err
+		}
+
+		if projectUser == nil || !projectUser.IsActive() {
+			return filter, core.NewUnauthorizedError("you're not part of this project")
+		}
+
+		filter.ProjectIds = append(filter.ProjectIds, prj.Identity.Public)
+
+		return filter, nil
+	}
+
+	projectUsers, err := s.ProjectUserRepository.GetProjectUsersByUserIdentity(projectrepo.GetProjectUsersByUserIdentityParams{
+		UserIdentity: input.UserIdentity,
+	})
+	if err != nil {
+		return filter, err
+	}
+
+	for _, projectUser := range projectUsers {
+		if projectUser.IsActive() {
+			filter.ProjectIds = append(filter.ProjectIds, projectUser.ProjectIdentity.Public)
+		}
+	}
+
+	return filter, nil
+}
@@ -32,6 +32,7 @@ const (
 	TasksCreate              PermissionSlugs = "tasks:create"
 	TasksUpdate              PermissionSlugs = "tasks:update"
 	TasksDelete              PermissionSlugs = "tasks:delete"
+	TasksCommentsModerate    PermissionSlugs = "tasks:comments:moderate"
 )
 
 type PermissionSlugsArrayItem struct {
@@ -68,6 +69,7 @@ var PermissionSlugsArray = []PermissionSlugsArrayItem{
 	{Name: "Tasks Create", Slug: TasksCreate, Description: "Allow users to create tasks"},
 	{Name: "Tasks Update", Slug: TasksUpdate, Description: "Allow users to update tasks"},
 	{Name: "Tasks Delete", Slug: TasksDelete, Description: "Allow users to delete tasks"},
+	{Name: "Tasks Comments Moderate", Slug: TasksCommentsModerate, Description: "Allow users to view the edit history of task comments"},
 }
 
 type DefaultRoleSlugs string
@@ -135,6 +137,7 @@ var DefaultRoleSlugsArray = []DefaultRoleSlugsArrayItem{
 			TasksCreate,
 			TasksUpdate,
 			TasksDelete,
+			TasksCommentsModerate,
 		},
 	},
 }
@@ -30,6 +30,14 @@ func (p *PermissionTable) ToEntity() *role.Permission {
 	}
 }
 
+var permissionSortableFields = coredatabase.SortableFields{
+	Fields: map[string]string{
+		"name": "permissions.name",
+		"slug": "permissions.slug",
+	},
+	IdColumn: "permissions.internal_id",
+}
+
 type PermissionBunRepository struct {
 	db *bun.DB
 	tx *coredatabase.TransactionBun
@@ -140,7 +148,11 @@ func (r *PermissionBunRepository) PaginatePermissionsBy(params rolerepo.Paginate
 		return nil, err
 	}
 
-	selectQuery = coredatabase.ApplySort(selectQuery, params.SortInput)
+	selectQuery, err = coredatabase.ApplySort(selectQuery, permissionSortableFields, params.SortInput)
+	if err != nil {
+		return nil, err
+	}
+
 	selectQuery = coredatabase.ApplyPagination(selectQuery, params.Pagination)
 	err = selectQuery.Scan(context.Background())
 	if err != nil {
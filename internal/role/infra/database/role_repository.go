@@ -128,6 +128,25 @@ func (r *RolePermissionTable) ToEntity() *role.Permission {
 	}
 }
 
+var roleSortableFields = coredatabase.SortableFields{
+	Fields: map[string]string{
+		"name":      "roles.name",
+		"slug":      "roles.slug",
+		"createdAt": "roles.created_at",
+		"updatedAt": "roles.updated_at",
+	},
+	IdColumn: "roles.internal_id",
+}
+
+var roleIncludableRelations = coredatabase.IncludableRelations{
+	Relations: []string{
+		"RolePermissions.Permission",
+		"Creator",
+		"Editor",
+	},
+	MaxDepth: 2,
+}
+
 type RoleBunRepository struct {
 	db *bun.DB
 	tx *coredatabase.TransactionBun
@@ -184,10 +203,14 @@ func (r *RoleBunRepository) GetRoleByIdentity(params rolerepo.GetRoleByIdentityP
 
 	selectQuery = selectQuery.Model(role)
 	selectQuery = selectQuery.Relation("RolePermissions.Permission")
-	selectQuery = coredatabase.ApplyRelations(selectQuery, params.RelationsInput)
+	selectQuery, err := coredatabase.ApplyRelations(selectQuery, roleIncludableRelations, params.RelationsInput)
+	if err != nil {
+		return nil, err
+	}
+
 	selectQuery = selectQuery.Where("roles.internal_id = ?", params.RoleIdentity.Internal.String())
 
-	err := selectQuery.Scan(context.Background())
+	err = selectQuery.Scan(context.Background())
 	if err != nil {
 		if err == sql.ErrNoRows {
 			return nil, nil
@@ -215,9 +238,13 @@ func (r *RoleBunRepository) GetRoleByIdentityAndOrganizationIdentity(params role
 
 	selectQuery = selectQuery.Model(role)
 	selectQuery = selectQuery.Relation("RolePermissions.Permission")
-	selectQuery = coredatabase.ApplyRelations(selectQuery, params.RelationsInput)
+	selectQuery, err := coredatabase.ApplyRelations(selectQuery, roleIncludableRelations, params.RelationsInput)
+	if err != nil {
+		return nil, err
+	}
+
 	selectQuery = selectQuery.Where("roles.internal_id = ? AND roles.organization_internal_id = ?", params.RoleIdentity.Internal.String(), params.OrganizationIdentity.Internal.String())
-	err := selectQuery.Scan(context.Background())
+	err = selectQuery.Scan(context.Background())
 	if err != nil {
 		if err == sql.ErrNoRows {
 			return nil, nil
@@ -282,7 +309,11 @@ func (r *RoleBunRepository) PaginateRolesBy(params rolerepo.PaginateRolesParams)
 
 	selectQuery = selectQuery.Model(&roles)
 	selectQuery = selectQuery.Relation("RolePermissions.Permission")
-	selectQuery = coredatabase.ApplyRelations(selectQuery, params.RelationsInput)
+	selectQuery, err := coredatabase.ApplyRelations(selectQuery, roleIncludableRelations, params.RelationsInput)
+	if err != nil {
+		return nil, err
+	}
+
 	selectQuery = r.applyFilters(selectQuery, params.Filters)
 
 	if !params.ShowDeleted {
@@ -294,7 +325,11 @@ func (r *RoleBunRepository) PaginateRolesBy(params rolerepo.PaginateRolesParams)
 		return nil, err
 	}
 
-	selectQuery = coredatabase.ApplySort(selectQuery, params.SortInput)
+	selectQuery, err = coredatabase.ApplySort(selectQuery, roleSortableFields, params.SortInput)
+	if err != nil {
+		return nil, err
+	}
+
 	selectQuery = coredatabase.ApplyPagination(selectQuery, params.Pagination)
 	err = selectQuery.Scan(context.Background())
 	if err != nil {
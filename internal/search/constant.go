@@ -0,0 +1,15 @@
+package search
+
+type SearchResultTypes string
+
+const (
+	SearchResultTypeTask            SearchResultTypes = "task"
+	SearchResultTypeTaskComment     SearchResultTypes = "task_comment"
+	SearchResultTypeProjectDocument SearchResultTypes = "project_document"
+)
+
+var SearchResultTypesArray = []SearchResultTypes{
+	SearchResultTypeTask,
+	SearchResultTypeTaskComment,
+	SearchResultTypeProjectDocument,
+}
@@ -0,0 +1,30 @@
+package search
+
+type SearchResultDto struct {
+	Type      string  `json:"type"`
+	Id        string  `json:"id"`
+	ProjectId string  `json:"projectId"`
+	TaskId    *string `json:"taskId"`
+	Title     string  `json:"title"`
+	Snippet   string  `json:"snippet"`
+	Rank      float64 `json:"rank"`
+	CreatedAt string  `json:"createdAt"`
+}
+
+func SearchResultToDto(searchResult *SearchResult) *SearchResultDto {
+	var taskId *string = nil
+	if searchResult.TaskIdentity != nil {
+		taskId = &searchResult.TaskIdentity.Public
+	}
+
+	return &SearchResultDto{
+		Type:      string(searchResult.Type),
+		Id:        searchResult.Identity.Public,
+		ProjectId: searchResult.ProjectIdentity.Public,
+		TaskId:    taskId,
+		Title:     searchResult.Title,
+		Snippet:   searchResult.Snippet,
+		Rank:      searchResult.Rank,
+		CreatedAt: searchResult.CreatedAt.ToRFC3339(),
+	}
+}
@@ -0,0 +1,18 @@
+package search
+
+import "github.com/gabrielmrtt/taski/internal/core"
+
+/*
+SearchResult is a single match of a search. Identity points to the matched task, task comment or project document
+version manager, and TaskIdentity is set for tasks and task comments.
+*/
+type SearchResult struct {
+	Type            SearchResultTypes
+	Identity        core.Identity
+	ProjectIdentity core.Identity
+	TaskIdentity    *core.Identity
+	Title           string
+	Snippet         string
+	Rank            float64
+	CreatedAt       core.DateTime
+}
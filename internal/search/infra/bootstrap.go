@@ -0,0 +1,28 @@
+package searchinfra
+
+import (
+	corehttp "github.com/gabrielmrtt/taski/internal/core/http"
+	searchdatabase "github.com/gabrielmrtt/taski/internal/search/infra/database"
+	searchhttp "github.com/gabrielmrtt/taski/internal/search/infra/http"
+	searchservice "github.com/gabrielmrtt/taski/internal/search/service"
+	"github.com/gin-gonic/gin"
+	"github.com/uptrace/bun"
+)
+
+type BootstrapInfraOptions struct {
+	RouterGroup  *gin.RouterGroup
+	DbConnection *bun.DB
+}
+
+func BootstrapInfra(options BootstrapInfraOptions) {
+	searchRepository := searchdatabase.NewSearchBunRepository(options.DbConnection)
+
+	searchService := searchservice.NewSearchService(searchRepository)
+
+	searchHandler := searchhttp.NewSearchHandler(searchService)
+
+	searchHandler.ConfigureRoutes(corehttp.ConfigureRoutesOptions{
+		DbConnection: options.DbConnection,
+		RouterGroup:  options.RouterGroup,
+	})
+}
@@ -0,0 +1,244 @@
+package searchdatabase
+
+import (
+	"context"
+	"database/sql"
+	"html"
+	"slices"
+	"strings"
+
+	"github.com/gabrielmrtt/taski/internal/core"
+	coredatabase "github.com/gabrielmrtt/taski/internal/core/database"
+	"github.com/gabrielmrtt/taski/internal/project"
+	"github.com/gabrielmrtt/taski/internal/search"
+	searchrepo "github.com/gabrielmrtt/taski/internal/search/repository"
+	"github.com/gabrielmrtt/taski/internal/task"
+	"github.com/google/uuid"
+	"github.com/uptrace/bun"
+)
+
+/*
+Postgres delimits the matches of a headline with control characters rather than with the mark tags, because the
+headline holds the content as written: it is escaped first and the delimiters are replaced by the tags afterwards. The
+delimiters are removed from the content before highlighting so it cannot forge them.
+*/
+const (
+	searchHighlightStart  = "\x02"
+	searchHighlightStop   = "\x03"
+	searchHeadlineOptions = "StartSel=" + searchHighlightStart + ", StopSel=" + searchHighlightStop + ", MaxWords=35, MinWords=15, MaxFragments=2, FragmentDelimiter=\" ... \""
+)
+
+// highlightSnippet escapes a headline and wraps its matches in mark tags, so it is safe to render as HTML
+func highlightSnippet(headline string) string {
+	var snippet string = html.EscapeString(headline)
+	snippet = strings.ReplaceAll(snippet, searchHighlightStart, "<mark>")
+	snippet = strings.ReplaceAll(snippet, searchHighlightStop, "</mark>")
+	return snippet
+}
+
+type searchResultTable struct {
+	Type              string  `bun:"type"`
+	InternalId        string  `bun:"internal_id"`
+	ProjectInternalId string  `bun:"project_internal_id"`
+	TaskInternalId    *string `bun:"task_internal_id"`
+	Title             string  `bun:"title"`
+	Snippet           string  `bun:"snippet"`
+	Rank              float64 `bun:"rank"`
+	CreatedAt         int64   `bun:"created_at"`
+	TotalCount        int     `bun:"total_count"`
+}
+
+func (s *searchResultTable) ToEntity() *search.SearchResult {
+	var resultType search.SearchResultTypes = search.SearchResultTypes(s.Type)
+
+	var identityPrefix string = task.TaskIdentityPrefix
+	switch resultType {
+	case search.SearchResultTypeTaskComment:
+		identityPrefix = task.TaskCommentIdentityPrefix
+	case search.SearchResultTypeProjectDocument:
+		identityPrefix = project.ProjectDocumentVersionManagerIdentityPrefix
+	}
+
+	var taskIdentity *core.Identity = nil
+	if s.TaskInternalId != nil {
+		identity := core.NewIdentityFromInternal(uuid.MustParse(*s.TaskInternalId), task.TaskIdentityPrefix)
+		taskIdentity = &identity
+	}
+
+	return &search.SearchResult{
+		Type:            resultType,
+		Identity:        core.NewIdentityFromInternal(uuid.MustParse(s.InternalId), identityPrefix),
+		ProjectIdentity: core.NewIdentityFromInternal(uuid.MustParse(s.ProjectInternalId), project.ProjectIdentityPrefix),
+		TaskIdentity:    taskIdentity,
+		Title:           s.Title,
+		Snippet:         highlightSnippet(s.Snippet),
+		Rank:            s.Rank,
+		CreatedAt:       core.DateTime{Value: s.CreatedAt},
+	}
+}
+
+type SearchBunRepository struct {
+	db *bun.DB
+	tx *coredatabase.TransactionBun
+}
+
+func NewSearchBunRepository(connection *bun.DB) *SearchBunRepository {
+	return &SearchBunRepository{db: connection, tx: nil}
+}
+
+func (r *SearchBunRepository) SetTransaction(tx core.Transaction) error {
+	if r.tx != nil && !r.tx.IsClosed() {
+		return nil
+	}
+
+	r.tx = tx.(*coredatabase.TransactionBun)
+	return nil
+}
+
+/*
+accessibleProjectsQuery returns the projects of the organization the authenticated user is an active member of.
+*/
+func (r *SearchBunRepository) accessibleProjectsQuery(params searchrepo.SearchParams) (string, []interface{}) {
+	var args []interface{} = []interface{}{params.OrganizationIdentity.Internal.String()}
+	var query string = `
+		SELECT project.internal_id FROM project
+		WHERE project.workspace_internal_id IN (
+			SELECT workspace.internal_id FROM workspace
+			WHERE workspace.organization_internal_id = ?
+		)`
+
+	if params.AuthenticatedUserIdentity != nil {
+		query = query + ` AND project.internal_id IN (
+			SELECT project_user.project_internal_id FROM project_user
+			WHERE project_user.user_internal_id = ? AND project_user.status = ?
+		)`
+		args = append(args, params.AuthenticatedUserIdentity.Internal.String(), project.ProjectUserStatusActive)
+	}
+
+	if params.ProjectIdentity != nil {
+		query = query + ` AND project.internal_id = ?`
+		args = append(args, params.ProjectIdentity.Internal.String())
+	}
+
+	return query, args
+}
+
+func (r *SearchBunRepository) Search(params searchrepo.SearchParams) (*core.PaginationOutput[search.SearchResult], error) {
+	var rows []searchResultTable = make([]searchResultTable, 0)
+	var perPage int = 10
+	var page int = 1
+
+	if params.Pagination.PerPage != nil {
+		perPage = *params.Pagination.PerPage
+	}
+
+	if params.Pagination.Page != nil {
+		page = *params.Pagination.Page
+	}
+
+	var types []search.SearchResultTypes = params.Types
+	if len(types) == 0 {
+		types = search.SearchResultTypesArray
+	}
+
+	accessibleProjectsQuery, accessibleProjectsArgs := r.accessibleProjectsQuery(params)
+
+	var branches []string = make([]string, 0)
+	var args []interface{} = []interface{}{params.Query}
+	args = append(args, accessibleProjectsArgs...)
+
+	if slices.Contains(types, search.SearchResultTypeTask) {
+		branches = append(branches, `
+			SELECT
+				'task' AS type,
+				task.internal_id AS internal_id,
+				task.project_internal_id AS project_internal_id,
+				task.internal_id AS task_internal_id,
+				task.name AS title,
+				ts_headline('simple', translate(CONCAT_WS(' ', task.name, task.description_text), chr(2) || chr(3), ''), search_query.query, ?) AS snippet,
+				ts_rank(task.search_vector, search_query.query) AS rank,
+				task.created_at AS created_at
+			FROM task
+			INNER JOIN accessible_project ON accessible_project.internal_id = task.project_internal_id
+			CROSS JOIN search_query
+			WHERE task.deleted_at IS NULL AND task.search_vector @@ search_query.query`)
+		args = append(args, searchHeadlineOptions)
+	}
+
+	if slices.Contains(types, search.SearchResultTypeTaskComment) {
+		branches = append(branches, `
+			SELECT
+				'task_comment' AS type,
+				task_comment.internal_id AS internal_id,
+				task.project_internal_id AS project_internal_id,
+				task.internal_id AS task_internal_id,
+				task.name AS title,
+				ts_headline('simple', translate(task_comment.content_text, chr(2) || chr(3), ''), search_query.query, ?) AS snippet,
+				ts_rank(task_comment.search_vector, search_query.query) AS rank,
+				task_comment.created_at AS created_at
+			FROM task_comment
+			INNER JOIN task ON task.internal_id = task_comment.task_internal_id
+			INNER JOIN accessible_project ON accessible_project.internal_id = task.project_internal_id
+			CROSS JOIN search_query
+			WHERE task.deleted_at IS NULL AND task_comment.deleted_at IS NULL AND task_comment.search_vector @@ search_query.query`)
+		args = append(args, searchHeadlineOptions)
+	}
+
+	if slices.Contains(types, search.SearchResultTypeProjectDocument) {
+		branches = append(branches, `
+			SELECT
+				'project_document' AS type,
+				project_document_version_manager.internal_id AS internal_id,
+				project_document_version_manager.project_internal_id AS project_internal_id,
+				NULL::UUID AS task_internal_id,
+				project_document_version.title AS title,
+				ts_headline('simple', translate(project_document_version.content_text, chr(2) || chr(3), ''), search_query.query, ?) AS snippet,
+				ts_rank(project_document_version.search_vector, search_query.query) AS rank,
+				project_document_version.created_at AS created_at
+			FROM project_document_version
+			INNER JOIN project_document_version_manager ON project_document_version_manager.internal_id = project_document_version.project_document_version_manager_internal_id
+			INNER JOIN accessible_project ON accessible_project.internal_id = project_document_version_manager.project_internal_id
+			CROSS JOIN search_query
+			WHERE project_document_version.latest = TRUE AND project_document_version.search_vector @@ search_query.query`)
+		args = append(args, searchHeadlineOptions)
+	}
+
+	query := `
+		WITH search_query AS (
+			SELECT websearch_to_tsquery('simple', ?) AS query
+		), accessible_project AS (` + accessibleProjectsQuery + `
+		), search_result AS (` + strings.Join(branches, " UNION ALL ") + `
+		)
+		SELECT search_result.*, COUNT(*) OVER () AS total_count
+		FROM search_result
+		ORDER BY search_result.rank DESC, search_result.created_at DESC
+		LIMIT ? OFFSET ?
+	`
+	args = append(args, perPage, (page-1)*perPage)
+
+	var rawQuery *bun.RawQuery
+	if r.tx != nil && !r.tx.IsClosed() {
+		rawQuery = r.tx.Tx.NewRaw(query, args...)
+	} else {
+		rawQuery = r.db.NewRaw(query, args...)
+	}
+
+	err := rawQuery.Scan(context.Background(), &rows)
+	if err != nil && err != sql.ErrNoRows {
+		return nil, err
+	}
+
+	var total int = 0
+	var results []search.SearchResult = make([]search.SearchResult, 0)
+	for _, row := range rows {
+		total = row.TotalCount
+		results = append(results, *row.ToEntity())
+	}
+
+	return &core.PaginationOutput[search.SearchResult]{
+		Data:    results,
+		Page:    page,
+		HasMore: page*perPage < total,
+		Total:   total,
+	}, nil
+}
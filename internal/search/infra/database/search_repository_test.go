@@ -0,0 +1,27 @@
+package searchdatabase
+
+import "testing"
+
+func TestHighlightSnippet(t *testing.T) {
+	tests := []struct {
+		name     string
+		headline string
+		expected string
+	}{
+		{name: "plain text", headline: "nothing matched", expected: "nothing matched"},
+		{name: "match", headline: "fix the \x02login\x03 page", expected: "fix the <mark>login</mark> page"},
+		{name: "several matches", headline: "\x02a\x03 ... \x02b\x03", expected: "<mark>a</mark> ... <mark>b</mark>"},
+		{name: "markup escaped", headline: "<img src=x onerror=\"alert(1)\"> \x02login\x03", expected: "&lt;img src=x onerror=&#34;alert(1)&#34;&gt; <mark>login</mark>"},
+		{name: "mark tags in content escaped", headline: "<mark>fake</mark> \x02real\x03", expected: "&lt;mark&gt;fake&lt;/mark&gt; <mark>real</mark>"},
+		{name: "entities escaped", headline: "a &amp; b", expected: "a &amp;amp; b"},
+		{name: "quotes escaped", headline: `it's "quoted"`, expected: "it&#39;s &#34;quoted&#34;"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := highlightSnippet(tt.headline); got != tt.expected {
+				t.Errorf("expected %q, got %q", tt.expected, got)
+			}
+		})
+	}
+}
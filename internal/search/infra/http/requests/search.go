@@ -0,0 +1,52 @@
+package searchhttprequests
+
+import (
+	"strings"
+
+	"github.com/gabrielmrtt/taski/internal/core"
+	"github.com/gabrielmrtt/taski/internal/search"
+	searchservice "github.com/gabrielmrtt/taski/internal/search/service"
+	"github.com/gin-gonic/gin"
+	"github.com/gorilla/schema"
+)
+
+type SearchRequest struct {
+	Query     string  `json:"q" schema:"q"`
+	Types     *string `json:"types" schema:"types"`
+	ProjectId *string `json:"projectId" schema:"projectId"`
+	Page      *int    `json:"page" schema:"page"`
+	PerPage   *int    `json:"perPage" schema:"perPage"`
+}
+
+func (r *SearchRequest) FromQuery(ctx *gin.Context) error {
+	schemaDecoder := schema.NewDecoder()
+	schemaDecoder.IgnoreUnknownKeys(true)
+	return schemaDecoder.Decode(r, ctx.Request.URL.Query())
+}
+
+func (r *SearchRequest) ToInput() searchservice.SearchInput {
+	var types []search.SearchResultTypes = make([]search.SearchResultTypes, 0)
+	if r.Types != nil {
+		for _, resultType := range strings.Split(*r.Types, ",") {
+			if strings.TrimSpace(resultType) != "" {
+				types = append(types, search.SearchResultTypes(strings.TrimSpace(resultType)))
+			}
+		}
+	}
+
+	var projectIdentity *core.Identity = nil
+	if r.ProjectId != nil {
+		identity := core.NewIdentityFromPublic(*r.ProjectId)
+		projectIdentity = &identity
+	}
+
+	return searchservice.SearchInput{
+		Query:           r.Query,
+		Types:           types,
+		ProjectIdentity: projectIdentity,
+		Pagination: core.PaginationInput{
+			Page:    r.Page,
+			PerPage: r.PerPage,
+		},
+	}
+}
@@ -0,0 +1,78 @@
+package searchhttp
+
+import (
+	"net/http"
+
+	authhttpmiddlewares "github.com/gabrielmrtt/taski/internal/auth/infra/http/middlewares"
+	"github.com/gabrielmrtt/taski/internal/core"
+	corehttp "github.com/gabrielmrtt/taski/internal/core/http"
+	organizationhttpmiddlewares "github.com/gabrielmrtt/taski/internal/organization/infra/http/middlewares"
+	"github.com/gabrielmrtt/taski/internal/search"
+	searchhttprequests "github.com/gabrielmrtt/taski/internal/search/infra/http/requests"
+	searchservice "github.com/gabrielmrtt/taski/internal/search/service"
+	"github.com/gin-gonic/gin"
+)
+
+type SearchHandler struct {
+	SearchService *searchservice.SearchService
+}
+
+func NewSearchHandler(
+	searchService *searchservice.SearchService,
+) *SearchHandler {
+	return &SearchHandler{
+		SearchService: searchService,
+	}
+}
+
+type SearchResponse = corehttp.HttpSuccessResponseWithData[core.PaginationOutput[search.SearchResultDto]]
+
+// Search godoc
+// @Summary Search tasks, comments and documents
+// @Description Searches the tasks, task comments and project documents the authenticated user can access in the current organization. Results are ordered by relevance and snippets are HTML escaped text highlighting the matches with <mark> tags.
+// @Tags Search
+// @Accept json
+// @Param request query searchhttprequests.SearchRequest true "Query parameters"
+// @Produce json
+// @Success 200 {object} SearchResponse
+// @Failure 400 {object} corehttp.HttpErrorResponse
+// @Failure 401 {object} corehttp.HttpErrorResponse
+// @Failure 403 {object} corehttp.HttpErrorResponse
+// @Failure 500 {object} corehttp.HttpErrorResponse
+// @Router /search [get]
+func (h *SearchHandler) Search(c *gin.Context) {
+	var request searchhttprequests.SearchRequest
+	var organizationIdentity *core.Identity = authhttpmiddlewares.GetAuthenticatedUserLastAccessedOrganizationIdentity(c)
+	var authenticatedUserIdentity *core.Identity = authhttpmiddlewares.GetAuthenticatedUserIdentity(c)
+	var input searchservice.SearchInput
+
+	if err := request.FromQuery(c); err != nil {
+		corehttp.NewHttpErrorResponse(c, err)
+		return
+	}
+
+	input = request.ToInput()
+	input.OrganizationIdentity = organizationIdentity
+	input.AuthenticatedUserIdentity = authenticatedUserIdentity
+	result, err := h.SearchService.Execute(input)
+	if err != nil {
+		corehttp.NewHttpErrorResponse(c, err)
+		return
+	}
+
+	corehttp.NewHttpSuccessResponseWithData(c, http.StatusOK, result)
+}
+
+func (h *SearchHandler) ConfigureRoutes(options corehttp.ConfigureRoutesOptions) *gin.RouterGroup {
+	middlewareOptions := corehttp.MiddlewareOptions{
+		DbConnection: options.DbConnection,
+	}
+
+	g := options.RouterGroup.Group("/search")
+	{
+		g.Use(authhttpmiddlewares.AuthMiddleware(middlewareOptions))
+		g.GET("", organizationhttpmiddlewares.UserMustHavePermission("projects:view", middlewareOptions), organizationhttpmiddlewares.UserMustHavePermission("tasks:view", middlewareOptions), h.Search)
+	}
+
+	return g
+}
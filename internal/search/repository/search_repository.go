@@ -0,0 +1,21 @@
+package searchrepo
+
+import (
+	"github.com/gabrielmrtt/taski/internal/core"
+	"github.com/gabrielmrtt/taski/internal/search"
+)
+
+type SearchParams struct {
+	Query                     string
+	Types                     []search.SearchResultTypes
+	OrganizationIdentity      core.Identity
+	AuthenticatedUserIdentity *core.Identity
+	ProjectIdentity           *core.Identity
+	Pagination                core.PaginationInput
+}
+
+type SearchRepository interface {
+	SetTransaction(tx core.Transaction) error
+
+	Search(params SearchParams) (*core.PaginationOutput[search.SearchResult], error)
+}
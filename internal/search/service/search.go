@@ -0,0 +1,94 @@
+package searchservice
+
+import (
+	"slices"
+	"strings"
+
+	"github.com/gabrielmrtt/taski/internal/core"
+	"github.com/gabrielmrtt/taski/internal/search"
+	searchrepo "github.com/gabrielmrtt/taski/internal/search/repository"
+)
+
+type SearchService struct {
+	SearchRepository searchrepo.SearchRepository
+}
+
+func NewSearchService(
+	searchRepository searchrepo.SearchRepository,
+) *SearchService {
+	return &SearchService{
+		SearchRepository: searchRepository,
+	}
+}
+
+type SearchInput struct {
+	OrganizationIdentity      *core.Identity
+	AuthenticatedUserIdentity *core.Identity
+	ProjectIdentity           *core.Identity
+	Query                     string
+	Types                     []search.SearchResultTypes
+	Pagination                core.PaginationInput
+}
+
+func (i SearchInput) Validate() error {
+	var fields []core.InvalidInputErrorField
+
+	if i.OrganizationIdentity == nil {
+		fields = append(fields, core.InvalidInputErrorField{
+			Field: "organization_identity",
+			Error: "organization identity is required",
+		})
+	}
+
+	if strings.TrimSpace(i.Query) == "" {
+		fields = append(fields, core.InvalidInputErrorField{
+			Field: "query",
+			Error: "query is required",
+		})
+	}
+
+	for _, resultType := range i.Types {
+		if !slices.Contains(search.SearchResultTypesArray, resultType) {
+			fields = append(fields, core.InvalidInputErrorField{
+				Field: "types",
+				Error: "type " + string(resultType) + " is not supported",
+			})
+		}
+	}
+
+	if len(fields) > 0 {
+		return core.NewInvalidInputError("invalid input", fields)
+	}
+
+	return nil
+}
+
+func (s *SearchService) Execute(input SearchInput) (*core.PaginationOutput[search.SearchResultDto], error) {
+	if err := input.Validate(); err != nil {
+		return nil, err
+	}
+
+	searchResults, err := s.SearchRepository.Search(searchrepo.SearchParams{
+		Query:                     input.Query,
+		Types:                     input.Types,
+		OrganizationIdentity:      *input.OrganizationIdentity,
+		AuthenticatedUserIdentity: input.AuthenticatedUserIdentity,
+		ProjectIdentity:           input.ProjectIdentity,
+		Pagination:                input.Pagination,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var searchResultsDto []search.SearchResultDto = make([]search.SearchResultDto, 0)
+	for _, searchResult := range searchResults.Data {
+		searchResultsDto = append(searchResultsDto, *search.SearchResultToDto(&searchResult))
+	}
+
+	return &core.PaginationOutput[search.SearchResultDto]{
+		Data:    searchResultsDto,
+		Page:    searchResults.Page,
+		HasMore: searchResults.HasMore,
+		Total:   searchResults.Total,
+	}, nil
+}
@@ -0,0 +1,3 @@
+DROP TABLE IF EXISTS task_custom_field_value;
+
+DROP TABLE IF EXISTS project_task_custom_field;
\ No newline at end of file
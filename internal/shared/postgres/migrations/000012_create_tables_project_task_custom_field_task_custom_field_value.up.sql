@@ -0,0 +1,28 @@
+CREATE TABLE IF NOT EXISTS project_task_custom_field (
+    internal_id UUID NOT NULL PRIMARY KEY,
+    public_id VARCHAR(510) UNIQUE NOT NULL,
+    name VARCHAR(255) NOT NULL,
+    type VARCHAR(100) NOT NULL,
+    options JSONB NOT NULL DEFAULT '[]',
+    is_required BOOLEAN NOT NULL DEFAULT FALSE,
+    project_internal_id UUID NOT NULL,
+    deleted_at BIGINT,
+
+    CONSTRAINT fk_project_task_custom_field_project FOREIGN KEY (project_internal_id) REFERENCES project(internal_id) ON DELETE CASCADE
+);
+
+CREATE TABLE IF NOT EXISTS task_custom_field_value (
+    internal_id UUID NOT NULL PRIMARY KEY,
+    task_internal_id UUID NOT NULL,
+    project_task_custom_field_internal_id UUID NOT NULL,
+    text_value TEXT,
+    number_value DOUBLE PRECISION,
+    date_value BIGINT,
+    user_internal_id UUID,
+
+    CONSTRAINT fk_task_custom_field_value_task FOREIGN KEY (task_internal_id) REFERENCES task(internal_id) ON DELETE CASCADE,
+    CONSTRAINT fk_task_custom_field_value_project_task_custom_field FOREIGN KEY (project_task_custom_field_internal_id) REFERENCES project_task_custom_field(internal_id) ON DELETE CASCADE,
+    CONSTRAINT fk_task_custom_field_value_user FOREIGN KEY (user_internal_id) REFERENCES users(internal_id) ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS idx_task_custom_field_value_field_task ON task_custom_field_value (project_task_custom_field_internal_id, task_internal_id);
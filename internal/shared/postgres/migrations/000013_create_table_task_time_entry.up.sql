@@ -0,0 +1,20 @@
+CREATE TABLE IF NOT EXISTS task_time_entry (
+    internal_id UUID NOT NULL PRIMARY KEY,
+    public_id VARCHAR(510) UNIQUE NOT NULL,
+    description VARCHAR(510),
+    started_at BIGINT NOT NULL,
+    ended_at BIGINT,
+    task_internal_id UUID NOT NULL,
+    user_internal_id UUID NOT NULL,
+    created_at BIGINT NOT NULL,
+    updated_at BIGINT,
+
+    CONSTRAINT fk_task_time_entry_task FOREIGN KEY (task_internal_id) REFERENCES task(internal_id) ON DELETE CASCADE,
+    CONSTRAINT fk_task_time_entry_user FOREIGN KEY (user_internal_id) REFERENCES users(internal_id) ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS idx_task_time_entry_task ON task_time_entry (task_internal_id);
+
+CREATE INDEX IF NOT EXISTS idx_task_time_entry_user_started_at ON task_time_entry (user_internal_id, started_at);
+
+CREATE UNIQUE INDEX IF NOT EXISTS uq_task_time_entry_running_per_user ON task_time_entry (user_internal_id) WHERE ended_at IS NULL;
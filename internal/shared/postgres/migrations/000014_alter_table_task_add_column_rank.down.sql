@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS idx_task_status_rank;
+
+ALTER TABLE task DROP COLUMN rank;
\ No newline at end of file
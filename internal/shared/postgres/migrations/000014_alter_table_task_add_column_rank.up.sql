@@ -0,0 +1,10 @@
+ALTER TABLE task ADD COLUMN rank VARCHAR(255) COLLATE "C" NOT NULL DEFAULT '';
+
+UPDATE task SET rank = ranked.rank
+FROM (
+    SELECT internal_id, LPAD(ROW_NUMBER() OVER (PARTITION BY project_internal_id, project_task_status_internal_id ORDER BY created_at, internal_id)::TEXT, 10, '0') || 'i' AS rank
+    FROM task
+) AS ranked
+WHERE task.internal_id = ranked.internal_id;
+
+CREATE INDEX IF NOT EXISTS idx_task_status_rank ON task (project_internal_id, project_task_status_internal_id, rank);
@@ -0,0 +1 @@
+DROP TABLE IF EXISTS project_task_status_transition;
\ No newline at end of file
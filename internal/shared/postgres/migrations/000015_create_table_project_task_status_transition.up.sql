@@ -0,0 +1,15 @@
+CREATE TABLE IF NOT EXISTS project_task_status_transition (
+    internal_id UUID NOT NULL PRIMARY KEY,
+    public_id VARCHAR(510) UNIQUE NOT NULL,
+    project_internal_id UUID NOT NULL,
+    from_status_internal_id UUID,
+    to_status_internal_id UUID NOT NULL,
+    role_internal_ids JSONB NOT NULL DEFAULT '[]',
+    required_fields JSONB NOT NULL DEFAULT '[]',
+
+    CONSTRAINT fk_project_task_status_transition_project FOREIGN KEY (project_internal_id) REFERENCES project(internal_id) ON DELETE CASCADE,
+    CONSTRAINT fk_project_task_status_transition_from_status FOREIGN KEY (from_status_internal_id) REFERENCES project_task_status(internal_id) ON DELETE CASCADE,
+    CONSTRAINT fk_project_task_status_transition_to_status FOREIGN KEY (to_status_internal_id) REFERENCES project_task_status(internal_id) ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS idx_project_task_status_transition_project ON project_task_status_transition (project_internal_id);
@@ -0,0 +1,3 @@
+ALTER TABLE project_task_status DROP COLUMN IF EXISTS wip_limit_policy;
+ALTER TABLE project_task_status DROP COLUMN IF EXISTS wip_limit_per_assignee;
+ALTER TABLE project_task_status DROP COLUMN IF EXISTS wip_limit;
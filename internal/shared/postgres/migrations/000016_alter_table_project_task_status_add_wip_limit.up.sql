@@ -0,0 +1,3 @@
+ALTER TABLE project_task_status ADD COLUMN wip_limit SMALLINT;
+ALTER TABLE project_task_status ADD COLUMN wip_limit_per_assignee SMALLINT;
+ALTER TABLE project_task_status ADD COLUMN wip_limit_policy VARCHAR(255) NOT NULL DEFAULT 'reject';
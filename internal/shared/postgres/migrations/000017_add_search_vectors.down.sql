@@ -0,0 +1,7 @@
+DROP INDEX IF EXISTS idx_project_document_version_search_vector;
+DROP INDEX IF EXISTS idx_task_comment_search_vector;
+DROP INDEX IF EXISTS idx_task_search_vector;
+
+ALTER TABLE project_document_version DROP COLUMN search_vector;
+ALTER TABLE task_comment DROP COLUMN search_vector;
+ALTER TABLE task DROP COLUMN search_vector;
@@ -0,0 +1,17 @@
+ALTER TABLE task ADD COLUMN search_vector TSVECTOR GENERATED ALWAYS AS (
+    setweight(to_tsvector('simple', COALESCE(name, '')), 'A') ||
+    setweight(to_tsvector('simple', COALESCE(description, '')), 'B')
+) STORED;
+
+ALTER TABLE task_comment ADD COLUMN search_vector TSVECTOR GENERATED ALWAYS AS (
+    to_tsvector('simple', COALESCE(content, ''))
+) STORED;
+
+ALTER TABLE project_document_version ADD COLUMN search_vector TSVECTOR GENERATED ALWAYS AS (
+    setweight(to_tsvector('simple', COALESCE(title, '')), 'A') ||
+    setweight(to_tsvector('simple', COALESCE(content, '')), 'B')
+) STORED;
+
+CREATE INDEX IF NOT EXISTS idx_task_search_vector ON task USING GIN (search_vector);
+CREATE INDEX IF NOT EXISTS idx_task_comment_search_vector ON task_comment USING GIN (search_vector);
+CREATE INDEX IF NOT EXISTS idx_project_document_version_search_vector ON project_document_version USING GIN (search_vector);
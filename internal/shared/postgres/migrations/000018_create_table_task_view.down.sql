@@ -0,0 +1 @@
+DROP TABLE IF EXISTS task_view;
\ No newline at end of file
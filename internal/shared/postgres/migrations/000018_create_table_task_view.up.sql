@@ -0,0 +1,22 @@
+CREATE TABLE IF NOT EXISTS task_view (
+    internal_id UUID NOT NULL PRIMARY KEY,
+    public_id VARCHAR(510) UNIQUE NOT NULL,
+    organization_internal_id UUID NOT NULL,
+    project_internal_id UUID,
+    user_owner_internal_id UUID NOT NULL,
+    name VARCHAR(255) NOT NULL,
+    visibility VARCHAR(50) NOT NULL DEFAULT 'private',
+    filters JSONB NOT NULL DEFAULT '{}',
+    sort_by VARCHAR(255),
+    sort_direction VARCHAR(10),
+    group_by VARCHAR(50),
+    created_at BIGINT NOT NULL,
+    updated_at BIGINT,
+
+    CONSTRAINT fk_task_view_organization FOREIGN KEY (organization_internal_id) REFERENCES organization(internal_id) ON DELETE CASCADE,
+    CONSTRAINT fk_task_view_project FOREIGN KEY (project_internal_id) REFERENCES project(internal_id) ON DELETE CASCADE,
+    CONSTRAINT fk_task_view_user_owner FOREIGN KEY (user_owner_internal_id) REFERENCES users(internal_id) ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS idx_task_view_organization_user_owner ON task_view (organization_internal_id, user_owner_internal_id);
+CREATE INDEX IF NOT EXISTS idx_task_view_project ON task_view (project_internal_id);
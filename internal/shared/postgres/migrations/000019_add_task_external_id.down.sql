@@ -0,0 +1,2 @@
+DROP INDEX IF EXISTS idx_task_project_external_id;
+ALTER TABLE task DROP COLUMN IF EXISTS external_id;
\ No newline at end of file
@@ -0,0 +1,3 @@
+ALTER TABLE task ADD COLUMN IF NOT EXISTS external_id VARCHAR(255);
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_task_project_external_id ON task (project_internal_id, external_id) WHERE external_id IS NOT NULL;
@@ -0,0 +1 @@
+DROP TABLE IF EXISTS task_calendar_feed;
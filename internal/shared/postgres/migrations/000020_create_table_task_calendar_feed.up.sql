@@ -0,0 +1,16 @@
+CREATE TABLE IF NOT EXISTS task_calendar_feed (
+    internal_id UUID NOT NULL PRIMARY KEY,
+    public_id VARCHAR(510) UNIQUE NOT NULL,
+    organization_internal_id UUID NOT NULL,
+    project_internal_id UUID,
+    user_owner_internal_id UUID NOT NULL,
+    token VARCHAR(255) UNIQUE NOT NULL,
+    created_at BIGINT NOT NULL,
+    revoked_at BIGINT,
+
+    CONSTRAINT fk_task_calendar_feed_organization FOREIGN KEY (organization_internal_id) REFERENCES organization(internal_id) ON DELETE CASCADE,
+    CONSTRAINT fk_task_calendar_feed_project FOREIGN KEY (project_internal_id) REFERENCES project(internal_id) ON DELETE CASCADE,
+    CONSTRAINT fk_task_calendar_feed_user_owner FOREIGN KEY (user_owner_internal_id) REFERENCES users(internal_id) ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS idx_task_calendar_feed_organization_user_owner ON task_calendar_feed (organization_internal_id, user_owner_internal_id);
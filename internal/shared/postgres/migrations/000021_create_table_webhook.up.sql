@@ -0,0 +1,42 @@
+CREATE TABLE IF NOT EXISTS webhook (
+    internal_id UUID NOT NULL PRIMARY KEY,
+    public_id VARCHAR(510) UNIQUE NOT NULL,
+    organization_internal_id UUID NOT NULL,
+    url VARCHAR(2048) NOT NULL,
+    secret VARCHAR(255) NOT NULL,
+    events JSONB NOT NULL DEFAULT '[]',
+    status VARCHAR(50) NOT NULL DEFAULT 'active',
+    user_creator_internal_id UUID,
+    user_editor_internal_id UUID,
+    created_at BIGINT NOT NULL,
+    updated_at BIGINT,
+
+    CONSTRAINT fk_webhook_organization FOREIGN KEY (organization_internal_id) REFERENCES organization(internal_id) ON DELETE CASCADE,
+    CONSTRAINT fk_webhook_user_creator FOREIGN KEY (user_creator_internal_id) REFERENCES users(internal_id) ON DELETE SET NULL,
+    CONSTRAINT fk_webhook_user_editor FOREIGN KEY (user_editor_internal_id) REFERENCES users(internal_id) ON DELETE SET NULL
+);
+
+CREATE INDEX IF NOT EXISTS idx_webhook_organization ON webhook (organization_internal_id);
+
+CREATE TABLE IF NOT EXISTS webhook_delivery (
+    internal_id UUID NOT NULL PRIMARY KEY,
+    public_id VARCHAR(510) UNIQUE NOT NULL,
+    webhook_internal_id UUID NOT NULL,
+    event_id VARCHAR(510) NOT NULL,
+    event_type VARCHAR(100) NOT NULL,
+    payload TEXT NOT NULL,
+    status VARCHAR(50) NOT NULL DEFAULT 'pending',
+    attempts SMALLINT NOT NULL DEFAULT 0,
+    next_attempt_at BIGINT,
+    last_attempt_at BIGINT,
+    response_status INTEGER,
+    response_body TEXT,
+    error TEXT,
+    created_at BIGINT NOT NULL,
+    completed_at BIGINT,
+
+    CONSTRAINT fk_webhook_delivery_webhook FOREIGN KEY (webhook_internal_id) REFERENCES webhook(internal_id) ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS idx_webhook_delivery_webhook_created_at ON webhook_delivery (webhook_internal_id, created_at);
+CREATE INDEX IF NOT EXISTS idx_webhook_delivery_pending ON webhook_delivery (next_attempt_at) WHERE status = 'pending';
@@ -0,0 +1,33 @@
+CREATE TABLE IF NOT EXISTS notification (
+    internal_id UUID NOT NULL PRIMARY KEY,
+    public_id VARCHAR(510) UNIQUE NOT NULL,
+    user_internal_id UUID NOT NULL,
+    type VARCHAR(100) NOT NULL,
+    message VARCHAR(1020) NOT NULL,
+    organization_internal_id UUID,
+    project_internal_id UUID,
+    task_internal_id UUID,
+    user_actor_internal_id UUID,
+    deduplication_key VARCHAR(510),
+    read_at BIGINT,
+    created_at BIGINT NOT NULL,
+
+    CONSTRAINT fk_notification_user FOREIGN KEY (user_internal_id) REFERENCES users(internal_id) ON DELETE CASCADE,
+    CONSTRAINT fk_notification_organization FOREIGN KEY (organization_internal_id) REFERENCES organization(internal_id) ON DELETE CASCADE,
+    CONSTRAINT fk_notification_project FOREIGN KEY (project_internal_id) REFERENCES project(internal_id) ON DELETE CASCADE,
+    CONSTRAINT fk_notification_task FOREIGN KEY (task_internal_id) REFERENCES task(internal_id) ON DELETE CASCADE,
+    CONSTRAINT fk_notification_user_actor FOREIGN KEY (user_actor_internal_id) REFERENCES users(internal_id) ON DELETE SET NULL,
+    CONSTRAINT uq_notification_user_deduplication_key UNIQUE (user_internal_id, deduplication_key)
+);
+
+CREATE INDEX IF NOT EXISTS idx_notification_user_created_at ON notification (user_internal_id, created_at);
+CREATE INDEX IF NOT EXISTS idx_notification_user_unread ON notification (user_internal_id) WHERE read_at IS NULL;
+
+CREATE TABLE IF NOT EXISTS notification_preference (
+    user_internal_id UUID NOT NULL,
+    type VARCHAR(100) NOT NULL,
+    enabled BOOLEAN NOT NULL DEFAULT TRUE,
+
+    PRIMARY KEY (user_internal_id, type),
+    CONSTRAINT fk_notification_preference_user FOREIGN KEY (user_internal_id) REFERENCES users(internal_id) ON DELETE CASCADE
+);
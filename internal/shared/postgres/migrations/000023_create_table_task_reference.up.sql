@@ -0,0 +1,20 @@
+CREATE TABLE IF NOT EXISTS task_reference (
+    internal_id UUID NOT NULL PRIMARY KEY,
+    type VARCHAR(100) NOT NULL,
+    task_internal_id UUID,
+    task_comment_internal_id UUID,
+    user_internal_id UUID,
+    referenced_task_internal_id UUID,
+    user_author_internal_id UUID NOT NULL,
+    created_at BIGINT NOT NULL,
+
+    CONSTRAINT fk_task_reference_task FOREIGN KEY (task_internal_id) REFERENCES task(internal_id) ON DELETE CASCADE,
+    CONSTRAINT fk_task_reference_task_comment FOREIGN KEY (task_comment_internal_id) REFERENCES task_comment(internal_id) ON DELETE CASCADE,
+    CONSTRAINT fk_task_reference_user FOREIGN KEY (user_internal_id) REFERENCES users(internal_id) ON DELETE CASCADE,
+    CONSTRAINT fk_task_reference_referenced_task FOREIGN KEY (referenced_task_internal_id) REFERENCES task(internal_id) ON DELETE CASCADE,
+    CONSTRAINT fk_task_reference_user_author FOREIGN KEY (user_author_internal_id) REFERENCES users(internal_id) ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS idx_task_reference_task ON task_reference (task_internal_id);
+CREATE INDEX IF NOT EXISTS idx_task_reference_task_comment ON task_reference (task_comment_internal_id);
+CREATE INDEX IF NOT EXISTS idx_task_reference_user_created_at ON task_reference (user_internal_id, created_at) WHERE user_internal_id IS NOT NULL;
@@ -0,0 +1,9 @@
+DROP TABLE IF EXISTS task_comment_reaction;
+
+DROP INDEX IF EXISTS idx_task_comment_parent_task_comment;
+
+ALTER TABLE task_comment DROP CONSTRAINT IF EXISTS fk_task_comment_user_resolved;
+ALTER TABLE task_comment DROP CONSTRAINT IF EXISTS fk_task_comment_parent_task_comment;
+ALTER TABLE task_comment DROP COLUMN IF EXISTS user_resolved_internal_id;
+ALTER TABLE task_comment DROP COLUMN IF EXISTS resolved_at;
+ALTER TABLE task_comment DROP COLUMN IF EXISTS parent_task_comment_internal_id;
@@ -0,0 +1,18 @@
+ALTER TABLE task_comment ADD COLUMN parent_task_comment_internal_id UUID;
+ALTER TABLE task_comment ADD COLUMN resolved_at BIGINT;
+ALTER TABLE task_comment ADD COLUMN user_resolved_internal_id UUID;
+ALTER TABLE task_comment ADD CONSTRAINT fk_task_comment_parent_task_comment FOREIGN KEY (parent_task_comment_internal_id) REFERENCES task_comment(internal_id) ON DELETE CASCADE;
+ALTER TABLE task_comment ADD CONSTRAINT fk_task_comment_user_resolved FOREIGN KEY (user_resolved_internal_id) REFERENCES users(internal_id) ON DELETE SET NULL;
+
+CREATE INDEX IF NOT EXISTS idx_task_comment_parent_task_comment ON task_comment (parent_task_comment_internal_id);
+
+CREATE TABLE IF NOT EXISTS task_comment_reaction (
+    task_comment_internal_id UUID NOT NULL,
+    user_internal_id UUID NOT NULL,
+    emoji VARCHAR(64) NOT NULL,
+    created_at BIGINT NOT NULL,
+
+    PRIMARY KEY (task_comment_internal_id, user_internal_id, emoji),
+    CONSTRAINT fk_task_comment_reaction_task_comment FOREIGN KEY (task_comment_internal_id) REFERENCES task_comment(internal_id) ON DELETE CASCADE,
+    CONSTRAINT fk_task_comment_reaction_user FOREIGN KEY (user_internal_id) REFERENCES users(internal_id) ON DELETE CASCADE
+);
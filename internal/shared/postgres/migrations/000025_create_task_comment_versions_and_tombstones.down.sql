@@ -0,0 +1,8 @@
+DROP TABLE IF EXISTS task_comment_version;
+
+ALTER TABLE task_comment DROP CONSTRAINT IF EXISTS fk_task_comment_user_deleted;
+ALTER TABLE task_comment DROP COLUMN IF EXISTS user_deleted_internal_id;
+ALTER TABLE task_comment DROP COLUMN IF EXISTS deleted_at;
+ALTER TABLE task_comment DROP CONSTRAINT IF EXISTS fk_task_comment_user_editor;
+ALTER TABLE task_comment DROP COLUMN IF EXISTS user_editor_internal_id;
+ALTER TABLE task_comment DROP COLUMN IF EXISTS version;
@@ -0,0 +1,23 @@
+ALTER TABLE task_comment ADD COLUMN version INT NOT NULL DEFAULT 1;
+ALTER TABLE task_comment ADD COLUMN user_editor_internal_id UUID;
+ALTER TABLE task_comment ADD COLUMN deleted_at BIGINT;
+ALTER TABLE task_comment ADD COLUMN user_deleted_internal_id UUID;
+ALTER TABLE task_comment ADD CONSTRAINT fk_task_comment_user_editor FOREIGN KEY (user_editor_internal_id) REFERENCES users(internal_id) ON DELETE SET NULL;
+ALTER TABLE task_comment ADD CONSTRAINT fk_task_comment_user_deleted FOREIGN KEY (user_deleted_internal_id) REFERENCES users(internal_id) ON DELETE SET NULL;
+
+CREATE TABLE IF NOT EXISTS task_comment_version (
+    internal_id UUID NOT NULL PRIMARY KEY,
+    task_comment_internal_id UUID NOT NULL,
+    version INT NOT NULL,
+    content TEXT NOT NULL,
+    user_editor_internal_id UUID,
+    created_at BIGINT NOT NULL,
+
+    CONSTRAINT uq_task_comment_version UNIQUE (task_comment_internal_id, version),
+    CONSTRAINT fk_task_comment_version_task_comment FOREIGN KEY (task_comment_internal_id) REFERENCES task_comment(internal_id) ON DELETE CASCADE,
+    CONSTRAINT fk_task_comment_version_user_editor FOREIGN KEY (user_editor_internal_id) REFERENCES users(internal_id) ON DELETE SET NULL
+);
+
+INSERT INTO task_comment_version (internal_id, task_comment_internal_id, version, content, user_editor_internal_id, created_at)
+SELECT gen_random_uuid(), internal_id, 1, content, user_author_internal_id, COALESCE(updated_at, created_at)
+FROM task_comment;
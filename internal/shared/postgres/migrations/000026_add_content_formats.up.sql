@@ -0,0 +1,39 @@
+ALTER TABLE task ADD COLUMN description_format VARCHAR(50) NOT NULL DEFAULT 'markdown';
+ALTER TABLE task ADD COLUMN description_text TEXT NOT NULL DEFAULT '';
+UPDATE task SET description_text = COALESCE(description, '');
+
+ALTER TABLE task_comment ADD COLUMN content_format VARCHAR(50) NOT NULL DEFAULT 'markdown';
+ALTER TABLE task_comment ADD COLUMN content_text TEXT NOT NULL DEFAULT '';
+UPDATE task_comment SET content_text = content;
+
+ALTER TABLE task_comment_version ADD COLUMN content_format VARCHAR(50) NOT NULL DEFAULT 'markdown';
+
+ALTER TABLE project_document_version ADD COLUMN content_format VARCHAR(50) NOT NULL DEFAULT 'markdown';
+ALTER TABLE project_document_version ADD COLUMN content_text TEXT NOT NULL DEFAULT '';
+UPDATE project_document_version SET content_text = content;
+
+DROP INDEX IF EXISTS idx_project_document_version_search_vector;
+DROP INDEX IF EXISTS idx_task_comment_search_vector;
+DROP INDEX IF EXISTS idx_task_search_vector;
+
+ALTER TABLE project_document_version DROP COLUMN search_vector;
+ALTER TABLE task_comment DROP COLUMN search_vector;
+ALTER TABLE task DROP COLUMN search_vector;
+
+ALTER TABLE task ADD COLUMN search_vector TSVECTOR GENERATED ALWAYS AS (
+    setweight(to_tsvector('simple', COALESCE(name, '')), 'A') ||
+    setweight(to_tsvector('simple', description_text), 'B')
+) STORED;
+
+ALTER TABLE task_comment ADD COLUMN search_vector TSVECTOR GENERATED ALWAYS AS (
+    to_tsvector('simple', content_text)
+) STORED;
+
+ALTER TABLE project_document_version ADD COLUMN search_vector TSVECTOR GENERATED ALWAYS AS (
+    setweight(to_tsvector('simple', COALESCE(title, '')), 'A') ||
+    setweight(to_tsvector('simple', content_text), 'B')
+) STORED;
+
+CREATE INDEX IF NOT EXISTS idx_task_search_vector ON task USING GIN (search_vector);
+CREATE INDEX IF NOT EXISTS idx_task_comment_search_vector ON task_comment USING GIN (search_vector);
+CREATE INDEX IF NOT EXISTS idx_project_document_version_search_vector ON project_document_version USING GIN (search_vector);
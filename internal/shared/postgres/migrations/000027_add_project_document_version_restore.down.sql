@@ -0,0 +1,6 @@
+DROP INDEX IF EXISTS idx_project_document_version_latest;
+
+ALTER TABLE project_document_version DROP CONSTRAINT IF EXISTS fk_project_document_version_restored_from_version;
+ALTER TABLE project_document_version DROP COLUMN IF EXISTS restored_from_version_internal_id;
+
+ALTER TABLE project_document_version_manager DROP COLUMN IF EXISTS version_scheme;
@@ -0,0 +1,24 @@
+ALTER TABLE project_document_version_manager ADD COLUMN version_scheme VARCHAR(50) NOT NULL DEFAULT 'semantic';
+
+ALTER TABLE project_document_version ADD COLUMN restored_from_version_internal_id UUID;
+ALTER TABLE project_document_version ADD CONSTRAINT fk_project_document_version_restored_from_version FOREIGN KEY (restored_from_version_internal_id) REFERENCES project_document_version(internal_id) ON DELETE SET NULL;
+
+UPDATE project_document_version SET latest = FALSE
+WHERE latest AND internal_id NOT IN (
+    SELECT DISTINCT ON (project_document_version_manager_internal_id) internal_id
+    FROM project_document_version
+    WHERE latest
+    ORDER BY project_document_version_manager_internal_id, created_at DESC, internal_id DESC
+);
+
+UPDATE project_document_version SET latest = TRUE
+WHERE internal_id IN (
+    SELECT DISTINCT ON (project_document_version_manager_internal_id) internal_id
+    FROM project_document_version
+    WHERE project_document_version_manager_internal_id NOT IN (
+        SELECT project_document_version_manager_internal_id FROM project_document_version WHERE latest
+    )
+    ORDER BY project_document_version_manager_internal_id, created_at DESC, internal_id DESC
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_project_document_version_latest ON project_document_version (project_document_version_manager_internal_id) WHERE latest;
@@ -0,0 +1,4 @@
+ALTER TABLE task DROP COLUMN IF EXISTS revision;
+ALTER TABLE project_document_version DROP COLUMN IF EXISTS revision;
+ALTER TABLE project DROP COLUMN IF EXISTS revision;
+ALTER TABLE workspace DROP COLUMN IF EXISTS revision;
@@ -0,0 +1,4 @@
+ALTER TABLE workspace ADD COLUMN revision BIGINT NOT NULL DEFAULT 1;
+ALTER TABLE project ADD COLUMN revision BIGINT NOT NULL DEFAULT 1;
+ALTER TABLE project_document_version ADD COLUMN revision BIGINT NOT NULL DEFAULT 1;
+ALTER TABLE task ADD COLUMN revision BIGINT NOT NULL DEFAULT 1;
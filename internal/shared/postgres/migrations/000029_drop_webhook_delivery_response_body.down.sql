@@ -0,0 +1 @@
+ALTER TABLE webhook_delivery ADD COLUMN IF NOT EXISTS response_body TEXT;
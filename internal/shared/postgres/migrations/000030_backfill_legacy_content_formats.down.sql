@@ -0,0 +1,2 @@
+-- Legacy rows cannot be told apart from plain text written afterwards, so their format is kept.
+SELECT 1;
@@ -0,0 +1,11 @@
+-- Contents written before formats existed were plain text, and 000026 filled their *_text columns with the raw
+-- content. Rows whose text still equals the raw content are treated as legacy plain text; markdown without any
+-- syntax also matches, and it reads the same either way.
+UPDATE task_comment_version SET content_format = 'plain_text'
+WHERE content_format = 'markdown' AND task_comment_internal_id IN (
+    SELECT internal_id FROM task_comment WHERE content_format = 'markdown' AND content_text = content
+);
+
+UPDATE task SET description_format = 'plain_text' WHERE description_format = 'markdown' AND description_text = COALESCE(description, '');
+UPDATE task_comment SET content_format = 'plain_text' WHERE content_format = 'markdown' AND content_text = content;
+UPDATE project_document_version SET content_format = 'plain_text' WHERE content_format = 'markdown' AND content_text = content;
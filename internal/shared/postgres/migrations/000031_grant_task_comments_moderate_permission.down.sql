@@ -0,0 +1 @@
+DELETE FROM permissions WHERE slug = 'tasks:comments:moderate';
@@ -0,0 +1,12 @@
+INSERT INTO permissions (internal_id, slug, name, description)
+VALUES (gen_random_uuid(), 'tasks:comments:moderate', 'Tasks Comments Moderate', 'Allow users to view the edit history of task comments')
+ON CONFLICT (slug) DO NOTHING;
+
+INSERT INTO role_permission (role_internal_id, permission_internal_id)
+SELECT roles.internal_id, permissions.internal_id
+FROM roles, permissions
+WHERE roles.slug = 'admin' AND roles.is_system_default = TRUE AND permissions.slug = 'tasks:comments:moderate'
+AND NOT EXISTS (
+    SELECT 1 FROM role_permission
+    WHERE role_permission.role_internal_id = roles.internal_id AND role_permission.permission_internal_id = permissions.internal_id
+);
@@ -0,0 +1,5 @@
+-- Hashed tokens cannot be restored, so feeds created before this migration are revoked and must be created again.
+UPDATE task_calendar_feed SET revoked_at = COALESCE(revoked_at, EXTRACT(EPOCH FROM NOW())::BIGINT);
+
+ALTER TABLE task_calendar_feed RENAME CONSTRAINT task_calendar_feed_token_hash_key TO task_calendar_feed_token_key;
+ALTER TABLE task_calendar_feed RENAME COLUMN token_hash TO token;
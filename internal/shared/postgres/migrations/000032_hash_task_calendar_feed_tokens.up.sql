@@ -0,0 +1,4 @@
+ALTER TABLE task_calendar_feed RENAME COLUMN token TO token_hash;
+ALTER TABLE task_calendar_feed RENAME CONSTRAINT task_calendar_feed_token_key TO task_calendar_feed_token_hash_key;
+
+UPDATE task_calendar_feed SET token_hash = encode(sha256(convert_to(token_hash, 'UTF8')), 'hex');
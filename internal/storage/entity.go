@@ -1,12 +1,45 @@
 package storage
 
 import (
+	"net/url"
+	"regexp"
 	"slices"
 
 	"github.com/gabrielmrtt/taski/internal/core"
 	"github.com/gabrielmrtt/taski/pkg/datetimeutils"
 )
 
+var embeddedFilePathRegex = regexp.MustCompile(`(?:^|/)file/(` + UploadedFileIdentityPrefix + `_[0-9A-Za-z]+)/?$`)
+
+/*
+EmbeddedFileIdentities returns the uploaded files a content links to or embeds, through their /file/:file_id URL.
+Each file is returned once.
+*/
+func EmbeddedFileIdentities(content core.Content) []core.Identity {
+	var identities []core.Identity = make([]core.Identity, 0)
+
+	for _, destination := range content.LinkDestinations() {
+		parsedUrl, err := url.Parse(destination)
+		if err != nil {
+			continue
+		}
+
+		matches := embeddedFilePathRegex.FindStringSubmatch(parsedUrl.Path)
+		if matches == nil {
+			continue
+		}
+
+		identity := core.NewIdentityFromPublic(matches[1])
+		if identity.IsEmpty() || slices.ContainsFunc(identities, identity.Equals) {
+			continue
+		}
+
+		identities = append(identities, identity)
+	}
+
+	return identities
+}
+
 type UploadedFile struct {
 	Identity               core.Identity
 	File                   *string
@@ -0,0 +1,74 @@
+package storageservice
+
+import (
+	"fmt"
+	"slices"
+
+	"github.com/gabrielmrtt/taski/internal/core"
+	"github.com/gabrielmrtt/taski/internal/storage"
+	storagerepo "github.com/gabrielmrtt/taski/internal/storage/repository"
+)
+
+type CheckEmbeddedFilesService struct {
+	UploadedFileRepository storagerepo.UploadedFileRepository
+}
+
+func NewCheckEmbeddedFilesService(uploadedFileRepository storagerepo.UploadedFileRepository) *CheckEmbeddedFilesService {
+	return &CheckEmbeddedFilesService{uploadedFileRepository}
+}
+
+/*
+CheckEmbeddedFilesInput describes a content written by UserIdentity. PreviousContent is the content it replaces, if
+any.
+*/
+type CheckEmbeddedFilesInput struct {
+	Field           string
+	Content         core.Content
+	PreviousContent *core.Content
+	UserIdentity    core.Identity
+}
+
+/*
+Execute checks that every file embedded in the content was uploaded by the user writing it. Files that were already
+embedded in the previous content are kept without checking, so editing a content does not require owning the files
+other users embedded in it.
+*/
+func (e *CheckEmbeddedFilesService) Execute(input CheckEmbeddedFilesInput) error {
+	var previousFileIdentities []core.Identity = make([]core.Identity, 0)
+	if input.PreviousContent != nil {
+		previousFileIdentities = storage.EmbeddedFileIdentities(*input.PreviousContent)
+	}
+
+	var fields []core.InvalidInputErrorField
+	for _, fileIdentity := range storage.EmbeddedFileIdentities(input.Content) {
+		if slices.ContainsFunc(previousFileIdentities, fileIdentity.Equals) {
+			continue
+		}
+
+		uploadedFile, err := e.UploadedFileRepository.GetUploadedFileByIdentity(storagerepo.GetUploadedFileByIdentityParams{FileIdentity: fileIdentity})
+		if err != nil {
+			return err
+		}
+
+		if uploadedFile == nil {
+			fields = append(fields, core.InvalidInputErrorField{
+				Field: input.Field,
+				Error: fmt.Sprintf("embedded file %s not found", fileIdentity.Public),
+			})
+			continue
+		}
+
+		if uploadedFile.UserUploadedByIdentity.Internal != input.UserIdentity.Internal {
+			fields = append(fields, core.InvalidInputErrorField{
+				Field: input.Field,
+				Error: fmt.Sprintf("embedded file %s was uploaded by another user", fileIdentity.Public),
+			})
+		}
+	}
+
+	if len(fields) > 0 {
+		return core.NewInvalidInputError("invalid embedded files", fields)
+	}
+
+	return nil
+}
@@ -1,5 +1,7 @@
 package task
 
+import "github.com/gabrielmrtt/taski/internal/core"
+
 var TaskIdentityPrefix = "tsk"
 
 var SubTaskIdentityPrefix = "sts"
@@ -8,6 +10,12 @@ var TaskCommentIdentityPrefix = "tsc"
 
 var TaskActionIdentityPrefix = "tsa"
 
+var TaskTimeEntryIdentityPrefix = "tte"
+
+var TaskViewIdentityPrefix = "tsv"
+
+var TaskCalendarFeedIdentityPrefix = "tcf"
+
 type TaskPriorityLevels int8
 
 const (
@@ -30,6 +38,7 @@ type TaskActionType string
 
 const (
 	TaskActionTypeChangeStatus      TaskActionType = "task_status_changed"
+	TaskActionTypeMove              TaskActionType = "task_moved"
 	TaskActionTypeCreate            TaskActionType = "task_created"
 	TaskActionTypeUpdate            TaskActionType = "task_updated"
 	TaskActionTypeDelete            TaskActionType = "task_deleted"
@@ -39,8 +48,162 @@ const (
 	TaskActionTypeAddComment        TaskActionType = "comment_created"
 	TaskActionTypeUpdateComment     TaskActionType = "comment_updated"
 	TaskActionTypeDeleteComment     TaskActionType = "comment_deleted"
+	TaskActionTypeResolveComment    TaskActionType = "comment_resolved"
+	TaskActionTypeUnresolveComment  TaskActionType = "comment_unresolved"
 	TaskActionTypeComplete          TaskActionType = "task_completed"
 	TaskActionTypeSubTaskComplete   TaskActionType = "sub_task_completed"
 	TaskActionTypeUncomplete        TaskActionType = "task_uncompleted"
 	TaskActionTypeSubTaskUncomplete TaskActionType = "sub_task_uncompleted"
+	TaskActionTypeAddTimeEntry      TaskActionType = "time_entry_created"
+	TaskActionTypeUpdateTimeEntry   TaskActionType = "time_entry_updated"
+	TaskActionTypeDeleteTimeEntry   TaskActionType = "time_entry_deleted"
+)
+
+/*
+TaskCommentReactionEmojiMaxLength is the maximum length, in bytes, of a reaction. It leaves room for emoji sequences
+joined with zero width joiners and skin tone modifiers.
+*/
+const TaskCommentReactionEmojiMaxLength = 64
+
+type TaskReferenceTypes string
+
+const (
+	TaskReferenceTypeUserMention   TaskReferenceTypes = "user_mention"
+	TaskReferenceTypeTaskReference TaskReferenceTypes = "task_reference"
+)
+
+type TaskViewVisibilities string
+
+const (
+	TaskViewVisibilityPrivate TaskViewVisibilities = "private"
+	TaskViewVisibilityProject TaskViewVisibilities = "project"
+)
+
+var TaskViewVisibilitiesArray = []TaskViewVisibilities{
+	TaskViewVisibilityPrivate,
+	TaskViewVisibilityProject,
+}
+
+type TaskGroupByFields string
+
+const (
+	TaskGroupByStatus   TaskGroupByFields = "status"
+	TaskGroupByCategory TaskGroupByFields = "category"
+	TaskGroupByPriority TaskGroupByFields = "priority"
 )
+
+var TaskGroupByFieldsArray = []TaskGroupByFields{
+	TaskGroupByStatus,
+	TaskGroupByCategory,
+	TaskGroupByPriority,
+}
+
+/*
+TaskViewFilterKeys are the task listing query parameters a view can store. Custom field filters are stored with
+their customFields.<customFieldId>.<operator> key.
+*/
+var TaskViewFilterKeys = []string{
+	"projectId",
+	"statusId",
+	"categoryId",
+	"parentTaskId",
+	"name",
+	"priority",
+	"completed",
+	"completedAtLte",
+	"completedAtGte",
+	"dueDateLte",
+	"dueDateGte",
+	"filter",
+}
+
+/*
+TaskFilterableFields are the fields a task listing filter expression can compare.
+*/
+var TaskFilterableFields = map[string]core.FilterFieldType{
+	"name":             core.FilterFieldTypeString,
+	"type":             core.FilterFieldTypeString,
+	"priority":         core.FilterFieldTypeNumber,
+	"estimatedMinutes": core.FilterFieldTypeNumber,
+	"project":          core.FilterFieldTypeIdentity,
+	"status":           core.FilterFieldTypeIdentity,
+	"category":         core.FilterFieldTypeIdentity,
+	"parentTask":       core.FilterFieldTypeIdentity,
+	"due":              core.FilterFieldTypeDate,
+	"completedAt":      core.FilterFieldTypeDate,
+	"createdAt":        core.FilterFieldTypeDate,
+	"updatedAt":        core.FilterFieldTypeDate,
+}
+
+type TaskImportFormats string
+
+const (
+	TaskImportFormatCsv  TaskImportFormats = "csv"
+	TaskImportFormatJson TaskImportFormats = "json"
+)
+
+var TaskImportFormatsArray = []TaskImportFormats{
+	TaskImportFormatCsv,
+	TaskImportFormatJson,
+}
+
+/*
+TaskImportFields are the task attributes an import can fill. CSV columns are mapped to these fields and JSON tasks
+use them as keys.
+*/
+var TaskImportFields = []string{
+	"externalId",
+	"parentExternalId",
+	"name",
+	"description",
+	"status",
+	"category",
+	"priority",
+	"estimatedMinutes",
+	"dueDate",
+	"subTasks",
+}
+
+var TaskPriorityLevelNames = map[string]TaskPriorityLevels{
+	"none":     TaskPriorityLevelNone,
+	"low":      TaskPriorityLevelLow,
+	"medium":   TaskPriorityLevelMedium,
+	"high":     TaskPriorityLevelHigh,
+	"critical": TaskPriorityLevelCritical,
+	"urgent":   TaskPriorityLevelUrgent,
+}
+
+type TaskImportRowActions string
+
+const (
+	TaskImportRowActionCreated TaskImportRowActions = "created"
+	TaskImportRowActionSkipped TaskImportRowActions = "skipped"
+	TaskImportRowActionFailed  TaskImportRowActions = "failed"
+)
+
+const TaskCalendarFeedTokenLength = 48
+
+type TaskCalendarFeedEntryTypes string
+
+const (
+	TaskCalendarFeedEntryTypeEvent TaskCalendarFeedEntryTypes = "event"
+	TaskCalendarFeedEntryTypeTodo  TaskCalendarFeedEntryTypes = "todo"
+)
+
+var TaskCalendarFeedEntryTypesArray = []TaskCalendarFeedEntryTypes{
+	TaskCalendarFeedEntryTypeEvent,
+	TaskCalendarFeedEntryTypeTodo,
+}
+
+/*
+TaskCalendarPriorityLevels maps task priority levels to the iCalendar PRIORITY property, where 1 is the highest
+priority and 0 leaves it undefined.
+*/
+var TaskCalendarPriorityLevels = map[TaskPriorityLevels]int{
+	TaskPriorityLevelNone:     0,
+	TaskPriorityLevelLow:      9,
+	TaskPriorityLevelMedium:   5,
+	TaskPriorityLevelHigh:     3,
+	TaskPriorityLevelCritical: 2,
+	TaskPriorityLevelUrgent:   1,
+}
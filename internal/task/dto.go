@@ -1,6 +1,9 @@
 package task
 
 import (
+	"slices"
+
+	"github.com/gabrielmrtt/taski/internal/core"
 	"github.com/gabrielmrtt/taski/internal/user"
 )
 
@@ -34,23 +37,111 @@ func TaskUserToDto(taskUser *TaskUser) *TaskUserDto {
 	}
 }
 
+type TaskCustomFieldValueDto struct {
+	CustomFieldId string `json:"customFieldId"`
+	Name          string `json:"name"`
+	Type          string `json:"type"`
+	Value         any    `json:"value"`
+}
+
+func TaskCustomFieldValueToDto(taskCustomFieldValue *TaskCustomFieldValue) *TaskCustomFieldValueDto {
+	return &TaskCustomFieldValueDto{
+		CustomFieldId: taskCustomFieldValue.CustomField.Identity.Public,
+		Name:          taskCustomFieldValue.CustomField.Name,
+		Type:          string(taskCustomFieldValue.CustomField.Type),
+		Value:         taskCustomFieldValue.Value(),
+	}
+}
+
+type TaskReferenceDto struct {
+	Type         string  `json:"type"`
+	UserId       *string `json:"userId"`
+	TaskId       *string `json:"taskId"`
+	UserAuthorId string  `json:"userAuthorId"`
+	CreatedAt    string  `json:"createdAt"`
+}
+
+func TaskReferenceToDto(taskReference *TaskReference) *TaskReferenceDto {
+	var userId *string = nil
+	if taskReference.UserIdentity != nil {
+		userId = &taskReference.UserIdentity.Public
+	}
+
+	var taskId *string = nil
+	if taskReference.TaskIdentity != nil {
+		taskId = &taskReference.TaskIdentity.Public
+	}
+
+	return &TaskReferenceDto{
+		Type:         string(taskReference.Type),
+		UserId:       userId,
+		TaskId:       taskId,
+		UserAuthorId: taskReference.UserAuthorIdentity.Public,
+		CreatedAt:    taskReference.CreatedAt.ToRFC3339(),
+	}
+}
+
+func taskReferencesToDto(taskReferences []TaskReference) []*TaskReferenceDto {
+	var referencesDto []*TaskReferenceDto = make([]*TaskReferenceDto, len(taskReferences))
+	for i := range taskReferences {
+		referencesDto[i] = TaskReferenceToDto(&taskReferences[i])
+	}
+
+	return referencesDto
+}
+
+type TaskMentionDto struct {
+	TaskId        string  `json:"taskId"`
+	TaskName      string  `json:"taskName"`
+	ProjectId     string  `json:"projectId"`
+	TaskCommentId *string `json:"taskCommentId"`
+	UserAuthorId  string  `json:"userAuthorId"`
+	CreatedAt     string  `json:"createdAt"`
+}
+
+func TaskMentionToDto(taskMention *TaskMention) *TaskMentionDto {
+	var taskCommentId *string = nil
+	if taskMention.TaskCommentIdentity != nil {
+		taskCommentId = &taskMention.TaskCommentIdentity.Public
+	}
+
+	return &TaskMentionDto{
+		TaskId:        taskMention.TaskIdentity.Public,
+		TaskName:      taskMention.TaskName,
+		ProjectId:     taskMention.ProjectIdentity.Public,
+		TaskCommentId: taskCommentId,
+		UserAuthorId:  taskMention.Reference.UserAuthorIdentity.Public,
+		CreatedAt:     taskMention.Reference.CreatedAt.ToRFC3339(),
+	}
+}
+
 type TaskDto struct {
-	Id               string         `json:"id"`
-	Name             string         `json:"name"`
-	Description      string         `json:"description"`
-	EstimatedMinutes int16          `json:"estimatedMinutes"`
-	PriorityLevel    int8           `json:"priorityLevel"`
-	DueDate          *string        `json:"dueDate"`
-	CompletedAt      *string        `json:"completedAt"`
-	SubTasks         []*SubTaskDto  `json:"subTasks"`
-	ChildrenTasks    []*TaskDto     `json:"childrenTasks"`
-	ParentTaskId     *string        `json:"parentTaskId"`
-	Users            []*TaskUserDto `json:"users"`
-	UserCreatorId    string         `json:"userCreatorId"`
-	UserEditorId     *string        `json:"userEditorId"`
-	UserCompletedId  *string        `json:"userCompletedId"`
-	CreatedAt        string         `json:"createdAt"`
-	UpdatedAt        *string        `json:"updatedAt"`
+	Id                string                     `json:"id"`
+	ExternalId        *string                    `json:"externalId"`
+	Name              string                     `json:"name"`
+	Rank              string                     `json:"rank"`
+	StatusId          *string                    `json:"statusId"`
+	CategoryId        *string                    `json:"categoryId"`
+	Description       string                     `json:"description"`
+	DescriptionFormat string                     `json:"descriptionFormat"`
+	DescriptionHtml   string                     `json:"descriptionHtml"`
+	EstimatedMinutes  int16                      `json:"estimatedMinutes"`
+	PriorityLevel     int8                       `json:"priorityLevel"`
+	DueDate           *string                    `json:"dueDate"`
+	CompletedAt       *string                    `json:"completedAt"`
+	SubTasks          []*SubTaskDto              `json:"subTasks"`
+	ChildrenTasks     []*TaskDto                 `json:"childrenTasks"`
+	ParentTaskId      *string                    `json:"parentTaskId"`
+	Users             []*TaskUserDto             `json:"users"`
+	CustomFields      []*TaskCustomFieldValueDto `json:"customFields"`
+	References        []*TaskReferenceDto        `json:"references"`
+	UserCreatorId     string                     `json:"userCreatorId"`
+	UserEditorId      *string                    `json:"userEditorId"`
+	UserCompletedId   *string                    `json:"userCompletedId"`
+	CreatedAt         string                     `json:"createdAt"`
+	UpdatedAt         *string                    `json:"updatedAt"`
+	Revision          int64                      `json:"revision"`
+	Warnings          []string                   `json:"warnings,omitempty"`
 }
 
 func TaskToDto(task *Task) *TaskDto {
@@ -82,6 +173,11 @@ func TaskToDto(task *Task) *TaskDto {
 		subTasksDto[i] = SubTaskToDto(subTask)
 	}
 
+	var customFieldsDto []*TaskCustomFieldValueDto = make([]*TaskCustomFieldValueDto, len(task.CustomFieldValues))
+	for i, customFieldValue := range task.CustomFieldValues {
+		customFieldsDto[i] = TaskCustomFieldValueToDto(customFieldValue)
+	}
+
 	var childrenTasksDto []*TaskDto = make([]*TaskDto, len(task.ChildrenTasks))
 	for i, childTask := range task.ChildrenTasks {
 		childrenTasksDto[i] = TaskToDto(childTask)
@@ -107,23 +203,42 @@ func TaskToDto(task *Task) *TaskDto {
 		parentTaskId = &task.ParentTaskIdentity.Public
 	}
 
+	var statusId *string = nil
+	if task.Status != nil {
+		statusId = &task.Status.Identity.Public
+	}
+
+	var categoryId *string = nil
+	if task.Category != nil {
+		categoryId = &task.Category.Identity.Public
+	}
+
 	return &TaskDto{
-		Id:               task.Identity.Public,
-		Name:             task.Name,
-		Description:      task.Description,
-		EstimatedMinutes: *task.EstimatedMinutes,
-		PriorityLevel:    int8(task.PriorityLevel),
-		DueDate:          dueDate,
-		CompletedAt:      completedAt,
-		SubTasks:         subTasksDto,
-		ChildrenTasks:    childrenTasksDto,
-		ParentTaskId:     parentTaskId,
-		Users:            usersDto,
-		UserCreatorId:    *userCreatorId,
-		UserEditorId:     userEditorId,
-		UserCompletedId:  userCompletedId,
-		CreatedAt:        task.Timestamps.CreatedAt.ToRFC3339(),
-		UpdatedAt:        updatedAt,
+		Id:                task.Identity.Public,
+		ExternalId:        task.ExternalId,
+		Name:              task.Name,
+		Rank:              task.Rank,
+		StatusId:          statusId,
+		CategoryId:        categoryId,
+		Description:       task.Description,
+		DescriptionFormat: string(task.DescriptionFormat),
+		DescriptionHtml:   task.FormattedDescription().Html(),
+		EstimatedMinutes:  *task.EstimatedMinutes,
+		PriorityLevel:     int8(task.PriorityLevel),
+		DueDate:           dueDate,
+		CompletedAt:       completedAt,
+		SubTasks:          subTasksDto,
+		ChildrenTasks:     childrenTasksDto,
+		ParentTaskId:      parentTaskId,
+		Users:             usersDto,
+		CustomFields:      customFieldsDto,
+		References:        taskReferencesToDto(task.References),
+		UserCreatorId:     *userCreatorId,
+		UserEditorId:      userEditorId,
+		UserCompletedId:   userCompletedId,
+		CreatedAt:         task.Timestamps.CreatedAt.ToRFC3339(),
+		UpdatedAt:         updatedAt,
+		Revision:          task.Revision,
 	}
 }
 
@@ -131,16 +246,63 @@ type TaskCommentFileDto struct {
 	FileId string `json:"fileId"`
 }
 
+type TaskCommentReactionDto struct {
+	Emoji       string `json:"emoji"`
+	Count       int    `json:"count"`
+	ReactedByMe bool   `json:"reactedByMe"`
+}
+
+/*
+TaskCommentReactionsToDto groups the reactions of a comment by emoji, in the order each emoji was first used.
+*/
+func TaskCommentReactionsToDto(reactions []TaskCommentReaction, userViewerIdentity *core.Identity) []TaskCommentReactionDto {
+	var reactionsDto []TaskCommentReactionDto = make([]TaskCommentReactionDto, 0)
+	for _, reaction := range reactions {
+		index := slices.IndexFunc(reactionsDto, func(reactionDto TaskCommentReactionDto) bool {
+			return reactionDto.Emoji == reaction.Emoji
+		})
+
+		if index < 0 {
+			reactionsDto = append(reactionsDto, TaskCommentReactionDto{Emoji: reaction.Emoji})
+			index = len(reactionsDto) - 1
+		}
+
+		reactionsDto[index].Count++
+		if userViewerIdentity != nil && reaction.UserIdentity.Internal == userViewerIdentity.Internal {
+			reactionsDto[index].ReactedByMe = true
+		}
+	}
+
+	return reactionsDto
+}
+
 type TaskCommentDto struct {
-	Id        string               `json:"id"`
-	Content   string               `json:"content"`
-	Files     []TaskCommentFileDto `json:"files"`
-	Author    *user.UserDto        `json:"author"`
-	CreatedAt string               `json:"createdAt"`
-	UpdatedAt *string              `json:"updatedAt"`
+	Id              string                   `json:"id"`
+	ParentCommentId *string                  `json:"parentCommentId"`
+	Content         string                   `json:"content"`
+	ContentFormat   string                   `json:"contentFormat"`
+	ContentHtml     string                   `json:"contentHtml"`
+	Files           []TaskCommentFileDto     `json:"files"`
+	References      []*TaskReferenceDto      `json:"references"`
+	Reactions       []TaskCommentReactionDto `json:"reactions"`
+	Replies         []*TaskCommentDto        `json:"replies"`
+	Author          *user.UserDto            `json:"author"`
+	ResolvedAt      *string                  `json:"resolvedAt"`
+	UserResolvedId  *string                  `json:"userResolvedId"`
+	Version         int                      `json:"version"`
+	Deleted         bool                     `json:"deleted"`
+	DeletedAt       *string                  `json:"deletedAt"`
+	UserDeletedId   *string                  `json:"userDeletedId"`
+	CreatedAt       string                   `json:"createdAt"`
+	UpdatedAt       *string                  `json:"updatedAt"`
 }
 
-func TaskCommentToDto(taskComment *TaskComment) *TaskCommentDto {
+/*
+TaskCommentToDto converts a comment and its replies. userViewerIdentity is the user reactions are flagged as reacted
+by; it can be nil when nobody is viewing the comment, as in exports. Deleted comments are converted to tombstones,
+without their content, files, references and reactions.
+*/
+func TaskCommentToDto(taskComment *TaskComment, userViewerIdentity *core.Identity) *TaskCommentDto {
 	var updatedAt *string = nil
 	if taskComment.Timestamps.UpdatedAt != nil {
 		updatedAtString := taskComment.Timestamps.UpdatedAt.ToRFC3339()
@@ -159,13 +321,88 @@ func TaskCommentToDto(taskComment *TaskComment) *TaskCommentDto {
 		author = user.UserToDto(taskComment.Author)
 	}
 
-	return &TaskCommentDto{
-		Id:        taskComment.Identity.Public,
-		Content:   taskComment.Content,
-		Files:     taskCommentFilesDto,
-		Author:    author,
-		CreatedAt: taskComment.Timestamps.CreatedAt.ToRFC3339(),
-		UpdatedAt: updatedAt,
+	var parentCommentId *string = nil
+	if taskComment.ParentTaskCommentIdentity != nil {
+		parentCommentId = &taskComment.ParentTaskCommentIdentity.Public
+	}
+
+	var resolvedAt *string = nil
+	if taskComment.ResolvedAt != nil {
+		resolvedAtString := taskComment.ResolvedAt.ToRFC3339()
+		resolvedAt = &resolvedAtString
+	}
+
+	var userResolvedId *string = nil
+	if taskComment.UserResolvedByIdentity != nil {
+		userResolvedId = &taskComment.UserResolvedByIdentity.Public
+	}
+
+	var repliesDto []*TaskCommentDto = make([]*TaskCommentDto, len(taskComment.Replies))
+	for i, reply := range taskComment.Replies {
+		repliesDto[i] = TaskCommentToDto(reply, userViewerIdentity)
+	}
+
+	taskCommentDto := &TaskCommentDto{
+		Id:              taskComment.Identity.Public,
+		ParentCommentId: parentCommentId,
+		Content:         taskComment.Content,
+		ContentFormat:   string(taskComment.ContentFormat),
+		ContentHtml:     taskComment.FormattedContent().Html(),
+		Files:           taskCommentFilesDto,
+		References:      taskReferencesToDto(taskComment.References),
+		Reactions:       TaskCommentReactionsToDto(taskComment.Reactions, userViewerIdentity),
+		Replies:         repliesDto,
+		Author:          author,
+		ResolvedAt:      resolvedAt,
+		UserResolvedId:  userResolvedId,
+		Version:         taskComment.Version,
+		Deleted:         false,
+		DeletedAt:       nil,
+		UserDeletedId:   nil,
+		CreatedAt:       taskComment.Timestamps.CreatedAt.ToRFC3339(),
+		UpdatedAt:       updatedAt,
+	}
+
+	if taskComment.IsDeleted() {
+		deletedAt := taskComment.DeletedAt.ToRFC3339()
+		taskCommentDto.Content = ""
+		taskCommentDto.ContentHtml = ""
+		taskCommentDto.Files = make([]TaskCommentFileDto, 0)
+		taskCommentDto.References = make([]*TaskReferenceDto, 0)
+		taskCommentDto.Reactions = make([]TaskCommentReactionDto, 0)
+		taskCommentDto.Deleted = true
+		taskCommentDto.DeletedAt = &deletedAt
+
+		if taskComment.UserDeletedByIdentity != nil {
+			taskCommentDto.UserDeletedId = &taskComment.UserDeletedByIdentity.Public
+		}
+	}
+
+	return taskCommentDto
+}
+
+type TaskCommentVersionDto struct {
+	Version       int     `json:"version"`
+	Content       string  `json:"content"`
+	ContentFormat string  `json:"contentFormat"`
+	ContentHtml   string  `json:"contentHtml"`
+	UserEditorId  *string `json:"userEditorId"`
+	CreatedAt     string  `json:"createdAt"`
+}
+
+func TaskCommentVersionToDto(taskCommentVersion *TaskCommentVersion) *TaskCommentVersionDto {
+	var userEditorId *string = nil
+	if taskCommentVersion.UserEditorIdentity != nil {
+		userEditorId = &taskCommentVersion.UserEditorIdentity.Public
+	}
+
+	return &TaskCommentVersionDto{
+		Version:       taskCommentVersion.Version,
+		Content:       taskCommentVersion.Content,
+		ContentFormat: string(taskCommentVersion.ContentFormat),
+		ContentHtml:   core.Content{Value: taskCommentVersion.Content, Format: taskCommentVersion.ContentFormat}.Html(),
+		UserEditorId:  userEditorId,
+		CreatedAt:     taskCommentVersion.CreatedAt.ToRFC3339(),
 	}
 }
 
@@ -184,3 +421,209 @@ func TaskActionToDto(taskAction *TaskAction) *TaskActionDto {
 		CreatedAt: taskAction.CreatedAt.ToRFC3339(),
 	}
 }
+
+type TaskTimeEntryDto struct {
+	Id              string  `json:"id"`
+	TaskId          string  `json:"taskId"`
+	UserId          string  `json:"userId"`
+	Description     string  `json:"description"`
+	StartedAt       string  `json:"startedAt"`
+	EndedAt         *string `json:"endedAt"`
+	DurationSeconds int64   `json:"durationSeconds"`
+	IsRunning       bool    `json:"isRunning"`
+	CreatedAt       string  `json:"createdAt"`
+	UpdatedAt       *string `json:"updatedAt"`
+}
+
+func TaskTimeEntryToDto(taskTimeEntry *TaskTimeEntry) *TaskTimeEntryDto {
+	var endedAt *string = nil
+	if taskTimeEntry.EndedAt != nil {
+		endedAtString := taskTimeEntry.EndedAt.ToRFC3339()
+		endedAt = &endedAtString
+	}
+
+	var updatedAt *string = nil
+	if taskTimeEntry.Timestamps.UpdatedAt != nil {
+		updatedAtString := taskTimeEntry.Timestamps.UpdatedAt.ToRFC3339()
+		updatedAt = &updatedAtString
+	}
+
+	return &TaskTimeEntryDto{
+		Id:              taskTimeEntry.Identity.Public,
+		TaskId:          taskTimeEntry.TaskIdentity.Public,
+		UserId:          taskTimeEntry.UserIdentity.Public,
+		Description:     taskTimeEntry.Description,
+		StartedAt:       taskTimeEntry.StartedAt.ToRFC3339(),
+		EndedAt:         endedAt,
+		DurationSeconds: taskTimeEntry.DurationSeconds(),
+		IsRunning:       taskTimeEntry.IsRunning(),
+		CreatedAt:       taskTimeEntry.Timestamps.CreatedAt.ToRFC3339(),
+		UpdatedAt:       updatedAt,
+	}
+}
+
+type TaskTimeSummaryDto struct {
+	TaskId           string `json:"taskId"`
+	EstimatedMinutes int16  `json:"estimatedMinutes"`
+	TrackedSeconds   int64  `json:"trackedSeconds"`
+	RolledUpSeconds  int64  `json:"rolledUpSeconds"`
+}
+
+type TaskTimeReportRowDto struct {
+	UserId         string `json:"userId"`
+	UserName       string `json:"userName"`
+	ProjectId      string `json:"projectId"`
+	ProjectName    string `json:"projectName"`
+	Date           string `json:"date"`
+	TrackedSeconds int64  `json:"trackedSeconds"`
+}
+
+type TaskTimeReportDto struct {
+	From           string                  `json:"from"`
+	To             string                  `json:"to"`
+	Rows           []*TaskTimeReportRowDto `json:"rows"`
+	TrackedSeconds int64                   `json:"trackedSeconds"`
+}
+
+type TaskAvailableTransitionDto struct {
+	StatusId       string   `json:"statusId"`
+	StatusName     string   `json:"statusName"`
+	StatusColor    string   `json:"statusColor"`
+	RequiredFields []string `json:"requiredFields"`
+	MissingFields  []string `json:"missingFields"`
+}
+
+type TaskStatusChangeDto struct {
+	TaskId   string   `json:"taskId"`
+	StatusId *string  `json:"statusId"`
+	Rank     string   `json:"rank"`
+	Warnings []string `json:"warnings"`
+}
+
+type TaskBulkResultDto struct {
+	TaskId   string   `json:"taskId"`
+	Success  bool     `json:"success"`
+	Error    *string  `json:"error"`
+	Warnings []string `json:"warnings,omitempty"`
+}
+
+type TaskBulkReportDto struct {
+	Total     int                 `json:"total"`
+	Succeeded int                 `json:"succeeded"`
+	Failed    int                 `json:"failed"`
+	Results   []TaskBulkResultDto `json:"results"`
+}
+
+type TaskImportRowResultDto struct {
+	Row        int      `json:"row"`
+	ExternalId *string  `json:"externalId"`
+	TaskId     *string  `json:"taskId"`
+	Action     string   `json:"action"`
+	Errors     []string `json:"errors,omitempty"`
+	Warnings   []string `json:"warnings,omitempty"`
+}
+
+type TaskImportReportDto struct {
+	DryRun            bool                     `json:"dryRun"`
+	Total             int                      `json:"total"`
+	Created           int                      `json:"created"`
+	Skipped           int                      `json:"skipped"`
+	Failed            int                      `json:"failed"`
+	CreatedStatuses   []string                 `json:"createdStatuses"`
+	CreatedCategories []string                 `json:"createdCategories"`
+	Results           []TaskImportRowResultDto `json:"results"`
+}
+
+type TaskViewDto struct {
+	Id            string            `json:"id"`
+	Name          string            `json:"name"`
+	Visibility    string            `json:"visibility"`
+	ProjectId     *string           `json:"projectId"`
+	UserOwnerId   string            `json:"userOwnerId"`
+	Filters       map[string]string `json:"filters"`
+	SortBy        *string           `json:"sortBy"`
+	SortDirection *string           `json:"sortDirection"`
+	GroupBy       *string           `json:"groupBy"`
+	CreatedAt     string            `json:"createdAt"`
+	UpdatedAt     *string           `json:"updatedAt"`
+}
+
+func TaskViewToDto(taskView *TaskView) *TaskViewDto {
+	var projectId *string = nil
+	if taskView.ProjectIdentity != nil {
+		projectId = &taskView.ProjectIdentity.Public
+	}
+
+	var sortDirection *string = nil
+	if taskView.SortDirection != nil {
+		sortDirectionString := string(*taskView.SortDirection)
+		sortDirection = &sortDirectionString
+	}
+
+	var groupBy *string = nil
+	if taskView.GroupBy != nil {
+		groupByString := string(*taskView.GroupBy)
+		groupBy = &groupByString
+	}
+
+	var updatedAt *string = nil
+	if taskView.Timestamps.UpdatedAt != nil {
+		updatedAtString := taskView.Timestamps.UpdatedAt.ToRFC3339()
+		updatedAt = &updatedAtString
+	}
+
+	return &TaskViewDto{
+		Id:            taskView.Identity.Public,
+		Name:          taskView.Name,
+		Visibility:    string(taskView.Visibility),
+		ProjectId:     projectId,
+		UserOwnerId:   taskView.UserOwnerIdentity.Public,
+		Filters:       taskView.Filters,
+		SortBy:        taskView.SortBy,
+		SortDirection: sortDirection,
+		GroupBy:       groupBy,
+		CreatedAt:     taskView.Timestamps.CreatedAt.ToRFC3339(),
+		UpdatedAt:     updatedAt,
+	}
+}
+
+type TaskCalendarFeedDto struct {
+	Id          string  `json:"id"`
+	ProjectId   *string `json:"projectId"`
+	UserOwnerId string  `json:"userOwnerId"`
+	Url         *string `json:"url,omitempty"`
+	CreatedAt   string  `json:"createdAt"`
+	RevokedAt   *string `json:"revokedAt"`
+}
+
+func TaskCalendarFeedToDto(taskCalendarFeed *TaskCalendarFeed) *TaskCalendarFeedDto {
+	var projectId *string = nil
+	if taskCalendarFeed.ProjectIdentity != nil {
+		projectId = &taskCalendarFeed.ProjectIdentity.Public
+	}
+
+	var revokedAt *string = nil
+	if taskCalendarFeed.RevokedAt != nil {
+		revokedAtString := taskCalendarFeed.RevokedAt.ToRFC3339()
+		revokedAt = &revokedAtString
+	}
+
+	return &TaskCalendarFeedDto{
+		Id:          taskCalendarFeed.Identity.Public,
+		ProjectId:   projectId,
+		UserOwnerId: taskCalendarFeed.UserOwnerIdentity.Public,
+		CreatedAt:   taskCalendarFeed.Timestamps.CreatedAt.ToRFC3339(),
+		RevokedAt:   revokedAt,
+	}
+}
+
+/*
+TaskCalendarFeedWithUrlToDto includes the feed URL, which embeds the secret token and is only returned when the feed
+is created.
+*/
+func TaskCalendarFeedWithUrlToDto(taskCalendarFeed *TaskCalendarFeed, feedBaseUrl string) *TaskCalendarFeedDto {
+	taskCalendarFeedDto := TaskCalendarFeedToDto(taskCalendarFeed)
+	url := feedBaseUrl + "/" + taskCalendarFeed.Token + "/feed.ics"
+	taskCalendarFeedDto.Url = &url
+	return taskCalendarFeedDto
+}
@@ -1,11 +1,16 @@
 package task
 
 import (
+	"regexp"
 	"slices"
+	"strings"
 
 	"github.com/gabrielmrtt/taski/internal/core"
 	"github.com/gabrielmrtt/taski/internal/project"
 	"github.com/gabrielmrtt/taski/internal/user"
+	"github.com/gabrielmrtt/taski/pkg/hashutils"
+	"github.com/gabrielmrtt/taski/pkg/rankutils"
+	"github.com/gabrielmrtt/taski/pkg/stringutils"
 )
 
 type TaskUser struct {
@@ -56,6 +61,143 @@ func (s *SubTask) IsCompleted() bool {
 	return s.CompletedAt != nil
 }
 
+type TaskCustomFieldValue struct {
+	CustomField  *project.ProjectTaskCustomField
+	Text         *string
+	Number       *float64
+	Date         *core.DateTime
+	Options      []string
+	UserIdentity *core.Identity
+}
+
+/*
+NewTaskCustomFieldValue parses a raw value (as decoded from JSON) according to the custom field type.
+Membership of user values must be checked by the caller.
+*/
+func NewTaskCustomFieldValue(customField *project.ProjectTaskCustomField, value any) (*TaskCustomFieldValue, error) {
+	invalidValueError := func(message string) error {
+		return core.NewInvalidInputError("invalid custom field value", []core.InvalidInputErrorField{
+			{
+				Field: "customFields." + customField.Identity.Public,
+				Error: message,
+			},
+		})
+	}
+
+	customFieldValue := &TaskCustomFieldValue{
+		CustomField: customField,
+		Options:     []string{},
+	}
+
+	switch customField.Type {
+	case project.ProjectTaskCustomFieldTypeText:
+		text, ok := value.(string)
+		if !ok || text == "" {
+			return nil, invalidValueError("value must be a non empty string")
+		}
+
+		if len(text) > 510 {
+			return nil, invalidValueError("value must be less than 510 characters")
+		}
+
+		customFieldValue.Text = &text
+	case project.ProjectTaskCustomFieldTypeNumber:
+		number, ok := value.(float64)
+		if !ok {
+			return nil, invalidValueError("value must be a number")
+		}
+
+		customFieldValue.Number = &number
+	case project.ProjectTaskCustomFieldTypeDate:
+		dateString, ok := value.(string)
+		if !ok {
+			return nil, invalidValueError("value must be a RFC 3339 date")
+		}
+
+		date, err := core.NewDateTimeFromRFC3339(dateString)
+		if err != nil {
+			return nil, invalidValueError("value must be a RFC 3339 date")
+		}
+
+		customFieldValue.Date = &date
+	case project.ProjectTaskCustomFieldTypeSingleSelect:
+		option, ok := value.(string)
+		if !ok || !customField.HasOption(option) {
+			return nil, invalidValueError("value must be one of the custom field options")
+		}
+
+		customFieldValue.Options = []string{option}
+	case project.ProjectTaskCustomFieldTypeMultiSelect:
+		rawOptions, ok := value.([]any)
+		if !ok {
+			return nil, invalidValueError("value must be a list of custom field options")
+		}
+
+		for _, rawOption := range rawOptions {
+			option, ok := rawOption.(string)
+			if !ok || !customField.HasOption(option) {
+				return nil, invalidValueError("value must be a list of custom field options")
+			}
+
+			if !slices.Contains(customFieldValue.Options, option) {
+				customFieldValue.Options = append(customFieldValue.Options, option)
+			}
+		}
+
+		if len(customFieldValue.Options) == 0 {
+			return nil, invalidValueError("value must contain at least one option")
+		}
+	case project.ProjectTaskCustomFieldTypeUser:
+		userId, ok := value.(string)
+		if !ok {
+			return nil, invalidValueError("value must be a user id")
+		}
+
+		userIdentity := core.NewIdentityFromPublic(userId)
+		if userIdentity.IsEmpty() {
+			return nil, invalidValueError("value must be a user id")
+		}
+
+		customFieldValue.UserIdentity = &userIdentity
+	default:
+		return nil, invalidValueError("custom field type is not supported")
+	}
+
+	return customFieldValue, nil
+}
+
+/*
+Value returns the custom field value in its public representation.
+*/
+func (v *TaskCustomFieldValue) Value() any {
+	switch v.CustomField.Type {
+	case project.ProjectTaskCustomFieldTypeText:
+		if v.Text != nil {
+			return *v.Text
+		}
+	case project.ProjectTaskCustomFieldTypeNumber:
+		if v.Number != nil {
+			return *v.Number
+		}
+	case project.ProjectTaskCustomFieldTypeDate:
+		if v.Date != nil {
+			return v.Date.ToRFC3339()
+		}
+	case project.ProjectTaskCustomFieldTypeSingleSelect:
+		if len(v.Options) > 0 {
+			return v.Options[0]
+		}
+	case project.ProjectTaskCustomFieldTypeMultiSelect:
+		return v.Options
+	case project.ProjectTaskCustomFieldTypeUser:
+		if v.UserIdentity != nil {
+			return v.UserIdentity.Public
+		}
+	}
+
+	return nil
+}
+
 type Task struct {
 	Identity                core.Identity
 	ProjectIdentity         core.Identity
@@ -63,8 +205,11 @@ type Task struct {
 	Category                *project.ProjectTaskCategory
 	ParentTaskIdentity      *core.Identity
 	Type                    TaskType
+	Rank                    string
+	ExternalId              *string
 	Name                    string
 	Description             string
+	DescriptionFormat       core.ContentFormats
 	EstimatedMinutes        *int16
 	PriorityLevel           TaskPriorityLevels
 	DueDate                 *core.DateTime
@@ -72,11 +217,14 @@ type Task struct {
 	SubTasks                []*SubTask
 	ChildrenTasks           []*Task
 	Users                   []*TaskUser
+	CustomFieldValues       []*TaskCustomFieldValue
+	References              []TaskReference
 	UserCompletedByIdentity *core.Identity
 	UserCreatorIdentity     *core.Identity
 	UserEditorIdentity      *core.Identity
 	Timestamps              core.Timestamps
 	DeletedAt               *core.DateTime
+	Revision                int64
 }
 
 type NewTaskInput struct {
@@ -84,14 +232,18 @@ type NewTaskInput struct {
 	Status              *project.ProjectTaskStatus
 	Category            *project.ProjectTaskCategory
 	ParentTaskIdentity  *core.Identity
+	Rank                string
+	ExternalId          *string
 	Name                string
 	Description         string
+	DescriptionFormat   core.ContentFormats
 	EstimatedMinutes    *int16
 	PriorityLevel       TaskPriorityLevels
 	DueDate             *core.DateTime
 	SubTasks            []*SubTask
 	Users               []*TaskUser
 	ChildrenTasks       []*Task
+	CustomFieldValues   []*TaskCustomFieldValue
 	UserCreatorIdentity *core.Identity
 }
 
@@ -106,12 +258,26 @@ func NewTask(input NewTaskInput) (*Task, error) {
 		return nil, err
 	}
 
+	var descriptionFormat core.ContentFormats = core.DefaultContentFormat
+	if input.DescriptionFormat != "" {
+		descriptionFormat = input.DescriptionFormat
+	}
+
+	if _, err := core.NewContent(input.Description, descriptionFormat); err != nil {
+		return nil, err
+	}
+
 	if input.EstimatedMinutes != nil {
 		if *input.EstimatedMinutes < 0 {
 			return nil, core.NewInternalError("estimated minutes cannot be negative")
 		}
 	}
 
+	var estimatedMinutes int16 = 0
+	if input.EstimatedMinutes != nil {
+		estimatedMinutes = *input.EstimatedMinutes
+	}
+
 	var taskType TaskType = TaskTypeNormal
 
 	if input.ChildrenTasks != nil {
@@ -120,6 +286,11 @@ func NewTask(input NewTaskInput) (*Task, error) {
 		}
 	}
 
+	var customFieldValues []*TaskCustomFieldValue = make([]*TaskCustomFieldValue, 0)
+	if input.CustomFieldValues != nil {
+		customFieldValues = input.CustomFieldValues
+	}
+
 	return &Task{
 		Identity:            core.NewIdentity(TaskIdentityPrefix),
 		ProjectIdentity:     input.ProjectIdentity,
@@ -127,11 +298,17 @@ func NewTask(input NewTaskInput) (*Task, error) {
 		Status:              input.Status,
 		Category:            input.Category,
 		Type:                taskType,
+		Rank:                input.Rank,
+		ExternalId:          input.ExternalId,
 		Name:                input.Name,
 		SubTasks:            input.SubTasks,
 		ChildrenTasks:       input.ChildrenTasks,
+		Users:               input.Users,
+		CustomFieldValues:   customFieldValues,
+		References:          make([]TaskReference, 0),
 		Description:         input.Description,
-		EstimatedMinutes:    input.EstimatedMinutes,
+		DescriptionFormat:   descriptionFormat,
+		EstimatedMinutes:    &estimatedMinutes,
 		PriorityLevel:       input.PriorityLevel,
 		DueDate:             input.DueDate,
 		CompletedAt:         nil,
@@ -142,9 +319,14 @@ func NewTask(input NewTaskInput) (*Task, error) {
 			UpdatedAt: nil,
 		},
 		DeletedAt: nil,
+		Revision:  core.InitialRevision,
 	}, nil
 }
 
+func (t *Task) FormattedDescription() core.Content {
+	return core.Content{Value: t.Description, Format: t.DescriptionFormat}
+}
+
 func (t *Task) ChangeName(name string, userEditorIdentity *core.Identity) error {
 	if _, err := core.NewName(name); err != nil {
 		return err
@@ -157,18 +339,37 @@ func (t *Task) ChangeName(name string, userEditorIdentity *core.Identity) error
 	return nil
 }
 
-func (t *Task) ChangeDescription(description string, userEditorIdentity *core.Identity) error {
+/*
+ChangeDescription replaces the description. An empty descriptionFormat keeps the format of the current description.
+*/
+func (t *Task) ChangeDescription(description string, descriptionFormat core.ContentFormats, userEditorIdentity *core.Identity) error {
 	if _, err := core.NewDescription(description); err != nil {
 		return err
 	}
 
+	if descriptionFormat == "" {
+		descriptionFormat = t.DescriptionFormat
+	}
+
+	if _, err := core.NewContent(description, descriptionFormat); err != nil {
+		return err
+	}
+
 	t.Description = description
+	t.DescriptionFormat = descriptionFormat
 	t.UserEditorIdentity = userEditorIdentity
 	now := core.NewDateTime()
 	t.Timestamps.UpdatedAt = &now
 	return nil
 }
 
+/*
+ChangeReferences replaces the references of the task with the ones read from its description.
+*/
+func (t *Task) ChangeReferences(references []TaskReference) {
+	t.References = mergeTaskReferences(t.References, references)
+}
+
 func (t *Task) ChangeEstimatedMinutes(estimatedMinutes int16, userEditorIdentity *core.Identity) error {
 	if estimatedMinutes < 0 {
 		return core.NewInternalError("estimated minutes cannot be negative")
@@ -202,6 +403,27 @@ func (t *Task) ChangeDueDate(dueDate core.DateTime, userEditorIdentity *core.Ide
 	return nil
 }
 
+/*
+ShiftDueDate moves the due date of the task by the given number of seconds. Unlike ChangeDueDate it allows the new
+due date to be in the past, so overdue tasks can be shifted by less than their delay.
+*/
+func (t *Task) ShiftDueDate(seconds int64, userEditorIdentity *core.Identity) error {
+	if t.DueDate == nil {
+		return core.NewConflictError("task has no due date to shift")
+	}
+
+	dueDate := core.DateTime{Value: t.DueDate.Value + seconds}
+	if dueDate.Value < 0 {
+		return core.NewConflictError("due date cannot be shifted before the epoch")
+	}
+
+	t.DueDate = &dueDate
+	t.UserEditorIdentity = userEditorIdentity
+	now := core.NewDateTime()
+	t.Timestamps.UpdatedAt = &now
+	return nil
+}
+
 func (t *Task) ChangeStatus(status *project.ProjectTaskStatus, userEditorIdentity *core.Identity) error {
 	t.Status = status
 	t.UserEditorIdentity = userEditorIdentity
@@ -210,6 +432,23 @@ func (t *Task) ChangeStatus(status *project.ProjectTaskStatus, userEditorIdentit
 	return nil
 }
 
+/*
+ChangeRank places the task between the tasks ranked previousRank and nextRank in its status.
+An empty previousRank places it first and an empty nextRank places it last.
+*/
+func (t *Task) ChangeRank(previousRank string, nextRank string, userEditorIdentity *core.Identity) error {
+	rank, err := rankutils.Between(previousRank, nextRank)
+	if err != nil {
+		return core.NewConflictError("task position is out of date, reload the tasks and try again")
+	}
+
+	t.Rank = rank
+	t.UserEditorIdentity = userEditorIdentity
+	now := core.NewDateTime()
+	t.Timestamps.UpdatedAt = &now
+	return nil
+}
+
 func (t *Task) ChangeCategory(category *project.ProjectTaskCategory, userEditorIdentity *core.Identity) error {
 	t.Category = category
 	t.UserEditorIdentity = userEditorIdentity
@@ -247,22 +486,31 @@ func (t *Task) IsOverdue() bool {
 	return t.DueDate != nil && t.DueDate.IsBefore(now) && !t.IsCompleted()
 }
 
-func (t *Task) AddUser(user *TaskUser) {
+func (t *Task) AddUser(user *TaskUser, userEditorIdentity *core.Identity) {
 	t.Users = append(t.Users, user)
+	t.UserEditorIdentity = userEditorIdentity
 	now := core.NewDateTime()
 	t.Timestamps.UpdatedAt = &now
 }
 
-func (t *Task) RemoveUser(user *TaskUser) {
+func (t *Task) RemoveUser(user *TaskUser, userEditorIdentity *core.Identity) {
 	t.Users = slices.DeleteFunc(t.Users, func(u *TaskUser) bool {
 		return u.User.Identity == user.User.Identity
 	})
+	t.UserEditorIdentity = userEditorIdentity
 	now := core.NewDateTime()
 	t.Timestamps.UpdatedAt = &now
 }
 
-func (t *Task) ClearUsers() {
+func (t *Task) HasUser(userIdentity core.Identity) bool {
+	return slices.ContainsFunc(t.Users, func(u *TaskUser) bool {
+		return u.User.Identity == userIdentity
+	})
+}
+
+func (t *Task) ClearUsers(userEditorIdentity *core.Identity) {
 	t.Users = []*TaskUser{}
+	t.UserEditorIdentity = userEditorIdentity
 	now := core.NewDateTime()
 	t.Timestamps.UpdatedAt = &now
 }
@@ -291,6 +539,97 @@ func (t *Task) AddChildTask(childTask *Task) {
 	t.Type = TaskTypeGroup
 }
 
+func (t *Task) GetCustomFieldValue(customFieldIdentity core.Identity) *TaskCustomFieldValue {
+	for _, customFieldValue := range t.CustomFieldValues {
+		if customFieldValue.CustomField.Identity.Equals(customFieldIdentity) {
+			return customFieldValue
+		}
+	}
+
+	return nil
+}
+
+func (t *Task) SetCustomFieldValue(customFieldValue *TaskCustomFieldValue, userEditorIdentity *core.Identity) {
+	t.CustomFieldValues = slices.DeleteFunc(t.CustomFieldValues, func(v *TaskCustomFieldValue) bool {
+		return v.CustomField.Identity.Equals(customFieldValue.CustomField.Identity)
+	})
+	t.CustomFieldValues = append(t.CustomFieldValues, customFieldValue)
+	t.UserEditorIdentity = userEditorIdentity
+	now := core.NewDateTime()
+	t.Timestamps.UpdatedAt = &now
+}
+
+func (t *Task) RemoveCustomFieldValue(customField *project.ProjectTaskCustomField, userEditorIdentity *core.Identity) error {
+	if customField.IsRequired {
+		return core.NewInvalidInputError("required custom field cannot be empty", []core.InvalidInputErrorField{
+			{
+				Field: "customFields." + customField.Identity.Public,
+				Error: "custom field is required",
+			},
+		})
+	}
+
+	t.CustomFieldValues = slices.DeleteFunc(t.CustomFieldValues, func(v *TaskCustomFieldValue) bool {
+		return v.CustomField.Identity.Equals(customField.Identity)
+	})
+	t.UserEditorIdentity = userEditorIdentity
+	now := core.NewDateTime()
+	t.Timestamps.UpdatedAt = &now
+	return nil
+}
+
+/*
+ValidateRequiredCustomFields checks that every required custom field of the project has a value on the task.
+*/
+func (t *Task) ValidateRequiredCustomFields(customFields []project.ProjectTaskCustomField) error {
+	var fields []core.InvalidInputErrorField
+
+	for _, customField := range customFields {
+		if customField.IsRequired && !customField.IsDeleted() && t.GetCustomFieldValue(customField.Identity) == nil {
+			fields = append(fields, core.InvalidInputErrorField{
+				Field: "customFields." + customField.Identity.Public,
+				Error: "custom field is required",
+			})
+		}
+	}
+
+	if len(fields) > 0 {
+		return core.NewInvalidInputError("required custom fields are missing", fields)
+	}
+
+	return nil
+}
+
+/*
+MissingRequiredFields returns the fields among the given ones that are not filled on the task.
+*/
+func (t *Task) MissingRequiredFields(requiredFields []project.ProjectTaskStatusTransitionRequiredFields) []project.ProjectTaskStatusTransitionRequiredFields {
+	var missingFields []project.ProjectTaskStatusTransitionRequiredFields = make([]project.ProjectTaskStatusTransitionRequiredFields, 0)
+
+	for _, requiredField := range requiredFields {
+		var missing bool
+
+		switch requiredField {
+		case project.ProjectTaskStatusTransitionRequiredFieldAssignee:
+			missing = len(t.Users) == 0
+		case project.ProjectTaskStatusTransitionRequiredFieldCategory:
+			missing = t.Category == nil
+		case project.ProjectTaskStatusTransitionRequiredFieldDescription:
+			missing = t.Description == ""
+		case project.ProjectTaskStatusTransitionRequiredFieldDueDate:
+			missing = t.DueDate == nil
+		case project.ProjectTaskStatusTransitionRequiredFieldEstimatedMinutes:
+			missing = t.EstimatedMinutes == nil || *t.EstimatedMinutes == 0
+		}
+
+		if missing {
+			missingFields = append(missingFields, requiredField)
+		}
+	}
+
+	return missingFields
+}
+
 func (t *Task) GetSubTaskByIdentity(subTaskIdentity core.Identity) *SubTask {
 	for _, subTask := range t.SubTasks {
 		if subTask.Identity.Equals(subTaskIdentity) {
@@ -328,25 +667,188 @@ func (t *Task) RegisterAction(actionType TaskActionType, user *user.User) TaskAc
 	}
 }
 
+/*
+Mentions are written as @<user public id> and task references as #<task public id>. The leading boundary keeps
+e-mail addresses from being read as mentions.
+*/
+var (
+	taskUserMentionPattern   = regexp.MustCompile(`(?:^|[^\w])@(` + user.UserIdentityPrefix + `_[0-9A-Za-z]+)`)
+	taskTaskReferencePattern = regexp.MustCompile(`(?:^|[^\w])#(` + TaskIdentityPrefix + `_[0-9A-Za-z]+)`)
+)
+
+type TaskReference struct {
+	Identity           core.Identity
+	Type               TaskReferenceTypes
+	UserIdentity       *core.Identity
+	TaskIdentity       *core.Identity
+	UserAuthorIdentity core.Identity
+	CreatedAt          core.DateTime
+}
+
+/*
+ParseTaskReferences reads the user mentions and task references of a content. Each user and each task is returned
+once, in the order they first appear. The referenced users and tasks are not checked to exist.
+*/
+func ParseTaskReferences(content string, userAuthorIdentity core.Identity) []TaskReference {
+	now := core.NewDateTime()
+	references := make([]TaskReference, 0)
+
+	for _, match := range taskUserMentionPattern.FindAllStringSubmatch(content, -1) {
+		userIdentity := core.NewIdentityFromPublic(match[1])
+		reference := TaskReference{
+			Identity:           core.NewIdentityWithoutPublic(),
+			Type:               TaskReferenceTypeUserMention,
+			UserIdentity:       &userIdentity,
+			UserAuthorIdentity: userAuthorIdentity,
+			CreatedAt:          now,
+		}
+
+		if !slices.ContainsFunc(references, reference.SameTarget) {
+			references = append(references, reference)
+		}
+	}
+
+	for _, match := range taskTaskReferencePattern.FindAllStringSubmatch(content, -1) {
+		taskIdentity := core.NewIdentityFromPublic(match[1])
+		reference := TaskReference{
+			Identity:           core.NewIdentityWithoutPublic(),
+			Type:               TaskReferenceTypeTaskReference,
+			TaskIdentity:       &taskIdentity,
+			UserAuthorIdentity: userAuthorIdentity,
+			CreatedAt:          now,
+		}
+
+		if !slices.ContainsFunc(references, reference.SameTarget) {
+			references = append(references, reference)
+		}
+	}
+
+	return references
+}
+
+func (r TaskReference) IsUserMention() bool {
+	return r.Type == TaskReferenceTypeUserMention
+}
+
+/*
+SameTarget tells whether two references point to the same user or to the same task.
+*/
+func (r TaskReference) SameTarget(other TaskReference) bool {
+	if r.Type != other.Type {
+		return false
+	}
+
+	if r.IsUserMention() {
+		return r.UserIdentity.Internal == other.UserIdentity.Internal
+	}
+
+	return r.TaskIdentity.Internal == other.TaskIdentity.Internal
+}
+
+/*
+mergeTaskReferences keeps the references that are still present so they keep their author and creation date, and
+adds the new ones.
+*/
+func mergeTaskReferences(current []TaskReference, next []TaskReference) []TaskReference {
+	merged := make([]TaskReference, 0, len(next))
+	for _, reference := range next {
+		index := slices.IndexFunc(current, reference.SameTarget)
+		if index >= 0 {
+			merged = append(merged, current[index])
+			continue
+		}
+
+		merged = append(merged, reference)
+	}
+
+	return merged
+}
+
+/*
+TaskMention is a user mention together with the task it was written in. TaskCommentIdentity is set when the mention
+was written in a comment of the task rather than in its description.
+*/
+type TaskMention struct {
+	Reference           TaskReference
+	TaskIdentity        core.Identity
+	TaskName            string
+	ProjectIdentity     core.Identity
+	TaskCommentIdentity *core.Identity
+}
+
 type TaskCommentFile struct {
 	Identity     core.Identity
 	FileIdentity core.Identity
 }
 
+type TaskCommentReaction struct {
+	UserIdentity core.Identity
+	Emoji        string
+	CreatedAt    core.DateTime
+}
+
+type NewTaskCommentReactionInput struct {
+	UserIdentity core.Identity
+	Emoji        string
+}
+
+func NewTaskCommentReaction(input NewTaskCommentReactionInput) (*TaskCommentReaction, error) {
+	if _, err := NewTaskCommentReactionEmoji(input.Emoji); err != nil {
+		return nil, err
+	}
+
+	return &TaskCommentReaction{
+		UserIdentity: input.UserIdentity,
+		Emoji:        input.Emoji,
+		CreatedAt:    core.NewDateTime(),
+	}, nil
+}
+
+/*
+TaskCommentVersion is one revision of the content of a comment. Version 1 is the content the comment was posted with,
+and every edit adds the next one.
+*/
+type TaskCommentVersion struct {
+	Identity           core.Identity
+	Version            int
+	Content            string
+	ContentFormat      core.ContentFormats
+	UserEditorIdentity *core.Identity
+	CreatedAt          core.DateTime
+}
+
+/*
+TaskComment is either a thread, a comment posted on the task, or a reply to a thread. Threads have a single level:
+replies cannot be replied to, and only threads can be resolved. Deleted comments are kept as tombstones, so the
+replies of a deleted thread and the versions of its content survive.
+*/
 type TaskComment struct {
-	Identity     core.Identity
-	TaskIdentity core.Identity
-	Content      string
-	Files        []TaskCommentFile
-	Author       *user.User
-	Timestamps   core.Timestamps
+	Identity                  core.Identity
+	TaskIdentity              core.Identity
+	ParentTaskCommentIdentity *core.Identity
+	Content                   string
+	ContentFormat             core.ContentFormats
+	Files                     []TaskCommentFile
+	References                []TaskReference
+	Reactions                 []TaskCommentReaction
+	Replies                   []*TaskComment
+	Author                    *user.User
+	ResolvedAt                *core.DateTime
+	UserResolvedByIdentity    *core.Identity
+	Version                   int
+	UserEditorIdentity        *core.Identity
+	DeletedAt                 *core.DateTime
+	UserDeletedByIdentity     *core.Identity
+	Timestamps                core.Timestamps
 }
 
 type NewTaskCommentInput struct {
-	TaskIdentity core.Identity
-	Content      string
-	Files        []TaskCommentFile
-	Author       *user.User
+	TaskIdentity      core.Identity
+	ParentTaskComment *TaskComment
+	Content           string
+	ContentFormat     core.ContentFormats
+	Files             []TaskCommentFile
+	Author            *user.User
 }
 
 func NewTaskComment(input NewTaskCommentInput) (*TaskComment, error) {
@@ -354,14 +856,55 @@ func NewTaskComment(input NewTaskCommentInput) (*TaskComment, error) {
 		return nil, err
 	}
 
+	var contentFormat core.ContentFormats = core.DefaultContentFormat
+	if input.ContentFormat != "" {
+		contentFormat = input.ContentFormat
+	}
+
+	if _, err := core.NewContent(input.Content, contentFormat); err != nil {
+		return nil, err
+	}
+
+	var parentTaskCommentIdentity *core.Identity = nil
+	if input.ParentTaskComment != nil {
+		if input.ParentTaskComment.IsReply() || input.ParentTaskComment.TaskIdentity.Internal != input.TaskIdentity.Internal {
+			field := core.InvalidInputErrorField{
+				Field: "parent comment",
+				Error: "replies can only be posted to a comment thread of the same task",
+			}
+			return nil, core.NewInvalidInputError("replies can only be posted to a comment thread of the same task", []core.InvalidInputErrorField{field})
+		}
+
+		if input.ParentTaskComment.IsDeleted() {
+			field := core.InvalidInputErrorField{
+				Field: "parent comment",
+				Error: "replies cannot be posted to a deleted comment thread",
+			}
+			return nil, core.NewInvalidInputError("replies cannot be posted to a deleted comment thread", []core.InvalidInputErrorField{field})
+		}
+
+		parentTaskCommentIdentity = &input.ParentTaskComment.Identity
+	}
+
 	now := core.NewDateTime()
 
 	return &TaskComment{
-		Identity:     core.NewIdentity(TaskCommentIdentityPrefix),
-		TaskIdentity: input.TaskIdentity,
-		Content:      input.Content,
-		Files:        input.Files,
-		Author:       input.Author,
+		Identity:                  core.NewIdentity(TaskCommentIdentityPrefix),
+		TaskIdentity:              input.TaskIdentity,
+		ParentTaskCommentIdentity: parentTaskCommentIdentity,
+		Content:                   input.Content,
+		ContentFormat:             contentFormat,
+		Files:                     input.Files,
+		References:                make([]TaskReference, 0),
+		Reactions:                 make([]TaskCommentReaction, 0),
+		Replies:                   make([]*TaskComment, 0),
+		Author:                    input.Author,
+		ResolvedAt:                nil,
+		UserResolvedByIdentity:    nil,
+		Version:                   1,
+		UserEditorIdentity:        nil,
+		DeletedAt:                 nil,
+		UserDeletedByIdentity:     nil,
 		Timestamps: core.Timestamps{
 			CreatedAt: &now,
 			UpdatedAt: nil,
@@ -369,17 +912,134 @@ func NewTaskComment(input NewTaskCommentInput) (*TaskComment, error) {
 	}, nil
 }
 
-func (t *TaskComment) ChangeContent(content string) error {
+func (t *TaskComment) IsReply() bool {
+	return t.ParentTaskCommentIdentity != nil
+}
+
+func (t *TaskComment) IsResolved() bool {
+	return t.ResolvedAt != nil
+}
+
+func (t *TaskComment) Resolve(userResolverIdentity core.Identity) error {
+	if t.IsReply() {
+		return core.NewConflictError("only comment threads can be resolved")
+	}
+
+	if t.IsResolved() {
+		return core.NewConflictError("comment thread is already resolved")
+	}
+
+	now := core.NewDateTime()
+	t.ResolvedAt = &now
+	t.UserResolvedByIdentity = &userResolverIdentity
+	return nil
+}
+
+func (t *TaskComment) Unresolve() error {
+	if !t.IsResolved() {
+		return core.NewConflictError("comment thread is not resolved")
+	}
+
+	t.ResolvedAt = nil
+	t.UserResolvedByIdentity = nil
+	return nil
+}
+
+func (t *TaskComment) HasReaction(userIdentity core.Identity, emoji string) bool {
+	return slices.ContainsFunc(t.Reactions, func(reaction TaskCommentReaction) bool {
+		return reaction.UserIdentity.Internal == userIdentity.Internal && reaction.Emoji == emoji
+	})
+}
+
+func (t *TaskComment) FormattedContent() core.Content {
+	return core.Content{Value: t.Content, Format: t.ContentFormat}
+}
+
+func (t *TaskComment) IsDeleted() bool {
+	return t.DeletedAt != nil
+}
+
+/*
+ChangeContent edits the content of the comment as a new version. An empty contentFormat keeps the format of the
+current content, and keeping the same content in the same format does not add a version.
+*/
+func (t *TaskComment) ChangeContent(content string, contentFormat core.ContentFormats, userEditorIdentity *core.Identity) error {
+	if t.IsDeleted() {
+		return core.NewConflictError("task comment is deleted")
+	}
+
 	if _, err := NewTaskCommentContent(content); err != nil {
 		return err
 	}
 
+	if contentFormat == "" {
+		contentFormat = t.ContentFormat
+	}
+
+	if _, err := core.NewContent(content, contentFormat); err != nil {
+		return err
+	}
+
+	if content == t.Content && contentFormat == t.ContentFormat {
+		return nil
+	}
+
 	t.Content = content
+	t.ContentFormat = contentFormat
+	t.Version++
+	t.UserEditorIdentity = userEditorIdentity
 	now := core.NewDateTime()
 	t.Timestamps.UpdatedAt = &now
 	return nil
 }
 
+/*
+CurrentVersion returns the revision the comment content is at, credited to its last editor or, before any edit, to its
+author.
+*/
+func (t *TaskComment) CurrentVersion() TaskCommentVersion {
+	var userEditorIdentity *core.Identity = t.UserEditorIdentity
+	if userEditorIdentity == nil && t.Author != nil {
+		userEditorIdentity = &t.Author.Identity
+	}
+
+	var createdAt core.DateTime = *t.Timestamps.CreatedAt
+	if t.Timestamps.UpdatedAt != nil {
+		createdAt = *t.Timestamps.UpdatedAt
+	}
+
+	return TaskCommentVersion{
+		Identity:           core.NewIdentityWithoutPublic(),
+		Version:            t.Version,
+		Content:            t.Content,
+		ContentFormat:      t.ContentFormat,
+		UserEditorIdentity: userEditorIdentity,
+		CreatedAt:          createdAt,
+	}
+}
+
+/*
+Delete turns the comment into a tombstone. Its content, files and versions are kept for auditing and are only hidden
+from its listing.
+*/
+func (t *TaskComment) Delete(userDeleterIdentity core.Identity) error {
+	if t.IsDeleted() {
+		return core.NewConflictError("task comment is already deleted")
+	}
+
+	now := core.NewDateTime()
+	t.DeletedAt = &now
+	t.UserDeletedByIdentity = &userDeleterIdentity
+	return nil
+}
+
+/*
+ChangeReferences replaces the references of the comment with the ones read from its content.
+*/
+func (t *TaskComment) ChangeReferences(references []TaskReference) {
+	t.References = mergeTaskReferences(t.References, references)
+}
+
 func (t *TaskComment) AddFile(file TaskCommentFile) {
 	t.Files = append(t.Files, file)
 	now := core.NewDateTime()
@@ -407,3 +1067,377 @@ type TaskAction struct {
 	User         *user.User
 	CreatedAt    core.DateTime
 }
+
+type TaskTimeEntry struct {
+	Identity     core.Identity
+	TaskIdentity core.Identity
+	UserIdentity core.Identity
+	Description  string
+	StartedAt    core.DateTime
+	EndedAt      *core.DateTime
+	Timestamps   core.Timestamps
+}
+
+type NewTaskTimeEntryInput struct {
+	TaskIdentity core.Identity
+	UserIdentity core.Identity
+	Description  string
+	StartedAt    core.DateTime
+	EndedAt      *core.DateTime
+}
+
+func validateTaskTimeEntryPeriod(startedAt core.DateTime, endedAt *core.DateTime) error {
+	now := core.NewDateTime()
+
+	if now.IsBefore(startedAt) {
+		return core.NewInvalidInputError("invalid time entry period", []core.InvalidInputErrorField{
+			{
+				Field: "startedAt",
+				Error: "started at cannot be in the future",
+			},
+		})
+	}
+
+	if endedAt != nil {
+		if !startedAt.IsBefore(*endedAt) {
+			return core.NewInvalidInputError("invalid time entry period", []core.InvalidInputErrorField{
+				{
+					Field: "endedAt",
+					Error: "ended at must be after started at",
+				},
+			})
+		}
+
+		if now.IsBefore(*endedAt) {
+			return core.NewInvalidInputError("invalid time entry period", []core.InvalidInputErrorField{
+				{
+					Field: "endedAt",
+					Error: "ended at cannot be in the future",
+				},
+			})
+		}
+	}
+
+	return nil
+}
+
+func NewTaskTimeEntry(input NewTaskTimeEntryInput) (*TaskTimeEntry, error) {
+	if input.Description != "" {
+		if _, err := core.NewDescription(input.Description); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := validateTaskTimeEntryPeriod(input.StartedAt, input.EndedAt); err != nil {
+		return nil, err
+	}
+
+	now := core.NewDateTime()
+
+	return &TaskTimeEntry{
+		Identity:     core.NewIdentity(TaskTimeEntryIdentityPrefix),
+		TaskIdentity: input.TaskIdentity,
+		UserIdentity: input.UserIdentity,
+		Description:  input.Description,
+		StartedAt:    input.StartedAt,
+		EndedAt:      input.EndedAt,
+		Timestamps: core.Timestamps{
+			CreatedAt: &now,
+			UpdatedAt: nil,
+		},
+	}, nil
+}
+
+func (t *TaskTimeEntry) IsRunning() bool {
+	return t.EndedAt == nil
+}
+
+/*
+DurationSeconds returns the tracked time of the entry, counting up to now while the timer is running.
+*/
+func (t *TaskTimeEntry) DurationSeconds() int64 {
+	if t.EndedAt == nil {
+		return core.NewDateTime().Value - t.StartedAt.Value
+	}
+
+	return t.EndedAt.Value - t.StartedAt.Value
+}
+
+func (t *TaskTimeEntry) IsOwnedBy(userIdentity core.Identity) bool {
+	return t.UserIdentity.Equals(userIdentity)
+}
+
+func (t *TaskTimeEntry) Stop() error {
+	if !t.IsRunning() {
+		return core.NewConflictError("time entry is not running")
+	}
+
+	now := core.NewDateTime()
+	t.EndedAt = &now
+	t.Timestamps.UpdatedAt = &now
+	return nil
+}
+
+func (t *TaskTimeEntry) ChangePeriod(startedAt core.DateTime, endedAt *core.DateTime) error {
+	if err := validateTaskTimeEntryPeriod(startedAt, endedAt); err != nil {
+		return err
+	}
+
+	t.StartedAt = startedAt
+	t.EndedAt = endedAt
+	now := core.NewDateTime()
+	t.Timestamps.UpdatedAt = &now
+	return nil
+}
+
+func (t *TaskTimeEntry) ChangeDescription(description string) error {
+	if description != "" {
+		if _, err := core.NewDescription(description); err != nil {
+			return err
+		}
+	}
+
+	t.Description = description
+	now := core.NewDateTime()
+	t.Timestamps.UpdatedAt = &now
+	return nil
+}
+
+/*
+TaskView is a named set of task listing filters, sorting and grouping. Private views are only visible to their
+owner while project views are shared with every member of the project.
+*/
+type TaskView struct {
+	Identity             core.Identity
+	OrganizationIdentity core.Identity
+	ProjectIdentity      *core.Identity
+	UserOwnerIdentity    core.Identity
+	Name                 string
+	Visibility           TaskViewVisibilities
+	Filters              map[string]string
+	SortBy               *string
+	SortDirection        *core.SortDirection
+	GroupBy              *TaskGroupByFields
+	Timestamps           core.Timestamps
+}
+
+type NewTaskViewInput struct {
+	OrganizationIdentity core.Identity
+	ProjectIdentity      *core.Identity
+	UserOwnerIdentity    core.Identity
+	Name                 string
+	Visibility           TaskViewVisibilities
+	Filters              map[string]string
+	SortBy               *string
+	SortDirection        *core.SortDirection
+	GroupBy              *TaskGroupByFields
+}
+
+func NewTaskView(input NewTaskViewInput) (*TaskView, error) {
+	now := core.NewDateTime()
+
+	if _, err := core.NewName(input.Name); err != nil {
+		return nil, err
+	}
+
+	taskView := &TaskView{
+		Identity:             core.NewIdentity(TaskViewIdentityPrefix),
+		OrganizationIdentity: input.OrganizationIdentity,
+		UserOwnerIdentity:    input.UserOwnerIdentity,
+		Name:                 input.Name,
+		Filters:              map[string]string{},
+		Timestamps: core.Timestamps{
+			CreatedAt: &now,
+			UpdatedAt: nil,
+		},
+	}
+
+	if err := taskView.ChangeVisibility(input.Visibility, input.ProjectIdentity); err != nil {
+		return nil, err
+	}
+
+	if input.Filters != nil {
+		if err := taskView.ChangeFilters(input.Filters); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := taskView.ChangeSort(input.SortBy, input.SortDirection); err != nil {
+		return nil, err
+	}
+
+	if err := taskView.ChangeGroupBy(input.GroupBy); err != nil {
+		return nil, err
+	}
+
+	taskView.Timestamps.UpdatedAt = nil
+	return taskView, nil
+}
+
+func (v *TaskView) ChangeName(name string) error {
+	if _, err := core.NewName(name); err != nil {
+		return err
+	}
+
+	v.Name = name
+	now := core.NewDateTime()
+	v.Timestamps.UpdatedAt = &now
+	return nil
+}
+
+/*
+ChangeVisibility sets who can see the view. Project views must belong to a project.
+*/
+func (v *TaskView) ChangeVisibility(visibility TaskViewVisibilities, projectIdentity *core.Identity) error {
+	if !slices.Contains(TaskViewVisibilitiesArray, visibility) {
+		return core.NewInvalidInputError("invalid visibility", []core.InvalidInputErrorField{
+			{
+				Field: "visibility",
+				Error: "visibility must be private or project",
+			},
+		})
+	}
+
+	if visibility == TaskViewVisibilityProject && projectIdentity == nil {
+		return core.NewInvalidInputError("invalid visibility", []core.InvalidInputErrorField{
+			{
+				Field: "projectId",
+				Error: "project is required to share a view",
+			},
+		})
+	}
+
+	v.Visibility = visibility
+	v.ProjectIdentity = projectIdentity
+	now := core.NewDateTime()
+	v.Timestamps.UpdatedAt = &now
+	return nil
+}
+
+func (v *TaskView) ChangeFilters(filters map[string]string) error {
+	var fields []core.InvalidInputErrorField
+
+	for key := range filters {
+		if slices.Contains(TaskViewFilterKeys, key) {
+			continue
+		}
+
+		parts := strings.Split(key, ".")
+		if len(parts) == 3 && parts[0] == "customFields" && parts[1] != "" && parts[2] != "" {
+			continue
+		}
+
+		fields = append(fields, core.InvalidInputErrorField{
+			Field: "filters." + key,
+			Error: "filter is not supported",
+		})
+	}
+
+	if len(fields) > 0 {
+		return core.NewInvalidInputError("invalid filters", fields)
+	}
+
+	v.Filters = filters
+	now := core.NewDateTime()
+	v.Timestamps.UpdatedAt = &now
+	return nil
+}
+
+func (v *TaskView) ChangeSort(sortBy *string, sortDirection *core.SortDirection) error {
+	if sortDirection != nil && *sortDirection != core.SortDirectionAsc && *sortDirection != core.SortDirectionDesc {
+		return core.NewInvalidInputError("invalid sort", []core.InvalidInputErrorField{
+			{
+				Field: "sortDirection",
+				Error: "sort direction must be asc or desc",
+			},
+		})
+	}
+
+	v.SortBy = sortBy
+	v.SortDirection = sortDirection
+	now := core.NewDateTime()
+	v.Timestamps.UpdatedAt = &now
+	return nil
+}
+
+func (v *TaskView) ChangeGroupBy(groupBy *TaskGroupByFields) error {
+	if groupBy != nil && !slices.Contains(TaskGroupByFieldsArray, *groupBy) {
+		return core.NewInvalidInputError("invalid group by", []core.InvalidInputErrorField{
+			{
+				Field: "groupBy",
+				Error: "tasks cannot be grouped by " + string(*groupBy),
+			},
+		})
+	}
+
+	v.GroupBy = groupBy
+	now := core.NewDateTime()
+	v.Timestamps.UpdatedAt = &now
+	return nil
+}
+
+func (v *TaskView) IsOwnedBy(userIdentity core.Identity) bool {
+	return v.UserOwnerIdentity.Equals(userIdentity)
+}
+
+func (v *TaskView) IsShared() bool {
+	return v.Visibility == TaskViewVisibilityProject
+}
+
+/*
+TaskCalendarFeed is a secret, tokenized iCalendar feed of task due dates. Feeds without a project cover every project
+the owner can see in the organization. Revoked feeds stop resolving but are kept for auditing. Only the hash of the
+token is stored: the token itself is only known when the feed is created.
+*/
+type TaskCalendarFeed struct {
+	Identity             core.Identity
+	OrganizationIdentity core.Identity
+	ProjectIdentity      *core.Identity
+	UserOwnerIdentity    core.Identity
+	Token                string
+	TokenHash            string
+	Timestamps           core.Timestamps
+	RevokedAt            *core.DateTime
+}
+
+type NewTaskCalendarFeedInput struct {
+	OrganizationIdentity core.Identity
+	ProjectIdentity      *core.Identity
+	UserOwnerIdentity    core.Identity
+}
+
+func NewTaskCalendarFeed(input NewTaskCalendarFeedInput) *TaskCalendarFeed {
+	now := core.NewDateTime()
+	token := stringutils.GenerateUniqueString(TaskCalendarFeedTokenLength)
+
+	return &TaskCalendarFeed{
+		Identity:             core.NewIdentity(TaskCalendarFeedIdentityPrefix),
+		OrganizationIdentity: input.OrganizationIdentity,
+		ProjectIdentity:      input.ProjectIdentity,
+		UserOwnerIdentity:    input.UserOwnerIdentity,
+		Token:                token,
+		TokenHash:            HashTaskCalendarFeedToken(token),
+		Timestamps: core.Timestamps{
+			CreatedAt: &now,
+			UpdatedAt: nil,
+		},
+		RevokedAt: nil,
+	}
+}
+
+func HashTaskCalendarFeedToken(token string) string {
+	return hashutils.Sha256(token)
+}
+
+func (f *TaskCalendarFeed) Revoke() {
+	now := core.NewDateTime()
+	f.RevokedAt = &now
+}
+
+func (f *TaskCalendarFeed) IsRevoked() bool {
+	return f.RevokedAt != nil
+}
+
+func (f *TaskCalendarFeed) IsOwnedBy(userIdentity core.Identity) bool {
+	return f.UserOwnerIdentity.Equals(userIdentity)
+}
@@ -0,0 +1,114 @@
+package task
+
+import (
+	"testing"
+
+	"github.com/gabrielmrtt/taski/internal/core"
+)
+
+func TestTaskCalendarFeedTokenIsOnlyShownOnCreation(t *testing.T) {
+	createdAt := core.NewDateTime()
+	feed := NewTaskCalendarFeed(NewTaskCalendarFeedInput{
+		OrganizationIdentity: core.NewIdentity("org"),
+		UserOwnerIdentity:    core.NewIdentity("usr"),
+	})
+	feed.Timestamps.CreatedAt = &createdAt
+
+	if len(feed.Token) != TaskCalendarFeedTokenLength {
+		t.Fatalf("expected a token of %d characters, got %q", TaskCalendarFeedTokenLength, feed.Token)
+	}
+
+	// Matches encode(sha256(convert_to(token, 'UTF8')), 'hex') used to hash the existing tokens
+	if feed.TokenHash != HashTaskCalendarFeedToken(feed.Token) || len(feed.TokenHash) != 64 || feed.TokenHash == feed.Token {
+		t.Errorf("expected the hex sha256 of the token, got %q", feed.TokenHash)
+	}
+
+	if HashTaskCalendarFeedToken("abc") != "ba7816bf8f01cfea414140de5dae2223b00361a396177a9cb410ff61f20015ad" {
+		t.Errorf("unexpected hash %s", HashTaskCalendarFeedToken("abc"))
+	}
+
+	created := TaskCalendarFeedWithUrlToDto(feed, "https://taski.test/feeds")
+	if created.Url == nil || *created.Url != "https://taski.test/feeds/"+feed.Token+"/feed.ics" {
+		t.Errorf("expected the feed url on creation, got %v", created.Url)
+	}
+
+	stored := &TaskCalendarFeed{
+		Identity:             feed.Identity,
+		OrganizationIdentity: feed.OrganizationIdentity,
+		UserOwnerIdentity:    feed.UserOwnerIdentity,
+		TokenHash:            feed.TokenHash,
+		Timestamps:           feed.Timestamps,
+	}
+
+	if listed := TaskCalendarFeedToDto(stored); listed.Url != nil {
+		t.Errorf("expected listed feeds to hide the url, got %s", *listed.Url)
+	}
+}
+
+func TestParseTaskReferences(t *testing.T) {
+	author := core.NewIdentity("usr")
+	alice := core.NewIdentity("usr")
+	bob := core.NewIdentity("usr")
+	referenced := core.NewIdentity(TaskIdentityPrefix)
+
+	content := "@" + alice.Public + " and @" + bob.Public + ", see #" + referenced.Public + ". Thanks @" + alice.Public +
+		" (not mail@" + bob.Public + ", not #" + alice.Public + ")"
+
+	references := ParseTaskReferences(content, author)
+	if len(references) != 3 {
+		t.Fatalf("expected 3 references, got %d", len(references))
+	}
+
+	expected := []struct {
+		mention  bool
+		identity core.Identity
+	}{
+		{mention: true, identity: alice},
+		{mention: true, identity: bob},
+		{mention: false, identity: referenced},
+	}
+
+	for i, reference := range references {
+		if reference.IsUserMention() != expected[i].mention || !reference.UserAuthorIdentity.Equals(author) {
+			t.Errorf("reference %d: unexpected reference %+v", i, reference)
+			continue
+		}
+
+		target := reference.TaskIdentity
+		if reference.IsUserMention() {
+			target = reference.UserIdentity
+		}
+
+		if target == nil || !target.Equals(expected[i].identity) {
+			t.Errorf("reference %d: expected %s, got %v", i, expected[i].identity.Public, target)
+		}
+	}
+}
+
+func TestChangeReferencesKeepsExistingReferences(t *testing.T) {
+	firstAuthor := core.NewIdentity("usr")
+	secondAuthor := core.NewIdentity("usr")
+	alice := core.NewIdentity("usr")
+	bob := core.NewIdentity("usr")
+
+	tsk := &Task{}
+	tsk.ChangeReferences(ParseTaskReferences("@"+alice.Public, firstAuthor))
+	tsk.ChangeReferences(ParseTaskReferences("@"+alice.Public+" @"+bob.Public, secondAuthor))
+
+	if len(tsk.References) != 2 {
+		t.Fatalf("expected 2 references, got %d", len(tsk.References))
+	}
+
+	if !tsk.References[0].UserIdentity.Equals(alice) || !tsk.References[0].UserAuthorIdentity.Equals(firstAuthor) {
+		t.Errorf("expected the existing mention to keep its author, got %+v", tsk.References[0])
+	}
+
+	if !tsk.References[1].UserIdentity.Equals(bob) || !tsk.References[1].UserAuthorIdentity.Equals(secondAuthor) {
+		t.Errorf("expected the new mention to be added by its author, got %+v", tsk.References[1])
+	}
+
+	tsk.ChangeReferences(ParseTaskReferences("no mentions left", secondAuthor))
+	if len(tsk.References) != 0 {
+		t.Errorf("expected removed mentions to be dropped, got %d references", len(tsk.References))
+	}
+}
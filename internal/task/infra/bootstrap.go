@@ -1,13 +1,24 @@
 package taskinfra
 
 import (
+	"fmt"
+
+	"github.com/gabrielmrtt/taski/config"
 	coredatabase "github.com/gabrielmrtt/taski/internal/core/database"
 	corehttp "github.com/gabrielmrtt/taski/internal/core/http"
+	notificationinfra "github.com/gabrielmrtt/taski/internal/notification/infra"
+	notificationevents "github.com/gabrielmrtt/taski/internal/notification/infra/events"
+	organizationdatabase "github.com/gabrielmrtt/taski/internal/organization/infra/database"
 	projectdatabase "github.com/gabrielmrtt/taski/internal/project/infra/database"
+	realtimedatabase "github.com/gabrielmrtt/taski/internal/realtime/infra/database"
+	realtimeevents "github.com/gabrielmrtt/taski/internal/realtime/infra/events"
 	storagedatabase "github.com/gabrielmrtt/taski/internal/storage/infra/database"
 	taskdatabase "github.com/gabrielmrtt/taski/internal/task/infra/database"
 	taskhttp "github.com/gabrielmrtt/taski/internal/task/infra/http"
 	taskservice "github.com/gabrielmrtt/taski/internal/task/services"
+	webhookinfra "github.com/gabrielmrtt/taski/internal/webhook/infra"
+	webhookevents "github.com/gabrielmrtt/taski/internal/webhook/infra/events"
+	workspacedatabase "github.com/gabrielmrtt/taski/internal/workspace/infra/database"
 	"github.com/gin-gonic/gin"
 	"github.com/uptrace/bun"
 )
@@ -18,37 +29,85 @@ type BootstrapInfraOptions struct {
 }
 
 func BootstrapInfra(options BootstrapInfraOptions) {
-	taskRepository := taskdatabase.NewTaskBunRepository(options.DbConnection)
-	taskCommentRepository := taskdatabase.NewTaskCommentBunRepository(options.DbConnection)
+	notifyUserService := notificationinfra.NewNotifyUserService(options.DbConnection)
+	taskRepository := notificationevents.NewTaskRepository(taskdatabase.NewTaskBunRepository(options.DbConnection), notifyUserService)
+	taskCommentRepository := notificationevents.NewTaskCommentRepository(taskdatabase.NewTaskCommentBunRepository(options.DbConnection), taskRepository, notifyUserService)
 	projectRepository := projectdatabase.NewProjectBunRepository(options.DbConnection)
 	projectTaskStatusRepository := projectdatabase.NewProjectTaskStatusBunRepository(options.DbConnection)
+	projectTaskStatusTransitionRepository := projectdatabase.NewProjectTaskStatusTransitionBunRepository(options.DbConnection)
 	projectTaskCategoryRepository := projectdatabase.NewProjectTaskCategoryBunRepository(options.DbConnection)
 	projectUserRepository := projectdatabase.NewProjectUserBunRepository(options.DbConnection)
+	projectTaskCustomFieldRepository := projectdatabase.NewProjectTaskCustomFieldBunRepository(options.DbConnection)
+	organizationUserRepository := organizationdatabase.NewOrganizationUserBunRepository(options.DbConnection)
 	transactionRepository := coredatabase.NewTransactionBunRepository(options.DbConnection)
 	uploadedFileRepository := storagedatabase.NewUploadedFileBunRepository(options.DbConnection)
 	storageRepository := storagedatabase.NewLocalStorageRepository()
-	taskActionRepository := taskdatabase.NewTaskActionBunRepository(options.DbConnection)
+	workspaceRepository := workspacedatabase.NewWorkspaceBunRepository(options.DbConnection)
+	publishWebhookEventService := webhookinfra.NewPublishWebhookEventService(options.DbConnection)
+	realtimeEventRepository := realtimedatabase.NewRealtimeEventBunRepository(options.DbConnection)
+	taskActionRepository := realtimeevents.NewTaskActionRepository(
+		webhookevents.NewTaskActionRepository(taskdatabase.NewTaskActionBunRepository(options.DbConnection), taskRepository, projectRepository, workspaceRepository, publishWebhookEventService),
+		taskRepository, projectRepository, workspaceRepository, realtimeEventRepository,
+	)
+	taskTimeEntryRepository := taskdatabase.NewTaskTimeEntryBunRepository(options.DbConnection)
+	taskViewRepository := taskdatabase.NewTaskViewBunRepository(options.DbConnection)
+	taskCalendarFeedRepository := taskdatabase.NewTaskCalendarFeedBunRepository(options.DbConnection)
+	taskMentionRepository := taskdatabase.NewTaskMentionBunRepository(options.DbConnection)
 
-	listTasksService := taskservice.NewListTasksService(taskRepository)
+	listTasksService := taskservice.NewListTasksService(taskRepository, projectTaskCustomFieldRepository)
 	getTaskService := taskservice.NewGetTaskService(taskRepository)
-	createTaskService := taskservice.NewCreateTaskService(taskRepository, taskActionRepository, projectRepository, projectUserRepository, projectTaskStatusRepository, projectTaskCategoryRepository, transactionRepository)
-	updateTaskService := taskservice.NewUpdateTaskService(taskRepository, taskActionRepository, projectTaskCategoryRepository, projectUserRepository, transactionRepository)
+	createTaskService := taskservice.NewCreateTaskService(taskRepository, taskActionRepository, projectRepository, projectUserRepository, projectTaskStatusRepository, projectTaskCategoryRepository, projectTaskCustomFieldRepository, uploadedFileRepository, transactionRepository)
+	updateTaskService := taskservice.NewUpdateTaskService(taskRepository, taskActionRepository, projectTaskCategoryRepository, projectUserRepository, projectTaskCustomFieldRepository, uploadedFileRepository, transactionRepository)
 	deleteTaskService := taskservice.NewDeleteTaskService(taskRepository, taskActionRepository, projectUserRepository, transactionRepository)
 	addSubTaskService := taskservice.NewAddSubTaskService(taskRepository, taskActionRepository, projectUserRepository, transactionRepository)
 	updateSubTaskService := taskservice.NewUpdateSubTaskService(taskRepository, taskActionRepository, projectUserRepository, transactionRepository)
 	removeSubTaskService := taskservice.NewRemoveSubTaskService(taskRepository, taskActionRepository, projectUserRepository, transactionRepository)
-	changeTaskStatusService := taskservice.NewChangeTaskStatusService(taskRepository, projectTaskStatusRepository, taskActionRepository, projectUserRepository, transactionRepository)
+	changeTaskStatusService := taskservice.NewChangeTaskStatusService(taskRepository, projectRepository, workspaceRepository, projectTaskStatusRepository, projectTaskStatusTransitionRepository, taskActionRepository, projectUserRepository, organizationUserRepository, transactionRepository)
 	completeTaskService := taskservice.NewCompleteTaskService(taskRepository, taskActionRepository, projectUserRepository, transactionRepository)
 	completeSubTaskService := taskservice.NewCompleteSubTaskService(taskRepository, taskActionRepository, projectUserRepository, transactionRepository)
 	getTaskHistoryService := taskservice.NewGetTaskHistoryService(taskActionRepository, taskRepository)
+	getTaskAvailableTransitionsService := taskservice.NewGetTaskAvailableTransitionsService(taskRepository, projectTaskStatusRepository, projectTaskStatusTransitionRepository, organizationUserRepository)
+	bulkUpdateTasksService := taskservice.NewBulkUpdateTasksService(taskRepository, taskActionRepository, projectTaskStatusRepository, projectTaskStatusTransitionRepository, projectTaskCategoryRepository, projectUserRepository, organizationUserRepository, transactionRepository)
+	importTasksService := taskservice.NewImportTasksService(taskRepository, projectRepository, projectTaskStatusRepository, projectTaskCategoryRepository, createTaskService, transactionRepository)
+
+	listTaskViewsService := taskservice.NewListTaskViewsService(taskViewRepository)
+	getTaskViewService := taskservice.NewGetTaskViewService(taskViewRepository, projectUserRepository)
+	createTaskViewService := taskservice.NewCreateTaskViewService(taskViewRepository, projectRepository, projectUserRepository, transactionRepository)
+	updateTaskViewService := taskservice.NewUpdateTaskViewService(taskViewRepository, projectRepository, projectUserRepository, transactionRepository)
+	deleteTaskViewService := taskservice.NewDeleteTaskViewService(taskViewRepository, transactionRepository)
+
+	taskCalendarFeedBaseUrl := fmt.Sprintf("%s/api/%s/task-calendar-feed", config.GetInstance().AppUrl, config.GetInstance().ApiVersion)
+	listTaskCalendarFeedsService := taskservice.NewListTaskCalendarFeedsService(taskCalendarFeedRepository)
+	createTaskCalendarFeedService := taskservice.NewCreateTaskCalendarFeedService(taskCalendarFeedRepository, projectRepository, projectUserRepository, transactionRepository, taskCalendarFeedBaseUrl)
+	revokeTaskCalendarFeedService := taskservice.NewRevokeTaskCalendarFeedService(taskCalendarFeedRepository, transactionRepository)
+	getTaskCalendarFeedContentService := taskservice.NewGetTaskCalendarFeedContentService(taskCalendarFeedRepository, taskRepository, projectRepository, projectUserRepository, organizationUserRepository, config.GetInstance().WebAppUrl)
 
 	listTaskCommentsService := taskservice.NewListTaskCommentsService(taskCommentRepository, taskRepository)
 	createTaskCommentService := taskservice.NewCreateTaskCommentService(taskRepository, taskCommentRepository, uploadedFileRepository, storageRepository, projectUserRepository, taskActionRepository, transactionRepository)
 	updateTaskCommentService := taskservice.NewUpdateTaskCommentService(taskCommentRepository, taskRepository, projectUserRepository, uploadedFileRepository, storageRepository, taskActionRepository, transactionRepository)
-	deleteTaskCommentService := taskservice.NewDeleteTaskCommentService(taskCommentRepository, taskRepository, projectUserRepository, uploadedFileRepository, storageRepository, taskActionRepository, transactionRepository)
+	deleteTaskCommentService := taskservice.NewDeleteTaskCommentService(taskCommentRepository, taskRepository, projectUserRepository, taskActionRepository, transactionRepository)
+	resolveTaskCommentService := taskservice.NewResolveTaskCommentService(taskCommentRepository, taskRepository, projectUserRepository, taskActionRepository, transactionRepository)
+	unresolveTaskCommentService := taskservice.NewUnresolveTaskCommentService(taskCommentRepository, taskRepository, projectUserRepository, taskActionRepository, transactionRepository)
+	addTaskCommentReactionService := taskservice.NewAddTaskCommentReactionService(taskCommentRepository, taskRepository, projectUserRepository, transactionRepository)
+	removeTaskCommentReactionService := taskservice.NewRemoveTaskCommentReactionService(taskCommentRepository, taskRepository, projectUserRepository, transactionRepository)
+	listTaskCommentVersionsService := taskservice.NewListTaskCommentVersionsService(taskCommentRepository, taskRepository)
+	listMyTaskMentionsService := taskservice.NewListMyTaskMentionsService(taskMentionRepository)
 
-	taskHandler := taskhttp.NewTaskHandler(listTasksService, getTaskService, createTaskService, updateTaskService, deleteTaskService, addSubTaskService, updateSubTaskService, removeSubTaskService, changeTaskStatusService, completeTaskService, completeSubTaskService, getTaskHistoryService)
-	taskCommentHandler := taskhttp.NewTaskCommentHandler(listTaskCommentsService, createTaskCommentService, updateTaskCommentService, deleteTaskCommentService)
+	listTaskTimeEntriesService := taskservice.NewListTaskTimeEntriesService(taskTimeEntryRepository, taskRepository)
+	createTaskTimeEntryService := taskservice.NewCreateTaskTimeEntryService(taskTimeEntryRepository, taskRepository, taskActionRepository, projectUserRepository, transactionRepository)
+	updateTaskTimeEntryService := taskservice.NewUpdateTaskTimeEntryService(taskTimeEntryRepository, taskRepository, taskActionRepository, projectUserRepository, transactionRepository)
+	deleteTaskTimeEntryService := taskservice.NewDeleteTaskTimeEntryService(taskTimeEntryRepository, taskRepository, taskActionRepository, projectUserRepository, transactionRepository)
+	startTaskTimerService := taskservice.NewStartTaskTimerService(taskTimeEntryRepository, taskRepository, projectUserRepository, transactionRepository)
+	stopTaskTimerService := taskservice.NewStopTaskTimerService(taskTimeEntryRepository, taskRepository, taskActionRepository, projectUserRepository, transactionRepository)
+	getTaskTimeSummaryService := taskservice.NewGetTaskTimeSummaryService(taskTimeEntryRepository, taskRepository)
+	getTaskTimeReportService := taskservice.NewGetTaskTimeReportService(taskTimeEntryRepository)
+
+	taskHandler := taskhttp.NewTaskHandler(listTasksService, getTaskService, createTaskService, updateTaskService, deleteTaskService, addSubTaskService, updateSubTaskService, removeSubTaskService, changeTaskStatusService, completeTaskService, completeSubTaskService, getTaskHistoryService, getTaskAvailableTransitionsService, getTaskViewService, bulkUpdateTasksService, importTasksService)
+	taskViewHandler := taskhttp.NewTaskViewHandler(listTaskViewsService, getTaskViewService, createTaskViewService, updateTaskViewService, deleteTaskViewService)
+	taskCalendarFeedHandler := taskhttp.NewTaskCalendarFeedHandler(listTaskCalendarFeedsService, createTaskCalendarFeedService, revokeTaskCalendarFeedService, getTaskCalendarFeedContentService)
+	taskCommentHandler := taskhttp.NewTaskCommentHandler(listTaskCommentsService, createTaskCommentService, updateTaskCommentService, deleteTaskCommentService, resolveTaskCommentService, unresolveTaskCommentService, addTaskCommentReactionService, removeTaskCommentReactionService, listTaskCommentVersionsService)
+	taskMentionHandler := taskhttp.NewTaskMentionHandler(listMyTaskMentionsService)
+	taskTimeEntryHandler := taskhttp.NewTaskTimeEntryHandler(listTaskTimeEntriesService, createTaskTimeEntryService, updateTaskTimeEntryService, deleteTaskTimeEntryService, startTaskTimerService, stopTaskTimerService, getTaskTimeSummaryService, getTaskTimeReportService)
 
 	taskHandler.ConfigureRoutes(corehttp.ConfigureRoutesOptions{
 		DbConnection: options.DbConnection,
@@ -59,4 +118,24 @@ func BootstrapInfra(options BootstrapInfraOptions) {
 		DbConnection: options.DbConnection,
 		RouterGroup:  options.RouterGroup,
 	})
+
+	taskMentionHandler.ConfigureRoutes(corehttp.ConfigureRoutesOptions{
+		DbConnection: options.DbConnection,
+		RouterGroup:  options.RouterGroup,
+	})
+
+	taskTimeEntryHandler.ConfigureRoutes(corehttp.ConfigureRoutesOptions{
+		DbConnection: options.DbConnection,
+		RouterGroup:  options.RouterGroup,
+	})
+
+	taskViewHandler.ConfigureRoutes(corehttp.ConfigureRoutesOptions{
+		DbConnection: options.DbConnection,
+		RouterGroup:  options.RouterGroup,
+	})
+
+	taskCalendarFeedHandler.ConfigureRoutes(corehttp.ConfigureRoutesOptions{
+		DbConnection: options.DbConnection,
+		RouterGroup:  options.RouterGroup,
+	})
 }
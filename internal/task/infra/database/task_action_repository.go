@@ -37,6 +37,22 @@ func (t *TaskActionTable) ToEntity() *task.TaskAction {
 	}
 }
 
+var taskActionSortableFields = coredatabase.SortableFields{
+	Fields: map[string]string{
+		"type":      "task_action.type",
+		"createdAt": "task_action.created_at",
+	},
+	IdColumn: "task_action.internal_id",
+}
+
+var taskActionIncludableRelations = coredatabase.IncludableRelations{
+	Relations: []string{
+		"Task",
+		"User",
+	},
+	MaxDepth: 1,
+}
+
 type TaskActionBunRepository struct {
 	db *bun.DB
 	tx *coredatabase.TransactionBun
@@ -74,16 +90,6 @@ func (r *TaskActionBunRepository) applyFilters(query *bun.SelectQuery, filters t
 func (r *TaskActionBunRepository) PaginateTaskActionsBy(params taskrepo.PaginateTaskActionsParams) (*core.PaginationOutput[task.TaskAction], error) {
 	var taskActions []*TaskActionTable = make([]*TaskActionTable, 0)
 	var selectQuery *bun.SelectQuery
-	var perPage int = 10
-	var page int = 1
-
-	if params.Pagination.PerPage != nil {
-		perPage = *params.Pagination.PerPage
-	}
-
-	if params.Pagination.Page != nil {
-		page = *params.Pagination.Page
-	}
 
 	if r.tx != nil && !r.tx.IsClosed() {
 		selectQuery = r.tx.Tx.NewSelect()
@@ -93,33 +99,54 @@ func (r *TaskActionBunRepository) PaginateTaskActionsBy(params taskrepo.Paginate
 
 	selectQuery = selectQuery.Model(&taskActions)
 	selectQuery = selectQuery.Relation("Task").Relation("User")
-	selectQuery = coredatabase.ApplyRelations(selectQuery, params.RelationsInput)
-	selectQuery = r.applyFilters(selectQuery, params.Filters)
-	countBeforePagination, err := selectQuery.Count(context.Background())
+	selectQuery, err := coredatabase.ApplyRelations(selectQuery, taskActionIncludableRelations, params.RelationsInput)
 	if err != nil {
 		return nil, err
 	}
 
-	selectQuery = coredatabase.ApplySort(selectQuery, params.SortInput)
-	selectQuery = coredatabase.ApplyPagination(selectQuery, params.Pagination)
-	err = selectQuery.Scan(context.Background())
+	selectQuery = r.applyFilters(selectQuery, params.Filters)
+
+	sortColumns, err := coredatabase.ResolveSort(taskActionSortableFields, params.SortInput)
 	if err != nil {
-		if err == sql.ErrNoRows {
-			return nil, nil
+		return nil, err
+	}
+
+	var countBeforePagination int = 0
+	if params.Pagination.ShouldCount() {
+		countBeforePagination, err = selectQuery.Count(context.Background())
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	var cursorPagination *coredatabase.CursorPagination = nil
+	if params.Pagination.IsCursor() {
+		selectQuery, cursorPagination, err = coredatabase.ApplyCursorPagination(selectQuery, taskActionSortableFields, sortColumns, params.Pagination)
+		if err != nil {
+			return nil, err
 		}
+	} else {
+		selectQuery = coredatabase.ApplySortColumns(selectQuery, sortColumns)
+		selectQuery = coredatabase.ApplyOffsetPagination(selectQuery, params.Pagination)
+	}
+
+	err = selectQuery.Scan(context.Background())
+	if err != nil && err != sql.ErrNoRows {
+		return nil, err
 	}
 
 	var taskActionEntities []task.TaskAction = make([]task.TaskAction, 0)
+	var taskActionPublicIds []string = make([]string, 0)
 	for _, taskAction := range taskActions {
 		taskActionEntities = append(taskActionEntities, *taskAction.ToEntity())
+		taskActionPublicIds = append(taskActionPublicIds, taskAction.PublicId)
+	}
+
+	if cursorPagination != nil {
+		return coredatabase.NewCursorPaginationOutput(taskActionEntities, taskActionPublicIds, cursorPagination, countBeforePagination), nil
 	}
 
-	return &core.PaginationOutput[task.TaskAction]{
-		Data:    taskActionEntities,
-		Page:    page,
-		HasMore: core.HasMorePages(page, countBeforePagination, perPage),
-		Total:   countBeforePagination,
-	}, nil
+	return coredatabase.NewOffsetPaginationOutput(taskActionEntities, params.Pagination, countBeforePagination), nil
 }
 
 func (r *TaskActionBunRepository) StoreTaskAction(params taskrepo.StoreTaskActionParams) (*task.TaskAction, error) {
@@ -0,0 +1,256 @@
+package taskdatabase
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/gabrielmrtt/taski/internal/core"
+	coredatabase "github.com/gabrielmrtt/taski/internal/core/database"
+	"github.com/gabrielmrtt/taski/internal/organization"
+	"github.com/gabrielmrtt/taski/internal/project"
+	"github.com/gabrielmrtt/taski/internal/task"
+	taskrepo "github.com/gabrielmrtt/taski/internal/task/repository"
+	"github.com/gabrielmrtt/taski/internal/user"
+	"github.com/google/uuid"
+	"github.com/uptrace/bun"
+)
+
+type TaskCalendarFeedTable struct {
+	bun.BaseModel `bun:"table:task_calendar_feed,alias:task_calendar_feed"`
+
+	InternalId             string  `bun:"internal_id,pk,notnull,type:uuid"`
+	PublicId               string  `bun:"public_id,notnull,type:varchar(510)"`
+	OrganizationInternalId string  `bun:"organization_internal_id,notnull,type:uuid"`
+	ProjectInternalId      *string `bun:"project_internal_id,type:uuid"`
+	UserOwnerInternalId    string  `bun:"user_owner_internal_id,notnull,type:uuid"`
+	TokenHash              string  `bun:"token_hash,notnull,type:varchar(255)"`
+	CreatedAt              int64   `bun:"created_at,notnull,type:bigint"`
+	RevokedAt              *int64  `bun:"revoked_at,type:bigint"`
+}
+
+func (t *TaskCalendarFeedTable) ToEntity() *task.TaskCalendarFeed {
+	var projectIdentity *core.Identity = nil
+	if t.ProjectInternalId != nil {
+		identity := core.NewIdentityFromInternal(uuid.MustParse(*t.ProjectInternalId), project.ProjectIdentityPrefix)
+		projectIdentity = &identity
+	}
+
+	createdAt := core.DateTime{Value: t.CreatedAt}
+	var revokedAt *core.DateTime = nil
+	if t.RevokedAt != nil {
+		revokedAt = &core.DateTime{Value: *t.RevokedAt}
+	}
+
+	return &task.TaskCalendarFeed{
+		Identity:             core.NewIdentityFromInternal(uuid.MustParse(t.InternalId), task.TaskCalendarFeedIdentityPrefix),
+		OrganizationIdentity: core.NewIdentityFromInternal(uuid.MustParse(t.OrganizationInternalId), organization.OrganizationIdentityPrefix),
+		ProjectIdentity:      projectIdentity,
+		UserOwnerIdentity:    core.NewIdentityFromInternal(uuid.MustParse(t.UserOwnerInternalId), user.UserIdentityPrefix),
+		TokenHash:            t.TokenHash,
+		Timestamps: core.Timestamps{
+			CreatedAt: &createdAt,
+			UpdatedAt: nil,
+		},
+		RevokedAt: revokedAt,
+	}
+}
+
+func taskCalendarFeedToTable(taskCalendarFeed *task.TaskCalendarFeed) *TaskCalendarFeedTable {
+	var projectInternalId *string = nil
+	if taskCalendarFeed.ProjectIdentity != nil {
+		internalId := taskCalendarFeed.ProjectIdentity.Internal.String()
+		projectInternalId = &internalId
+	}
+
+	var createdAt int64 = 0
+	if taskCalendarFeed.Timestamps.CreatedAt != nil {
+		createdAt = taskCalendarFeed.Timestamps.CreatedAt.Value
+	}
+
+	var revokedAt *int64 = nil
+	if taskCalendarFeed.RevokedAt != nil {
+		revokedAt = &taskCalendarFeed.RevokedAt.Value
+	}
+
+	return &TaskCalendarFeedTable{
+		InternalId:             taskCalendarFeed.Identity.Internal.String(),
+		PublicId:               taskCalendarFeed.Identity.Public,
+		OrganizationInternalId: taskCalendarFeed.OrganizationIdentity.Internal.String(),
+		ProjectInternalId:      projectInternalId,
+		UserOwnerInternalId:    taskCalendarFeed.UserOwnerIdentity.Internal.String(),
+		TokenHash:              taskCalendarFeed.TokenHash,
+		CreatedAt:              createdAt,
+		RevokedAt:              revokedAt,
+	}
+}
+
+type TaskCalendarFeedBunRepository struct {
+	db *bun.DB
+	tx *coredatabase.TransactionBun
+}
+
+func NewTaskCalendarFeedBunRepository(connection *bun.DB) *TaskCalendarFeedBunRepository {
+	return &TaskCalendarFeedBunRepository{db: connection, tx: nil}
+}
+
+func (r *TaskCalendarFeedBunRepository) SetTransaction(tx core.Transaction) error {
+	if r.tx != nil && !r.tx.IsClosed() {
+		return nil
+	}
+
+	r.tx = tx.(*coredatabase.TransactionBun)
+	return nil
+}
+
+func (r *TaskCalendarFeedBunRepository) applyFilters(selectQuery *bun.SelectQuery, filters taskrepo.TaskCalendarFeedFilters) *bun.SelectQuery {
+	if filters.OrganizationIdentity != nil {
+		selectQuery = selectQuery.Where("task_calendar_feed.organization_internal_id = ?", filters.OrganizationIdentity.Internal.String())
+	}
+
+	if filters.ProjectIdentity != nil {
+		selectQuery = selectQuery.Where("task_calendar_feed.project_internal_id = ?", filters.ProjectIdentity.Internal.String())
+	}
+
+	if filters.UserOwnerIdentity != nil {
+		selectQuery = selectQuery.Where("task_calendar_feed.user_owner_internal_id = ?", filters.UserOwnerIdentity.Internal.String())
+	}
+
+	if !filters.ShowRevoked {
+		selectQuery = selectQuery.Where("task_calendar_feed.revoked_at IS NULL")
+	}
+
+	return selectQuery
+}
+
+func (r *TaskCalendarFeedBunRepository) getTaskCalendarFeedBy(where string, args ...interface{}) (*task.TaskCalendarFeed, error) {
+	var taskCalendarFeed *TaskCalendarFeedTable = new(TaskCalendarFeedTable)
+	var selectQuery *bun.SelectQuery
+
+	if r.tx != nil && !r.tx.IsClosed() {
+		selectQuery = r.tx.Tx.NewSelect()
+	} else {
+		selectQuery = r.db.NewSelect()
+	}
+
+	selectQuery = selectQuery.Model(taskCalendarFeed)
+	selectQuery = selectQuery.Where(where, args...)
+
+	err := selectQuery.Scan(context.Background())
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil
+		}
+
+		return nil, err
+	}
+
+	if taskCalendarFeed.InternalId == "" {
+		return nil, nil
+	}
+
+	return taskCalendarFeed.ToEntity(), nil
+}
+
+func (r *TaskCalendarFeedBunRepository) GetTaskCalendarFeedByIdentity(params taskrepo.GetTaskCalendarFeedByIdentityParams) (*task.TaskCalendarFeed, error) {
+	if params.OrganizationIdentity != nil {
+		return r.getTaskCalendarFeedBy("task_calendar_feed.internal_id = ? AND task_calendar_feed.organization_internal_id = ?", params.TaskCalendarFeedIdentity.Internal.String(), params.OrganizationIdentity.Internal.String())
+	}
+
+	return r.getTaskCalendarFeedBy("task_calendar_feed.internal_id = ?", params.TaskCalendarFeedIdentity.Internal.String())
+}
+
+func (r *TaskCalendarFeedBunRepository) GetTaskCalendarFeedByTokenHash(params taskrepo.GetTaskCalendarFeedByTokenHashParams) (*task.TaskCalendarFeed, error) {
+	return r.getTaskCalendarFeedBy("task_calendar_feed.token_hash = ?", params.TokenHash)
+}
+
+func (r *TaskCalendarFeedBunRepository) ListTaskCalendarFeedsBy(params taskrepo.ListTaskCalendarFeedsByParams) ([]task.TaskCalendarFeed, error) {
+	var taskCalendarFeeds []TaskCalendarFeedTable = make([]TaskCalendarFeedTable, 0)
+	var selectQuery *bun.SelectQuery
+
+	if r.tx != nil && !r.tx.IsClosed() {
+		selectQuery = r.tx.Tx.NewSelect()
+	} else {
+		selectQuery = r.db.NewSelect()
+	}
+
+	selectQuery = selectQuery.Model(&taskCalendarFeeds)
+	selectQuery = r.applyFilters(selectQuery, params.Filters)
+	selectQuery = selectQuery.Order("task_calendar_feed.created_at DESC")
+	err := selectQuery.Scan(context.Background())
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return []task.TaskCalendarFeed{}, nil
+		}
+
+		return nil, err
+	}
+
+	var taskCalendarFeedEntities []task.TaskCalendarFeed = make([]task.TaskCalendarFeed, 0)
+	for _, taskCalendarFeed := range taskCalendarFeeds {
+		taskCalendarFeedEntities = append(taskCalendarFeedEntities, *taskCalendarFeed.ToEntity())
+	}
+
+	return taskCalendarFeedEntities, nil
+}
+
+func (r *TaskCalendarFeedBunRepository) StoreTaskCalendarFeed(params taskrepo.StoreTaskCalendarFeedParams) (*task.TaskCalendarFeed, error) {
+	var tx bun.Tx
+	var shouldCommit bool = false
+
+	if r.tx != nil && !r.tx.IsClosed() {
+		tx = *r.tx.Tx
+	} else {
+		var err error
+		tx, err = r.db.BeginTx(context.Background(), nil)
+		shouldCommit = true
+
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	_, err := tx.NewInsert().Model(taskCalendarFeedToTable(params.TaskCalendarFeed)).Exec(context.Background())
+	if err != nil {
+		return nil, err
+	}
+
+	if shouldCommit {
+		err = tx.Commit()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return params.TaskCalendarFeed, nil
+}
+
+func (r *TaskCalendarFeedBunRepository) UpdateTaskCalendarFeed(params taskrepo.UpdateTaskCalendarFeedParams) error {
+	var tx bun.Tx
+	var shouldCommit bool = false
+
+	if r.tx != nil && !r.tx.IsClosed() {
+		tx = *r.tx.Tx
+	} else {
+		var err error
+		tx, err = r.db.BeginTx(context.Background(), nil)
+		shouldCommit = true
+
+		if err != nil {
+			return err
+		}
+	}
+
+	_, err := tx.NewUpdate().Model(taskCalendarFeedToTable(params.TaskCalendarFeed)).Where("task_calendar_feed.internal_id = ?", params.TaskCalendarFeed.Identity.Internal.String()).Exec(context.Background())
+	if err != nil {
+		return err
+	}
+
+	if shouldCommit {
+		err = tx.Commit()
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -10,6 +10,7 @@ import (
 	storagedatabase "github.com/gabrielmrtt/taski/internal/storage/infra/database"
 	"github.com/gabrielmrtt/taski/internal/task"
 	taskrepo "github.com/gabrielmrtt/taski/internal/task/repository"
+	"github.com/gabrielmrtt/taski/internal/user"
 	userdatabase "github.com/gabrielmrtt/taski/internal/user/infra/database"
 	"github.com/google/uuid"
 	"github.com/uptrace/bun"
@@ -33,20 +34,105 @@ func (t *TaskCommentFileTable) ToEntity() *task.TaskCommentFile {
 	}
 }
 
+type TaskCommentReactionTable struct {
+	bun.BaseModel `bun:"table:task_comment_reaction,alias:task_comment_reaction"`
+
+	TaskCommentInternalId string `bun:"task_comment_internal_id,pk,notnull,type:uuid"`
+	UserInternalId        string `bun:"user_internal_id,pk,notnull,type:uuid"`
+	Emoji                 string `bun:"emoji,pk,notnull,type:varchar(64)"`
+	CreatedAt             int64  `bun:"created_at,notnull,type:bigint"`
+}
+
+func (t *TaskCommentReactionTable) ToEntity() *task.TaskCommentReaction {
+	return &task.TaskCommentReaction{
+		UserIdentity: core.NewIdentityFromInternal(uuid.MustParse(t.UserInternalId), user.UserIdentityPrefix),
+		Emoji:        t.Emoji,
+		CreatedAt:    core.DateTime{Value: t.CreatedAt},
+	}
+}
+
+type TaskCommentVersionTable struct {
+	bun.BaseModel `bun:"table:task_comment_version,alias:task_comment_version"`
+
+	InternalId            string  `bun:"internal_id,pk,notnull,type:uuid"`
+	TaskCommentInternalId string  `bun:"task_comment_internal_id,notnull,type:uuid"`
+	Version               int     `bun:"version,notnull,type:int"`
+	Content               string  `bun:"content,notnull,type:text"`
+	ContentFormat         string  `bun:"content_format,notnull,type:varchar(50)"`
+	UserEditorInternalId  *string `bun:"user_editor_internal_id,type:uuid"`
+	CreatedAt             int64   `bun:"created_at,notnull,type:bigint"`
+}
+
+func (t *TaskCommentVersionTable) ToEntity() *task.TaskCommentVersion {
+	var userEditorIdentity *core.Identity = nil
+	if t.UserEditorInternalId != nil {
+		identity := core.NewIdentityFromInternal(uuid.MustParse(*t.UserEditorInternalId), user.UserIdentityPrefix)
+		userEditorIdentity = &identity
+	}
+
+	return &task.TaskCommentVersion{
+		Identity:           core.NewIdentityWithoutPublicFromInternal(uuid.MustParse(t.InternalId)),
+		Version:            t.Version,
+		Content:            t.Content,
+		ContentFormat:      core.ContentFormats(t.ContentFormat),
+		UserEditorIdentity: userEditorIdentity,
+		CreatedAt:          core.DateTime{Value: t.CreatedAt},
+	}
+}
+
+/*
+storeTaskCommentVersion records the current version of a comment. A version that is already recorded is left as is,
+so updates that do not edit the content add nothing.
+*/
+func storeTaskCommentVersion(tx bun.Tx, taskComment *task.TaskComment) error {
+	taskCommentVersion := taskComment.CurrentVersion()
+
+	var userEditorInternalId *string = nil
+	if taskCommentVersion.UserEditorIdentity != nil {
+		internalId := taskCommentVersion.UserEditorIdentity.Internal.String()
+		userEditorInternalId = &internalId
+	}
+
+	taskCommentVersionTable := &TaskCommentVersionTable{
+		InternalId:            taskCommentVersion.Identity.Internal.String(),
+		TaskCommentInternalId: taskComment.Identity.Internal.String(),
+		Version:               taskCommentVersion.Version,
+		Content:               taskCommentVersion.Content,
+		ContentFormat:         string(taskCommentVersion.ContentFormat),
+		UserEditorInternalId:  userEditorInternalId,
+		CreatedAt:             taskCommentVersion.CreatedAt.Value,
+	}
+
+	_, err := tx.NewInsert().Model(taskCommentVersionTable).On("CONFLICT (task_comment_internal_id, version) DO NOTHING").Exec(context.Background())
+	return err
+}
+
 type TaskCommentTable struct {
 	bun.BaseModel `bun:"table:task_comment,alias:task_comment"`
 
-	InternalId           string `bun:"internal_id,pk,notnull,type:uuid"`
-	PublicId             string `bun:"public_id,notnull,type:varchar(510)"`
-	Content              string `bun:"content,notnull,type:text"`
-	TaskInternalId       string `bun:"task_internal_id,notnull,type:uuid"`
-	UserAuthorInternalId string `bun:"user_author_internal_id,notnull,type:uuid"`
-	CreatedAt            int64  `bun:"created_at,notnull,type:bigint"`
-	UpdatedAt            *int64 `bun:"updated_at,type:bigint"`
-
-	Task   *TaskTable              `bun:"rel:has-one,join:task_internal_id=internal_id"`
-	Author *userdatabase.UserTable `bun:"rel:has-one,join:user_author_internal_id=internal_id"`
-	Files  []*TaskCommentFileTable `bun:"rel:has-many,join:internal_id=task_comment_internal_id"`
+	InternalId                  string  `bun:"internal_id,pk,notnull,type:uuid"`
+	PublicId                    string  `bun:"public_id,notnull,type:varchar(510)"`
+	Content                     string  `bun:"content,notnull,type:text"`
+	ContentFormat               string  `bun:"content_format,notnull,type:varchar(50)"`
+	ContentText                 string  `bun:"content_text,notnull,type:text"`
+	TaskInternalId              string  `bun:"task_internal_id,notnull,type:uuid"`
+	ParentTaskCommentInternalId *string `bun:"parent_task_comment_internal_id,type:uuid"`
+	UserAuthorInternalId        string  `bun:"user_author_internal_id,notnull,type:uuid"`
+	ResolvedAt                  *int64  `bun:"resolved_at,type:bigint"`
+	UserResolvedInternalId      *string `bun:"user_resolved_internal_id,type:uuid"`
+	Version                     int     `bun:"version,notnull,type:int"`
+	UserEditorInternalId        *string `bun:"user_editor_internal_id,type:uuid"`
+	DeletedAt                   *int64  `bun:"deleted_at,type:bigint"`
+	UserDeletedInternalId       *string `bun:"user_deleted_internal_id,type:uuid"`
+	CreatedAt                   int64   `bun:"created_at,notnull,type:bigint"`
+	UpdatedAt                   *int64  `bun:"updated_at,type:bigint"`
+
+	Task       *TaskTable                  `bun:"rel:has-one,join:task_internal_id=internal_id"`
+	Author     *userdatabase.UserTable     `bun:"rel:has-one,join:user_author_internal_id=internal_id"`
+	Files      []*TaskCommentFileTable     `bun:"rel:has-many,join:internal_id=task_comment_internal_id"`
+	References []*TaskReferenceTable       `bun:"rel:has-many,join:internal_id=task_comment_internal_id"`
+	Reactions  []*TaskCommentReactionTable `bun:"rel:has-many,join:internal_id=task_comment_internal_id"`
+	Replies    []*TaskCommentTable         `bun:"rel:has-many,join:internal_id=parent_task_comment_internal_id"`
 }
 
 func (t *TaskCommentTable) ToEntity() *task.TaskComment {
@@ -61,12 +147,67 @@ func (t *TaskCommentTable) ToEntity() *task.TaskComment {
 		updatedAt = &core.DateTime{Value: *t.UpdatedAt}
 	}
 
+	var parentTaskCommentIdentity *core.Identity = nil
+	if t.ParentTaskCommentInternalId != nil {
+		identity := core.NewIdentityFromInternal(uuid.MustParse(*t.ParentTaskCommentInternalId), task.TaskCommentIdentityPrefix)
+		parentTaskCommentIdentity = &identity
+	}
+
+	var resolvedAt *core.DateTime = nil
+	if t.ResolvedAt != nil {
+		resolvedAt = &core.DateTime{Value: *t.ResolvedAt}
+	}
+
+	var userResolvedByIdentity *core.Identity = nil
+	if t.UserResolvedInternalId != nil {
+		identity := core.NewIdentityFromInternal(uuid.MustParse(*t.UserResolvedInternalId), user.UserIdentityPrefix)
+		userResolvedByIdentity = &identity
+	}
+
+	var userEditorIdentity *core.Identity = nil
+	if t.UserEditorInternalId != nil {
+		identity := core.NewIdentityFromInternal(uuid.MustParse(*t.UserEditorInternalId), user.UserIdentityPrefix)
+		userEditorIdentity = &identity
+	}
+
+	var deletedAt *core.DateTime = nil
+	if t.DeletedAt != nil {
+		deletedAt = &core.DateTime{Value: *t.DeletedAt}
+	}
+
+	var userDeletedByIdentity *core.Identity = nil
+	if t.UserDeletedInternalId != nil {
+		identity := core.NewIdentityFromInternal(uuid.MustParse(*t.UserDeletedInternalId), user.UserIdentityPrefix)
+		userDeletedByIdentity = &identity
+	}
+
+	var reactions []task.TaskCommentReaction = make([]task.TaskCommentReaction, len(t.Reactions))
+	for i, reaction := range t.Reactions {
+		reactions[i] = *reaction.ToEntity()
+	}
+
+	var replies []*task.TaskComment = make([]*task.TaskComment, len(t.Replies))
+	for i, reply := range t.Replies {
+		replies[i] = reply.ToEntity()
+	}
+
 	return &task.TaskComment{
-		Identity:     core.NewIdentityFromInternal(uuid.MustParse(t.InternalId), task.TaskCommentIdentityPrefix),
-		Content:      t.Content,
-		Files:        files,
-		TaskIdentity: core.NewIdentityFromInternal(uuid.MustParse(t.TaskInternalId), task.TaskIdentityPrefix),
-		Author:       t.Author.ToEntity(),
+		Identity:                  core.NewIdentityFromInternal(uuid.MustParse(t.InternalId), task.TaskCommentIdentityPrefix),
+		ParentTaskCommentIdentity: parentTaskCommentIdentity,
+		Content:                   t.Content,
+		ContentFormat:             core.ContentFormats(t.ContentFormat),
+		Files:                     files,
+		References:                taskReferencesToEntities(t.References),
+		Reactions:                 reactions,
+		Replies:                   replies,
+		TaskIdentity:              core.NewIdentityFromInternal(uuid.MustParse(t.TaskInternalId), task.TaskIdentityPrefix),
+		Author:                    t.Author.ToEntity(),
+		ResolvedAt:                resolvedAt,
+		UserResolvedByIdentity:    userResolvedByIdentity,
+		Version:                   t.Version,
+		UserEditorIdentity:        userEditorIdentity,
+		DeletedAt:                 deletedAt,
+		UserDeletedByIdentity:     userDeletedByIdentity,
 		Timestamps: core.Timestamps{
 			CreatedAt: &createdAt,
 			UpdatedAt: updatedAt,
@@ -74,6 +215,102 @@ func (t *TaskCommentTable) ToEntity() *task.TaskComment {
 	}
 }
 
+func taskCommentToTable(taskComment *task.TaskComment) *TaskCommentTable {
+	var parentTaskCommentInternalId *string = nil
+	if taskComment.ParentTaskCommentIdentity != nil {
+		internalId := taskComment.ParentTaskCommentIdentity.Internal.String()
+		parentTaskCommentInternalId = &internalId
+	}
+
+	var resolvedAt *int64 = nil
+	if taskComment.ResolvedAt != nil {
+		resolvedAt = &taskComment.ResolvedAt.Value
+	}
+
+	var userResolvedInternalId *string = nil
+	if taskComment.UserResolvedByIdentity != nil {
+		internalId := taskComment.UserResolvedByIdentity.Internal.String()
+		userResolvedInternalId = &internalId
+	}
+
+	var userEditorInternalId *string = nil
+	if taskComment.UserEditorIdentity != nil {
+		internalId := taskComment.UserEditorIdentity.Internal.String()
+		userEditorInternalId = &internalId
+	}
+
+	var deletedAt *int64 = nil
+	if taskComment.DeletedAt != nil {
+		deletedAt = &taskComment.DeletedAt.Value
+	}
+
+	var userDeletedInternalId *string = nil
+	if taskComment.UserDeletedByIdentity != nil {
+		internalId := taskComment.UserDeletedByIdentity.Internal.String()
+		userDeletedInternalId = &internalId
+	}
+
+	var updatedAt *int64 = nil
+	if taskComment.Timestamps.UpdatedAt != nil {
+		updatedAt = &taskComment.Timestamps.UpdatedAt.Value
+	}
+
+	return &TaskCommentTable{
+		InternalId:                  taskComment.Identity.Internal.String(),
+		PublicId:                    taskComment.Identity.Public,
+		Content:                     taskComment.Content,
+		ContentFormat:               string(taskComment.ContentFormat),
+		ContentText:                 taskComment.FormattedContent().PlainText(),
+		TaskInternalId:              taskComment.TaskIdentity.Internal.String(),
+		ParentTaskCommentInternalId: parentTaskCommentInternalId,
+		UserAuthorInternalId:        taskComment.Author.Identity.Internal.String(),
+		ResolvedAt:                  resolvedAt,
+		UserResolvedInternalId:      userResolvedInternalId,
+		Version:                     taskComment.Version,
+		UserEditorInternalId:        userEditorInternalId,
+		DeletedAt:                   deletedAt,
+		UserDeletedInternalId:       userDeletedInternalId,
+		CreatedAt:                   taskComment.Timestamps.CreatedAt.Value,
+		UpdatedAt:                   updatedAt,
+	}
+}
+
+var taskCommentSortableFields = coredatabase.SortableFields{
+	Fields: map[string]string{
+		"createdAt": "task_comment.created_at",
+		"updatedAt": "task_comment.updated_at",
+	},
+	IdColumn: "task_comment.internal_id",
+}
+
+var taskCommentIncludableRelations = coredatabase.IncludableRelations{
+	Relations: []string{
+		"Task",
+		"Author",
+		"Files.File",
+		"References",
+	},
+	MaxDepth: 2,
+}
+
+func orderTaskCommentReactions(query *bun.SelectQuery) *bun.SelectQuery {
+	return query.Order("task_comment_reaction.created_at ASC")
+}
+
+/*
+withTaskCommentReplies loads the replies of a comment thread, oldest first, with the same relations as the thread.
+*/
+func withTaskCommentReplies(query *bun.SelectQuery) *bun.SelectQuery {
+	return query.
+		Relation("Replies", func(query *bun.SelectQuery) *bun.SelectQuery {
+			return query.Order("task_comment.created_at ASC", "task_comment.internal_id ASC")
+		}).
+		Relation("Replies.Author").
+		Relation("Replies.Files.File").
+		Relation("Replies.References").
+		Relation("Replies.Reactions", orderTaskCommentReactions)
+}
+
 type TaskCommentBunRepository struct {
 	db *bun.DB
 	tx *coredatabase.TransactionBun
@@ -101,6 +338,26 @@ func (r *TaskCommentBunRepository) applyFilters(query *bun.SelectQuery, filters
 		query = query.Where("task_comment.user_author_internal_id = ?", filters.AuthorIdentity.Internal.String())
 	}
 
+	if filters.ParentTaskCommentIdentity != nil {
+		query = query.Where("task_comment.parent_task_comment_internal_id = ?", filters.ParentTaskCommentIdentity.Internal.String())
+	}
+
+	if filters.IsReply != nil {
+		if *filters.IsReply {
+			query = query.Where("task_comment.parent_task_comment_internal_id IS NOT NULL")
+		} else {
+			query = query.Where("task_comment.parent_task_comment_internal_id IS NULL")
+		}
+	}
+
+	if filters.Resolved != nil {
+		if *filters.Resolved {
+			query = query.Where("task_comment.resolved_at IS NOT NULL")
+		} else {
+			query = query.Where("task_comment.resolved_at IS NULL")
+		}
+	}
+
 	if filters.CreatedAt != nil {
 		query = coredatabase.ApplyComparableFilter(query, "task_comment.created_at", filters.CreatedAt)
 	}
@@ -123,10 +380,19 @@ func (r *TaskCommentBunRepository) GetTaskCommentByIdentity(params taskrepo.GetT
 	}
 
 	selectQuery = selectQuery.Model(taskComment)
-	selectQuery = selectQuery.Relation("Author").Relation("Files.File")
-	selectQuery = coredatabase.ApplyRelations(selectQuery, params.RelationsInput)
+	selectQuery = selectQuery.Relation("Author").Relation("Files.File").Relation("References").Relation("Reactions", orderTaskCommentReactions)
+	selectQuery = withTaskCommentReplies(selectQuery)
+	selectQuery, err := coredatabase.ApplyRelations(selectQuery, taskCommentIncludableRelations, params.RelationsInput)
+	if err != nil {
+		return nil, err
+	}
+
 	selectQuery = selectQuery.Where("task_comment.internal_id = ?", params.TaskCommentIdentity.Internal.String())
-	err := selectQuery.Scan(context.Background())
+	if params.TaskIdentity != nil {
+		selectQuery = selectQuery.Where("task_comment.task_internal_id = ?", params.TaskIdentity.Internal.String())
+	}
+
+	err = selectQuery.Scan(context.Background())
 	if err != nil {
 		if err == sql.ErrNoRows {
 			return nil, nil
@@ -153,11 +419,21 @@ func (r *TaskCommentBunRepository) PaginateTaskCommentsBy(params taskrepo.Pagina
 	}
 
 	selectQuery = selectQuery.Model(&taskComments)
-	selectQuery = selectQuery.Relation("Author").Relation("Files.File")
-	selectQuery = coredatabase.ApplyRelations(selectQuery, params.RelationsInput)
+	selectQuery = selectQuery.Relation("Author").Relation("Files.File").Relation("References").Relation("Reactions", orderTaskCommentReactions)
+	if params.IncludeReplies {
+		selectQuery = withTaskCommentReplies(selectQuery)
+	}
+	selectQuery, err := coredatabase.ApplyRelations(selectQuery, taskCommentIncludableRelations, params.RelationsInput)
+	if err != nil {
+		return nil, err
+	}
+
 	selectQuery = r.applyFilters(selectQuery, params.Filters)
 	selectQuery = coredatabase.ApplyPagination(selectQuery, params.Pagination)
-	selectQuery = coredatabase.ApplySort(selectQuery, params.SortInput)
+	selectQuery, err = coredatabase.ApplySort(selectQuery, taskCommentSortableFields, params.SortInput)
+	if err != nil {
+		return nil, err
+	}
 
 	countBeforePagination, err := selectQuery.Count(context.Background())
 	if err != nil {
@@ -191,6 +467,33 @@ func (r *TaskCommentBunRepository) PaginateTaskCommentsBy(params taskrepo.Pagina
 	}, nil
 }
 
+func (r *TaskCommentBunRepository) ListTaskCommentVersionsBy(params taskrepo.ListTaskCommentVersionsByParams) ([]task.TaskCommentVersion, error) {
+	var taskCommentVersions []*TaskCommentVersionTable = make([]*TaskCommentVersionTable, 0)
+	var selectQuery *bun.SelectQuery
+
+	if r.tx != nil && !r.tx.IsClosed() {
+		selectQuery = r.tx.Tx.NewSelect()
+	} else {
+		selectQuery = r.db.NewSelect()
+	}
+
+	err := selectQuery.
+		Model(&taskCommentVersions).
+		Where("task_comment_version.task_comment_internal_id = ?", params.TaskCommentIdentity.Internal.String()).
+		Order("task_comment_version.version ASC").
+		Scan(context.Background())
+	if err != nil && err != sql.ErrNoRows {
+		return nil, err
+	}
+
+	var versions []task.TaskCommentVersion = make([]task.TaskCommentVersion, len(taskCommentVersions))
+	for i, taskCommentVersion := range taskCommentVersions {
+		versions[i] = *taskCommentVersion.ToEntity()
+	}
+
+	return versions, nil
+}
+
 func (r *TaskCommentBunRepository) StoreTaskComment(params taskrepo.StoreTaskCommentParams) (*task.TaskComment, error) {
 	var tx bun.Tx
 	var shouldCommit bool = false
@@ -207,25 +510,7 @@ func (r *TaskCommentBunRepository) StoreTaskComment(params taskrepo.StoreTaskCom
 		}
 	}
 
-	var createdAt *int64 = nil
-	if params.TaskComment.Timestamps.CreatedAt != nil {
-		createdAt = &params.TaskComment.Timestamps.CreatedAt.Value
-	}
-
-	var updatedAt *int64 = nil
-	if params.TaskComment.Timestamps.UpdatedAt != nil {
-		updatedAt = &params.TaskComment.Timestamps.UpdatedAt.Value
-	}
-
-	taskCommentTable := &TaskCommentTable{
-		InternalId:           params.TaskComment.Identity.Internal.String(),
-		PublicId:             params.TaskComment.Identity.Public,
-		Content:              params.TaskComment.Content,
-		TaskInternalId:       params.TaskComment.TaskIdentity.Internal.String(),
-		UserAuthorInternalId: params.TaskComment.Author.Identity.Internal.String(),
-		CreatedAt:            *createdAt,
-		UpdatedAt:            updatedAt,
-	}
+	taskCommentTable := taskCommentToTable(params.TaskComment)
 
 	_, err := tx.NewInsert().Model(taskCommentTable).Exec(context.Background())
 	if err != nil {
@@ -245,6 +530,16 @@ func (r *TaskCommentBunRepository) StoreTaskComment(params taskrepo.StoreTaskCom
 		}
 	}
 
+	err = storeTaskReferences(tx, nil, &taskCommentTable.InternalId, params.TaskComment.References)
+	if err != nil {
+		return nil, err
+	}
+
+	err = storeTaskCommentVersion(tx, params.TaskComment)
+	if err != nil {
+		return nil, err
+	}
+
 	if shouldCommit {
 		err = tx.Commit()
 		if err != nil {
@@ -271,24 +566,7 @@ func (r *TaskCommentBunRepository) UpdateTaskComment(params taskrepo.UpdateTaskC
 		}
 	}
 
-	var createdAt *int64 = nil
-	if params.TaskComment.Timestamps.CreatedAt != nil {
-		createdAt = &params.TaskComment.Timestamps.CreatedAt.Value
-	}
-
-	var updatedAt *int64 = nil
-	if params.TaskComment.Timestamps.UpdatedAt != nil {
-		updatedAt = &params.TaskComment.Timestamps.UpdatedAt.Value
-	}
-
-	taskCommentTable := &TaskCommentTable{
-		InternalId:           params.TaskComment.Identity.Internal.String(),
-		Content:              params.TaskComment.Content,
-		TaskInternalId:       params.TaskComment.TaskIdentity.Internal.String(),
-		UserAuthorInternalId: params.TaskComment.Author.Identity.Internal.String(),
-		CreatedAt:            *createdAt,
-		UpdatedAt:            updatedAt,
-	}
+	taskCommentTable := taskCommentToTable(params.TaskComment)
 
 	_, err := tx.NewUpdate().Model(taskCommentTable).Where("task_comment.internal_id = ?", params.TaskComment.Identity.Internal.String()).Exec(context.Background())
 	if err != nil {
@@ -317,6 +595,16 @@ func (r *TaskCommentBunRepository) UpdateTaskComment(params taskrepo.UpdateTaskC
 		}
 	}
 
+	err = storeTaskReferences(tx, nil, &taskCommentTable.InternalId, params.TaskComment.References)
+	if err != nil {
+		return err
+	}
+
+	err = storeTaskCommentVersion(tx, params.TaskComment)
+	if err != nil {
+		return err
+	}
+
 	if shouldCommit {
 		err = tx.Commit()
 		if err != nil {
@@ -348,6 +636,16 @@ func (r *TaskCommentBunRepository) DeleteTaskComment(params taskrepo.DeleteTaskC
 		return err
 	}
 
+	_, err = tx.NewDelete().Model(&TaskReferenceTable{}).Where("task_reference.task_comment_internal_id = ?", params.TaskCommentIdentity.Internal.String()).Exec(context.Background())
+	if err != nil {
+		return err
+	}
+
+	_, err = tx.NewDelete().Model(&TaskCommentReactionTable{}).Where("task_comment_reaction.task_comment_internal_id = ?", params.TaskCommentIdentity.Internal.String()).Exec(context.Background())
+	if err != nil {
+		return err
+	}
+
 	_, err = tx.NewDelete().Model(&TaskCommentTable{}).Where("task_comment.internal_id = ?", params.TaskCommentIdentity.Internal.String()).Exec(context.Background())
 	if err != nil {
 		return err
@@ -362,3 +660,77 @@ func (r *TaskCommentBunRepository) DeleteTaskComment(params taskrepo.DeleteTaskC
 
 	return nil
 }
+
+func (r *TaskCommentBunRepository) AddTaskCommentReaction(params taskrepo.AddTaskCommentReactionParams) error {
+	var tx bun.Tx
+	var shouldCommit bool = false
+
+	if r.tx != nil && !r.tx.IsClosed() {
+		tx = *r.tx.Tx
+	} else {
+		var err error
+		tx, err = r.db.BeginTx(context.Background(), nil)
+		shouldCommit = true
+
+		if err != nil {
+			return err
+		}
+	}
+
+	taskCommentReactionTable := &TaskCommentReactionTable{
+		TaskCommentInternalId: params.TaskCommentIdentity.Internal.String(),
+		UserInternalId:        params.Reaction.UserIdentity.Internal.String(),
+		Emoji:                 params.Reaction.Emoji,
+		CreatedAt:             params.Reaction.CreatedAt.Value,
+	}
+
+	_, err := tx.NewInsert().Model(taskCommentReactionTable).On("CONFLICT DO NOTHING").Exec(context.Background())
+	if err != nil {
+		return err
+	}
+
+	if shouldCommit {
+		err = tx.Commit()
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (r *TaskCommentBunRepository) RemoveTaskCommentReaction(params taskrepo.RemoveTaskCommentReactionParams) error {
+	var tx bun.Tx
+	var shouldCommit bool = false
+
+	if r.tx != nil && !r.tx.IsClosed() {
+		tx = *r.tx.Tx
+	} else {
+		var err error
+		tx, err = r.db.BeginTx(context.Background(), nil)
+		shouldCommit = true
+
+		if err != nil {
+			return err
+		}
+	}
+
+	_, err := tx.NewDelete().
+		Model(&TaskCommentReactionTable{}).
+		Where("task_comment_reaction.task_comment_internal_id = ?", params.TaskCommentIdentity.Internal.String()).
+		Where("task_comment_reaction.user_internal_id = ?", params.UserIdentity.Internal.String()).
+		Where("task_comment_reaction.emoji = ?", params.Emoji).
+		Exec(context.Background())
+	if err != nil {
+		return err
+	}
+
+	if shouldCommit {
+		err = tx.Commit()
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
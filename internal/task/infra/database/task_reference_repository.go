@@ -0,0 +1,239 @@
+package taskdatabase
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/gabrielmrtt/taski/internal/core"
+	coredatabase "github.com/gabrielmrtt/taski/internal/core/database"
+	"github.com/gabrielmrtt/taski/internal/project"
+	"github.com/gabrielmrtt/taski/internal/task"
+	taskrepo "github.com/gabrielmrtt/taski/internal/task/repository"
+	"github.com/gabrielmrtt/taski/internal/user"
+	"github.com/google/uuid"
+	"github.com/uptrace/bun"
+)
+
+/*
+TaskReferenceTable stores the references of a task description, with TaskInternalId set, and the references of a task
+comment, with TaskCommentInternalId set.
+*/
+type TaskReferenceTable struct {
+	bun.BaseModel `bun:"table:task_reference,alias:task_reference"`
+
+	InternalId               string  `bun:"internal_id,pk,notnull,type:uuid"`
+	Type                     string  `bun:"type,notnull,type:varchar(100)"`
+	TaskInternalId           *string `bun:"task_internal_id,type:uuid"`
+	TaskCommentInternalId    *string `bun:"task_comment_internal_id,type:uuid"`
+	UserInternalId           *string `bun:"user_internal_id,type:uuid"`
+	ReferencedTaskInternalId *string `bun:"referenced_task_internal_id,type:uuid"`
+	UserAuthorInternalId     string  `bun:"user_author_internal_id,notnull,type:uuid"`
+	CreatedAt                int64   `bun:"created_at,notnull,type:bigint"`
+}
+
+func (t *TaskReferenceTable) ToEntity() *task.TaskReference {
+	var userIdentity *core.Identity = nil
+	if t.UserInternalId != nil {
+		identity := core.NewIdentityFromInternal(uuid.MustParse(*t.UserInternalId), user.UserIdentityPrefix)
+		userIdentity = &identity
+	}
+
+	var taskIdentity *core.Identity = nil
+	if t.ReferencedTaskInternalId != nil {
+		identity := core.NewIdentityFromInternal(uuid.MustParse(*t.ReferencedTaskInternalId), task.TaskIdentityPrefix)
+		taskIdentity = &identity
+	}
+
+	return &task.TaskReference{
+		Identity:           core.NewIdentityWithoutPublicFromInternal(uuid.MustParse(t.InternalId)),
+		Type:               task.TaskReferenceTypes(t.Type),
+		UserIdentity:       userIdentity,
+		TaskIdentity:       taskIdentity,
+		UserAuthorIdentity: core.NewIdentityFromInternal(uuid.MustParse(t.UserAuthorInternalId), user.UserIdentityPrefix),
+		CreatedAt:          core.DateTime{Value: t.CreatedAt},
+	}
+}
+
+func taskReferencesToEntities(rows []*TaskReferenceTable) []task.TaskReference {
+	var references []task.TaskReference = make([]task.TaskReference, 0)
+	for _, row := range rows {
+		references = append(references, *row.ToEntity())
+	}
+
+	return references
+}
+
+/*
+storeTaskReferences replaces the references stored for a task description, when taskCommentInternalId is nil, or for
+a task comment.
+*/
+func storeTaskReferences(tx bun.Tx, taskInternalId *string, taskCommentInternalId *string, references []task.TaskReference) error {
+	deleteQuery := tx.NewDelete().Model(&TaskReferenceTable{})
+	if taskCommentInternalId != nil {
+		deleteQuery = deleteQuery.Where("task_reference.task_comment_internal_id = ?", *taskCommentInternalId)
+	} else {
+		deleteQuery = deleteQuery.Where("task_reference.task_internal_id = ?", *taskInternalId)
+	}
+
+	_, err := deleteQuery.Exec(context.Background())
+	if err != nil {
+		return err
+	}
+
+	var rows []*TaskReferenceTable = make([]*TaskReferenceTable, 0)
+	for _, reference := range references {
+		row := &TaskReferenceTable{
+			InternalId:           reference.Identity.Internal.String(),
+			Type:                 string(reference.Type),
+			UserAuthorInternalId: reference.UserAuthorIdentity.Internal.String(),
+			CreatedAt:            reference.CreatedAt.Value,
+		}
+
+		if taskCommentInternalId != nil {
+			row.TaskCommentInternalId = taskCommentInternalId
+		} else {
+			row.TaskInternalId = taskInternalId
+		}
+
+		if reference.UserIdentity != nil {
+			userInternalId := reference.UserIdentity.Internal.String()
+			row.UserInternalId = &userInternalId
+		}
+
+		if reference.TaskIdentity != nil {
+			referencedTaskInternalId := reference.TaskIdentity.Internal.String()
+			row.ReferencedTaskInternalId = &referencedTaskInternalId
+		}
+
+		rows = append(rows, row)
+	}
+
+	if len(rows) == 0 {
+		return nil
+	}
+
+	_, err = tx.NewInsert().Model(&rows).Exec(context.Background())
+	return err
+}
+
+type taskMentionTable struct {
+	TaskReferenceTable
+
+	MentionTaskInternalId    string `bun:"mention_task_internal_id"`
+	MentionTaskName          string `bun:"mention_task_name"`
+	MentionProjectInternalId string `bun:"mention_project_internal_id"`
+}
+
+func (t *taskMentionTable) ToEntity() *task.TaskMention {
+	var taskCommentIdentity *core.Identity = nil
+	if t.TaskCommentInternalId != nil {
+		identity := core.NewIdentityFromInternal(uuid.MustParse(*t.TaskCommentInternalId), task.TaskCommentIdentityPrefix)
+		taskCommentIdentity = &identity
+	}
+
+	return &task.TaskMention{
+		Reference:           *t.TaskReferenceTable.ToEntity(),
+		TaskIdentity:        core.NewIdentityFromInternal(uuid.MustParse(t.MentionTaskInternalId), task.TaskIdentityPrefix),
+		TaskName:            t.MentionTaskName,
+		ProjectIdentity:     core.NewIdentityFromInternal(uuid.MustParse(t.MentionProjectInternalId), project.ProjectIdentityPrefix),
+		TaskCommentIdentity: taskCommentIdentity,
+	}
+}
+
+type TaskMentionBunRepository struct {
+	db *bun.DB
+	tx *coredatabase.TransactionBun
+}
+
+func NewTaskMentionBunRepository(connection *bun.DB) *TaskMentionBunRepository {
+	return &TaskMentionBunRepository{db: connection, tx: nil}
+}
+
+func (r *TaskMentionBunRepository) SetTransaction(tx core.Transaction) error {
+	if r.tx != nil && !r.tx.IsClosed() {
+		return nil
+	}
+
+	r.tx = tx.(*coredatabase.TransactionBun)
+	return nil
+}
+
+/*
+PaginateTaskMentionsBy lists the mentions of a user, newest first. Mentions in deleted tasks and comments, and in
+projects the user is no longer an active member of, are left out.
+*/
+func (r *TaskMentionBunRepository) PaginateTaskMentionsBy(params taskrepo.PaginateTaskMentionsParams) (*core.PaginationOutput[task.TaskMention], error) {
+	var rows []taskMentionTable = make([]taskMentionTable, 0)
+	var selectQuery *bun.SelectQuery
+	var perPage int = 10
+	var page int = 1
+
+	if params.Pagination.PerPage != nil {
+		perPage = *params.Pagination.PerPage
+	}
+
+	if params.Pagination.Page != nil {
+		page = *params.Pagination.Page
+	}
+
+	if r.tx != nil && !r.tx.IsClosed() {
+		selectQuery = r.tx.Tx.NewSelect()
+	} else {
+		selectQuery = r.db.NewSelect()
+	}
+
+	selectQuery = selectQuery.
+		TableExpr("task_reference").
+		ColumnExpr("task_reference.*").
+		ColumnExpr("task.internal_id AS mention_task_internal_id").
+		ColumnExpr("task.name AS mention_task_name").
+		ColumnExpr("task.project_internal_id AS mention_project_internal_id").
+		Join("LEFT JOIN task_comment ON task_comment.internal_id = task_reference.task_comment_internal_id").
+		Join("INNER JOIN task ON task.internal_id = COALESCE(task_reference.task_internal_id, task_comment.task_internal_id)").
+		Where("task_reference.type = ?", task.TaskReferenceTypeUserMention).
+		Where("task_reference.user_internal_id = ?", params.Filters.UserIdentity.Internal.String()).
+		Where("task.deleted_at IS NULL").
+		Where("task_comment.deleted_at IS NULL").
+		Where(`
+			task.project_internal_id IN (
+				SELECT project.internal_id FROM project
+				WHERE project.workspace_internal_id IN (
+					SELECT workspace.internal_id FROM workspace
+					WHERE workspace.organization_internal_id = ?
+				)
+			)`, params.Filters.OrganizationIdentity.Internal.String()).
+		Where(`
+			task.project_internal_id IN (
+				SELECT project_user.project_internal_id FROM project_user
+				WHERE project_user.user_internal_id = ? AND project_user.status = ?
+			)`, params.Filters.UserIdentity.Internal.String(), project.ProjectUserStatusActive)
+
+	if params.Filters.ProjectIdentity != nil {
+		selectQuery = selectQuery.Where("task.project_internal_id = ?", params.Filters.ProjectIdentity.Internal.String())
+	}
+
+	selectQuery = coredatabase.ApplyPagination(selectQuery, params.Pagination)
+	selectQuery = selectQuery.OrderExpr("task_reference.created_at DESC, task_reference.internal_id DESC")
+
+	countBeforePagination, err := selectQuery.Count(context.Background())
+	if err != nil {
+		return nil, err
+	}
+
+	err = selectQuery.Scan(context.Background(), &rows)
+	if err != nil && err != sql.ErrNoRows {
+		return nil, err
+	}
+
+	var taskMentions []task.TaskMention = make([]task.TaskMention, 0)
+	for _, row := range rows {
+		taskMentions = append(taskMentions, *row.ToEntity())
+	}
+
+	return &core.PaginationOutput[task.TaskMention]{
+		Data:    taskMentions,
+		Page:    page,
+		HasMore: core.HasMorePages(page, countBeforePagination, perPage),
+		Total:   countBeforePagination,
+	}, nil
+}
@@ -57,18 +57,124 @@ func (s *SubTaskTable) ToEntity() *task.SubTask {
 	}
 }
 
+type TaskCustomFieldValueTable struct {
+	bun.BaseModel `bun:"table:task_custom_field_value,alias:task_custom_field_value"`
+
+	InternalId                       string   `bun:"internal_id,pk,notnull,type:uuid"`
+	TaskInternalId                   string   `bun:"task_internal_id,notnull,type:uuid"`
+	ProjectTaskCustomFieldInternalId string   `bun:"project_task_custom_field_internal_id,notnull,type:uuid"`
+	TextValue                        *string  `bun:"text_value,type:text"`
+	NumberValue                      *float64 `bun:"number_value,type:double precision"`
+	DateValue                        *int64   `bun:"date_value,type:bigint"`
+	UserInternalId                   *string  `bun:"user_internal_id,type:uuid"`
+
+	Task                   *TaskTable                                   `bun:"rel:has-one,join:task_internal_id=internal_id"`
+	ProjectTaskCustomField *projectdatabase.ProjectTaskCustomFieldTable `bun:"rel:has-one,join:project_task_custom_field_internal_id=internal_id"`
+}
+
+/*
+taskCustomFieldValuesToEntities groups the value rows by custom field, as multi select values are stored one option per row.
+*/
+func taskCustomFieldValuesToEntities(rows []*TaskCustomFieldValueTable) []*task.TaskCustomFieldValue {
+	var customFieldValues []*task.TaskCustomFieldValue = make([]*task.TaskCustomFieldValue, 0)
+	var customFieldValuesByField map[string]*task.TaskCustomFieldValue = make(map[string]*task.TaskCustomFieldValue)
+
+	for _, row := range rows {
+		if row.ProjectTaskCustomField == nil || row.ProjectTaskCustomField.DeletedAt != nil {
+			continue
+		}
+
+		customFieldValue, ok := customFieldValuesByField[row.ProjectTaskCustomFieldInternalId]
+		if !ok {
+			customFieldValue = &task.TaskCustomFieldValue{
+				CustomField: row.ProjectTaskCustomField.ToEntity(),
+				Options:     []string{},
+			}
+			customFieldValuesByField[row.ProjectTaskCustomFieldInternalId] = customFieldValue
+			customFieldValues = append(customFieldValues, customFieldValue)
+		}
+
+		switch customFieldValue.CustomField.Type {
+		case project.ProjectTaskCustomFieldTypeSingleSelect, project.ProjectTaskCustomFieldTypeMultiSelect:
+			if row.TextValue != nil {
+				customFieldValue.Options = append(customFieldValue.Options, *row.TextValue)
+			}
+		default:
+			customFieldValue.Text = row.TextValue
+			customFieldValue.Number = row.NumberValue
+
+			if row.DateValue != nil {
+				customFieldValue.Date = &core.DateTime{Value: *row.DateValue}
+			}
+
+			if row.UserInternalId != nil {
+				identity := core.NewIdentityFromInternal(uuid.MustParse(*row.UserInternalId), user.UserIdentityPrefix)
+				customFieldValue.UserIdentity = &identity
+			}
+		}
+	}
+
+	return customFieldValues
+}
+
+/*
+taskCustomFieldValuesToTables maps the custom field values of a task to rows, one per option for select fields.
+*/
+func taskCustomFieldValuesToTables(tsk *task.Task) []*TaskCustomFieldValueTable {
+	var rows []*TaskCustomFieldValueTable = make([]*TaskCustomFieldValueTable, 0)
+
+	for _, customFieldValue := range tsk.CustomFieldValues {
+		row := TaskCustomFieldValueTable{
+			TaskInternalId:                   tsk.Identity.Internal.String(),
+			ProjectTaskCustomFieldInternalId: customFieldValue.CustomField.Identity.Internal.String(),
+		}
+
+		if customFieldValue.CustomField.IsSelect() {
+			for _, option := range customFieldValue.Options {
+				optionRow := row
+				optionRow.InternalId = uuid.New().String()
+				optionRow.TextValue = &option
+				rows = append(rows, &optionRow)
+			}
+
+			continue
+		}
+
+		row.InternalId = uuid.New().String()
+		row.TextValue = customFieldValue.Text
+		row.NumberValue = customFieldValue.Number
+
+		if customFieldValue.Date != nil {
+			row.DateValue = &customFieldValue.Date.Value
+		}
+
+		if customFieldValue.UserIdentity != nil {
+			userInternalId := customFieldValue.UserIdentity.Internal.String()
+			row.UserInternalId = &userInternalId
+		}
+
+		rows = append(rows, &row)
+	}
+
+	return rows
+}
+
 type TaskTable struct {
 	bun.BaseModel `bun:"table:task,alias:task"`
 
 	InternalId                    string  `bun:"internal_id,pk,notnull,type:uuid"`
 	PublicId                      string  `bun:"public_id,notnull,type:varchar(510)"`
+	ExternalId                    *string `bun:"external_id,type:varchar(255)"`
 	Name                          string  `bun:"name,notnull,type:varchar(255)"`
 	Description                   string  `bun:"description,type:varchar(510)"`
+	DescriptionFormat             string  `bun:"description_format,notnull,type:varchar(50)"`
+	DescriptionText               string  `bun:"description_text,notnull,type:text"`
 	EstimatedMinutes              int16   `bun:"estimated_minutes,notnull,type:int16"`
 	PriorityLevel                 int8    `bun:"priority_level,notnull,type:int8"`
 	DueDate                       *int64  `bun:"due_date,type:bigint"`
 	CompletedAt                   *int64  `bun:"completed_at,type:bigint"`
 	Type                          string  `bun:"type,notnull,type:varchar(100)"`
+	Rank                          string  `bun:"rank,notnull,type:varchar(255)"`
 	ProjectTaskStatusInternalId   *string `bun:"project_task_status_internal_id,type:uuid"`
 	ProjectTaskCategoryInternalId *string `bun:"project_task_category_internal_id,type:uuid"`
 	ParentTaskInternalId          *string `bun:"parent_task_internal_id,type:uuid"`
@@ -79,6 +185,7 @@ type TaskTable struct {
 	CreatedAt                     int64   `bun:"created_at,notnull,type:bigint"`
 	UpdatedAt                     *int64  `bun:"updated_at,type:bigint"`
 	DeletedAt                     *int64  `bun:"deleted_at,type:bigint"`
+	Revision                      int64   `bun:"revision,notnull,type:bigint"`
 
 	ProjectTaskStatus   *projectdatabase.ProjectTaskStatusTable   `bun:"rel:has-one,join:project_task_status_internal_id=internal_id"`
 	ProjectTaskCategory *projectdatabase.ProjectTaskCategoryTable `bun:"rel:has-one,join:project_task_category_internal_id=internal_id"`
@@ -86,6 +193,8 @@ type TaskTable struct {
 	ChildrenTasks       []*TaskTable                              `bun:"rel:has-many,join:internal_id=parent_task_internal_id"`
 	Users               []*TaskUserTable                          `bun:"rel:has-many,join:internal_id=task_internal_id"`
 	SubTasks            []*SubTaskTable                           `bun:"rel:has-many,join:internal_id=task_internal_id"`
+	CustomFieldValues   []*TaskCustomFieldValueTable              `bun:"rel:has-many,join:internal_id=task_internal_id"`
+	References          []*TaskReferenceTable                     `bun:"rel:has-many,join:internal_id=task_internal_id"`
 	Project             *projectdatabase.ProjectTable             `bun:"rel:has-one,join:project_internal_id=internal_id"`
 	UserCompleted       *userdatabase.UserTable                   `bun:"rel:has-one,join:user_completed_internal_id=internal_id"`
 	UserCreator         *userdatabase.UserTable                   `bun:"rel:has-one,join:user_creator_internal_id=internal_id"`
@@ -174,8 +283,11 @@ func (t *TaskTable) ToEntity() *task.Task {
 		Category:                projectTaskCategory,
 		ParentTaskIdentity:      parentTaskIdentity,
 		Type:                    task.TaskType(t.Type),
+		Rank:                    t.Rank,
+		ExternalId:              t.ExternalId,
 		Name:                    t.Name,
 		Description:             t.Description,
+		DescriptionFormat:       core.ContentFormats(t.DescriptionFormat),
 		EstimatedMinutes:        &t.EstimatedMinutes,
 		PriorityLevel:           task.TaskPriorityLevels(t.PriorityLevel),
 		DueDate:                 dueDate,
@@ -183,6 +295,8 @@ func (t *TaskTable) ToEntity() *task.Task {
 		SubTasks:                subTasks,
 		ChildrenTasks:           childrenTasks,
 		Users:                   users,
+		CustomFieldValues:       taskCustomFieldValuesToEntities(t.CustomFieldValues),
+		References:              taskReferencesToEntities(t.References),
 		UserCompletedByIdentity: userCompletedIdentity,
 		UserCreatorIdentity:     userCreatorIdentity,
 		UserEditorIdentity:      userEditorIdentity,
@@ -191,9 +305,57 @@ func (t *TaskTable) ToEntity() *task.Task {
 			UpdatedAt: updatedAt,
 		},
 		DeletedAt: deletedAt,
+		Revision:  t.Revision,
 	}
 }
 
+var taskSortableFields = coredatabase.SortableFields{
+	Fields: map[string]string{
+		"name":             "task.name",
+		"rank":             "task.rank",
+		"priority":         "task.priority_level",
+		"estimatedMinutes": "task.estimated_minutes",
+		"dueDate":          "task.due_date",
+		"completedAt":      "task.completed_at",
+		"createdAt":        "task.created_at",
+		"updatedAt":        "task.updated_at",
+	},
+	IdColumn: "task.internal_id",
+}
+
+var taskFilterableColumns = coredatabase.MustFilterableColumns(task.TaskFilterableFields, map[string]string{
+	"name":             "task.name",
+	"type":             "task.type",
+	"priority":         "task.priority_level",
+	"estimatedMinutes": "task.estimated_minutes",
+	"project":          "task.project_internal_id",
+	"status":           "task.project_task_status_internal_id",
+	"category":         "task.project_task_category_internal_id",
+	"parentTask":       "task.parent_task_internal_id",
+	"due":              "task.due_date",
+	"completedAt":      "task.completed_at",
+	"createdAt":        "task.created_at",
+	"updatedAt":        "task.updated_at",
+})
+
+var taskIncludableRelations = coredatabase.IncludableRelations{
+	Relations: []string{
+		"Project",
+		"ParentTask",
+		"ChildrenTasks",
+		"ProjectTaskStatus",
+		"ProjectTaskCategory",
+		"SubTasks",
+		"Users.User",
+		"CustomFieldValues.ProjectTaskCustomField",
+		"References",
+		"UserCompleted",
+		"UserCreator",
+		"UserEditor",
+	},
+	MaxDepth: 2,
+}
+
 type TaskBunRepository struct {
 	db *bun.DB
 	tx *coredatabase.TransactionBun
@@ -212,6 +374,15 @@ func (r *TaskBunRepository) SetTransaction(tx core.Transaction) error {
 	return nil
 }
 
+// newSelect starts a query inside the repository transaction when there is one
+func (r *TaskBunRepository) newSelect() *bun.SelectQuery {
+	if r.tx != nil && !r.tx.IsClosed() {
+		return r.tx.Tx.NewSelect()
+	}
+
+	return r.db.NewSelect()
+}
+
 func (r *TaskBunRepository) applyFilters(selectQuery *bun.SelectQuery, filters taskrepo.TaskFilters) *bun.SelectQuery {
 	if filters.OrganizationIdentity != nil {
 		args := []interface{}{filters.OrganizationIdentity.Internal.String()}
@@ -279,9 +450,136 @@ func (r *TaskBunRepository) applyFilters(selectQuery *bun.SelectQuery, filters t
 		selectQuery = coredatabase.ApplyComparableFilter(selectQuery, "task.priority_level", filters.Priority)
 	}
 
+	if filters.Expression != nil {
+		selectQuery = coredatabase.ApplyFilterExpression(selectQuery, filters.Expression, taskFilterableColumns)
+	}
+
+	for _, customFieldFilter := range filters.CustomFields {
+		customFieldQuery := r.newSelect().
+			TableExpr("task_custom_field_value").
+			Column("task_custom_field_value.task_internal_id").
+			Where("task_custom_field_value.project_task_custom_field_internal_id = ?", customFieldFilter.CustomFieldIdentity.Internal.String())
+
+		if customFieldFilter.Text != nil {
+			customFieldQuery = coredatabase.ApplyComparableFilter(customFieldQuery, "task_custom_field_value.text_value", customFieldFilter.Text)
+		}
+
+		if customFieldFilter.Number != nil {
+			customFieldQuery = coredatabase.ApplyComparableFilter(customFieldQuery, "task_custom_field_value.number_value", customFieldFilter.Number)
+		}
+
+		if customFieldFilter.Date != nil {
+			customFieldQuery = coredatabase.ApplyComparableFilter(customFieldQuery, "task_custom_field_value.date_value", customFieldFilter.Date)
+		}
+
+		if customFieldFilter.User != nil {
+			customFieldQuery = coredatabase.ApplyComparableFilter(customFieldQuery, "task_custom_field_value.user_internal_id", customFieldFilter.User)
+		}
+
+		selectQuery = selectQuery.Where("task.internal_id IN (?)", customFieldQuery)
+	}
+
 	return selectQuery
 }
 
+func (r *TaskBunRepository) applyCustomFieldSort(selectQuery *bun.SelectQuery, sortInput *taskrepo.TaskCustomFieldSortInput) *bun.SelectQuery {
+	if sortInput == nil {
+		return selectQuery
+	}
+
+	var valueExpression string
+	switch sortInput.CustomFieldType {
+	case project.ProjectTaskCustomFieldTypeNumber:
+		valueExpression = "task_custom_field_value.number_value"
+	case project.ProjectTaskCustomFieldTypeDate:
+		valueExpression = "task_custom_field_value.date_value"
+	case project.ProjectTaskCustomFieldTypeUser:
+		valueExpression = "(SELECT user_data.display_name FROM user_data WHERE user_data.user_internal_id = task_custom_field_value.user_internal_id)"
+	default:
+		valueExpression = "task_custom_field_value.text_value"
+	}
+
+	var direction string = "ASC"
+	if sortInput.Direction == core.SortDirectionDesc {
+		direction = "DESC"
+	}
+
+	return selectQuery.OrderExpr(`(
+		SELECT MIN(`+valueExpression+`) FROM task_custom_field_value
+		WHERE task_custom_field_value.task_internal_id = task.internal_id
+		AND task_custom_field_value.project_task_custom_field_internal_id = ?
+	) `+direction+` NULLS LAST`, sortInput.CustomFieldIdentity.Internal.String())
+}
+
+/*
+groupByColumns returns the columns tasks are ordered by first so that tasks of the same group are returned together.
+*/
+func (r *TaskBunRepository) groupByColumns(groupBy *task.TaskGroupByFields) []coredatabase.SortColumn {
+	if groupBy == nil {
+		return []coredatabase.SortColumn{}
+	}
+
+	switch *groupBy {
+	case task.TaskGroupByStatus:
+		return []coredatabase.SortColumn{
+			{Key: "group:status", Column: "(SELECT project_task_status.status_order FROM project_task_status WHERE project_task_status.internal_id = task.project_task_status_internal_id)", Direction: core.SortDirectionAsc},
+			{Key: "group:statusId", Column: "task.project_task_status_internal_id", Direction: core.SortDirectionAsc},
+		}
+	case task.TaskGroupByCategory:
+		return []coredatabase.SortColumn{
+			{Key: "group:category", Column: "task.project_task_category_internal_id", Direction: core.SortDirectionAsc},
+		}
+	case task.TaskGroupByPriority:
+		return []coredatabase.SortColumn{
+			{Key: "group:priority", Column: "task.priority_level", Direction: core.SortDirectionDesc},
+		}
+	}
+
+	return []coredatabase.SortColumn{}
+}
+
+func (r *TaskBunRepository) storeCustomFieldValues(tx bun.Tx, tsk *task.Task) error {
+	_, err := tx.NewDelete().Model(&TaskCustomFieldValueTable{}).Where("task_custom_field_value.task_internal_id = ?", tsk.Identity.Internal.String()).Exec(context.Background())
+	if err != nil {
+		return err
+	}
+
+	rows := taskCustomFieldValuesToTables(tsk)
+	if len(rows) == 0 {
+		return nil
+	}
+
+	_, err = tx.NewInsert().Model(&rows).Exec(context.Background())
+	return err
+}
+
+func (r *TaskBunRepository) storeUsers(tx bun.Tx, tsk *task.Task) error {
+	_, err := tx.NewDelete().Model(&TaskUserTable{}).Where("task_user.task_internal_id = ?", tsk.Identity.Internal.String()).Exec(context.Background())
+	if err != nil {
+		return err
+	}
+
+	var rows []*TaskUserTable = make([]*TaskUserTable, 0)
+	for _, taskUser := range tsk.Users {
+		rows = append(rows, &TaskUserTable{
+			TaskInternalId: tsk.Identity.Internal.String(),
+			UserInternalId: taskUser.User.Identity.Internal.String(),
+		})
+	}
+
+	if len(rows) == 0 {
+		return nil
+	}
+
+	_, err = tx.NewInsert().Model(&rows).Exec(context.Background())
+	return err
+}
+
+func (r *TaskBunRepository) storeReferences(tx bun.Tx, tsk *task.Task) error {
+	taskInternalId := tsk.Identity.Internal.String()
+	return storeTaskReferences(tx, &taskInternalId, nil, tsk.References)
+}
+
 func (r *TaskBunRepository) GetTaskByIdentity(params taskrepo.GetTaskByIdentityParams) (*task.Task, error) {
 	var task *TaskTable = new(TaskTable)
 	var selectQuery *bun.SelectQuery
@@ -293,8 +591,12 @@ func (r *TaskBunRepository) GetTaskByIdentity(params taskrepo.GetTaskByIdentityP
 	}
 
 	selectQuery = selectQuery.Model(task)
-	selectQuery = selectQuery.Relation("ProjectTaskStatus").Relation("ProjectTaskCategory").Relation("SubTasks")
-	selectQuery = coredatabase.ApplyRelations(selectQuery, params.RelationsInput)
+	selectQuery = selectQuery.Relation("ProjectTaskStatus").Relation("ProjectTaskCategory").Relation("SubTasks").Relation("Users.User").Relation("CustomFieldValues.ProjectTaskCustomField").Relation("References")
+	selectQuery, err := coredatabase.ApplyRelations(selectQuery, taskIncludableRelations, params.RelationsInput)
+	if err != nil {
+		return nil, err
+	}
+
 	selectQuery = selectQuery.Where("task.internal_id = ?", params.TaskIdentity.Internal.String())
 
 	if params.OrganizationIdentity != nil {
@@ -312,7 +614,43 @@ func (r *TaskBunRepository) GetTaskByIdentity(params taskrepo.GetTaskByIdentityP
 		selectQuery = selectQuery.Where("task.project_internal_id = ?", params.ProjectIdentity.Internal.String())
 	}
 
-	err := selectQuery.Scan(context.Background())
+	err = selectQuery.Scan(context.Background())
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil
+		}
+
+		return nil, err
+	}
+
+	if task.InternalId == "" {
+		return nil, nil
+	}
+
+	return task.ToEntity(), nil
+}
+
+func (r *TaskBunRepository) GetTaskByExternalId(params taskrepo.GetTaskByExternalIdParams) (*task.Task, error) {
+	var task *TaskTable = new(TaskTable)
+	var selectQuery *bun.SelectQuery
+
+	if r.tx != nil && !r.tx.IsClosed() {
+		selectQuery = r.tx.Tx.NewSelect()
+	} else {
+		selectQuery = r.db.NewSelect()
+	}
+
+	selectQuery = selectQuery.Model(task)
+	selectQuery = selectQuery.Relation("ProjectTaskStatus").Relation("ProjectTaskCategory").Relation("SubTasks").Relation("Users.User").Relation("CustomFieldValues.ProjectTaskCustomField").Relation("References")
+	selectQuery, err := coredatabase.ApplyRelations(selectQuery, taskIncludableRelations, params.RelationsInput)
+	if err != nil {
+		return nil, err
+	}
+
+	selectQuery = selectQuery.Where("task.project_internal_id = ?", params.ProjectIdentity.Internal.String())
+	selectQuery = selectQuery.Where("task.external_id = ?", params.ExternalId)
+
+	err = selectQuery.Scan(context.Background())
 	if err != nil {
 		if err == sql.ErrNoRows {
 			return nil, nil
@@ -331,17 +669,23 @@ func (r *TaskBunRepository) GetTaskByIdentity(params taskrepo.GetTaskByIdentityP
 func (r *TaskBunRepository) PaginateTasksBy(params taskrepo.PaginateTasksParams) (*core.PaginationOutput[task.Task], error) {
 	var tasks []*TaskTable = make([]*TaskTable, 0)
 	var selectQuery *bun.SelectQuery
-	var perPage int = 10
-	var page int = 1
 
-	if params.Pagination.PerPage != nil {
-		perPage = *params.Pagination.PerPage
+	if params.Pagination.IsCursor() && params.CustomFieldSortInput != nil {
+		return nil, core.NewInvalidInputError("invalid pagination", []core.InvalidInputErrorField{
+			{
+				Field: "sortBy",
+				Error: "cursor pagination cannot be used while sorting by a custom field",
+			},
+		})
 	}
 
-	if params.Pagination.Page != nil {
-		page = *params.Pagination.Page
+	sortColumns, err := coredatabase.ResolveSort(taskSortableFields, params.SortInput)
+	if err != nil {
+		return nil, err
 	}
 
+	sortColumns = append(r.groupByColumns(params.GroupBy), sortColumns...)
+
 	if r.tx != nil && !r.tx.IsClosed() {
 		selectQuery = r.tx.Tx.NewSelect()
 	} else {
@@ -349,34 +693,114 @@ func (r *TaskBunRepository) PaginateTasksBy(params taskrepo.PaginateTasksParams)
 	}
 
 	selectQuery = selectQuery.Model(&tasks)
-	selectQuery = selectQuery.Relation("ProjectTaskStatus").Relation("ProjectTaskCategory").Relation("SubTasks")
-	selectQuery = coredatabase.ApplyRelations(selectQuery, params.RelationsInput)
-	selectQuery = r.applyFilters(selectQuery, params.Filters)
-	countBeforePagination, err := selectQuery.Count(context.Background())
+	selectQuery = selectQuery.Relation("ProjectTaskStatus").Relation("ProjectTaskCategory").Relation("SubTasks").Relation("Users.User").Relation("CustomFieldValues.ProjectTaskCustomField").Relation("References")
+	selectQuery, err = coredatabase.ApplyRelations(selectQuery, taskIncludableRelations, params.RelationsInput)
 	if err != nil {
 		return nil, err
 	}
 
-	selectQuery = coredatabase.ApplySort(selectQuery, params.SortInput)
-	selectQuery = coredatabase.ApplyPagination(selectQuery, params.Pagination)
-	err = selectQuery.Scan(context.Background())
-	if err != nil {
-		if err == sql.ErrNoRows {
-			return nil, nil
+	selectQuery = r.applyFilters(selectQuery, params.Filters)
+
+	if !params.ShowDeleted {
+		selectQuery = selectQuery.Where("task.deleted_at IS NULL")
+	}
+
+	var countBeforePagination int = 0
+	if params.Pagination.ShouldCount() {
+		countBeforePagination, err = selectQuery.Count(context.Background())
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	var cursorPagination *coredatabase.CursorPagination = nil
+	if params.Pagination.IsCursor() {
+		selectQuery, cursorPagination, err = coredatabase.ApplyCursorPagination(selectQuery, taskSortableFields, sortColumns, params.Pagination)
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		if len(sortColumns) > 0 && params.CustomFieldSortInput != nil {
+			selectQuery = coredatabase.ApplySortColumns(selectQuery, sortColumns[:len(sortColumns)-1])
+			selectQuery = r.applyCustomFieldSort(selectQuery, params.CustomFieldSortInput)
+			selectQuery = coredatabase.ApplySortColumns(selectQuery, sortColumns[len(sortColumns)-1:])
+		} else {
+			selectQuery = coredatabase.ApplySortColumns(selectQuery, sortColumns)
 		}
+
+		selectQuery = coredatabase.ApplyOffsetPagination(selectQuery, params.Pagination)
+	}
+
+	err = selectQuery.Scan(context.Background())
+	if err != nil && err != sql.ErrNoRows {
+		return nil, err
 	}
 
 	var taskEntities []task.Task = make([]task.Task, 0)
+	var taskPublicIds []string = make([]string, 0)
 	for _, task := range tasks {
 		taskEntities = append(taskEntities, *task.ToEntity())
+		taskPublicIds = append(taskPublicIds, task.PublicId)
+	}
+
+	if cursorPagination != nil {
+		return coredatabase.NewCursorPaginationOutput(taskEntities, taskPublicIds, cursorPagination, countBeforePagination), nil
+	}
+
+	return coredatabase.NewOffsetPaginationOutput(taskEntities, params.Pagination, countBeforePagination), nil
+}
+
+/*
+GetAdjacentTaskRank returns the closest rank after (asc) or before (desc) the given rank among the tasks of a status.
+Without a rank it returns the first (asc) or last (desc) rank of the status. An empty string means there is none.
+*/
+func (r *TaskBunRepository) GetAdjacentTaskRank(params taskrepo.GetAdjacentTaskRankParams) (string, error) {
+	var ranks []string = make([]string, 0)
+	var selectQuery *bun.SelectQuery
+
+	if r.tx != nil && !r.tx.IsClosed() {
+		selectQuery = r.tx.Tx.NewSelect()
+	} else {
+		selectQuery = r.db.NewSelect()
+	}
+
+	selectQuery = selectQuery.Model((*TaskTable)(nil)).Column("task.rank")
+	selectQuery = selectQuery.Where("task.project_internal_id = ?", params.ProjectIdentity.Internal.String())
+
+	if params.ProjectTaskStatusIdentity != nil {
+		selectQuery = selectQuery.Where("task.project_task_status_internal_id = ?", params.ProjectTaskStatusIdentity.Internal.String())
+	} else {
+		selectQuery = selectQuery.Where("task.project_task_status_internal_id IS NULL")
+	}
+
+	if params.Direction == core.SortDirectionDesc {
+		if params.Rank != nil {
+			selectQuery = selectQuery.Where("task.rank < ?", *params.Rank)
+		}
+
+		selectQuery = selectQuery.OrderExpr("task.rank DESC")
+	} else {
+		if params.Rank != nil {
+			selectQuery = selectQuery.Where("task.rank > ?", *params.Rank)
+		}
+
+		selectQuery = selectQuery.OrderExpr("task.rank ASC")
 	}
 
-	return &core.PaginationOutput[task.Task]{
-		Data:    taskEntities,
-		Page:    page,
-		HasMore: core.HasMorePages(page, countBeforePagination, perPage),
-		Total:   countBeforePagination,
-	}, nil
+	err := selectQuery.Limit(1).Scan(context.Background(), &ranks)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return "", nil
+		}
+
+		return "", err
+	}
+
+	if len(ranks) == 0 {
+		return "", nil
+	}
+
+	return ranks[0], nil
 }
 
 func (r *TaskBunRepository) GetTasksByParentTaskIdentity(params taskrepo.GetTasksByParentTaskIdentityParams) ([]*task.Task, error) {
@@ -390,10 +814,14 @@ func (r *TaskBunRepository) GetTasksByParentTaskIdentity(params taskrepo.GetTask
 	}
 
 	selectQuery = selectQuery.Model(&tasks)
-	selectQuery = selectQuery.Relation("ProjectTaskStatus").Relation("ProjectTaskCategory").Relation("SubTasks")
-	selectQuery = coredatabase.ApplyRelations(selectQuery, params.RelationsInput)
+	selectQuery = selectQuery.Relation("ProjectTaskStatus").Relation("ProjectTaskCategory").Relation("SubTasks").Relation("Users.User").Relation("CustomFieldValues.ProjectTaskCustomField").Relation("References")
+	selectQuery, err := coredatabase.ApplyRelations(selectQuery, taskIncludableRelations, params.RelationsInput)
+	if err != nil {
+		return nil, err
+	}
+
 	selectQuery = selectQuery.Where("task.parent_task_internal_id = ?", params.ParentTaskIdentity.Internal.String())
-	err := selectQuery.Scan(context.Background())
+	err = selectQuery.Scan(context.Background())
 	if err != nil {
 		return nil, err
 	}
@@ -602,13 +1030,17 @@ func (r *TaskBunRepository) StoreTask(params taskrepo.StoreTaskParams) (*task.Ta
 	_, err := tx.NewInsert().Model(&TaskTable{
 		InternalId:                    params.Task.Identity.Internal.String(),
 		PublicId:                      params.Task.Identity.Public,
+		ExternalId:                    params.Task.ExternalId,
 		Name:                          params.Task.Name,
 		Description:                   params.Task.Description,
+		DescriptionFormat:             string(params.Task.DescriptionFormat),
+		DescriptionText:               params.Task.FormattedDescription().PlainText(),
 		EstimatedMinutes:              *params.Task.EstimatedMinutes,
 		PriorityLevel:                 int8(params.Task.PriorityLevel),
 		DueDate:                       dueDate,
 		CompletedAt:                   completedAt,
 		Type:                          string(params.Task.Type),
+		Rank:                          params.Task.Rank,
 		ProjectTaskStatusInternalId:   projectTaskStatusInternalId,
 		ProjectTaskCategoryInternalId: projectTaskCategoryInternalId,
 		ParentTaskInternalId:          parentTaskInternalId,
@@ -618,6 +1050,7 @@ func (r *TaskBunRepository) StoreTask(params taskrepo.StoreTaskParams) (*task.Ta
 		CreatedAt:                     *createdAt,
 		UpdatedAt:                     updatedAt,
 		DeletedAt:                     deletedAt,
+		Revision:                      params.Task.Revision,
 	}).Exec(context.Background())
 	if err != nil {
 		if err == sql.ErrNoRows {
@@ -637,6 +1070,21 @@ func (r *TaskBunRepository) StoreTask(params taskrepo.StoreTaskParams) (*task.Ta
 		}
 	}
 
+	err = r.storeUsers(tx, params.Task)
+	if err != nil {
+		return nil, err
+	}
+
+	err = r.storeCustomFieldValues(tx, params.Task)
+	if err != nil {
+		return nil, err
+	}
+
+	err = r.storeReferences(tx, params.Task)
+	if err != nil {
+		return nil, err
+	}
+
 	if shouldCommit {
 		err = tx.Commit()
 		if err != nil {
@@ -716,13 +1164,17 @@ func (r *TaskBunRepository) UpdateTask(params taskrepo.UpdateTaskParams) error {
 	taskTable := &TaskTable{
 		InternalId:                    params.Task.Identity.Internal.String(),
 		PublicId:                      params.Task.Identity.Public,
+		ExternalId:                    params.Task.ExternalId,
 		Name:                          params.Task.Name,
 		Description:                   params.Task.Description,
+		DescriptionFormat:             string(params.Task.DescriptionFormat),
+		DescriptionText:               params.Task.FormattedDescription().PlainText(),
 		EstimatedMinutes:              *params.Task.EstimatedMinutes,
 		PriorityLevel:                 int8(params.Task.PriorityLevel),
 		DueDate:                       dueDate,
 		CompletedAt:                   completedAt,
 		Type:                          string(params.Task.Type),
+		Rank:                          params.Task.Rank,
 		ProjectTaskStatusInternalId:   projectTaskStatusInternalId,
 		ProjectTaskCategoryInternalId: projectTaskCategoryInternalId,
 		ParentTaskInternalId:          parentTaskInternalId,
@@ -733,13 +1185,30 @@ func (r *TaskBunRepository) UpdateTask(params taskrepo.UpdateTaskParams) error {
 		CreatedAt:                     params.Task.Timestamps.CreatedAt.Value,
 		UpdatedAt:                     updatedAt,
 		DeletedAt:                     deletedAt,
+		Revision:                      params.Task.Revision + 1,
 	}
 
-	_, err := tx.NewUpdate().Model(taskTable).Where("task.internal_id = ?", params.Task.Identity.Internal.String()).Exec(context.Background())
+	updateQuery := tx.NewUpdate().Model(taskTable).Where("task.internal_id = ?", params.Task.Identity.Internal.String())
+	err := coredatabase.ExecRevisionedUpdate(updateQuery, "task.revision", params.Task.Revision, "task")
 	if err != nil {
-		if err == sql.ErrNoRows {
-			return nil
-		}
+		return err
+	}
+
+	params.Task.Revision++
+
+	err = r.storeUsers(tx, params.Task)
+	if err != nil {
+		return err
+	}
+
+	err = r.storeCustomFieldValues(tx, params.Task)
+	if err != nil {
+		return err
+	}
+
+	err = r.storeReferences(tx, params.Task)
+	if err != nil {
+		return err
 	}
 
 	if shouldCommit {
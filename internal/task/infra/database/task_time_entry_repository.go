@@ -0,0 +1,472 @@
+package taskdatabase
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/gabrielmrtt/taski/internal/core"
+	coredatabase "github.com/gabrielmrtt/taski/internal/core/database"
+	"github.com/gabrielmrtt/taski/internal/project"
+	"github.com/gabrielmrtt/taski/internal/task"
+	taskrepo "github.com/gabrielmrtt/taski/internal/task/repository"
+	"github.com/gabrielmrtt/taski/internal/user"
+	userdatabase "github.com/gabrielmrtt/taski/internal/user/infra/database"
+	"github.com/google/uuid"
+	"github.com/uptrace/bun"
+)
+
+type TaskTimeEntryTable struct {
+	bun.BaseModel `bun:"table:task_time_entry,alias:task_time_entry"`
+
+	InternalId     string  `bun:"internal_id,pk,notnull,type:uuid"`
+	PublicId       string  `bun:"public_id,notnull,type:varchar(510)"`
+	Description    *string `bun:"description,type:varchar(510)"`
+	StartedAt      int64   `bun:"started_at,notnull,type:bigint"`
+	EndedAt        *int64  `bun:"ended_at,type:bigint"`
+	TaskInternalId string  `bun:"task_internal_id,notnull,type:uuid"`
+	UserInternalId string  `bun:"user_internal_id,notnull,type:uuid"`
+	CreatedAt      int64   `bun:"created_at,notnull,type:bigint"`
+	UpdatedAt      *int64  `bun:"updated_at,type:bigint"`
+
+	Task *TaskTable              `bun:"rel:has-one,join:task_internal_id=internal_id"`
+	User *userdatabase.UserTable `bun:"rel:has-one,join:user_internal_id=internal_id"`
+}
+
+func (t *TaskTimeEntryTable) ToEntity() *task.TaskTimeEntry {
+	var description string = ""
+	if t.Description != nil {
+		description = *t.Description
+	}
+
+	var endedAt *core.DateTime = nil
+	if t.EndedAt != nil {
+		endedAt = &core.DateTime{Value: *t.EndedAt}
+	}
+
+	createdAt := core.DateTime{Value: t.CreatedAt}
+	var updatedAt *core.DateTime = nil
+	if t.UpdatedAt != nil {
+		updatedAt = &core.DateTime{Value: *t.UpdatedAt}
+	}
+
+	return &task.TaskTimeEntry{
+		Identity:     core.NewIdentityFromInternal(uuid.MustParse(t.InternalId), task.TaskTimeEntryIdentityPrefix),
+		TaskIdentity: core.NewIdentityFromInternal(uuid.MustParse(t.TaskInternalId), task.TaskIdentityPrefix),
+		UserIdentity: core.NewIdentityFromInternal(uuid.MustParse(t.UserInternalId), user.UserIdentityPrefix),
+		Description:  description,
+		StartedAt:    core.DateTime{Value: t.StartedAt},
+		EndedAt:      endedAt,
+		Timestamps: core.Timestamps{
+			CreatedAt: &createdAt,
+			UpdatedAt: updatedAt,
+		},
+	}
+}
+
+func taskTimeEntryToTable(taskTimeEntry *task.TaskTimeEntry) *TaskTimeEntryTable {
+	var description *string = nil
+	if taskTimeEntry.Description != "" {
+		description = &taskTimeEntry.Description
+	}
+
+	var endedAt *int64 = nil
+	if taskTimeEntry.EndedAt != nil {
+		endedAt = &taskTimeEntry.EndedAt.Value
+	}
+
+	var updatedAt *int64 = nil
+	if taskTimeEntry.Timestamps.UpdatedAt != nil {
+		updatedAt = &taskTimeEntry.Timestamps.UpdatedAt.Value
+	}
+
+	return &TaskTimeEntryTable{
+		InternalId:     taskTimeEntry.Identity.Internal.String(),
+		PublicId:       taskTimeEntry.Identity.Public,
+		Description:    description,
+		StartedAt:      taskTimeEntry.StartedAt.Value,
+		EndedAt:        endedAt,
+		TaskInternalId: taskTimeEntry.TaskIdentity.Internal.String(),
+		UserInternalId: taskTimeEntry.UserIdentity.Internal.String(),
+		CreatedAt:      taskTimeEntry.Timestamps.CreatedAt.Value,
+		UpdatedAt:      updatedAt,
+	}
+}
+
+type taskTimeReportRowTable struct {
+	UserInternalId    string  `bun:"user_internal_id"`
+	UserName          *string `bun:"user_name"`
+	ProjectInternalId string  `bun:"project_internal_id"`
+	ProjectName       string  `bun:"project_name"`
+	Date              string  `bun:"date"`
+	TrackedSeconds    int64   `bun:"tracked_seconds"`
+}
+
+var taskTimeEntrySortableFields = coredatabase.SortableFields{
+	Fields: map[string]string{
+		"startedAt": "task_time_entry.started_at",
+		"endedAt":   "task_time_entry.ended_at",
+		"createdAt": "task_time_entry.created_at",
+		"updatedAt": "task_time_entry.updated_at",
+	},
+	IdColumn: "task_time_entry.internal_id",
+}
+
+type TaskTimeEntryBunRepository struct {
+	db *bun.DB
+	tx *coredatabase.TransactionBun
+}
+
+func NewTaskTimeEntryBunRepository(connection *bun.DB) *TaskTimeEntryBunRepository {
+	return &TaskTimeEntryBunRepository{db: connection, tx: nil}
+}
+
+func (r *TaskTimeEntryBunRepository) SetTransaction(tx core.Transaction) error {
+	if r.tx != nil && !r.tx.IsClosed() {
+		return nil
+	}
+
+	r.tx = tx.(*coredatabase.TransactionBun)
+	return nil
+}
+
+func (r *TaskTimeEntryBunRepository) newSelect() *bun.SelectQuery {
+	if r.tx != nil && !r.tx.IsClosed() {
+		return r.tx.Tx.NewSelect()
+	}
+
+	return r.db.NewSelect()
+}
+
+func (r *TaskTimeEntryBunRepository) applyFilters(selectQuery *bun.SelectQuery, filters taskrepo.TaskTimeEntryFilters) *bun.SelectQuery {
+	if filters.TaskIdentity != nil {
+		selectQuery = selectQuery.Where("task_time_entry.task_internal_id = ?", filters.TaskIdentity.Internal.String())
+	}
+
+	if filters.UserIdentity != nil {
+		selectQuery = selectQuery.Where("task_time_entry.user_internal_id = ?", filters.UserIdentity.Internal.String())
+	}
+
+	if filters.StartedAt != nil {
+		selectQuery = coredatabase.ApplyComparableFilter(selectQuery, "task_time_entry.started_at", filters.StartedAt)
+	}
+
+	if filters.IsRunning != nil {
+		if *filters.IsRunning {
+			selectQuery = selectQuery.Where("task_time_entry.ended_at IS NULL")
+		} else {
+			selectQuery = selectQuery.Where("task_time_entry.ended_at IS NOT NULL")
+		}
+	}
+
+	return selectQuery
+}
+
+func (r *TaskTimeEntryBunRepository) GetTaskTimeEntryByIdentity(params taskrepo.GetTaskTimeEntryByIdentityParams) (*task.TaskTimeEntry, error) {
+	var taskTimeEntry *TaskTimeEntryTable = new(TaskTimeEntryTable)
+
+	selectQuery := r.newSelect().Model(taskTimeEntry)
+	selectQuery = selectQuery.Where("task_time_entry.internal_id = ?", params.TaskTimeEntryIdentity.Internal.String())
+
+	if params.TaskIdentity != nil {
+		selectQuery = selectQuery.Where("task_time_entry.task_internal_id = ?", params.TaskIdentity.Internal.String())
+	}
+
+	err := selectQuery.Scan(context.Background())
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil
+		}
+
+		return nil, err
+	}
+
+	if taskTimeEntry.InternalId == "" {
+		return nil, nil
+	}
+
+	return taskTimeEntry.ToEntity(), nil
+}
+
+func (r *TaskTimeEntryBunRepository) GetRunningTaskTimeEntry(params taskrepo.GetRunningTaskTimeEntryParams) (*task.TaskTimeEntry, error) {
+	var taskTimeEntry *TaskTimeEntryTable = new(TaskTimeEntryTable)
+
+	selectQuery := r.newSelect().Model(taskTimeEntry)
+	selectQuery = selectQuery.Where("task_time_entry.user_internal_id = ?", params.UserIdentity.Internal.String())
+	selectQuery = selectQuery.Where("task_time_entry.ended_at IS NULL")
+
+	err := selectQuery.Limit(1).Scan(context.Background())
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil
+		}
+
+		return nil, err
+	}
+
+	if taskTimeEntry.InternalId == "" {
+		return nil, nil
+	}
+
+	return taskTimeEntry.ToEntity(), nil
+}
+
+func (r *TaskTimeEntryBunRepository) PaginateTaskTimeEntriesBy(params taskrepo.PaginateTaskTimeEntriesParams) (*core.PaginationOutput[task.TaskTimeEntry], error) {
+	var taskTimeEntries []*TaskTimeEntryTable = make([]*TaskTimeEntryTable, 0)
+	var perPage int = 10
+	var page int = 1
+
+	if params.Pagination.PerPage != nil {
+		perPage = *params.Pagination.PerPage
+	}
+
+	if params.Pagination.Page != nil {
+		page = *params.Pagination.Page
+	}
+
+	selectQuery := r.newSelect().Model(&taskTimeEntries)
+	selectQuery = r.applyFilters(selectQuery, params.Filters)
+	countBeforePagination, err := selectQuery.Count(context.Background())
+	if err != nil {
+		return nil, err
+	}
+
+	selectQuery, err = coredatabase.ApplySort(selectQuery, taskTimeEntrySortableFields, params.SortInput)
+	if err != nil {
+		return nil, err
+	}
+
+	selectQuery = coredatabase.ApplyPagination(selectQuery, params.Pagination)
+	err = selectQuery.Scan(context.Background())
+	if err != nil && err != sql.ErrNoRows {
+		return nil, err
+	}
+
+	var taskTimeEntryEntities []task.TaskTimeEntry = make([]task.TaskTimeEntry, 0)
+	for _, taskTimeEntry := range taskTimeEntries {
+		taskTimeEntryEntities = append(taskTimeEntryEntities, *taskTimeEntry.ToEntity())
+	}
+
+	return &core.PaginationOutput[task.TaskTimeEntry]{
+		Data:    taskTimeEntryEntities,
+		Page:    page,
+		HasMore: core.HasMorePages(page, countBeforePagination, perPage),
+		Total:   countBeforePagination,
+	}, nil
+}
+
+/*
+GetTaskTimeTotals sums the tracked time of a task and of the whole tree of tasks below it.
+Running entries are counted up to now.
+*/
+func (r *TaskTimeEntryBunRepository) GetTaskTimeTotals(params taskrepo.GetTaskTimeTotalsParams) (*taskrepo.TaskTimeTotals, error) {
+	var totals struct {
+		TrackedSeconds  int64 `bun:"tracked_seconds"`
+		RolledUpSeconds int64 `bun:"rolled_up_seconds"`
+	}
+
+	taskInternalId := params.TaskIdentity.Internal.String()
+	now := core.NewDateTime().Value
+
+	query := `
+		WITH RECURSIVE task_tree AS (
+			SELECT task.internal_id FROM task WHERE task.internal_id = ?
+			UNION ALL
+			SELECT task.internal_id FROM task
+			INNER JOIN task_tree ON task.parent_task_internal_id = task_tree.internal_id
+			WHERE task.deleted_at IS NULL
+		)
+		SELECT
+			COALESCE(SUM(COALESCE(task_time_entry.ended_at, ?) - task_time_entry.started_at) FILTER (WHERE task_time_entry.task_internal_id = ?), 0) AS tracked_seconds,
+			COALESCE(SUM(COALESCE(task_time_entry.ended_at, ?) - task_time_entry.started_at), 0) AS rolled_up_seconds
+		FROM task_time_entry
+		WHERE task_time_entry.task_internal_id IN (SELECT task_tree.internal_id FROM task_tree)
+	`
+
+	var rawQuery *bun.RawQuery
+	if r.tx != nil && !r.tx.IsClosed() {
+		rawQuery = r.tx.Tx.NewRaw(query, taskInternalId, now, taskInternalId, now)
+	} else {
+		rawQuery = r.db.NewRaw(query, taskInternalId, now, taskInternalId, now)
+	}
+
+	err := rawQuery.Scan(context.Background(), &totals)
+	if err != nil && err != sql.ErrNoRows {
+		return nil, err
+	}
+
+	return &taskrepo.TaskTimeTotals{
+		TrackedSeconds:  totals.TrackedSeconds,
+		RolledUpSeconds: totals.RolledUpSeconds,
+	}, nil
+}
+
+func (r *TaskTimeEntryBunRepository) ReportTaskTimeEntries(params taskrepo.ReportTaskTimeEntriesParams) ([]taskrepo.TaskTimeReportRow, error) {
+	var rows []taskTimeReportRowTable = make([]taskTimeReportRowTable, 0)
+	now := core.NewDateTime().Value
+
+	selectQuery := r.newSelect().
+		TableExpr("task_time_entry").
+		ColumnExpr("task_time_entry.user_internal_id AS user_internal_id").
+		ColumnExpr("user_data.display_name AS user_name").
+		ColumnExpr("project.internal_id AS project_internal_id").
+		ColumnExpr("project.name AS project_name").
+		ColumnExpr("to_char(to_timestamp(task_time_entry.started_at) AT TIME ZONE 'UTC', 'YYYY-MM-DD') AS date").
+		ColumnExpr("SUM(COALESCE(task_time_entry.ended_at, ?) - task_time_entry.started_at) AS tracked_seconds", now).
+		Join("INNER JOIN task ON task.internal_id = task_time_entry.task_internal_id").
+		Join("INNER JOIN project ON project.internal_id = task.project_internal_id").
+		Join("LEFT JOIN user_data ON user_data.user_internal_id = task_time_entry.user_internal_id").
+		Where("task.deleted_at IS NULL").
+		Where("task_time_entry.started_at >= ?", params.From.Value).
+		Where("task_time_entry.started_at < ?", params.To.Value)
+
+	if params.OrganizationIdentity != nil {
+		selectQuery = selectQuery.Where(`project.workspace_internal_id IN (
+			SELECT workspace.internal_id FROM workspace
+			WHERE workspace.organization_internal_id = ?
+		)`, params.OrganizationIdentity.Internal.String())
+	}
+
+	if params.AuthenticatedUserIdentity != nil {
+		selectQuery = selectQuery.Where(`project.internal_id IN (
+			SELECT project_user.project_internal_id FROM project_user
+			WHERE project_user.user_internal_id = ? AND project_user.status = ?
+		)`, params.AuthenticatedUserIdentity.Internal.String(), project.ProjectUserStatusActive)
+	}
+
+	if params.ProjectIdentity != nil {
+		selectQuery = selectQuery.Where("project.internal_id = ?", params.ProjectIdentity.Internal.String())
+	}
+
+	if params.UserIdentity != nil {
+		selectQuery = selectQuery.Where("task_time_entry.user_internal_id = ?", params.UserIdentity.Internal.String())
+	}
+
+	selectQuery = selectQuery.
+		GroupExpr("task_time_entry.user_internal_id, user_data.display_name, project.internal_id, project.name, date").
+		OrderExpr("date ASC, user_name ASC, project_name ASC")
+
+	err := selectQuery.Scan(context.Background(), &rows)
+	if err != nil && err != sql.ErrNoRows {
+		return nil, err
+	}
+
+	var reportRows []taskrepo.TaskTimeReportRow = make([]taskrepo.TaskTimeReportRow, 0)
+	for _, row := range rows {
+		var userName string = ""
+		if row.UserName != nil {
+			userName = *row.UserName
+		}
+
+		reportRows = append(reportRows, taskrepo.TaskTimeReportRow{
+			UserIdentity:    core.NewIdentityFromInternal(uuid.MustParse(row.UserInternalId), user.UserIdentityPrefix),
+			UserName:        userName,
+			ProjectIdentity: core.NewIdentityFromInternal(uuid.MustParse(row.ProjectInternalId), project.ProjectIdentityPrefix),
+			ProjectName:     row.ProjectName,
+			Date:            row.Date,
+			TrackedSeconds:  row.TrackedSeconds,
+		})
+	}
+
+	return reportRows, nil
+}
+
+func (r *TaskTimeEntryBunRepository) StoreTaskTimeEntry(params taskrepo.StoreTaskTimeEntryParams) (*task.TaskTimeEntry, error) {
+	var tx bun.Tx
+	var shouldCommit bool = false
+
+	if r.tx != nil && !r.tx.IsClosed() {
+		tx = *r.tx.Tx
+	} else {
+		var err error
+		tx, err = r.db.BeginTx(context.Background(), nil)
+		shouldCommit = true
+
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	_, err := tx.NewInsert().Model(taskTimeEntryToTable(params.TaskTimeEntry)).Exec(context.Background())
+	if err != nil {
+		return nil, err
+	}
+
+	if shouldCommit {
+		err = tx.Commit()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return params.TaskTimeEntry, nil
+}
+
+func (r *TaskTimeEntryBunRepository) UpdateTaskTimeEntry(params taskrepo.UpdateTaskTimeEntryParams) error {
+	var tx bun.Tx
+	var shouldCommit bool = false
+
+	if r.tx != nil && !r.tx.IsClosed() {
+		tx = *r.tx.Tx
+	} else {
+		var err error
+		tx, err = r.db.BeginTx(context.Background(), nil)
+		shouldCommit = true
+
+		if err != nil {
+			return err
+		}
+	}
+
+	_, err := tx.NewUpdate().Model(taskTimeEntryToTable(params.TaskTimeEntry)).Where("task_time_entry.internal_id = ?", params.TaskTimeEntry.Identity.Internal.String()).Exec(context.Background())
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil
+		}
+
+		return err
+	}
+
+	if shouldCommit {
+		err = tx.Commit()
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (r *TaskTimeEntryBunRepository) DeleteTaskTimeEntry(params taskrepo.DeleteTaskTimeEntryParams) error {
+	var tx bun.Tx
+	var shouldCommit bool = false
+
+	if r.tx != nil && !r.tx.IsClosed() {
+		tx = *r.tx.Tx
+	} else {
+		var err error
+		tx, err = r.db.BeginTx(context.Background(), nil)
+		shouldCommit = true
+
+		if err != nil {
+			return err
+		}
+	}
+
+	_, err := tx.NewDelete().Model(&TaskTimeEntryTable{}).Where("task_time_entry.internal_id = ?", params.TaskTimeEntryIdentity.Internal.String()).Exec(context.Background())
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil
+		}
+
+		return err
+	}
+
+	if shouldCommit {
+		err = tx.Commit()
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,327 @@
+package taskdatabase
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/gabrielmrtt/taski/internal/core"
+	coredatabase "github.com/gabrielmrtt/taski/internal/core/database"
+	"github.com/gabrielmrtt/taski/internal/organization"
+	"github.com/gabrielmrtt/taski/internal/project"
+	"github.com/gabrielmrtt/taski/internal/task"
+	taskrepo "github.com/gabrielmrtt/taski/internal/task/repository"
+	"github.com/gabrielmrtt/taski/internal/user"
+	"github.com/google/uuid"
+	"github.com/uptrace/bun"
+)
+
+type TaskViewTable struct {
+	bun.BaseModel `bun:"table:task_view,alias:task_view"`
+
+	InternalId             string            `bun:"internal_id,pk,notnull,type:uuid"`
+	PublicId               string            `bun:"public_id,notnull,type:varchar(510)"`
+	OrganizationInternalId string            `bun:"organization_internal_id,notnull,type:uuid"`
+	ProjectInternalId      *string           `bun:"project_internal_id,type:uuid"`
+	UserOwnerInternalId    string            `bun:"user_owner_internal_id,notnull,type:uuid"`
+	Name                   string            `bun:"name,notnull,type:varchar(255)"`
+	Visibility             string            `bun:"visibility,notnull,type:varchar(50)"`
+	Filters                map[string]string `bun:"filters,notnull,type:jsonb"`
+	SortBy                 *string           `bun:"sort_by,type:varchar(255)"`
+	SortDirection          *string           `bun:"sort_direction,type:varchar(10)"`
+	GroupBy                *string           `bun:"group_by,type:varchar(50)"`
+	CreatedAt              int64             `bun:"created_at,notnull,type:bigint"`
+	UpdatedAt              *int64            `bun:"updated_at,type:bigint"`
+}
+
+func (t *TaskViewTable) ToEntity() *task.TaskView {
+	var projectIdentity *core.Identity = nil
+	if t.ProjectInternalId != nil {
+		identity := core.NewIdentityFromInternal(uuid.MustParse(*t.ProjectInternalId), project.ProjectIdentityPrefix)
+		projectIdentity = &identity
+	}
+
+	var sortDirection *core.SortDirection = nil
+	if t.SortDirection != nil {
+		direction := core.SortDirection(*t.SortDirection)
+		sortDirection = &direction
+	}
+
+	var groupBy *task.TaskGroupByFields = nil
+	if t.GroupBy != nil {
+		field := task.TaskGroupByFields(*t.GroupBy)
+		groupBy = &field
+	}
+
+	var filters map[string]string = t.Filters
+	if filters == nil {
+		filters = map[string]string{}
+	}
+
+	createdAt := core.DateTime{Value: t.CreatedAt}
+	var updatedAt *core.DateTime = nil
+	if t.UpdatedAt != nil {
+		updatedAt = &core.DateTime{Value: *t.UpdatedAt}
+	}
+
+	return &task.TaskView{
+		Identity:             core.NewIdentityFromInternal(uuid.MustParse(t.InternalId), task.TaskViewIdentityPrefix),
+		OrganizationIdentity: core.NewIdentityFromInternal(uuid.MustParse(t.OrganizationInternalId), organization.OrganizationIdentityPrefix),
+		ProjectIdentity:      projectIdentity,
+		UserOwnerIdentity:    core.NewIdentityFromInternal(uuid.MustParse(t.UserOwnerInternalId), user.UserIdentityPrefix),
+		Name:                 t.Name,
+		Visibility:           task.TaskViewVisibilities(t.Visibility),
+		Filters:              filters,
+		SortBy:               t.SortBy,
+		SortDirection:        sortDirection,
+		GroupBy:              groupBy,
+		Timestamps: core.Timestamps{
+			CreatedAt: &createdAt,
+			UpdatedAt: updatedAt,
+		},
+	}
+}
+
+func taskViewToTable(taskView *task.TaskView) *TaskViewTable {
+	var projectInternalId *string = nil
+	if taskView.ProjectIdentity != nil {
+		internalId := taskView.ProjectIdentity.Internal.String()
+		projectInternalId = &internalId
+	}
+
+	var sortDirection *string = nil
+	if taskView.SortDirection != nil {
+		direction := string(*taskView.SortDirection)
+		sortDirection = &direction
+	}
+
+	var groupBy *string = nil
+	if taskView.GroupBy != nil {
+		field := string(*taskView.GroupBy)
+		groupBy = &field
+	}
+
+	var filters map[string]string = taskView.Filters
+	if filters == nil {
+		filters = map[string]string{}
+	}
+
+	var createdAt int64 = 0
+	if taskView.Timestamps.CreatedAt != nil {
+		createdAt = taskView.Timestamps.CreatedAt.Value
+	}
+
+	var updatedAt *int64 = nil
+	if taskView.Timestamps.UpdatedAt != nil {
+		updatedAt = &taskView.Timestamps.UpdatedAt.Value
+	}
+
+	return &TaskViewTable{
+		InternalId:             taskView.Identity.Internal.String(),
+		PublicId:               taskView.Identity.Public,
+		OrganizationInternalId: taskView.OrganizationIdentity.Internal.String(),
+		ProjectInternalId:      projectInternalId,
+		UserOwnerInternalId:    taskView.UserOwnerIdentity.Internal.String(),
+		Name:                   taskView.Name,
+		Visibility:             string(taskView.Visibility),
+		Filters:                filters,
+		SortBy:                 taskView.SortBy,
+		SortDirection:          sortDirection,
+		GroupBy:                groupBy,
+		CreatedAt:              createdAt,
+		UpdatedAt:              updatedAt,
+	}
+}
+
+type TaskViewBunRepository struct {
+	db *bun.DB
+	tx *coredatabase.TransactionBun
+}
+
+func NewTaskViewBunRepository(connection *bun.DB) *TaskViewBunRepository {
+	return &TaskViewBunRepository{db: connection, tx: nil}
+}
+
+func (r *TaskViewBunRepository) SetTransaction(tx core.Transaction) error {
+	if r.tx != nil && !r.tx.IsClosed() {
+		return nil
+	}
+
+	r.tx = tx.(*coredatabase.TransactionBun)
+	return nil
+}
+
+func (r *TaskViewBunRepository) applyFilters(selectQuery *bun.SelectQuery, filters taskrepo.TaskViewFilters) *bun.SelectQuery {
+	if filters.OrganizationIdentity != nil {
+		selectQuery = selectQuery.Where("task_view.organization_internal_id = ?", filters.OrganizationIdentity.Internal.String())
+	}
+
+	if filters.ProjectIdentity != nil {
+		selectQuery = selectQuery.Where("task_view.project_internal_id = ?", filters.ProjectIdentity.Internal.String())
+	}
+
+	if filters.UserIdentity != nil {
+		selectQuery = selectQuery.WhereGroup(" AND ", func(q *bun.SelectQuery) *bun.SelectQuery {
+			q = q.Where("task_view.user_owner_internal_id = ?", filters.UserIdentity.Internal.String())
+			q = q.WhereOr("task_view.visibility = ? AND task_view.project_internal_id IN (SELECT project_user.project_internal_id FROM project_user WHERE project_user.user_internal_id = ? AND project_user.status = ?)", task.TaskViewVisibilityProject, filters.UserIdentity.Internal.String(), project.ProjectUserStatusActive)
+			return q
+		})
+	}
+
+	return selectQuery
+}
+
+func (r *TaskViewBunRepository) GetTaskViewByIdentity(params taskrepo.GetTaskViewByIdentityParams) (*task.TaskView, error) {
+	var taskView *TaskViewTable = new(TaskViewTable)
+	var selectQuery *bun.SelectQuery
+
+	if r.tx != nil && !r.tx.IsClosed() {
+		selectQuery = r.tx.Tx.NewSelect()
+	} else {
+		selectQuery = r.db.NewSelect()
+	}
+
+	selectQuery = selectQuery.Model(taskView)
+	selectQuery = selectQuery.Where("task_view.internal_id = ?", params.TaskViewIdentity.Internal.String())
+
+	if params.OrganizationIdentity != nil {
+		selectQuery = selectQuery.Where("task_view.organization_internal_id = ?", params.OrganizationIdentity.Internal.String())
+	}
+
+	err := selectQuery.Scan(context.Background())
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil
+		}
+
+		return nil, err
+	}
+
+	if taskView.InternalId == "" {
+		return nil, nil
+	}
+
+	return taskView.ToEntity(), nil
+}
+
+func (r *TaskViewBunRepository) ListTaskViewsBy(params taskrepo.ListTaskViewsByParams) ([]task.TaskView, error) {
+	var taskViews []TaskViewTable = make([]TaskViewTable, 0)
+	var selectQuery *bun.SelectQuery
+
+	if r.tx != nil && !r.tx.IsClosed() {
+		selectQuery = r.tx.Tx.NewSelect()
+	} else {
+		selectQuery = r.db.NewSelect()
+	}
+
+	selectQuery = selectQuery.Model(&taskViews)
+	selectQuery = r.applyFilters(selectQuery, params.Filters)
+	selectQuery = selectQuery.Order("task_view.name ASC")
+	err := selectQuery.Scan(context.Background())
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return []task.TaskView{}, nil
+		}
+
+		return nil, err
+	}
+
+	var taskViewEntities []task.TaskView = make([]task.TaskView, 0)
+	for _, taskView := range taskViews {
+		taskViewEntities = append(taskViewEntities, *taskView.ToEntity())
+	}
+
+	return taskViewEntities, nil
+}
+
+func (r *TaskViewBunRepository) StoreTaskView(params taskrepo.StoreTaskViewParams) (*task.TaskView, error) {
+	var tx bun.Tx
+	var shouldCommit bool = false
+
+	if r.tx != nil && !r.tx.IsClosed() {
+		tx = *r.tx.Tx
+	} else {
+		var err error
+		tx, err = r.db.BeginTx(context.Background(), nil)
+		shouldCommit = true
+
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	_, err := tx.NewInsert().Model(taskViewToTable(params.TaskView)).Exec(context.Background())
+	if err != nil {
+		return nil, err
+	}
+
+	if shouldCommit {
+		err = tx.Commit()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return params.TaskView, nil
+}
+
+func (r *TaskViewBunRepository) UpdateTaskView(params taskrepo.UpdateTaskViewParams) error {
+	var tx bun.Tx
+	var shouldCommit bool = false
+
+	if r.tx != nil && !r.tx.IsClosed() {
+		tx = *r.tx.Tx
+	} else {
+		var err error
+		tx, err = r.db.BeginTx(context.Background(), nil)
+		shouldCommit = true
+
+		if err != nil {
+			return err
+		}
+	}
+
+	_, err := tx.NewUpdate().Model(taskViewToTable(params.TaskView)).Where("task_view.internal_id = ?", params.TaskView.Identity.Internal.String()).Exec(context.Background())
+	if err != nil {
+		return err
+	}
+
+	if shouldCommit {
+		err = tx.Commit()
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (r *TaskViewBunRepository) DeleteTaskView(params taskrepo.DeleteTaskViewParams) error {
+	var tx bun.Tx
+	var shouldCommit bool = false
+
+	if r.tx != nil && !r.tx.IsClosed() {
+		tx = *r.tx.Tx
+	} else {
+		var err error
+		tx, err = r.db.BeginTx(context.Background(), nil)
+		shouldCommit = true
+
+		if err != nil {
+			return err
+		}
+	}
+
+	_, err := tx.NewDelete().Model(&TaskViewTable{}).Where("task_view.internal_id = ?", params.TaskViewIdentity.Internal.String()).Exec(context.Background())
+	if err != nil {
+		return err
+	}
+
+	if shouldCommit {
+		err = tx.Commit()
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,68 @@
+package taskhttprequests
+
+import (
+	"github.com/gabrielmrtt/taski/internal/core"
+	"github.com/gabrielmrtt/taski/internal/task"
+	taskservice "github.com/gabrielmrtt/taski/internal/task/services"
+)
+
+type BulkUpdateTasksRequest struct {
+	TaskIds          []string `json:"taskIds"`
+	Filter           *string  `json:"filter"`
+	StatusId         *string  `json:"statusId"`
+	CategoryId       *string  `json:"categoryId"`
+	PriorityLevel    *int8    `json:"priorityLevel"`
+	AddUserIds       []string `json:"addUserIds"`
+	RemoveUserIds    []string `json:"removeUserIds"`
+	ShiftDueDateDays *int     `json:"shiftDueDateDays"`
+	Complete         *bool    `json:"complete"`
+	Delete           bool     `json:"delete"`
+}
+
+func (r *BulkUpdateTasksRequest) ToInput() taskservice.BulkUpdateTasksInput {
+	var taskIdentities []core.Identity = make([]core.Identity, len(r.TaskIds))
+	for i, taskId := range r.TaskIds {
+		taskIdentities[i] = core.NewIdentityFromPublic(taskId)
+	}
+
+	var statusIdentity *core.Identity = nil
+	if r.StatusId != nil {
+		identity := core.NewIdentityFromPublic(*r.StatusId)
+		statusIdentity = &identity
+	}
+
+	var categoryIdentity *core.Identity = nil
+	if r.CategoryId != nil {
+		identity := core.NewIdentityFromPublic(*r.CategoryId)
+		categoryIdentity = &identity
+	}
+
+	var priorityLevel *task.TaskPriorityLevels = nil
+	if r.PriorityLevel != nil {
+		p := task.TaskPriorityLevels(*r.PriorityLevel)
+		priorityLevel = &p
+	}
+
+	var addUserIdentities []core.Identity = make([]core.Identity, len(r.AddUserIds))
+	for i, userId := range r.AddUserIds {
+		addUserIdentities[i] = core.NewIdentityFromPublic(userId)
+	}
+
+	var removeUserIdentities []core.Identity = make([]core.Identity, len(r.RemoveUserIds))
+	for i, userId := range r.RemoveUserIds {
+		removeUserIdentities[i] = core.NewIdentityFromPublic(userId)
+	}
+
+	return taskservice.BulkUpdateTasksInput{
+		TaskIdentities:       taskIdentities,
+		Filter:               r.Filter,
+		StatusIdentity:       statusIdentity,
+		CategoryIdentity:     categoryIdentity,
+		PriorityLevel:        priorityLevel,
+		AddUserIdentities:    addUserIdentities,
+		RemoveUserIdentities: removeUserIdentities,
+		ShiftDueDateDays:     r.ShiftDueDateDays,
+		Complete:             r.Complete,
+		Delete:               r.Delete,
+	}
+}
@@ -11,18 +11,21 @@ type CreateSubTaskRequest struct {
 }
 
 type CreateTaskRequest struct {
-	ProjectId        string                  `json:"projectId"`
-	StatusId         string                  `json:"statusId"`
-	CategoryId       *string                 `json:"categoryId"`
-	ParentTaskId     *string                 `json:"parentTaskId"`
-	Name             string                  `json:"name"`
-	Description      string                  `json:"description"`
-	EstimatedMinutes *int16                  `json:"estimatedMinutes"`
-	PriorityLevel    int8                    `json:"priorityLevel"`
-	DueDate          *string                 `json:"dueDate"`
-	SubTasks         []*CreateSubTaskRequest `json:"subTasks"`
-	Users            []*string               `json:"users"`
-	ChildrenTasks    []*string               `json:"childrenTasks"`
+	ProjectId         string                  `json:"projectId"`
+	StatusId          string                  `json:"statusId"`
+	CategoryId        *string                 `json:"categoryId"`
+	ParentTaskId      *string                 `json:"parentTaskId"`
+	ExternalId        *string                 `json:"externalId"`
+	Name              string                  `json:"name"`
+	Description       string                  `json:"description"`
+	DescriptionFormat string                  `json:"descriptionFormat"`
+	EstimatedMinutes  *int16                  `json:"estimatedMinutes"`
+	PriorityLevel     int8                    `json:"priorityLevel"`
+	DueDate           *string                 `json:"dueDate"`
+	SubTasks          []*CreateSubTaskRequest `json:"subTasks"`
+	Users             []*string               `json:"users"`
+	ChildrenTasks     []*string               `json:"childrenTasks"`
+	CustomFields      map[string]any          `json:"customFields"`
 }
 
 func (r *CreateTaskRequest) ToInput() taskservice.CreateTaskInput {
@@ -67,18 +70,32 @@ func (r *CreateTaskRequest) ToInput() taskservice.CreateTaskInput {
 		}
 	}
 
+	var customFields []*taskservice.TaskCustomFieldValueInput = nil
+	if r.CustomFields != nil {
+		customFields = make([]*taskservice.TaskCustomFieldValueInput, 0, len(r.CustomFields))
+		for customFieldId, value := range r.CustomFields {
+			customFields = append(customFields, &taskservice.TaskCustomFieldValueInput{
+				CustomFieldIdentity: core.NewIdentityFromPublic(customFieldId),
+				Value:               value,
+			})
+		}
+	}
+
 	return taskservice.CreateTaskInput{
 		ProjectIdentity:    core.NewIdentityFromPublic(r.ProjectId),
 		StatusIdentity:     core.NewIdentityFromPublic(r.StatusId),
 		CategoryIdentity:   categoryIdentity,
 		ParentTaskIdentity: parentTaskIdentity,
+		ExternalId:         r.ExternalId,
 		Name:               r.Name,
 		Description:        r.Description,
+		DescriptionFormat:  core.ContentFormats(r.DescriptionFormat),
 		EstimatedMinutes:   r.EstimatedMinutes,
 		PriorityLevel:      task.TaskPriorityLevels(r.PriorityLevel),
 		DueDate:            dueDate,
 		SubTasks:           subTasks,
 		Users:              users,
 		ChildrenTasks:      childrenTasks,
+		CustomFields:       customFields,
 	}
 }
@@ -0,0 +1,22 @@
+package taskhttprequests
+
+import (
+	"github.com/gabrielmrtt/taski/internal/core"
+	taskservice "github.com/gabrielmrtt/taski/internal/task/services"
+)
+
+type CreateTaskCalendarFeedRequest struct {
+	ProjectId *string `json:"projectId"`
+}
+
+func (r *CreateTaskCalendarFeedRequest) ToInput() taskservice.CreateTaskCalendarFeedInput {
+	var projectIdentity *core.Identity = nil
+	if r.ProjectId != nil {
+		identity := core.NewIdentityFromPublic(*r.ProjectId)
+		projectIdentity = &identity
+	}
+
+	return taskservice.CreateTaskCalendarFeedInput{
+		ProjectIdentity: projectIdentity,
+	}
+}
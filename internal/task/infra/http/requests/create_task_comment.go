@@ -9,8 +9,10 @@ import (
 )
 
 type CreateTaskCommentRequest struct {
-	Content string                 `json:"content"`
-	Files   []multipart.FileHeader `json:"files"`
+	ParentCommentId *string                `json:"parentCommentId"`
+	Content         string                 `json:"content"`
+	ContentFormat   string                 `json:"contentFormat"`
+	Files           []multipart.FileHeader `json:"files"`
 }
 
 func (r *CreateTaskCommentRequest) ToInput() taskservice.CreateTaskCommentInput {
@@ -35,8 +37,16 @@ func (r *CreateTaskCommentRequest) ToInput() taskservice.CreateTaskCommentInput
 		}
 	}
 
+	var parentTaskCommentIdentity *core.Identity = nil
+	if r.ParentCommentId != nil {
+		identity := core.NewIdentityFromPublic(*r.ParentCommentId)
+		parentTaskCommentIdentity = &identity
+	}
+
 	return taskservice.CreateTaskCommentInput{
-		Content: r.Content,
-		Files:   files,
+		ParentTaskCommentIdentity: parentTaskCommentIdentity,
+		Content:                   r.Content,
+		ContentFormat:             core.ContentFormats(r.ContentFormat),
+		Files:                     files,
 	}
 }
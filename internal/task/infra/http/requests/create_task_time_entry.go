@@ -0,0 +1,30 @@
+package taskhttprequests
+
+import (
+	"github.com/gabrielmrtt/taski/internal/core"
+	taskservice "github.com/gabrielmrtt/taski/internal/task/services"
+)
+
+type CreateTaskTimeEntryRequest struct {
+	Description string `json:"description"`
+	StartedAt   string `json:"startedAt"`
+	EndedAt     string `json:"endedAt"`
+}
+
+func (r *CreateTaskTimeEntryRequest) ToInput() taskservice.CreateTaskTimeEntryInput {
+	var startedAt *core.DateTime = nil
+	if d, err := core.NewDateTimeFromRFC3339(r.StartedAt); err == nil {
+		startedAt = &d
+	}
+
+	var endedAt *core.DateTime = nil
+	if d, err := core.NewDateTimeFromRFC3339(r.EndedAt); err == nil {
+		endedAt = &d
+	}
+
+	return taskservice.CreateTaskTimeEntryInput{
+		Description: r.Description,
+		StartedAt:   startedAt,
+		EndedAt:     endedAt,
+	}
+}
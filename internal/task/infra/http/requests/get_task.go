@@ -9,6 +9,7 @@ import (
 
 type GetTaskRequest struct {
 	Relations *string `json:"relations" schema:"relations"`
+	Fields    *string `json:"fields" schema:"fields"`
 }
 
 func (r *GetTaskRequest) FromQuery(ctx *gin.Context) error {
@@ -0,0 +1,29 @@
+package taskhttprequests
+
+import (
+	"github.com/gabrielmrtt/taski/internal/task"
+	taskservice "github.com/gabrielmrtt/taski/internal/task/services"
+	"github.com/gin-gonic/gin"
+	"github.com/gorilla/schema"
+)
+
+type GetTaskCalendarFeedContentRequest struct {
+	Type *string `json:"type" schema:"type"`
+}
+
+func (r *GetTaskCalendarFeedContentRequest) FromQuery(ctx *gin.Context) error {
+	schemaDecoder := schema.NewDecoder()
+	schemaDecoder.IgnoreUnknownKeys(true)
+	return schemaDecoder.Decode(r, ctx.Request.URL.Query())
+}
+
+func (r *GetTaskCalendarFeedContentRequest) ToInput() taskservice.GetTaskCalendarFeedContentInput {
+	var entryType task.TaskCalendarFeedEntryTypes = task.TaskCalendarFeedEntryTypeEvent
+	if r.Type != nil {
+		entryType = task.TaskCalendarFeedEntryTypes(*r.Type)
+	}
+
+	return taskservice.GetTaskCalendarFeedContentInput{
+		EntryType: entryType,
+	}
+}
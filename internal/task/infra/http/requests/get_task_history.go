@@ -13,6 +13,9 @@ type GetTaskHistoryRequest struct {
 	SortDirection *string `json:"sortDirection"`
 	Page          *int    `json:"page"`
 	PerPage       *int    `json:"perPage"`
+	After         *string `json:"after"`
+	Before        *string `json:"before"`
+	WithTotal     *bool   `json:"withTotal"`
 	Relations     *string `json:"relations"`
 }
 
@@ -31,7 +34,7 @@ func (r *GetTaskHistoryRequest) ToInput() taskservice.GetTaskHistoryInput {
 
 	return taskservice.GetTaskHistoryInput{
 		SortInput:       core.SortInput{By: r.SortBy, Direction: sortDirection},
-		PaginationInput: core.PaginationInput{Page: r.Page, PerPage: r.PerPage},
+		PaginationInput: core.PaginationInput{Page: r.Page, PerPage: r.PerPage, After: r.After, Before: r.Before, WithTotal: r.WithTotal},
 		RelationsInput:  corehttp.GetRelationsInput(r.Relations),
 	}
 }
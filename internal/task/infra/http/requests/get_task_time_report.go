@@ -0,0 +1,61 @@
+package taskhttprequests
+
+import (
+	"github.com/gabrielmrtt/taski/internal/core"
+	taskservice "github.com/gabrielmrtt/taski/internal/task/services"
+	"github.com/gin-gonic/gin"
+	"github.com/gorilla/schema"
+)
+
+type GetTaskTimeReportRequest struct {
+	ProjectId *string `json:"projectId" schema:"projectId"`
+	UserId    *string `json:"userId" schema:"userId"`
+	From      *string `json:"from" schema:"from"`
+	To        *string `json:"to" schema:"to"`
+	Format    *string `json:"format" schema:"format"`
+}
+
+func (r *GetTaskTimeReportRequest) FromQuery(ctx *gin.Context) error {
+	schemaDecoder := schema.NewDecoder()
+	schemaDecoder.IgnoreUnknownKeys(true)
+	return schemaDecoder.Decode(r, ctx.Request.URL.Query())
+}
+
+func (r *GetTaskTimeReportRequest) IsCSV() bool {
+	return r.Format != nil && *r.Format == "csv"
+}
+
+func (r *GetTaskTimeReportRequest) ToInput() taskservice.GetTaskTimeReportInput {
+	var projectIdentity *core.Identity = nil
+	if r.ProjectId != nil {
+		identity := core.NewIdentityFromPublic(*r.ProjectId)
+		projectIdentity = &identity
+	}
+
+	var userIdentity *core.Identity = nil
+	if r.UserId != nil {
+		identity := core.NewIdentityFromPublic(*r.UserId)
+		userIdentity = &identity
+	}
+
+	var from *core.DateTime = nil
+	if r.From != nil {
+		if d, err := core.NewDateTimeFromRFC3339(*r.From); err == nil {
+			from = &d
+		}
+	}
+
+	var to *core.DateTime = nil
+	if r.To != nil {
+		if d, err := core.NewDateTimeFromRFC3339(*r.To); err == nil {
+			to = &d
+		}
+	}
+
+	return taskservice.GetTaskTimeReportInput{
+		ProjectIdentity: projectIdentity,
+		UserIdentity:    userIdentity,
+		From:            from,
+		To:              to,
+	}
+}
@@ -0,0 +1,42 @@
+package taskhttprequests
+
+import (
+	"io"
+	"mime/multipart"
+
+	"github.com/gabrielmrtt/taski/internal/core"
+	"github.com/gabrielmrtt/taski/internal/task"
+	taskservice "github.com/gabrielmrtt/taski/internal/task/services"
+)
+
+type ImportTasksRequest struct {
+	ProjectId     string                `form:"projectId"`
+	Format        string                `form:"format"`
+	File          *multipart.FileHeader `form:"file"`
+	ColumnMapping map[string]string     `form:"columnMapping"`
+	DryRun        bool                  `form:"dryRun"`
+}
+
+func (r *ImportTasksRequest) ToInput() taskservice.ImportTasksInput {
+	var content []byte = nil
+	if r.File != nil {
+		f, err := r.File.Open()
+		if err != nil {
+			return taskservice.ImportTasksInput{}
+		}
+		defer f.Close()
+
+		content, err = io.ReadAll(f)
+		if err != nil {
+			return taskservice.ImportTasksInput{}
+		}
+	}
+
+	return taskservice.ImportTasksInput{
+		ProjectIdentity: core.NewIdentityFromPublic(r.ProjectId),
+		Format:          task.TaskImportFormats(r.Format),
+		Content:         content,
+		ColumnMapping:   r.ColumnMapping,
+		DryRun:          r.DryRun,
+	}
+}
@@ -0,0 +1,36 @@
+package taskhttprequests
+
+import (
+	"github.com/gabrielmrtt/taski/internal/core"
+	taskservice "github.com/gabrielmrtt/taski/internal/task/services"
+	"github.com/gin-gonic/gin"
+	"github.com/gorilla/schema"
+)
+
+type ListMyTaskMentionsRequest struct {
+	ProjectId *string `json:"projectId" schema:"projectId"`
+	Page      *int    `json:"page" schema:"page"`
+	PerPage   *int    `json:"perPage" schema:"perPage"`
+}
+
+func (r *ListMyTaskMentionsRequest) FromQuery(ctx *gin.Context) error {
+	schemaDecoder := schema.NewDecoder()
+	schemaDecoder.IgnoreUnknownKeys(true)
+	return schemaDecoder.Decode(r, ctx.Request.URL.Query())
+}
+
+func (r *ListMyTaskMentionsRequest) ToInput() taskservice.ListMyTaskMentionsInput {
+	var projectIdentity *core.Identity = nil
+	if r.ProjectId != nil {
+		identity := core.NewIdentityFromPublic(*r.ProjectId)
+		projectIdentity = &identity
+	}
+
+	return taskservice.ListMyTaskMentionsInput{
+		ProjectIdentity: projectIdentity,
+		Pagination: core.PaginationInput{
+			Page:    r.Page,
+			PerPage: r.PerPage,
+		},
+	}
+}
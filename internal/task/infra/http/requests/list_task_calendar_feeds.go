@@ -0,0 +1,30 @@
+package taskhttprequests
+
+import (
+	"github.com/gabrielmrtt/taski/internal/core"
+	taskservice "github.com/gabrielmrtt/taski/internal/task/services"
+	"github.com/gin-gonic/gin"
+	"github.com/gorilla/schema"
+)
+
+type ListTaskCalendarFeedsRequest struct {
+	ProjectId *string `json:"projectId" schema:"projectId"`
+}
+
+func (r *ListTaskCalendarFeedsRequest) FromQuery(ctx *gin.Context) error {
+	schemaDecoder := schema.NewDecoder()
+	schemaDecoder.IgnoreUnknownKeys(true)
+	return schemaDecoder.Decode(r, ctx.Request.URL.Query())
+}
+
+func (r *ListTaskCalendarFeedsRequest) ToInput() taskservice.ListTaskCalendarFeedsInput {
+	var projectIdentity *core.Identity = nil
+	if r.ProjectId != nil {
+		identity := core.NewIdentityFromPublic(*r.ProjectId)
+		projectIdentity = &identity
+	}
+
+	return taskservice.ListTaskCalendarFeedsInput{
+		ProjectIdentity: projectIdentity,
+	}
+}
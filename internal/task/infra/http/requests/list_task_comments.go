@@ -11,6 +11,8 @@ import (
 
 type ListTaskCommentsRequest struct {
 	AuthorId      *string `json:"authorId"`
+	Threaded      *bool   `json:"threaded"`
+	Resolved      *bool   `json:"resolved"`
 	PerPage       *int    `json:"perPage"`
 	Page          *int    `json:"page"`
 	SortBy        *string `json:"sortBy"`
@@ -27,7 +29,7 @@ func (r *ListTaskCommentsRequest) FromQuery(ctx *gin.Context) error {
 func (r *ListTaskCommentsRequest) ToInput() taskservice.ListTaskCommentsInput {
 	var authorIdentity *core.Identity = nil
 	if r.AuthorId != nil {
-		identity := core.NewIdentity(*r.AuthorId)
+		identity := core.NewIdentityFromPublic(*r.AuthorId)
 		authorIdentity = &identity
 	}
 
@@ -40,7 +42,9 @@ func (r *ListTaskCommentsRequest) ToInput() taskservice.ListTaskCommentsInput {
 	return taskservice.ListTaskCommentsInput{
 		Filters: taskrepo.TaskCommentFilters{
 			AuthorIdentity: authorIdentity,
+			Resolved:       r.Resolved,
 		},
+		Threaded: r.Threaded != nil && *r.Threaded,
 		Pagination: core.PaginationInput{
 			Page:    r.Page,
 			PerPage: r.PerPage,
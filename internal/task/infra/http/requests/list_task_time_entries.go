@@ -0,0 +1,73 @@
+package taskhttprequests
+
+import (
+	"github.com/gabrielmrtt/taski/internal/core"
+	taskrepo "github.com/gabrielmrtt/taski/internal/task/repository"
+	taskservice "github.com/gabrielmrtt/taski/internal/task/services"
+	"github.com/gin-gonic/gin"
+	"github.com/gorilla/schema"
+)
+
+type ListTaskTimeEntriesRequest struct {
+	UserId        *string `json:"userId" schema:"userId"`
+	IsRunning     *bool   `json:"isRunning" schema:"isRunning"`
+	StartedAtGte  *string `json:"startedAtGte" schema:"startedAtGte"`
+	StartedAtLte  *string `json:"startedAtLte" schema:"startedAtLte"`
+	Page          *int    `json:"page" schema:"page"`
+	PerPage       *int    `json:"perPage" schema:"perPage"`
+	SortBy        *string `json:"sortBy" schema:"sortBy"`
+	SortDirection *string `json:"sortDirection" schema:"sortDirection"`
+}
+
+func (r *ListTaskTimeEntriesRequest) FromQuery(ctx *gin.Context) error {
+	schemaDecoder := schema.NewDecoder()
+	schemaDecoder.IgnoreUnknownKeys(true)
+	return schemaDecoder.Decode(r, ctx.Request.URL.Query())
+}
+
+func (r *ListTaskTimeEntriesRequest) ToInput() taskservice.ListTaskTimeEntriesInput {
+	var userIdentity *core.Identity = nil
+	if r.UserId != nil {
+		identity := core.NewIdentityFromPublic(*r.UserId)
+		userIdentity = &identity
+	}
+
+	var startedAtFilter *core.ComparableFilter[int64] = nil
+	if r.StartedAtGte != nil || r.StartedAtLte != nil {
+		startedAtFilter = &core.ComparableFilter[int64]{}
+
+		if r.StartedAtGte != nil {
+			if startedAtGte, err := core.NewDateTimeFromRFC3339(*r.StartedAtGte); err == nil {
+				startedAtFilter.GreaterThanOrEqual = &startedAtGte.Value
+			}
+		}
+
+		if r.StartedAtLte != nil {
+			if startedAtLte, err := core.NewDateTimeFromRFC3339(*r.StartedAtLte); err == nil {
+				startedAtFilter.LessThanOrEqual = &startedAtLte.Value
+			}
+		}
+	}
+
+	var sortDirection *core.SortDirection = nil
+	if r.SortDirection != nil {
+		s := core.SortDirection(*r.SortDirection)
+		sortDirection = &s
+	}
+
+	return taskservice.ListTaskTimeEntriesInput{
+		Filters: taskrepo.TaskTimeEntryFilters{
+			UserIdentity: userIdentity,
+			StartedAt:    startedAtFilter,
+			IsRunning:    r.IsRunning,
+		},
+		Pagination: core.PaginationInput{
+			Page:    r.Page,
+			PerPage: r.PerPage,
+		},
+		SortInput: core.SortInput{
+			By:        r.SortBy,
+			Direction: sortDirection,
+		},
+	}
+}
@@ -1,8 +1,13 @@
 package taskhttprequests
 
 import (
+	"net/url"
+	"strconv"
+	"strings"
+
 	"github.com/gabrielmrtt/taski/internal/core"
 	corehttp "github.com/gabrielmrtt/taski/internal/core/http"
+	"github.com/gabrielmrtt/taski/internal/task"
 	taskrepo "github.com/gabrielmrtt/taski/internal/task/repository"
 	taskservice "github.com/gabrielmrtt/taski/internal/task/services"
 	"github.com/gin-gonic/gin"
@@ -15,46 +20,150 @@ type ListTasksRequest struct {
 	CategoryId     *string `json:"categoryId"`
 	ParentTaskId   *string `json:"parentTaskId"`
 	Name           *string `json:"name"`
+	Priority       *string `json:"priority"`
 	Completed      *bool   `json:"completed"`
 	CompletedAtLte *int64  `json:"completedAtLte"`
 	CompletedAtGte *int64  `json:"completedAtGte"`
 	DueDateLte     *int64  `json:"dueDateLte"`
 	DueDateGte     *int64  `json:"dueDateGte"`
+	Filter         *string `json:"filter"`
 	Page           *int    `json:"page"`
 	PerPage        *int    `json:"perPage"`
+	After          *string `json:"after"`
+	Before         *string `json:"before"`
+	WithTotal      *bool   `json:"withTotal"`
 	SortBy         *string `json:"sortBy"`
 	SortDirection  *string `json:"sortDirection"`
+	GroupBy        *string `json:"groupBy"`
+	View           *string `json:"view"`
 	Relations      *string `json:"relations"`
+	Fields         *string `json:"fields"`
+
+	CustomFieldFilters []*taskservice.TaskCustomFieldFilterInput `json:"-" schema:"-"`
+	PriorityLevels     []task.TaskPriorityLevels                 `json:"-" schema:"-"`
 }
 
 func (r *ListTasksRequest) FromQuery(ctx *gin.Context) error {
+	return r.fromValues(ctx.Request.URL.Query())
+}
+
+/*
+ApplyView decodes the request again on top of the filters, sorting and grouping saved in the view. Parameters
+present in the query override the ones stored in the view.
+*/
+func (r *ListTasksRequest) ApplyView(taskView *task.TaskViewDto, ctx *gin.Context) error {
+	var values url.Values = url.Values{}
+	for key, value := range taskView.Filters {
+		values.Set(key, value)
+	}
+
+	if taskView.SortBy != nil {
+		values.Set("sortBy", *taskView.SortBy)
+	}
+
+	if taskView.SortDirection != nil {
+		values.Set("sortDirection", *taskView.SortDirection)
+	}
+
+	if taskView.GroupBy != nil {
+		values.Set("groupBy", *taskView.GroupBy)
+	}
+
+	for key, queryValues := range ctx.Request.URL.Query() {
+		values[key] = queryValues
+	}
+
+	*r = ListTasksRequest{}
+	return r.fromValues(values)
+}
+
+func (r *ListTasksRequest) fromValues(values url.Values) error {
 	schemaDecoder := schema.NewDecoder()
 	schemaDecoder.IgnoreUnknownKeys(true)
-	return schemaDecoder.Decode(r, ctx.Request.URL.Query())
+	r.CustomFieldFilters = customFieldFilterInputs(values)
+	if err := schemaDecoder.Decode(r, values); err != nil {
+		return err
+	}
+
+	priorityLevels, err := priorityLevelInputs(r.Priority)
+	if err != nil {
+		return err
+	}
+
+	r.PriorityLevels = priorityLevels
+	return nil
+}
+
+/*
+priorityLevelInputs parses a comma separated list of priority levels.
+*/
+func priorityLevelInputs(priority *string) ([]task.TaskPriorityLevels, error) {
+	var priorityLevels []task.TaskPriorityLevels = make([]task.TaskPriorityLevels, 0)
+	if priority == nil || *priority == "" {
+		return priorityLevels, nil
+	}
+
+	for _, rawValue := range strings.Split(*priority, ",") {
+		value, err := strconv.ParseInt(strings.TrimSpace(rawValue), 10, 8)
+		if err != nil {
+			return nil, core.NewInvalidInputError("invalid input", []core.InvalidInputErrorField{
+				{
+					Field: "priority",
+					Error: "priority must be a comma separated list of priority levels",
+				},
+			})
+		}
+
+		priorityLevels = append(priorityLevels, task.TaskPriorityLevels(value))
+	}
+
+	return priorityLevels, nil
+}
+
+/*
+customFieldFilterInputs reads custom field filters from query keys shaped as customFields.<customFieldId>.<operator>.
+*/
+func customFieldFilterInputs(query url.Values) []*taskservice.TaskCustomFieldFilterInput {
+	var customFieldFilters []*taskservice.TaskCustomFieldFilterInput = make([]*taskservice.TaskCustomFieldFilterInput, 0)
+
+	for key, values := range query {
+		parts := strings.Split(key, ".")
+		if len(parts) != 3 || parts[0] != "customFields" || len(values) == 0 {
+			continue
+		}
+
+		customFieldFilters = append(customFieldFilters, &taskservice.TaskCustomFieldFilterInput{
+			CustomFieldIdentity: core.NewIdentityFromPublic(parts[1]),
+			Operator:            parts[2],
+			Value:               values[0],
+		})
+	}
+
+	return customFieldFilters
 }
 
 func (r *ListTasksRequest) ToInput() taskservice.ListTasksInput {
 	var projectIdentity *core.Identity = nil
 	if r.ProjectId != nil {
-		identity := core.NewIdentity(*r.ProjectId)
+		identity := core.NewIdentityFromPublic(*r.ProjectId)
 		projectIdentity = &identity
 	}
 
 	var statusIdentity *core.Identity = nil
 	if r.StatusId != nil {
-		identity := core.NewIdentity(*r.StatusId)
+		identity := core.NewIdentityFromPublic(*r.StatusId)
 		statusIdentity = &identity
 	}
 
 	var categoryIdentity *core.Identity = nil
 	if r.CategoryId != nil {
-		identity := core.NewIdentity(*r.CategoryId)
+		identity := core.NewIdentityFromPublic(*r.CategoryId)
 		categoryIdentity = &identity
 	}
 
 	var parentTaskIdentity *core.Identity = nil
 	if r.ParentTaskId != nil {
-		identity := core.NewIdentity(*r.ParentTaskId)
+		identity := core.NewIdentityFromPublic(*r.ParentTaskId)
 		parentTaskIdentity = &identity
 	}
 
@@ -87,11 +196,24 @@ func (r *ListTasksRequest) ToInput() taskservice.ListTasksInput {
 		dueDateFilter.GreaterThanOrEqual = r.DueDateGte
 	}
 
+	var priorityFilter *core.ComparableFilter[task.TaskPriorityLevels] = nil
+	if len(r.PriorityLevels) > 0 {
+		priorityFilter = &core.ComparableFilter[task.TaskPriorityLevels]{
+			In: &r.PriorityLevels,
+		}
+	}
+
 	var sortDirection core.SortDirection = core.SortDirectionAsc
 	if r.SortDirection != nil {
 		sortDirection = core.SortDirection(*r.SortDirection)
 	}
 
+	var groupBy *task.TaskGroupByFields = nil
+	if r.GroupBy != nil && *r.GroupBy != "" {
+		field := task.TaskGroupByFields(*r.GroupBy)
+		groupBy = &field
+	}
+
 	return taskservice.ListTasksInput{
 		Filters: taskrepo.TaskFilters{
 			ProjectIdentity:      projectIdentity,
@@ -99,17 +221,24 @@ func (r *ListTasksRequest) ToInput() taskservice.ListTasksInput {
 			TaskCategoryIdentity: categoryIdentity,
 			ParentTaskIdentity:   parentTaskIdentity,
 			Name:                 nameFilter,
+			Priority:             priorityFilter,
 			CompletedAt:          completedAtFilter,
 			DueDate:              dueDateFilter,
 		},
+		CustomFieldFilters: r.CustomFieldFilters,
+		Filter:             r.Filter,
 		Pagination: core.PaginationInput{
-			Page:    r.Page,
-			PerPage: r.PerPage,
+			Page:      r.Page,
+			PerPage:   r.PerPage,
+			After:     r.After,
+			Before:    r.Before,
+			WithTotal: r.WithTotal,
 		},
 		SortInput: core.SortInput{
 			By:        r.SortBy,
 			Direction: &sortDirection,
 		},
+		GroupBy:        groupBy,
 		RelationsInput: corehttp.GetRelationsInput(r.Relations),
 	}
 }
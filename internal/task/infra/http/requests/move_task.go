@@ -0,0 +1,39 @@
+package taskhttprequests
+
+import (
+	"github.com/gabrielmrtt/taski/internal/core"
+	taskservice "github.com/gabrielmrtt/taski/internal/task/services"
+)
+
+type MoveTaskRequest struct {
+	StatusId       *string `json:"statusId"`
+	PreviousTaskId *string `json:"previousTaskId"`
+	NextTaskId     *string `json:"nextTaskId"`
+}
+
+func (r *MoveTaskRequest) ToInput() taskservice.ChangeTaskStatusInput {
+	var projectTaskStatusIdentity *core.Identity = nil
+	if r.StatusId != nil {
+		identity := core.NewIdentityFromPublic(*r.StatusId)
+		projectTaskStatusIdentity = &identity
+	}
+
+	var previousTaskIdentity *core.Identity = nil
+	if r.PreviousTaskId != nil {
+		identity := core.NewIdentityFromPublic(*r.PreviousTaskId)
+		previousTaskIdentity = &identity
+	}
+
+	var nextTaskIdentity *core.Identity = nil
+	if r.NextTaskId != nil {
+		identity := core.NewIdentityFromPublic(*r.NextTaskId)
+		nextTaskIdentity = &identity
+	}
+
+	return taskservice.ChangeTaskStatusInput{
+		ProjectTaskStatusIdentity: projectTaskStatusIdentity,
+		PreviousTaskIdentity:      previousTaskIdentity,
+		NextTaskIdentity:          nextTaskIdentity,
+		AdvanceOrder:              false,
+	}
+}
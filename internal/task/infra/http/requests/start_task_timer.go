@@ -0,0 +1,15 @@
+package taskhttprequests
+
+import (
+	taskservice "github.com/gabrielmrtt/taski/internal/task/services"
+)
+
+type StartTaskTimerRequest struct {
+	Description string `json:"description"`
+}
+
+func (r *StartTaskTimerRequest) ToInput() taskservice.StartTaskTimerInput {
+	return taskservice.StartTaskTimerInput{
+		Description: r.Description,
+	}
+}
@@ -7,15 +7,17 @@ import (
 )
 
 type UpdateTaskRequest struct {
-	CategoryId       *string   `json:"categoryId"`
-	ParentTaskId     *string   `json:"parentTaskId"`
-	Name             *string   `json:"name"`
-	Description      *string   `json:"description"`
-	EstimatedMinutes *int16    `json:"estimatedMinutes"`
-	PriorityLevel    *int8     `json:"priorityLevel"`
-	DueDate          *string   `json:"dueDate"`
-	Users            *[]string `json:"users"`
-	ChildrenTasks    *[]string `json:"childrenTasks"`
+	CategoryId        *string        `json:"categoryId"`
+	ParentTaskId      *string        `json:"parentTaskId"`
+	Name              *string        `json:"name"`
+	Description       *string        `json:"description"`
+	DescriptionFormat *string        `json:"descriptionFormat"`
+	EstimatedMinutes  *int16         `json:"estimatedMinutes"`
+	PriorityLevel     *int8          `json:"priorityLevel"`
+	DueDate           *string        `json:"dueDate"`
+	Users             *[]string      `json:"users"`
+	ChildrenTasks     *[]string      `json:"childrenTasks"`
+	CustomFields      map[string]any `json:"customFields"`
 }
 
 func (r *UpdateTaskRequest) ToInput() taskservice.UpdateTaskInput {
@@ -57,6 +59,12 @@ func (r *UpdateTaskRequest) ToInput() taskservice.UpdateTaskInput {
 		priorityLevel = &p
 	}
 
+	var descriptionFormat *core.ContentFormats = nil
+	if r.DescriptionFormat != nil {
+		f := core.ContentFormats(*r.DescriptionFormat)
+		descriptionFormat = &f
+	}
+
 	var dueDate *core.DateTime = nil
 	if r.DueDate != nil {
 		d, err := core.NewDateTimeFromRFC3339(*r.DueDate)
@@ -67,16 +75,29 @@ func (r *UpdateTaskRequest) ToInput() taskservice.UpdateTaskInput {
 		}
 	}
 
+	var customFields []*taskservice.TaskCustomFieldValueInput = nil
+	if r.CustomFields != nil {
+		customFields = make([]*taskservice.TaskCustomFieldValueInput, 0, len(r.CustomFields))
+		for customFieldId, value := range r.CustomFields {
+			customFields = append(customFields, &taskservice.TaskCustomFieldValueInput{
+				CustomFieldIdentity: core.NewIdentityFromPublic(customFieldId),
+				Value:               value,
+			})
+		}
+	}
+
 	return taskservice.UpdateTaskInput{
 		StatusIdentity:     statusIdentity,
 		CategoryIdentity:   categoryIdentity,
 		ParentTaskIdentity: parentTaskIdentity,
 		Name:               r.Name,
 		Description:        r.Description,
+		DescriptionFormat:  descriptionFormat,
 		EstimatedMinutes:   r.EstimatedMinutes,
 		PriorityLevel:      priorityLevel,
 		DueDate:            dueDate,
 		Users:              users,
 		ChildrenTasks:      childrenTasks,
+		CustomFields:       customFields,
 	}
 }
@@ -9,8 +9,9 @@ import (
 )
 
 type UpdateTaskCommentRequest struct {
-	Content *string                `json:"content"`
-	Files   []multipart.FileHeader `json:"files"`
+	Content       *string                `json:"content"`
+	ContentFormat *string                `json:"contentFormat"`
+	Files         []multipart.FileHeader `json:"files"`
 }
 
 func (r *UpdateTaskCommentRequest) ToInput() taskservice.UpdateTaskCommentInput {
@@ -35,8 +36,15 @@ func (r *UpdateTaskCommentRequest) ToInput() taskservice.UpdateTaskCommentInput
 		}
 	}
 
+	var contentFormat *core.ContentFormats = nil
+	if r.ContentFormat != nil {
+		f := core.ContentFormats(*r.ContentFormat)
+		contentFormat = &f
+	}
+
 	return taskservice.UpdateTaskCommentInput{
-		Content: r.Content,
-		Files:   files,
+		Content:       r.Content,
+		ContentFormat: contentFormat,
+		Files:         files,
 	}
 }
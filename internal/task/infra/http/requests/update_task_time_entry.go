@@ -0,0 +1,34 @@
+package taskhttprequests
+
+import (
+	"github.com/gabrielmrtt/taski/internal/core"
+	taskservice "github.com/gabrielmrtt/taski/internal/task/services"
+)
+
+type UpdateTaskTimeEntryRequest struct {
+	Description *string `json:"description"`
+	StartedAt   *string `json:"startedAt"`
+	EndedAt     *string `json:"endedAt"`
+}
+
+func (r *UpdateTaskTimeEntryRequest) ToInput() taskservice.UpdateTaskTimeEntryInput {
+	var startedAt *core.DateTime = nil
+	if r.StartedAt != nil {
+		if d, err := core.NewDateTimeFromRFC3339(*r.StartedAt); err == nil {
+			startedAt = &d
+		}
+	}
+
+	var endedAt *core.DateTime = nil
+	if r.EndedAt != nil {
+		if d, err := core.NewDateTimeFromRFC3339(*r.EndedAt); err == nil {
+			endedAt = &d
+		}
+	}
+
+	return taskservice.UpdateTaskTimeEntryInput{
+		Description: r.Description,
+		StartedAt:   startedAt,
+		EndedAt:     endedAt,
+	}
+}
@@ -0,0 +1,53 @@
+package taskhttprequests
+
+import (
+	"github.com/gabrielmrtt/taski/internal/core"
+	"github.com/gabrielmrtt/taski/internal/task"
+	taskservice "github.com/gabrielmrtt/taski/internal/task/services"
+)
+
+type UpdateTaskViewRequest struct {
+	Name          *string            `json:"name"`
+	Visibility    *string            `json:"visibility"`
+	ProjectId     *string            `json:"projectId"`
+	Filters       *map[string]string `json:"filters"`
+	SortBy        *string            `json:"sortBy"`
+	SortDirection *string            `json:"sortDirection"`
+	GroupBy       *string            `json:"groupBy"`
+}
+
+func (r *UpdateTaskViewRequest) ToInput() taskservice.UpdateTaskViewInput {
+	var visibility *task.TaskViewVisibilities = nil
+	if r.Visibility != nil {
+		value := task.TaskViewVisibilities(*r.Visibility)
+		visibility = &value
+	}
+
+	var projectIdentity *core.Identity = nil
+	if r.ProjectId != nil {
+		identity := core.NewIdentityFromPublic(*r.ProjectId)
+		projectIdentity = &identity
+	}
+
+	var sortDirection *core.SortDirection = nil
+	if r.SortDirection != nil {
+		direction := core.SortDirection(*r.SortDirection)
+		sortDirection = &direction
+	}
+
+	var groupBy *task.TaskGroupByFields = nil
+	if r.GroupBy != nil {
+		field := task.TaskGroupByFields(*r.GroupBy)
+		groupBy = &field
+	}
+
+	return taskservice.UpdateTaskViewInput{
+		Name:            r.Name,
+		Visibility:      visibility,
+		ProjectIdentity: projectIdentity,
+		Filters:         r.Filters,
+		SortBy:          r.SortBy,
+		SortDirection:   sortDirection,
+		GroupBy:         groupBy,
+	}
+}
@@ -0,0 +1,199 @@
+package taskhttp
+
+import (
+	"net/http"
+
+	authhttpmiddlewares "github.com/gabrielmrtt/taski/internal/auth/infra/http/middlewares"
+	"github.com/gabrielmrtt/taski/internal/core"
+	corehttp "github.com/gabrielmrtt/taski/internal/core/http"
+	organizationhttpmiddlewares "github.com/gabrielmrtt/taski/internal/organization/infra/http/middlewares"
+	"github.com/gabrielmrtt/taski/internal/task"
+	taskhttprequests "github.com/gabrielmrtt/taski/internal/task/infra/http/requests"
+	taskservice "github.com/gabrielmrtt/taski/internal/task/services"
+	"github.com/gin-gonic/gin"
+)
+
+type TaskCalendarFeedHandler struct {
+	ListTaskCalendarFeedsService      *taskservice.ListTaskCalendarFeedsService
+	CreateTaskCalendarFeedService     *taskservice.CreateTaskCalendarFeedService
+	RevokeTaskCalendarFeedService     *taskservice.RevokeTaskCalendarFeedService
+	GetTaskCalendarFeedContentService *taskservice.GetTaskCalendarFeedContentService
+}
+
+func NewTaskCalendarFeedHandler(
+	listTaskCalendarFeedsService *taskservice.ListTaskCalendarFeedsService,
+	createTaskCalendarFeedService *taskservice.CreateTaskCalendarFeedService,
+	revokeTaskCalendarFeedService *taskservice.RevokeTaskCalendarFeedService,
+	getTaskCalendarFeedContentService *taskservice.GetTaskCalendarFeedContentService,
+) *TaskCalendarFeedHandler {
+	return &TaskCalendarFeedHandler{
+		ListTaskCalendarFeedsService:      listTaskCalendarFeedsService,
+		CreateTaskCalendarFeedService:     createTaskCalendarFeedService,
+		RevokeTaskCalendarFeedService:     revokeTaskCalendarFeedService,
+		GetTaskCalendarFeedContentService: getTaskCalendarFeedContentService,
+	}
+}
+
+type ListTaskCalendarFeedsResponse = corehttp.HttpSuccessResponseWithData[[]task.TaskCalendarFeedDto]
+
+// ListTaskCalendarFeeds godoc
+// @Summary List task calendar feeds
+// @Description Returns the active calendar feeds of the authenticated user in the organization. Feed URLs are only shown when a feed is created.
+// @Tags Task Calendar Feed
+// @Accept json
+// @Param request query taskhttprequests.ListTaskCalendarFeedsRequest true "Query parameters"
+// @Produce json
+// @Success 200 {object} ListTaskCalendarFeedsResponse
+// @Failure 400 {object} corehttp.HttpErrorResponse
+// @Failure 401 {object} corehttp.HttpErrorResponse
+// @Failure 403 {object} corehttp.HttpErrorResponse
+// @Failure 500 {object} corehttp.HttpErrorResponse
+// @Router /task-calendar-feed [get]
+func (h *TaskCalendarFeedHandler) ListTaskCalendarFeeds(c *gin.Context) {
+	var request taskhttprequests.ListTaskCalendarFeedsRequest
+	var organizationIdentity *core.Identity = authhttpmiddlewares.GetAuthenticatedUserLastAccessedOrganizationIdentity(c)
+	var authenticatedUserIdentity *core.Identity = authhttpmiddlewares.GetAuthenticatedUserIdentity(c)
+	var input taskservice.ListTaskCalendarFeedsInput
+
+	if err := request.FromQuery(c); err != nil {
+		corehttp.NewHttpErrorResponse(c, err)
+		return
+	}
+
+	input = request.ToInput()
+	input.OrganizationIdentity = organizationIdentity
+	input.UserOwnerIdentity = *authenticatedUserIdentity
+	response, err := h.ListTaskCalendarFeedsService.Execute(input)
+	if err != nil {
+		corehttp.NewHttpErrorResponse(c, err)
+		return
+	}
+
+	corehttp.NewHttpSuccessResponseWithData(c, http.StatusOK, &response)
+}
+
+type CreateTaskCalendarFeedResponse = corehttp.HttpSuccessResponseWithData[task.TaskCalendarFeedDto]
+
+// CreateTaskCalendarFeed godoc
+// @Summary Create a task calendar feed
+// @Description Creates a secret iCalendar feed URL with the due dates of the tasks the authenticated user can see. Feeds can be limited to a project. The URL is only shown once, in this response, since only a hash of its token is stored.
+// @Tags Task Calendar Feed
+// @Accept json
+// @Param request body taskhttprequests.CreateTaskCalendarFeedRequest true "Request body"
+// @Produce json
+// @Success 200 {object} CreateTaskCalendarFeedResponse
+// @Failure 400 {object} corehttp.HttpErrorResponse
+// @Failure 401 {object} corehttp.HttpErrorResponse
+// @Failure 403 {object} corehttp.HttpErrorResponse
+// @Failure 404 {object} corehttp.HttpErrorResponse
+// @Failure 500 {object} corehttp.HttpErrorResponse
+// @Router /task-calendar-feed [post]
+func (h *TaskCalendarFeedHandler) CreateTaskCalendarFeed(c *gin.Context) {
+	var request taskhttprequests.CreateTaskCalendarFeedRequest
+	var organizationIdentity *core.Identity = authhttpmiddlewares.GetAuthenticatedUserLastAccessedOrganizationIdentity(c)
+	var authenticatedUserIdentity *core.Identity = authhttpmiddlewares.GetAuthenticatedUserIdentity(c)
+	var input taskservice.CreateTaskCalendarFeedInput
+
+	if err := c.ShouldBindJSON(&request); err != nil {
+		corehttp.NewHttpErrorResponse(c, err)
+		return
+	}
+
+	input = request.ToInput()
+	input.OrganizationIdentity = organizationIdentity
+	input.UserOwnerIdentity = *authenticatedUserIdentity
+	response, err := h.CreateTaskCalendarFeedService.Execute(input)
+	if err != nil {
+		corehttp.NewHttpErrorResponse(c, err)
+		return
+	}
+
+	corehttp.NewHttpSuccessResponseWithData(c, http.StatusOK, response)
+}
+
+type RevokeTaskCalendarFeedResponse = corehttp.EmptyHttpSuccessResponse
+
+// RevokeTaskCalendarFeed godoc
+// @Summary Revoke a task calendar feed
+// @Description Revokes a calendar feed owned by the authenticated user. Its URL stops working immediately.
+// @Tags Task Calendar Feed
+// @Accept json
+// @Param feedId path string true "Task Calendar Feed ID"
+// @Produce json
+// @Success 200 {object} RevokeTaskCalendarFeedResponse
+// @Failure 400 {object} corehttp.HttpErrorResponse
+// @Failure 401 {object} corehttp.HttpErrorResponse
+// @Failure 403 {object} corehttp.HttpErrorResponse
+// @Failure 404 {object} corehttp.HttpErrorResponse
+// @Failure 500 {object} corehttp.HttpErrorResponse
+// @Router /task-calendar-feed/:feedId [delete]
+func (h *TaskCalendarFeedHandler) RevokeTaskCalendarFeed(c *gin.Context) {
+	var organizationIdentity *core.Identity = authhttpmiddlewares.GetAuthenticatedUserLastAccessedOrganizationIdentity(c)
+	var authenticatedUserIdentity *core.Identity = authhttpmiddlewares.GetAuthenticatedUserIdentity(c)
+	var input taskservice.RevokeTaskCalendarFeedInput = taskservice.RevokeTaskCalendarFeedInput{
+		OrganizationIdentity:     organizationIdentity,
+		TaskCalendarFeedIdentity: core.NewIdentityFromPublic(c.Param("feedId")),
+		UserIdentity:             *authenticatedUserIdentity,
+	}
+
+	err := h.RevokeTaskCalendarFeedService.Execute(input)
+	if err != nil {
+		corehttp.NewHttpErrorResponse(c, err)
+		return
+	}
+
+	corehttp.NewEmptyHttpSuccessResponse(c, http.StatusOK)
+}
+
+// GetTaskCalendarFeedContent godoc
+// @Summary Get a task calendar feed
+// @Description Returns the iCalendar document of a feed. The secret token in the URL authenticates the request, so calendar apps can subscribe to it.
+// @Tags Task Calendar Feed
+// @Param token path string true "Feed token"
+// @Param request query taskhttprequests.GetTaskCalendarFeedContentRequest true "Query parameters"
+// @Produce text/calendar
+// @Success 200 {string} string
+// @Failure 400 {object} corehttp.HttpErrorResponse
+// @Failure 403 {object} corehttp.HttpErrorResponse
+// @Failure 404 {object} corehttp.HttpErrorResponse
+// @Failure 500 {object} corehttp.HttpErrorResponse
+// @Router /task-calendar-feed/:token/feed.ics [get]
+func (h *TaskCalendarFeedHandler) GetTaskCalendarFeedContent(c *gin.Context) {
+	var request taskhttprequests.GetTaskCalendarFeedContentRequest
+	var input taskservice.GetTaskCalendarFeedContentInput
+
+	if err := request.FromQuery(c); err != nil {
+		corehttp.NewHttpErrorResponse(c, err)
+		return
+	}
+
+	input = request.ToInput()
+	input.Token = c.Param("token")
+	content, err := h.GetTaskCalendarFeedContentService.Execute(input)
+	if err != nil {
+		corehttp.NewHttpErrorResponse(c, err)
+		return
+	}
+
+	c.Header("Content-Disposition", "inline; filename=feed.ics")
+	c.Data(http.StatusOK, "text/calendar; charset=utf-8", []byte(content))
+}
+
+func (h *TaskCalendarFeedHandler) ConfigureRoutes(options corehttp.ConfigureRoutesOptions) *gin.RouterGroup {
+	middlewareOptions := corehttp.MiddlewareOptions{
+		DbConnection: options.DbConnection,
+	}
+
+	// Calendar apps cannot send bearer tokens, so the feed itself is only protected by its secret token.
+	options.RouterGroup.GET("/task-calendar-feed/:token/feed.ics", h.GetTaskCalendarFeedContent)
+
+	g := options.RouterGroup.Group("/task-calendar-feed")
+	{
+		g.Use(authhttpmiddlewares.AuthMiddleware(middlewareOptions))
+		g.GET("", organizationhttpmiddlewares.UserMustHavePermission("tasks:view", middlewareOptions), h.ListTaskCalendarFeeds)
+		g.POST("", organizationhttpmiddlewares.UserMustHavePermission("tasks:view", middlewareOptions), h.CreateTaskCalendarFeed)
+		g.DELETE("/:feedId", organizationhttpmiddlewares.UserMustHavePermission("tasks:view", middlewareOptions), h.RevokeTaskCalendarFeed)
+	}
+
+	return g
+}
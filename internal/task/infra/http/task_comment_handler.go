@@ -14,10 +14,15 @@ import (
 )
 
 type TaskCommentHandler struct {
-	ListTaskCommentsService  *taskservice.ListTaskCommentsService
-	CreateTaskCommentService *taskservice.CreateTaskCommentService
-	UpdateTaskCommentService *taskservice.UpdateTaskCommentService
-	DeleteTaskCommentService *taskservice.DeleteTaskCommentService
+	ListTaskCommentsService          *taskservice.ListTaskCommentsService
+	CreateTaskCommentService         *taskservice.CreateTaskCommentService
+	UpdateTaskCommentService         *taskservice.UpdateTaskCommentService
+	DeleteTaskCommentService         *taskservice.DeleteTaskCommentService
+	ResolveTaskCommentService        *taskservice.ResolveTaskCommentService
+	UnresolveTaskCommentService      *taskservice.UnresolveTaskCommentService
+	AddTaskCommentReactionService    *taskservice.AddTaskCommentReactionService
+	RemoveTaskCommentReactionService *taskservice.RemoveTaskCommentReactionService
+	ListTaskCommentVersionsService   *taskservice.ListTaskCommentVersionsService
 }
 
 func NewTaskCommentHandler(
@@ -25,12 +30,22 @@ func NewTaskCommentHandler(
 	createTaskCommentService *taskservice.CreateTaskCommentService,
 	updateTaskCommentService *taskservice.UpdateTaskCommentService,
 	deleteTaskCommentService *taskservice.DeleteTaskCommentService,
+	resolveTaskCommentService *taskservice.ResolveTaskCommentService,
+	unresolveTaskCommentService *taskservice.UnresolveTaskCommentService,
+	addTaskCommentReactionService *taskservice.AddTaskCommentReactionService,
+	removeTaskCommentReactionService *taskservice.RemoveTaskCommentReactionService,
+	listTaskCommentVersionsService *taskservice.ListTaskCommentVersionsService,
 ) *TaskCommentHandler {
 	return &TaskCommentHandler{
-		ListTaskCommentsService:  listTaskCommentsService,
-		CreateTaskCommentService: createTaskCommentService,
-		UpdateTaskCommentService: updateTaskCommentService,
-		DeleteTaskCommentService: deleteTaskCommentService,
+		ListTaskCommentsService:          listTaskCommentsService,
+		CreateTaskCommentService:         createTaskCommentService,
+		UpdateTaskCommentService:         updateTaskCommentService,
+		DeleteTaskCommentService:         deleteTaskCommentService,
+		ResolveTaskCommentService:        resolveTaskCommentService,
+		UnresolveTaskCommentService:      unresolveTaskCommentService,
+		AddTaskCommentReactionService:    addTaskCommentReactionService,
+		RemoveTaskCommentReactionService: removeTaskCommentReactionService,
+		ListTaskCommentVersionsService:   listTaskCommentVersionsService,
 	}
 }
 
@@ -38,7 +53,7 @@ type ListTaskCommentsResponse = corehttp.HttpSuccessResponseWithData[core.Pagina
 
 // ListTaskComments godoc
 // @Summary List task comments
-// @Description Returns all accessible task comments by the authenticated user.
+// @Description Returns all accessible task comments by the authenticated user. With threaded, only comment threads are listed, each one with its replies.
 // @Tags Task
 // @Accept json
 // @Param taskId path string true "Task ID"
@@ -52,6 +67,7 @@ type ListTaskCommentsResponse = corehttp.HttpSuccessResponseWithData[core.Pagina
 // @Router /task/:taskId/comment [get]
 func (h *TaskCommentHandler) ListTaskComments(c *gin.Context) {
 	var request taskhttprequests.ListTaskCommentsRequest
+	var authenticatedUserIdentity *core.Identity = authhttpmiddlewares.GetAuthenticatedUserIdentity(c)
 	var taskIdentity core.Identity = core.NewIdentityFromPublic(c.Param("taskId"))
 	var input taskservice.ListTaskCommentsInput
 
@@ -62,6 +78,7 @@ func (h *TaskCommentHandler) ListTaskComments(c *gin.Context) {
 
 	input = request.ToInput()
 	input.TaskIdentity = taskIdentity
+	input.UserViewerIdentity = authenticatedUserIdentity
 	response1, err := h.ListTaskCommentsService.Execute(input)
 	if err != nil {
 		corehttp.NewHttpErrorResponse(c, err)
@@ -75,7 +92,7 @@ type CreateTaskCommentResponse = corehttp.HttpSuccessResponseWithData[task.TaskC
 
 // CreateTaskComment godoc
 // @Summary Create a task comment
-// @Description Creates a new task comment.
+// @Description Creates a new task comment. With parentCommentId, the comment is posted as a reply to that comment thread.
 // @Tags Task
 // @Accept json
 // @Param taskId path string true "Task ID"
@@ -89,6 +106,7 @@ type CreateTaskCommentResponse = corehttp.HttpSuccessResponseWithData[task.TaskC
 // @Router /task/:taskId/comment [post]
 func (h *TaskCommentHandler) CreateTaskComment(c *gin.Context) {
 	var request taskhttprequests.CreateTaskCommentRequest
+	var authenticatedUserIdentity *core.Identity = authhttpmiddlewares.GetAuthenticatedUserIdentity(c)
 	var taskIdentity core.Identity = core.NewIdentityFromPublic(c.Param("taskId"))
 	var input taskservice.CreateTaskCommentInput
 
@@ -99,6 +117,7 @@ func (h *TaskCommentHandler) CreateTaskComment(c *gin.Context) {
 
 	input = request.ToInput()
 	input.TaskIdentity = taskIdentity
+	input.AuthorIdentity = *authenticatedUserIdentity
 	response, err := h.CreateTaskCommentService.Execute(input)
 	if err != nil {
 		corehttp.NewHttpErrorResponse(c, err)
@@ -128,6 +147,7 @@ type UpdateTaskCommentResponse = corehttp.HttpSuccessResponseWithData[task.TaskC
 // @Router /task/:taskId/comment/:commentId [put]
 func (h *TaskCommentHandler) UpdateTaskComment(c *gin.Context) {
 	var request taskhttprequests.UpdateTaskCommentRequest
+	var authenticatedUserIdentity *core.Identity = authhttpmiddlewares.GetAuthenticatedUserIdentity(c)
 	var taskIdentity core.Identity = core.NewIdentityFromPublic(c.Param("taskId"))
 	var commentIdentity core.Identity = core.NewIdentityFromPublic(c.Param("commentId"))
 	var input taskservice.UpdateTaskCommentInput
@@ -140,6 +160,7 @@ func (h *TaskCommentHandler) UpdateTaskComment(c *gin.Context) {
 	input = request.ToInput()
 	input.TaskIdentity = taskIdentity
 	input.TaskCommentIdentity = commentIdentity
+	input.UserEditorIdentity = *authenticatedUserIdentity
 	err := h.UpdateTaskCommentService.Execute(input)
 	if err != nil {
 		corehttp.NewHttpErrorResponse(c, err)
@@ -153,7 +174,7 @@ type DeleteTaskCommentResponse = corehttp.EmptyHttpSuccessResponse
 
 // DeleteTaskComment godoc
 // @Summary Delete a task comment
-// @Description Deletes an accessible task comment.
+// @Description Deletes an accessible task comment. The comment is kept as a tombstone, so its replies and edit history survive.
 // @Tags Task
 // @Accept json
 // @Param taskId path string true "Task ID"
@@ -166,11 +187,13 @@ type DeleteTaskCommentResponse = corehttp.EmptyHttpSuccessResponse
 // @Failure 500 {object} corehttp.HttpErrorResponse
 // @Router /task/:taskId/comment/:commentId [delete]
 func (h *TaskCommentHandler) DeleteTaskComment(c *gin.Context) {
+	var authenticatedUserIdentity *core.Identity = authhttpmiddlewares.GetAuthenticatedUserIdentity(c)
 	var taskIdentity core.Identity = core.NewIdentityFromPublic(c.Param("taskId"))
 	var commentIdentity core.Identity = core.NewIdentityFromPublic(c.Param("commentId"))
 	var input taskservice.DeleteTaskCommentInput = taskservice.DeleteTaskCommentInput{
 		TaskIdentity:        taskIdentity,
 		TaskCommentIdentity: commentIdentity,
+		UserDeleterIdentity: *authenticatedUserIdentity,
 	}
 
 	err := h.DeleteTaskCommentService.Execute(input)
@@ -182,6 +205,182 @@ func (h *TaskCommentHandler) DeleteTaskComment(c *gin.Context) {
 	corehttp.NewEmptyHttpSuccessResponse(c, http.StatusOK)
 }
 
+type ResolveTaskCommentResponse = corehttp.EmptyHttpSuccessResponse
+
+// ResolveTaskComment godoc
+// @Summary Resolve a task comment thread
+// @Description Marks an accessible task comment thread as resolved. Replies cannot be resolved.
+// @Tags Task
+// @Accept json
+// @Param taskId path string true "Task ID"
+// @Param commentId path string true "Comment ID"
+// @Produce json
+// @Success 200 {object} ResolveTaskCommentResponse
+// @Failure 400 {object} corehttp.HttpErrorResponse
+// @Failure 401 {object} corehttp.HttpErrorResponse
+// @Failure 403 {object} corehttp.HttpErrorResponse
+// @Failure 404 {object} corehttp.HttpErrorResponse
+// @Failure 409 {object} corehttp.HttpErrorResponse
+// @Failure 500 {object} corehttp.HttpErrorResponse
+// @Router /task/:taskId/comment/:commentId/resolve [patch]
+func (h *TaskCommentHandler) ResolveTaskComment(c *gin.Context) {
+	var authenticatedUserIdentity *core.Identity = authhttpmiddlewares.GetAuthenticatedUserIdentity(c)
+	var input taskservice.ResolveTaskCommentInput = taskservice.ResolveTaskCommentInput{
+		TaskIdentity:         core.NewIdentityFromPublic(c.Param("taskId")),
+		TaskCommentIdentity:  core.NewIdentityFromPublic(c.Param("commentId")),
+		UserResolverIdentity: *authenticatedUserIdentity,
+	}
+
+	err := h.ResolveTaskCommentService.Execute(input)
+	if err != nil {
+		corehttp.NewHttpErrorResponse(c, err)
+		return
+	}
+
+	corehttp.NewEmptyHttpSuccessResponse(c, http.StatusOK)
+}
+
+type UnresolveTaskCommentResponse = corehttp.EmptyHttpSuccessResponse
+
+// UnresolveTaskComment godoc
+// @Summary Unresolve a task comment thread
+// @Description Reopens a resolved task comment thread.
+// @Tags Task
+// @Accept json
+// @Param taskId path string true "Task ID"
+// @Param commentId path string true "Comment ID"
+// @Produce json
+// @Success 200 {object} UnresolveTaskCommentResponse
+// @Failure 400 {object} corehttp.HttpErrorResponse
+// @Failure 401 {object} corehttp.HttpErrorResponse
+// @Failure 403 {object} corehttp.HttpErrorResponse
+// @Failure 404 {object} corehttp.HttpErrorResponse
+// @Failure 409 {object} corehttp.HttpErrorResponse
+// @Failure 500 {object} corehttp.HttpErrorResponse
+// @Router /task/:taskId/comment/:commentId/unresolve [patch]
+func (h *TaskCommentHandler) UnresolveTaskComment(c *gin.Context) {
+	var authenticatedUserIdentity *core.Identity = authhttpmiddlewares.GetAuthenticatedUserIdentity(c)
+	var input taskservice.UnresolveTaskCommentInput = taskservice.UnresolveTaskCommentInput{
+		TaskIdentity:        core.NewIdentityFromPublic(c.Param("taskId")),
+		TaskCommentIdentity: core.NewIdentityFromPublic(c.Param("commentId")),
+		UserEditorIdentity:  *authenticatedUserIdentity,
+	}
+
+	err := h.UnresolveTaskCommentService.Execute(input)
+	if err != nil {
+		corehttp.NewHttpErrorResponse(c, err)
+		return
+	}
+
+	corehttp.NewEmptyHttpSuccessResponse(c, http.StatusOK)
+}
+
+type AddTaskCommentReactionResponse = corehttp.EmptyHttpSuccessResponse
+
+// AddTaskCommentReaction godoc
+// @Summary React to a task comment
+// @Description Adds an emoji reaction of the authenticated user to an accessible task comment. Reacting again with the same emoji has no effect.
+// @Tags Task
+// @Accept json
+// @Param taskId path string true "Task ID"
+// @Param commentId path string true "Comment ID"
+// @Param emoji path string true "Emoji"
+// @Produce json
+// @Success 200 {object} AddTaskCommentReactionResponse
+// @Failure 400 {object} corehttp.HttpErrorResponse
+// @Failure 401 {object} corehttp.HttpErrorResponse
+// @Failure 403 {object} corehttp.HttpErrorResponse
+// @Failure 404 {object} corehttp.HttpErrorResponse
+// @Failure 500 {object} corehttp.HttpErrorResponse
+// @Router /task/:taskId/comment/:commentId/reaction/:emoji [put]
+func (h *TaskCommentHandler) AddTaskCommentReaction(c *gin.Context) {
+	var authenticatedUserIdentity *core.Identity = authhttpmiddlewares.GetAuthenticatedUserIdentity(c)
+	var input taskservice.AddTaskCommentReactionInput = taskservice.AddTaskCommentReactionInput{
+		TaskIdentity:        core.NewIdentityFromPublic(c.Param("taskId")),
+		TaskCommentIdentity: core.NewIdentityFromPublic(c.Param("commentId")),
+		Emoji:               c.Param("emoji"),
+		UserIdentity:        *authenticatedUserIdentity,
+	}
+
+	err := h.AddTaskCommentReactionService.Execute(input)
+	if err != nil {
+		corehttp.NewHttpErrorResponse(c, err)
+		return
+	}
+
+	corehttp.NewEmptyHttpSuccessResponse(c, http.StatusOK)
+}
+
+type RemoveTaskCommentReactionResponse = corehttp.EmptyHttpSuccessResponse
+
+// RemoveTaskCommentReaction godoc
+// @Summary Remove a task comment reaction
+// @Description Removes an emoji reaction of the authenticated user from an accessible task comment.
+// @Tags Task
+// @Accept json
+// @Param taskId path string true "Task ID"
+// @Param commentId path string true "Comment ID"
+// @Param emoji path string true "Emoji"
+// @Produce json
+// @Success 200 {object} RemoveTaskCommentReactionResponse
+// @Failure 400 {object} corehttp.HttpErrorResponse
+// @Failure 401 {object} corehttp.HttpErrorResponse
+// @Failure 403 {object} corehttp.HttpErrorResponse
+// @Failure 404 {object} corehttp.HttpErrorResponse
+// @Failure 500 {object} corehttp.HttpErrorResponse
+// @Router /task/:taskId/comment/:commentId/reaction/:emoji [delete]
+func (h *TaskCommentHandler) RemoveTaskCommentReaction(c *gin.Context) {
+	var authenticatedUserIdentity *core.Identity = authhttpmiddlewares.GetAuthenticatedUserIdentity(c)
+	var input taskservice.RemoveTaskCommentReactionInput = taskservice.RemoveTaskCommentReactionInput{
+		TaskIdentity:        core.NewIdentityFromPublic(c.Param("taskId")),
+		TaskCommentIdentity: core.NewIdentityFromPublic(c.Param("commentId")),
+		Emoji:               c.Param("emoji"),
+		UserIdentity:        *authenticatedUserIdentity,
+	}
+
+	err := h.RemoveTaskCommentReactionService.Execute(input)
+	if err != nil {
+		corehttp.NewHttpErrorResponse(c, err)
+		return
+	}
+
+	corehttp.NewEmptyHttpSuccessResponse(c, http.StatusOK)
+}
+
+type ListTaskCommentVersionsResponse = corehttp.HttpSuccessResponseWithData[[]task.TaskCommentVersionDto]
+
+// ListTaskCommentVersions godoc
+// @Summary List task comment versions
+// @Description Returns the edit history of a task comment, oldest version first, including for deleted comments. Requires the tasks:comments:moderate permission.
+// @Tags Task
+// @Accept json
+// @Param taskId path string true "Task ID"
+// @Param commentId path string true "Comment ID"
+// @Produce json
+// @Success 200 {object} ListTaskCommentVersionsResponse
+// @Failure 400 {object} corehttp.HttpErrorResponse
+// @Failure 401 {object} corehttp.HttpErrorResponse
+// @Failure 403 {object} corehttp.HttpErrorResponse
+// @Failure 404 {object} corehttp.HttpErrorResponse
+// @Failure 500 {object} corehttp.HttpErrorResponse
+// @Router /task/:taskId/comment/:commentId/version [get]
+func (h *TaskCommentHandler) ListTaskCommentVersions(c *gin.Context) {
+	var organizationIdentity *core.Identity = authhttpmiddlewares.GetAuthenticatedUserLastAccessedOrganizationIdentity(c)
+	var input taskservice.ListTaskCommentVersionsInput = taskservice.ListTaskCommentVersionsInput{
+		OrganizationIdentity: organizationIdentity,
+		TaskIdentity:         core.NewIdentityFromPublic(c.Param("taskId")),
+		TaskCommentIdentity:  core.NewIdentityFromPublic(c.Param("commentId")),
+	}
+
+	response, err := h.ListTaskCommentVersionsService.Execute(input)
+	if err != nil {
+		corehttp.NewHttpErrorResponse(c, err)
+		return
+	}
+
+	corehttp.NewHttpSuccessResponseWithData(c, http.StatusOK, &response)
+}
+
 func (h *TaskCommentHandler) ConfigureRoutes(options corehttp.ConfigureRoutesOptions) *gin.RouterGroup {
 	middlewareOptions := corehttp.MiddlewareOptions{
 		DbConnection: options.DbConnection,
@@ -194,6 +393,11 @@ func (h *TaskCommentHandler) ConfigureRoutes(options corehttp.ConfigureRoutesOpt
 		g.POST("", organizationhttpmiddlewares.UserMustHavePermission("tasks:view", middlewareOptions), h.CreateTaskComment)
 		g.PUT("/:commentId", organizationhttpmiddlewares.UserMustHavePermission("tasks:view", middlewareOptions), h.UpdateTaskComment)
 		g.DELETE("/:commentId", organizationhttpmiddlewares.UserMustHavePermission("tasks:view", middlewareOptions), h.DeleteTaskComment)
+		g.GET("/:commentId/version", organizationhttpmiddlewares.UserMustHavePermission("tasks:comments:moderate", middlewareOptions), h.ListTaskCommentVersions)
+		g.PATCH("/:commentId/resolve", organizationhttpmiddlewares.UserMustHavePermission("tasks:view", middlewareOptions), h.ResolveTaskComment)
+		g.PATCH("/:commentId/unresolve", organizationhttpmiddlewares.UserMustHavePermission("tasks:view", middlewareOptions), h.UnresolveTaskComment)
+		g.PUT("/:commentId/reaction/:emoji", organizationhttpmiddlewares.UserMustHavePermission("tasks:view", middlewareOptions), h.AddTaskCommentReaction)
+		g.DELETE("/:commentId/reaction/:emoji", organizationhttpmiddlewares.UserMustHavePermission("tasks:view", middlewareOptions), h.RemoveTaskCommentReaction)
 	}
 
 	return g
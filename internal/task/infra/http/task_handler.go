@@ -15,18 +15,22 @@ import (
 )
 
 type TaskHandler struct {
-	ListTasksService        *taskservice.ListTasksService
-	GetTaskService          *taskservice.GetTaskService
-	CreateTaskService       *taskservice.CreateTaskService
-	UpdateTaskService       *taskservice.UpdateTaskService
-	DeleteTaskService       *taskservice.DeleteTaskService
-	AddSubTaskService       *taskservice.AddSubTaskService
-	UpdateSubTaskService    *taskservice.UpdateSubTaskService
-	RemoveSubTaskService    *taskservice.RemoveSubTaskService
-	ChangeTaskStatusService *taskservice.ChangeTaskStatusService
-	CompleteTaskService     *taskservice.CompleteTaskService
-	CompleteSubTaskService  *taskservice.CompleteSubTaskService
-	GetTaskHistoryService   *taskservice.GetTaskHistoryService
+	ListTasksService                   *taskservice.ListTasksService
+	GetTaskService                     *taskservice.GetTaskService
+	CreateTaskService                  *taskservice.CreateTaskService
+	UpdateTaskService                  *taskservice.UpdateTaskService
+	DeleteTaskService                  *taskservice.DeleteTaskService
+	AddSubTaskService                  *taskservice.AddSubTaskService
+	UpdateSubTaskService               *taskservice.UpdateSubTaskService
+	RemoveSubTaskService               *taskservice.RemoveSubTaskService
+	ChangeTaskStatusService            *taskservice.ChangeTaskStatusService
+	CompleteTaskService                *taskservice.CompleteTaskService
+	CompleteSubTaskService             *taskservice.CompleteSubTaskService
+	GetTaskHistoryService              *taskservice.GetTaskHistoryService
+	GetTaskAvailableTransitionsService *taskservice.GetTaskAvailableTransitionsService
+	GetTaskViewService                 *taskservice.GetTaskViewService
+	BulkUpdateTasksService             *taskservice.BulkUpdateTasksService
+	ImportTasksService                 *taskservice.ImportTasksService
 }
 
 func NewTaskHandler(
@@ -42,20 +46,28 @@ func NewTaskHandler(
 	completeTaskService *taskservice.CompleteTaskService,
 	completeSubTaskService *taskservice.CompleteSubTaskService,
 	getTaskHistoryService *taskservice.GetTaskHistoryService,
+	getTaskAvailableTransitionsService *taskservice.GetTaskAvailableTransitionsService,
+	getTaskViewService *taskservice.GetTaskViewService,
+	bulkUpdateTasksService *taskservice.BulkUpdateTasksService,
+	importTasksService *taskservice.ImportTasksService,
 ) *TaskHandler {
 	return &TaskHandler{
-		ListTasksService:        listTasksService,
-		GetTaskService:          getTaskService,
-		CreateTaskService:       createTaskService,
-		UpdateTaskService:       updateTaskService,
-		DeleteTaskService:       deleteTaskService,
-		AddSubTaskService:       addSubTaskService,
-		UpdateSubTaskService:    updateSubTaskService,
-		RemoveSubTaskService:    removeSubTaskService,
-		ChangeTaskStatusService: changeTaskStatusService,
-		CompleteTaskService:     completeTaskService,
-		CompleteSubTaskService:  completeSubTaskService,
-		GetTaskHistoryService:   getTaskHistoryService,
+		ListTasksService:                   listTasksService,
+		GetTaskService:                     getTaskService,
+		CreateTaskService:                  createTaskService,
+		UpdateTaskService:                  updateTaskService,
+		DeleteTaskService:                  deleteTaskService,
+		AddSubTaskService:                  addSubTaskService,
+		UpdateSubTaskService:               updateSubTaskService,
+		RemoveSubTaskService:               removeSubTaskService,
+		ChangeTaskStatusService:            changeTaskStatusService,
+		CompleteTaskService:                completeTaskService,
+		CompleteSubTaskService:             completeSubTaskService,
+		GetTaskHistoryService:              getTaskHistoryService,
+		GetTaskAvailableTransitionsService: getTaskAvailableTransitionsService,
+		GetTaskViewService:                 getTaskViewService,
+		BulkUpdateTasksService:             bulkUpdateTasksService,
+		ImportTasksService:                 importTasksService,
 	}
 }
 
@@ -63,7 +75,7 @@ type ListTasksResponse = corehttp.HttpSuccessResponseWithData[core.PaginationOut
 
 // ListTasks godoc
 // @Summary List tasks
-// @Description Returns all accessible tasks by the authenticated user.
+// @Description Returns all accessible tasks by the authenticated user. When a saved view is given its filters, sorting and grouping are applied and any other query parameter overrides them. The filter parameter accepts a boolean expression such as priority>=3 and (status in [pts_a, pts_b] or due<now+7d).
 // @Tags Task
 // @Accept json
 // @Param request query taskhttprequests.ListTasksRequest true "Query parameters"
@@ -86,6 +98,29 @@ func (h *TaskHandler) ListTasks(c *gin.Context) {
 		return
 	}
 
+	if request.View != nil && *request.View != "" {
+		taskView, err := h.GetTaskViewService.Execute(taskservice.GetTaskViewInput{
+			OrganizationIdentity: organizationIdentity,
+			TaskViewIdentity:     core.NewIdentityFromPublic(*request.View),
+			UserIdentity:         *authenticatedUserIdentity,
+		})
+		if err != nil {
+			corehttp.NewHttpErrorResponse(c, err)
+			return
+		}
+
+		if err := request.ApplyView(taskView, c); err != nil {
+			corehttp.NewHttpErrorResponse(c, err)
+			return
+		}
+	}
+
+	fieldset, err := corehttp.GetSparseFieldset[task.TaskDto](request.Fields)
+	if err != nil {
+		corehttp.NewHttpErrorResponse(c, err)
+		return
+	}
+
 	input = request.ToInput()
 	input.Filters.OrganizationIdentity = organizationIdentity
 	input.Filters.AuthenticatedUserIdentity = authenticatedUserIdentity
@@ -95,19 +130,27 @@ func (h *TaskHandler) ListTasks(c *gin.Context) {
 		return
 	}
 
-	corehttp.NewHttpSuccessResponseWithData(c, http.StatusOK, result)
+	data, err := corehttp.SelectPaginationFields(result, fieldset)
+	if err != nil {
+		corehttp.NewHttpErrorResponse(c, err)
+		return
+	}
+
+	corehttp.NewHttpSuccessResponseWithData(c, http.StatusOK, &data)
 }
 
 type GetTaskResponse = corehttp.HttpSuccessResponseWithData[task.TaskDto]
 
 // GetTask godoc
 // @Summary Get a task
-// @Description Returns an accessible task by its ID.
+// @Description Returns an accessible task by its ID. The fields parameter trims the task to a comma separated list of its attributes.
 // @Tags Task
 // @Accept json
 // @Param taskId path string true "Task ID"
+// @Param request query taskhttprequests.GetTaskRequest false "Query parameters"
 // @Produce json
 // @Success 200 {object} GetTaskResponse
+// @Header 200 {string} ETag "Revision of the task"
 // @Failure 400 {object} corehttp.HttpErrorResponse
 // @Failure 401 {object} corehttp.HttpErrorResponse
 // @Failure 403 {object} corehttp.HttpErrorResponse
@@ -125,6 +168,12 @@ func (h *TaskHandler) GetTask(c *gin.Context) {
 		return
 	}
 
+	fieldset, err := corehttp.GetSparseFieldset[task.TaskDto](request.Fields)
+	if err != nil {
+		corehttp.NewHttpErrorResponse(c, err)
+		return
+	}
+
 	input = request.ToInput()
 	input.TaskIdentity = taskIdentity
 	input.OrganizationIdentity = organizationIdentity
@@ -134,7 +183,15 @@ func (h *TaskHandler) GetTask(c *gin.Context) {
 		return
 	}
 
-	corehttp.NewHttpSuccessResponseWithData(c, http.StatusOK, result)
+	corehttp.SetETag(c, result.Revision)
+
+	data, err := corehttp.SelectFields(result, fieldset)
+	if err != nil {
+		corehttp.NewHttpErrorResponse(c, err)
+		return
+	}
+
+	corehttp.NewHttpSuccessResponseWithData(c, http.StatusOK, &data)
 }
 
 type CreateTaskResponse = corehttp.HttpSuccessResponseWithData[task.TaskDto]
@@ -188,12 +245,14 @@ type UpdateTaskResponse = corehttp.EmptyHttpSuccessResponse
 // @Accept json
 // @Param taskId path string true "Task ID"
 // @Param request body taskhttprequests.UpdateTaskRequest true "Request body"
+// @Param If-Match header string false "ETag the task was read with"
 // @Produce json
 // @Success 200 {object} UpdateTaskResponse
 // @Failure 400 {object} corehttp.HttpErrorResponse
 // @Failure 401 {object} corehttp.HttpErrorResponse
 // @Failure 403 {object} corehttp.HttpErrorResponse
 // @Failure 404 {object} corehttp.HttpErrorResponse
+// @Failure 412 {object} corehttp.HttpErrorResponse
 // @Failure 500 {object} corehttp.HttpErrorResponse
 // @Router /task/:taskId [put]
 func (h *TaskHandler) UpdateTask(c *gin.Context) {
@@ -208,12 +267,19 @@ func (h *TaskHandler) UpdateTask(c *gin.Context) {
 		return
 	}
 
+	expectedRevision, err := corehttp.GetIfMatchRevision(c)
+	if err != nil {
+		corehttp.NewHttpErrorResponse(c, err)
+		return
+	}
+
 	input = request.ToInput()
 	input.OrganizationIdentity = organizationIdentity
 	input.UserEditorIdentity = *authenticatedUserIdentity
 	input.TaskIdentity = taskIdentity
+	input.ExpectedRevision = expectedRevision
 
-	err := h.UpdateTaskService.Execute(input)
+	err = h.UpdateTaskService.Execute(input)
 	if err != nil {
 		corehttp.NewHttpErrorResponse(c, err)
 		return
@@ -222,6 +288,85 @@ func (h *TaskHandler) UpdateTask(c *gin.Context) {
 	corehttp.NewEmptyHttpSuccessResponse(c, http.StatusOK)
 }
 
+type BulkUpdateTasksResponse = corehttp.HttpSuccessResponseWithData[task.TaskBulkReportDto]
+
+// BulkUpdateTasks godoc
+// @Summary Update tasks in bulk
+// @Description Applies a status, category, priority, assignee, due date shift, completion or deletion change to up to 200 accessible tasks given by id or matched by a filter expression. All tasks are changed in a single transaction and the response reports the result of each task.
+// @Tags Task
+// @Accept json
+// @Param request body taskhttprequests.BulkUpdateTasksRequest true "Request body"
+// @Produce json
+// @Success 200 {object} BulkUpdateTasksResponse
+// @Failure 400 {object} corehttp.HttpErrorResponse
+// @Failure 401 {object} corehttp.HttpErrorResponse
+// @Failure 403 {object} corehttp.HttpErrorResponse
+// @Failure 500 {object} corehttp.HttpErrorResponse
+// @Router /task/bulk [post]
+func (h *TaskHandler) BulkUpdateTasks(c *gin.Context) {
+	var request taskhttprequests.BulkUpdateTasksRequest
+	var organizationIdentity *core.Identity = authhttpmiddlewares.GetAuthenticatedUserLastAccessedOrganizationIdentity(c)
+	var authenticatedUserIdentity *core.Identity = authhttpmiddlewares.GetAuthenticatedUserIdentity(c)
+	var input taskservice.BulkUpdateTasksInput
+
+	if err := c.ShouldBindJSON(&request); err != nil {
+		corehttp.NewHttpErrorResponse(c, err)
+		return
+	}
+
+	input = request.ToInput()
+	input.OrganizationIdentity = *organizationIdentity
+	input.UserEditorIdentity = *authenticatedUserIdentity
+
+	result, err := h.BulkUpdateTasksService.Execute(input)
+	if err != nil {
+		corehttp.NewHttpErrorResponse(c, err)
+		return
+	}
+
+	corehttp.NewHttpSuccessResponseWithData(c, http.StatusOK, result)
+}
+
+type ImportTasksResponse = corehttp.HttpSuccessResponseWithData[task.TaskImportReportDto]
+
+// ImportTasks godoc
+// @Summary Import tasks into a project
+// @Description Creates the tasks of a CSV file, whose columns are matched to the task fields by the column mapping, or of a JSON document. Missing statuses and categories are created, rows whose external id was already imported are skipped and a dry run only validates the rows. The response reports the result of each row.
+// @Tags Task
+// @Accept multipart/form-data
+// @Param request formData taskhttprequests.ImportTasksRequest true "Request body"
+// @Produce json
+// @Success 200 {object} ImportTasksResponse
+// @Failure 400 {object} corehttp.HttpErrorResponse
+// @Failure 401 {object} corehttp.HttpErrorResponse
+// @Failure 403 {object} corehttp.HttpErrorResponse
+// @Failure 404 {object} corehttp.HttpErrorResponse
+// @Failure 500 {object} corehttp.HttpErrorResponse
+// @Router /task/import [post]
+func (h *TaskHandler) ImportTasks(c *gin.Context) {
+	var request taskhttprequests.ImportTasksRequest
+	var organizationIdentity *core.Identity = authhttpmiddlewares.GetAuthenticatedUserLastAccessedOrganizationIdentity(c)
+	var authenticatedUserIdentity *core.Identity = authhttpmiddlewares.GetAuthenticatedUserIdentity(c)
+	var input taskservice.ImportTasksInput
+
+	if err := c.ShouldBind(&request); err != nil {
+		corehttp.NewHttpErrorResponse(c, err)
+		return
+	}
+
+	input = request.ToInput()
+	input.OrganizationIdentity = *organizationIdentity
+	input.UserCreatorIdentity = *authenticatedUserIdentity
+
+	result, err := h.ImportTasksService.Execute(input)
+	if err != nil {
+		corehttp.NewHttpErrorResponse(c, err)
+		return
+	}
+
+	corehttp.NewHttpSuccessResponseWithData(c, http.StatusOK, result)
+}
+
 type DeleteTaskResponse = corehttp.EmptyHttpSuccessResponse
 
 // DeleteTask godoc
@@ -230,25 +375,35 @@ type DeleteTaskResponse = corehttp.EmptyHttpSuccessResponse
 // @Tags Task
 // @Accept json
 // @Param taskId path string true "Task ID"
+// @Param If-Match header string false "ETag the task was read with"
 // @Produce json
 // @Success 200 {object} DeleteTaskResponse
 // @Failure 400 {object} corehttp.HttpErrorResponse
 // @Failure 401 {object} corehttp.HttpErrorResponse
 // @Failure 403 {object} corehttp.HttpErrorResponse
 // @Failure 404 {object} corehttp.HttpErrorResponse
+// @Failure 412 {object} corehttp.HttpErrorResponse
 // @Failure 500 {object} corehttp.HttpErrorResponse
 // @Router /task/:taskId [delete]
 func (h *TaskHandler) DeleteTask(c *gin.Context) {
 	var organizationIdentity *core.Identity = authhttpmiddlewares.GetAuthenticatedUserLastAccessedOrganizationIdentity(c)
 	var authenticatedUserIdentity *core.Identity = authhttpmiddlewares.GetAuthenticatedUserIdentity(c)
 	var taskIdentity core.Identity = core.NewIdentityFromPublic(c.Param("taskId"))
+
+	expectedRevision, err := corehttp.GetIfMatchRevision(c)
+	if err != nil {
+		corehttp.NewHttpErrorResponse(c, err)
+		return
+	}
+
 	var input taskservice.DeleteTaskInput = taskservice.DeleteTaskInput{
 		OrganizationIdentity: organizationIdentity,
 		TaskIdentity:         taskIdentity,
 		UserDeleterIdentity:  *authenticatedUserIdentity,
+		ExpectedRevision:     expectedRevision,
 	}
 
-	err := h.DeleteTaskService.Execute(input)
+	err = h.DeleteTaskService.Execute(input)
 	if err != nil {
 		corehttp.NewHttpErrorResponse(c, err)
 		return
@@ -384,21 +539,24 @@ func (h *TaskHandler) RemoveSubTask(c *gin.Context) {
 	corehttp.NewEmptyHttpSuccessResponse(c, http.StatusOK)
 }
 
-type ChangeTaskStatusResponse = corehttp.EmptyHttpSuccessResponse
+type ChangeTaskStatusResponse = corehttp.HttpSuccessResponseWithData[task.TaskStatusChangeDto]
 
 // ChangeTaskStatus godoc
 // @Summary Change the status of a task
-// @Description Changes the status of an accessible task.
+// @Description Changes the status of an accessible task. Moves exceeding a work in progress limit are rejected, or returned with warnings when the status only warns about them.
 // @Tags Task
 // @Accept json
 // @Param taskId path string true "Task ID"
 // @Param request body taskhttprequests.ChangeTaskStatusRequest true "Request body"
+// @Param If-Match header string false "ETag the task was read with"
 // @Produce json
 // @Success 200 {object} ChangeTaskStatusResponse
 // @Failure 400 {object} corehttp.HttpErrorResponse
 // @Failure 401 {object} corehttp.HttpErrorResponse
 // @Failure 403 {object} corehttp.HttpErrorResponse
 // @Failure 404 {object} corehttp.HttpErrorResponse
+// @Failure 409 {object} corehttp.HttpErrorResponse
+// @Failure 412 {object} corehttp.HttpErrorResponse
 // @Failure 500 {object} corehttp.HttpErrorResponse
 // @Router /task/:taskId/status [put]
 func (h *TaskHandler) ChangeTaskStatus(c *gin.Context) {
@@ -413,16 +571,78 @@ func (h *TaskHandler) ChangeTaskStatus(c *gin.Context) {
 		return
 	}
 
+	expectedRevision, err := corehttp.GetIfMatchRevision(c)
+	if err != nil {
+		corehttp.NewHttpErrorResponse(c, err)
+		return
+	}
+
+	input = request.ToInput()
+	input.OrganizationIdentity = organizationIdentity
+	input.TaskIdentity = taskIdentity
+	input.ChangedByUserIdentity = *authenticatedUserIdentity
+	input.ExpectedRevision = expectedRevision
+
+	result, err := h.ChangeTaskStatusService.Execute(input)
+	if err != nil {
+		corehttp.NewHttpErrorResponse(c, err)
+		return
+	}
+
+	corehttp.NewHttpSuccessResponseWithData(c, http.StatusOK, result)
+}
+
+type MoveTaskResponse = corehttp.HttpSuccessResponseWithData[task.TaskStatusChangeDto]
+
+// MoveTask godoc
+// @Summary Move a task
+// @Description Moves an accessible task to a status and a position between two tasks of that status. Without a status the task is reordered inside its current status, and without neighbours it is placed last.
+// @Tags Task
+// @Accept json
+// @Param taskId path string true "Task ID"
+// @Param request body taskhttprequests.MoveTaskRequest true "Request body"
+// @Param If-Match header string false "ETag the task was read with"
+// @Produce json
+// @Success 200 {object} MoveTaskResponse
+// @Failure 400 {object} corehttp.HttpErrorResponse
+// @Failure 401 {object} corehttp.HttpErrorResponse
+// @Failure 403 {object} corehttp.HttpErrorResponse
+// @Failure 404 {object} corehttp.HttpErrorResponse
+// @Failure 409 {object} corehttp.HttpErrorResponse
+// @Failure 412 {object} corehttp.HttpErrorResponse
+// @Failure 500 {object} corehttp.HttpErrorResponse
+// @Router /task/:taskId/move [patch]
+func (h *TaskHandler) MoveTask(c *gin.Context) {
+	var request taskhttprequests.MoveTaskRequest
+	var organizationIdentity *core.Identity = authhttpmiddlewares.GetAuthenticatedUserLastAccessedOrganizationIdentity(c)
+	var authenticatedUserIdentity *core.Identity = authhttpmiddlewares.GetAuthenticatedUserIdentity(c)
+	var taskIdentity core.Identity = core.NewIdentityFromPublic(c.Param("taskId"))
+	var input taskservice.ChangeTaskStatusInput
+
+	if err := c.ShouldBindJSON(&request); err != nil {
+		corehttp.NewHttpErrorResponse(c, err)
+		return
+	}
+
+	expectedRevision, err := corehttp.GetIfMatchRevision(c)
+	if err != nil {
+		corehttp.NewHttpErrorResponse(c, err)
+		return
+	}
+
 	input = request.ToInput()
 	input.OrganizationIdentity = organizationIdentity
 	input.TaskIdentity = taskIdentity
 	input.ChangedByUserIdentity = *authenticatedUserIdentity
+	input.ExpectedRevision = expectedRevision
 
-	err := h.ChangeTaskStatusService.Execute(input)
+	result, err := h.ChangeTaskStatusService.Execute(input)
 	if err != nil {
 		corehttp.NewHttpErrorResponse(c, err)
 		return
 	}
+
+	corehttp.NewHttpSuccessResponseWithData(c, http.StatusOK, result)
 }
 
 type CompleteTaskResponse = corehttp.EmptyHttpSuccessResponse
@@ -433,25 +653,35 @@ type CompleteTaskResponse = corehttp.EmptyHttpSuccessResponse
 // @Tags Task
 // @Accept json
 // @Param taskId path string true "Task ID"
+// @Param If-Match header string false "ETag the task was read with"
 // @Produce json
 // @Success 200 {object} CompleteTaskResponse
 // @Failure 400 {object} corehttp.HttpErrorResponse
 // @Failure 401 {object} corehttp.HttpErrorResponse
 // @Failure 403 {object} corehttp.HttpErrorResponse
 // @Failure 404 {object} corehttp.HttpErrorResponse
+// @Failure 412 {object} corehttp.HttpErrorResponse
 // @Failure 500 {object} corehttp.HttpErrorResponse
 // @Router /task/:taskId/complete [post]
 func (h *TaskHandler) CompleteTask(c *gin.Context) {
 	var organizationIdentity *core.Identity = authhttpmiddlewares.GetAuthenticatedUserLastAccessedOrganizationIdentity(c)
 	var authenticatedUserIdentity *core.Identity = authhttpmiddlewares.GetAuthenticatedUserIdentity(c)
 	var taskIdentity core.Identity = core.NewIdentityFromPublic(c.Param("taskId"))
+
+	expectedRevision, err := corehttp.GetIfMatchRevision(c)
+	if err != nil {
+		corehttp.NewHttpErrorResponse(c, err)
+		return
+	}
+
 	var input taskservice.CompleteTaskInput = taskservice.CompleteTaskInput{
 		OrganizationIdentity:  organizationIdentity,
 		TaskIdentity:          taskIdentity,
 		UserCompleterIdentity: *authenticatedUserIdentity,
+		ExpectedRevision:      expectedRevision,
 	}
 
-	err := h.CompleteTaskService.Execute(input)
+	err = h.CompleteTaskService.Execute(input)
 	if err != nil {
 		corehttp.NewHttpErrorResponse(c, err)
 		return
@@ -502,7 +732,7 @@ type GetTaskHistoryResponse = corehttp.HttpSuccessResponseWithData[core.Paginati
 
 // GetTaskHistory godoc
 // @Summary Get the history of a task
-// @Description Returns the history of an accessible task.
+// @Description Returns the history of an accessible task. Passing after or before switches to cursor pagination, which follows the nextCursor and prevCursor of the response; an empty before starts at the last page. The total is only counted in cursor mode when withTotal is true.
 // @Tags Task
 // @Accept json
 // @Param taskId path string true "Task ID"
@@ -538,6 +768,42 @@ func (h *TaskHandler) GetTaskHistory(c *gin.Context) {
 	corehttp.NewHttpSuccessResponseWithData(c, http.StatusOK, result)
 }
 
+type GetTaskAvailableTransitionsResponse = corehttp.HttpSuccessResponseWithData[[]task.TaskAvailableTransitionDto]
+
+// GetTaskAvailableTransitions godoc
+// @Summary Get the available status transitions of a task
+// @Description Returns the statuses the authenticated user can move an accessible task to, with the fields each move requires.
+// @Tags Task
+// @Accept json
+// @Param taskId path string true "Task ID"
+// @Produce json
+// @Success 200 {object} GetTaskAvailableTransitionsResponse
+// @Failure 400 {object} corehttp.HttpErrorResponse
+// @Failure 401 {object} corehttp.HttpErrorResponse
+// @Failure 403 {object} corehttp.HttpErrorResponse
+// @Failure 404 {object} corehttp.HttpErrorResponse
+// @Failure 500 {object} corehttp.HttpErrorResponse
+// @Router /task/:taskId/transitions [get]
+func (h *TaskHandler) GetTaskAvailableTransitions(c *gin.Context) {
+	var organizationIdentity *core.Identity = authhttpmiddlewares.GetAuthenticatedUserLastAccessedOrganizationIdentity(c)
+	var authenticatedUserIdentity *core.Identity = authhttpmiddlewares.GetAuthenticatedUserIdentity(c)
+	var taskIdentity core.Identity = core.NewIdentityFromPublic(c.Param("taskId"))
+
+	input := taskservice.GetTaskAvailableTransitionsInput{
+		OrganizationIdentity: organizationIdentity,
+		TaskIdentity:         taskIdentity,
+		UserIdentity:         *authenticatedUserIdentity,
+	}
+
+	result, err := h.GetTaskAvailableTransitionsService.Execute(input)
+	if err != nil {
+		corehttp.NewHttpErrorResponse(c, err)
+		return
+	}
+
+	corehttp.NewHttpSuccessResponseWithData(c, http.StatusOK, &result)
+}
+
 func (h *TaskHandler) ConfigureRoutes(options corehttp.ConfigureRoutesOptions) *gin.RouterGroup {
 	middlewareOptions := corehttp.MiddlewareOptions{
 		DbConnection: options.DbConnection,
@@ -549,9 +815,14 @@ func (h *TaskHandler) ConfigureRoutes(options corehttp.ConfigureRoutesOptions) *
 		g.GET("", organizationhttpmiddlewares.UserMustHavePermission("tasks:view", middlewareOptions), h.ListTasks)
 		g.GET("/:taskId", organizationhttpmiddlewares.UserMustHavePermission("tasks:view", middlewareOptions), h.GetTask)
 		g.POST("", organizationhttpmiddlewares.UserMustHavePermission("tasks:create", middlewareOptions), h.CreateTask)
+		g.POST("/bulk", organizationhttpmiddlewares.UserMustHavePermission("tasks:update", middlewareOptions), h.BulkUpdateTasks)
+		g.POST("/import", organizationhttpmiddlewares.UserMustHavePermission("tasks:create", middlewareOptions), organizationhttpmiddlewares.UserMustHavePermission("projects:update", middlewareOptions), h.ImportTasks)
 		g.PUT("/:taskId", organizationhttpmiddlewares.UserMustHavePermission("tasks:update", middlewareOptions), h.UpdateTask)
 		g.DELETE("/:taskId", organizationhttpmiddlewares.UserMustHavePermission("tasks:delete", middlewareOptions), h.DeleteTask)
+		g.GET("/:taskId/history", organizationhttpmiddlewares.UserMustHavePermission("tasks:view", middlewareOptions), h.GetTaskHistory)
+		g.GET("/:taskId/transitions", organizationhttpmiddlewares.UserMustHavePermission("tasks:view", middlewareOptions), h.GetTaskAvailableTransitions)
 		g.PATCH("/:taskId/status", organizationhttpmiddlewares.UserMustHavePermission("tasks:update", middlewareOptions), h.ChangeTaskStatus)
+		g.PATCH("/:taskId/move", organizationhttpmiddlewares.UserMustHavePermission("tasks:update", middlewareOptions), h.MoveTask)
 		g.PUT("/:taskId/complete", organizationhttpmiddlewares.UserMustHavePermission("tasks:update", middlewareOptions), h.CompleteTask)
 		g.POST("/:taskId/sub-task", organizationhttpmiddlewares.UserMustHavePermission("tasks:create", middlewareOptions), h.AddSubTask)
 		g.PUT("/:taskId/sub-task/:subTaskId", organizationhttpmiddlewares.UserMustHavePermission("tasks:update", middlewareOptions), h.UpdateSubTask)
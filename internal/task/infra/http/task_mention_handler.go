@@ -0,0 +1,76 @@
+package taskhttp
+
+import (
+	"net/http"
+
+	authhttpmiddlewares "github.com/gabrielmrtt/taski/internal/auth/infra/http/middlewares"
+	"github.com/gabrielmrtt/taski/internal/core"
+	corehttp "github.com/gabrielmrtt/taski/internal/core/http"
+	organizationhttpmiddlewares "github.com/gabrielmrtt/taski/internal/organization/infra/http/middlewares"
+	"github.com/gabrielmrtt/taski/internal/task"
+	taskhttprequests "github.com/gabrielmrtt/taski/internal/task/infra/http/requests"
+	taskservice "github.com/gabrielmrtt/taski/internal/task/services"
+	"github.com/gin-gonic/gin"
+)
+
+type TaskMentionHandler struct {
+	ListMyTaskMentionsService *taskservice.ListMyTaskMentionsService
+}
+
+func NewTaskMentionHandler(listMyTaskMentionsService *taskservice.ListMyTaskMentionsService) *TaskMentionHandler {
+	return &TaskMentionHandler{
+		ListMyTaskMentionsService: listMyTaskMentionsService,
+	}
+}
+
+type ListMyTaskMentionsResponse = corehttp.HttpSuccessResponseWithData[core.PaginationOutput[task.TaskMentionDto]]
+
+// ListMyTaskMentions godoc
+// @Summary List my mentions
+// @Description Lists the task descriptions and comments of the organization where the authenticated user was mentioned, newest first.
+// @Tags Task
+// @Accept json
+// @Param request query taskhttprequests.ListMyTaskMentionsRequest true "Query parameters"
+// @Produce json
+// @Success 200 {object} ListMyTaskMentionsResponse
+// @Failure 400 {object} corehttp.HttpErrorResponse
+// @Failure 401 {object} corehttp.HttpErrorResponse
+// @Failure 403 {object} corehttp.HttpErrorResponse
+// @Failure 500 {object} corehttp.HttpErrorResponse
+// @Router /me/mentions [get]
+func (h *TaskMentionHandler) ListMyTaskMentions(c *gin.Context) {
+	var request taskhttprequests.ListMyTaskMentionsRequest
+	var organizationIdentity *core.Identity = authhttpmiddlewares.GetAuthenticatedUserLastAccessedOrganizationIdentity(c)
+	var authenticatedUserIdentity *core.Identity = authhttpmiddlewares.GetAuthenticatedUserIdentity(c)
+	var input taskservice.ListMyTaskMentionsInput
+
+	if err := request.FromQuery(c); err != nil {
+		corehttp.NewHttpErrorResponse(c, err)
+		return
+	}
+
+	input = request.ToInput()
+	input.OrganizationIdentity = *organizationIdentity
+	input.UserIdentity = *authenticatedUserIdentity
+	response, err := h.ListMyTaskMentionsService.Execute(input)
+	if err != nil {
+		corehttp.NewHttpErrorResponse(c, err)
+		return
+	}
+
+	corehttp.NewHttpSuccessResponseWithData(c, http.StatusOK, response)
+}
+
+func (h *TaskMentionHandler) ConfigureRoutes(options corehttp.ConfigureRoutesOptions) *gin.RouterGroup {
+	middlewareOptions := corehttp.MiddlewareOptions{
+		DbConnection: options.DbConnection,
+	}
+
+	g := options.RouterGroup.Group("/me/mentions")
+	{
+		g.Use(authhttpmiddlewares.AuthMiddleware(middlewareOptions))
+		g.GET("", organizationhttpmiddlewares.UserMustHavePermission("tasks:view", middlewareOptions), h.ListMyTaskMentions)
+	}
+
+	return g
+}
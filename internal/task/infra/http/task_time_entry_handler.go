@@ -0,0 +1,425 @@
+package taskhttp
+
+import (
+	"bytes"
+	"encoding/csv"
+	"net/http"
+	"strconv"
+
+	authhttpmiddlewares "github.com/gabrielmrtt/taski/internal/auth/infra/http/middlewares"
+	"github.com/gabrielmrtt/taski/internal/core"
+	corehttp "github.com/gabrielmrtt/taski/internal/core/http"
+	organizationhttpmiddlewares "github.com/gabrielmrtt/taski/internal/organization/infra/http/middlewares"
+	"github.com/gabrielmrtt/taski/internal/task"
+	taskhttprequests "github.com/gabrielmrtt/taski/internal/task/infra/http/requests"
+	taskservice "github.com/gabrielmrtt/taski/internal/task/services"
+	"github.com/gin-gonic/gin"
+)
+
+type TaskTimeEntryHandler struct {
+	ListTaskTimeEntriesService *taskservice.ListTaskTimeEntriesService
+	CreateTaskTimeEntryService *taskservice.CreateTaskTimeEntryService
+	UpdateTaskTimeEntryService *taskservice.UpdateTaskTimeEntryService
+	DeleteTaskTimeEntryService *taskservice.DeleteTaskTimeEntryService
+	StartTaskTimerService      *taskservice.StartTaskTimerService
+	StopTaskTimerService       *taskservice.StopTaskTimerService
+	GetTaskTimeSummaryService  *taskservice.GetTaskTimeSummaryService
+	GetTaskTimeReportService   *taskservice.GetTaskTimeReportService
+}
+
+func NewTaskTimeEntryHandler(
+	listTaskTimeEntriesService *taskservice.ListTaskTimeEntriesService,
+	createTaskTimeEntryService *taskservice.CreateTaskTimeEntryService,
+	updateTaskTimeEntryService *taskservice.UpdateTaskTimeEntryService,
+	deleteTaskTimeEntryService *taskservice.DeleteTaskTimeEntryService,
+	startTaskTimerService *taskservice.StartTaskTimerService,
+	stopTaskTimerService *taskservice.StopTaskTimerService,
+	getTaskTimeSummaryService *taskservice.GetTaskTimeSummaryService,
+	getTaskTimeReportService *taskservice.GetTaskTimeReportService,
+) *TaskTimeEntryHandler {
+	return &TaskTimeEntryHandler{
+		ListTaskTimeEntriesService: listTaskTimeEntriesService,
+		CreateTaskTimeEntryService: createTaskTimeEntryService,
+		UpdateTaskTimeEntryService: updateTaskTimeEntryService,
+		DeleteTaskTimeEntryService: deleteTaskTimeEntryService,
+		StartTaskTimerService:      startTaskTimerService,
+		StopTaskTimerService:       stopTaskTimerService,
+		GetTaskTimeSummaryService:  getTaskTimeSummaryService,
+		GetTaskTimeReportService:   getTaskTimeReportService,
+	}
+}
+
+type ListTaskTimeEntriesResponse = corehttp.HttpSuccessResponseWithData[core.PaginationOutput[task.TaskTimeEntryDto]]
+
+// ListTaskTimeEntries godoc
+// @Summary List task time entries
+// @Description Returns the time entries of an accessible task.
+// @Tags Task Time Entry
+// @Accept json
+// @Param taskId path string true "Task ID"
+// @Param request query taskhttprequests.ListTaskTimeEntriesRequest true "Query parameters"
+// @Produce json
+// @Success 200 {object} ListTaskTimeEntriesResponse
+// @Failure 400 {object} corehttp.HttpErrorResponse
+// @Failure 401 {object} corehttp.HttpErrorResponse
+// @Failure 403 {object} corehttp.HttpErrorResponse
+// @Failure 404 {object} corehttp.HttpErrorResponse
+// @Failure 500 {object} corehttp.HttpErrorResponse
+// @Router /task/:taskId/time-entry [get]
+func (h *TaskTimeEntryHandler) ListTaskTimeEntries(c *gin.Context) {
+	var request taskhttprequests.ListTaskTimeEntriesRequest
+	var organizationIdentity *core.Identity = authhttpmiddlewares.GetAuthenticatedUserLastAccessedOrganizationIdentity(c)
+	var taskIdentity core.Identity = core.NewIdentityFromPublic(c.Param("taskId"))
+	var input taskservice.ListTaskTimeEntriesInput
+
+	if err := request.FromQuery(c); err != nil {
+		corehttp.NewHttpErrorResponse(c, err)
+		return
+	}
+
+	input = request.ToInput()
+	input.OrganizationIdentity = organizationIdentity
+	input.TaskIdentity = taskIdentity
+	response, err := h.ListTaskTimeEntriesService.Execute(input)
+	if err != nil {
+		corehttp.NewHttpErrorResponse(c, err)
+		return
+	}
+
+	corehttp.NewHttpSuccessResponseWithData(c, http.StatusOK, response)
+}
+
+type CreateTaskTimeEntryResponse = corehttp.HttpSuccessResponseWithData[task.TaskTimeEntryDto]
+
+// CreateTaskTimeEntry godoc
+// @Summary Create a task time entry
+// @Description Records a finished period of time spent on a task by the authenticated user.
+// @Tags Task Time Entry
+// @Accept json
+// @Param taskId path string true "Task ID"
+// @Param request body taskhttprequests.CreateTaskTimeEntryRequest true "Request body"
+// @Produce json
+// @Success 200 {object} CreateTaskTimeEntryResponse
+// @Failure 400 {object} corehttp.HttpErrorResponse
+// @Failure 401 {object} corehttp.HttpErrorResponse
+// @Failure 403 {object} corehttp.HttpErrorResponse
+// @Failure 404 {object} corehttp.HttpErrorResponse
+// @Failure 500 {object} corehttp.HttpErrorResponse
+// @Router /task/:taskId/time-entry [post]
+func (h *TaskTimeEntryHandler) CreateTaskTimeEntry(c *gin.Context) {
+	var request taskhttprequests.CreateTaskTimeEntryRequest
+	var organizationIdentity *core.Identity = authhttpmiddlewares.GetAuthenticatedUserLastAccessedOrganizationIdentity(c)
+	var authenticatedUserIdentity *core.Identity = authhttpmiddlewares.GetAuthenticatedUserIdentity(c)
+	var taskIdentity core.Identity = core.NewIdentityFromPublic(c.Param("taskId"))
+	var input taskservice.CreateTaskTimeEntryInput
+
+	if err := c.ShouldBindJSON(&request); err != nil {
+		corehttp.NewHttpErrorResponse(c, err)
+		return
+	}
+
+	input = request.ToInput()
+	input.OrganizationIdentity = organizationIdentity
+	input.TaskIdentity = taskIdentity
+	input.UserIdentity = *authenticatedUserIdentity
+	response, err := h.CreateTaskTimeEntryService.Execute(input)
+	if err != nil {
+		corehttp.NewHttpErrorResponse(c, err)
+		return
+	}
+
+	corehttp.NewHttpSuccessResponseWithData(c, http.StatusOK, response)
+}
+
+type UpdateTaskTimeEntryResponse = corehttp.EmptyHttpSuccessResponse
+
+// UpdateTaskTimeEntry godoc
+// @Summary Update a task time entry
+// @Description Updates a time entry owned by the authenticated user.
+// @Tags Task Time Entry
+// @Accept json
+// @Param taskId path string true "Task ID"
+// @Param timeEntryId path string true "Time Entry ID"
+// @Param request body taskhttprequests.UpdateTaskTimeEntryRequest true "Request body"
+// @Produce json
+// @Success 200 {object} UpdateTaskTimeEntryResponse
+// @Failure 400 {object} corehttp.HttpErrorResponse
+// @Failure 401 {object} corehttp.HttpErrorResponse
+// @Failure 403 {object} corehttp.HttpErrorResponse
+// @Failure 404 {object} corehttp.HttpErrorResponse
+// @Failure 500 {object} corehttp.HttpErrorResponse
+// @Router /task/:taskId/time-entry/:timeEntryId [put]
+func (h *TaskTimeEntryHandler) UpdateTaskTimeEntry(c *gin.Context) {
+	var request taskhttprequests.UpdateTaskTimeEntryRequest
+	var organizationIdentity *core.Identity = authhttpmiddlewares.GetAuthenticatedUserLastAccessedOrganizationIdentity(c)
+	var authenticatedUserIdentity *core.Identity = authhttpmiddlewares.GetAuthenticatedUserIdentity(c)
+	var taskIdentity core.Identity = core.NewIdentityFromPublic(c.Param("taskId"))
+	var taskTimeEntryIdentity core.Identity = core.NewIdentityFromPublic(c.Param("timeEntryId"))
+	var input taskservice.UpdateTaskTimeEntryInput
+
+	if err := c.ShouldBindJSON(&request); err != nil {
+		corehttp.NewHttpErrorResponse(c, err)
+		return
+	}
+
+	input = request.ToInput()
+	input.OrganizationIdentity = organizationIdentity
+	input.TaskIdentity = taskIdentity
+	input.TaskTimeEntryIdentity = taskTimeEntryIdentity
+	input.UserEditorIdentity = *authenticatedUserIdentity
+	err := h.UpdateTaskTimeEntryService.Execute(input)
+	if err != nil {
+		corehttp.NewHttpErrorResponse(c, err)
+		return
+	}
+
+	corehttp.NewEmptyHttpSuccessResponse(c, http.StatusOK)
+}
+
+type DeleteTaskTimeEntryResponse = corehttp.EmptyHttpSuccessResponse
+
+// DeleteTaskTimeEntry godoc
+// @Summary Delete a task time entry
+// @Description Deletes a time entry owned by the authenticated user.
+// @Tags Task Time Entry
+// @Accept json
+// @Param taskId path string true "Task ID"
+// @Param timeEntryId path string true "Time Entry ID"
+// @Produce json
+// @Success 200 {object} DeleteTaskTimeEntryResponse
+// @Failure 400 {object} corehttp.HttpErrorResponse
+// @Failure 401 {object} corehttp.HttpErrorResponse
+// @Failure 403 {object} corehttp.HttpErrorResponse
+// @Failure 404 {object} corehttp.HttpErrorResponse
+// @Failure 500 {object} corehttp.HttpErrorResponse
+// @Router /task/:taskId/time-entry/:timeEntryId [delete]
+func (h *TaskTimeEntryHandler) DeleteTaskTimeEntry(c *gin.Context) {
+	var organizationIdentity *core.Identity = authhttpmiddlewares.GetAuthenticatedUserLastAccessedOrganizationIdentity(c)
+	var authenticatedUserIdentity *core.Identity = authhttpmiddlewares.GetAuthenticatedUserIdentity(c)
+	var input taskservice.DeleteTaskTimeEntryInput = taskservice.DeleteTaskTimeEntryInput{
+		OrganizationIdentity:  organizationIdentity,
+		TaskIdentity:          core.NewIdentityFromPublic(c.Param("taskId")),
+		TaskTimeEntryIdentity: core.NewIdentityFromPublic(c.Param("timeEntryId")),
+		UserDeleterIdentity:   *authenticatedUserIdentity,
+	}
+
+	err := h.DeleteTaskTimeEntryService.Execute(input)
+	if err != nil {
+		corehttp.NewHttpErrorResponse(c, err)
+		return
+	}
+
+	corehttp.NewEmptyHttpSuccessResponse(c, http.StatusOK)
+}
+
+type StartTaskTimerResponse = corehttp.HttpSuccessResponseWithData[task.TaskTimeEntryDto]
+
+// StartTaskTimer godoc
+// @Summary Start a task timer
+// @Description Starts a timer on a task for the authenticated user. A user can only have one running timer.
+// @Tags Task Time Entry
+// @Accept json
+// @Param taskId path string true "Task ID"
+// @Param request body taskhttprequests.StartTaskTimerRequest false "Request body"
+// @Produce json
+// @Success 200 {object} StartTaskTimerResponse
+// @Failure 400 {object} corehttp.HttpErrorResponse
+// @Failure 401 {object} corehttp.HttpErrorResponse
+// @Failure 403 {object} corehttp.HttpErrorResponse
+// @Failure 404 {object} corehttp.HttpErrorResponse
+// @Failure 409 {object} corehttp.HttpErrorResponse
+// @Failure 500 {object} corehttp.HttpErrorResponse
+// @Router /task/:taskId/time-entry/start [post]
+func (h *TaskTimeEntryHandler) StartTaskTimer(c *gin.Context) {
+	var request taskhttprequests.StartTaskTimerRequest
+	var organizationIdentity *core.Identity = authhttpmiddlewares.GetAuthenticatedUserLastAccessedOrganizationIdentity(c)
+	var authenticatedUserIdentity *core.Identity = authhttpmiddlewares.GetAuthenticatedUserIdentity(c)
+	var taskIdentity core.Identity = core.NewIdentityFromPublic(c.Param("taskId"))
+	var input taskservice.StartTaskTimerInput
+
+	if c.Request.ContentLength > 0 {
+		if err := c.ShouldBindJSON(&request); err != nil {
+			corehttp.NewHttpErrorResponse(c, err)
+			return
+		}
+	}
+
+	input = request.ToInput()
+	input.OrganizationIdentity = organizationIdentity
+	input.TaskIdentity = taskIdentity
+	input.UserIdentity = *authenticatedUserIdentity
+	response, err := h.StartTaskTimerService.Execute(input)
+	if err != nil {
+		corehttp.NewHttpErrorResponse(c, err)
+		return
+	}
+
+	corehttp.NewHttpSuccessResponseWithData(c, http.StatusOK, response)
+}
+
+type StopTaskTimerResponse = corehttp.HttpSuccessResponseWithData[task.TaskTimeEntryDto]
+
+// StopTaskTimer godoc
+// @Summary Stop a task timer
+// @Description Stops the running timer of the authenticated user on a task.
+// @Tags Task Time Entry
+// @Accept json
+// @Param taskId path string true "Task ID"
+// @Produce json
+// @Success 200 {object} StopTaskTimerResponse
+// @Failure 400 {object} corehttp.HttpErrorResponse
+// @Failure 401 {object} corehttp.HttpErrorResponse
+// @Failure 403 {object} corehttp.HttpErrorResponse
+// @Failure 404 {object} corehttp.HttpErrorResponse
+// @Failure 500 {object} corehttp.HttpErrorResponse
+// @Router /task/:taskId/time-entry/stop [post]
+func (h *TaskTimeEntryHandler) StopTaskTimer(c *gin.Context) {
+	var organizationIdentity *core.Identity = authhttpmiddlewares.GetAuthenticatedUserLastAccessedOrganizationIdentity(c)
+	var authenticatedUserIdentity *core.Identity = authhttpmiddlewares.GetAuthenticatedUserIdentity(c)
+	var input taskservice.StopTaskTimerInput = taskservice.StopTaskTimerInput{
+		OrganizationIdentity: organizationIdentity,
+		TaskIdentity:         core.NewIdentityFromPublic(c.Param("taskId")),
+		UserIdentity:         *authenticatedUserIdentity,
+	}
+
+	response, err := h.StopTaskTimerService.Execute(input)
+	if err != nil {
+		corehttp.NewHttpErrorResponse(c, err)
+		return
+	}
+
+	corehttp.NewHttpSuccessResponseWithData(c, http.StatusOK, response)
+}
+
+type GetTaskTimeSummaryResponse = corehttp.HttpSuccessResponseWithData[task.TaskTimeSummaryDto]
+
+// GetTaskTimeSummary godoc
+// @Summary Get a task time summary
+// @Description Returns the time tracked on a task and the total rolled up from its children tasks.
+// @Tags Task Time Entry
+// @Accept json
+// @Param taskId path string true "Task ID"
+// @Produce json
+// @Success 200 {object} GetTaskTimeSummaryResponse
+// @Failure 400 {object} corehttp.HttpErrorResponse
+// @Failure 401 {object} corehttp.HttpErrorResponse
+// @Failure 403 {object} corehttp.HttpErrorResponse
+// @Failure 404 {object} corehttp.HttpErrorResponse
+// @Failure 500 {object} corehttp.HttpErrorResponse
+// @Router /task/:taskId/time-entry/summary [get]
+func (h *TaskTimeEntryHandler) GetTaskTimeSummary(c *gin.Context) {
+	var organizationIdentity *core.Identity = authhttpmiddlewares.GetAuthenticatedUserLastAccessedOrganizationIdentity(c)
+	var input taskservice.GetTaskTimeSummaryInput = taskservice.GetTaskTimeSummaryInput{
+		OrganizationIdentity: organizationIdentity,
+		TaskIdentity:         core.NewIdentityFromPublic(c.Param("taskId")),
+	}
+
+	response, err := h.GetTaskTimeSummaryService.Execute(input)
+	if err != nil {
+		corehttp.NewHttpErrorResponse(c, err)
+		return
+	}
+
+	corehttp.NewHttpSuccessResponseWithData(c, http.StatusOK, response)
+}
+
+type GetTaskTimeReportResponse = corehttp.HttpSuccessResponseWithData[task.TaskTimeReportDto]
+
+// GetTaskTimeReport godoc
+// @Summary Get a time report
+// @Description Returns the time tracked per user, project and day in a date range. Use format=csv to download it as CSV.
+// @Tags Task Time Entry
+// @Accept json
+// @Param request query taskhttprequests.GetTaskTimeReportRequest true "Query parameters"
+// @Produce json
+// @Produce text/csv
+// @Success 200 {object} GetTaskTimeReportResponse
+// @Failure 400 {object} corehttp.HttpErrorResponse
+// @Failure 401 {object} corehttp.HttpErrorResponse
+// @Failure 403 {object} corehttp.HttpErrorResponse
+// @Failure 500 {object} corehttp.HttpErrorResponse
+// @Router /time-entry/report [get]
+func (h *TaskTimeEntryHandler) GetTaskTimeReport(c *gin.Context) {
+	var request taskhttprequests.GetTaskTimeReportRequest
+	var organizationIdentity *core.Identity = authhttpmiddlewares.GetAuthenticatedUserLastAccessedOrganizationIdentity(c)
+	var authenticatedUserIdentity *core.Identity = authhttpmiddlewares.GetAuthenticatedUserIdentity(c)
+	var input taskservice.GetTaskTimeReportInput
+
+	if err := request.FromQuery(c); err != nil {
+		corehttp.NewHttpErrorResponse(c, err)
+		return
+	}
+
+	input = request.ToInput()
+	input.OrganizationIdentity = organizationIdentity
+	input.AuthenticatedUserIdentity = authenticatedUserIdentity
+	response, err := h.GetTaskTimeReportService.Execute(input)
+	if err != nil {
+		corehttp.NewHttpErrorResponse(c, err)
+		return
+	}
+
+	if request.IsCSV() {
+		content, err := taskTimeReportToCSV(response)
+		if err != nil {
+			corehttp.NewHttpErrorResponse(c, err)
+			return
+		}
+
+		c.Header("Content-Disposition", "attachment; filename=time-report.csv")
+		c.Data(http.StatusOK, "text/csv", content)
+		return
+	}
+
+	corehttp.NewHttpSuccessResponseWithData(c, http.StatusOK, response)
+}
+
+func taskTimeReportToCSV(report *task.TaskTimeReportDto) ([]byte, error) {
+	var buffer bytes.Buffer
+	writer := csv.NewWriter(&buffer)
+
+	records := [][]string{{"date", "user_id", "user_name", "project_id", "project_name", "tracked_seconds", "tracked_hours"}}
+	for _, row := range report.Rows {
+		records = append(records, []string{
+			row.Date,
+			row.UserId,
+			row.UserName,
+			row.ProjectId,
+			row.ProjectName,
+			strconv.FormatInt(row.TrackedSeconds, 10),
+			strconv.FormatFloat(float64(row.TrackedSeconds)/3600, 'f', 2, 64),
+		})
+	}
+
+	if err := writer.WriteAll(records); err != nil {
+		return nil, err
+	}
+
+	return buffer.Bytes(), nil
+}
+
+func (h *TaskTimeEntryHandler) ConfigureRoutes(options corehttp.ConfigureRoutesOptions) *gin.RouterGroup {
+	middlewareOptions := corehttp.MiddlewareOptions{
+		DbConnection: options.DbConnection,
+	}
+
+	reportGroup := options.RouterGroup.Group("/time-entry")
+	{
+		reportGroup.Use(authhttpmiddlewares.AuthMiddleware(middlewareOptions))
+		reportGroup.GET("/report", organizationhttpmiddlewares.UserMustHavePermission("tasks:view", middlewareOptions), h.GetTaskTimeReport)
+	}
+
+	g := options.RouterGroup.Group("/task/:taskId/time-entry")
+	{
+		g.Use(authhttpmiddlewares.AuthMiddleware(middlewareOptions))
+		g.GET("", organizationhttpmiddlewares.UserMustHavePermission("tasks:view", middlewareOptions), h.ListTaskTimeEntries)
+		g.POST("", organizationhttpmiddlewares.UserMustHavePermission("tasks:view", middlewareOptions), h.CreateTaskTimeEntry)
+		g.GET("/summary", organizationhttpmiddlewares.UserMustHavePermission("tasks:view", middlewareOptions), h.GetTaskTimeSummary)
+		g.POST("/start", organizationhttpmiddlewares.UserMustHavePermission("tasks:view", middlewareOptions), h.StartTaskTimer)
+		g.POST("/stop", organizationhttpmiddlewares.UserMustHavePermission("tasks:view", middlewareOptions), h.StopTaskTimer)
+		g.PUT("/:timeEntryId", organizationhttpmiddlewares.UserMustHavePermission("tasks:view", middlewareOptions), h.UpdateTaskTimeEntry)
+		g.DELETE("/:timeEntryId", organizationhttpmiddlewares.UserMustHavePermission("tasks:view", middlewareOptions), h.DeleteTaskTimeEntry)
+	}
+
+	return g
+}
@@ -0,0 +1,242 @@
+package taskhttp
+
+import (
+	"net/http"
+
+	authhttpmiddlewares "github.com/gabrielmrtt/taski/internal/auth/infra/http/middlewares"
+	"github.com/gabrielmrtt/taski/internal/core"
+	corehttp "github.com/gabrielmrtt/taski/internal/core/http"
+	organizationhttpmiddlewares "github.com/gabrielmrtt/taski/internal/organization/infra/http/middlewares"
+	"github.com/gabrielmrtt/taski/internal/task"
+	taskhttprequests "github.com/gabrielmrtt/taski/internal/task/infra/http/requests"
+	taskservice "github.com/gabrielmrtt/taski/internal/task/services"
+	"github.com/gin-gonic/gin"
+)
+
+type TaskViewHandler struct {
+	ListTaskViewsService  *taskservice.ListTaskViewsService
+	GetTaskViewService    *taskservice.GetTaskViewService
+	CreateTaskViewService *taskservice.CreateTaskViewService
+	UpdateTaskViewService *taskservice.UpdateTaskViewService
+	DeleteTaskViewService *taskservice.DeleteTaskViewService
+}
+
+func NewTaskViewHandler(
+	listTaskViewsService *taskservice.ListTaskViewsService,
+	getTaskViewService *taskservice.GetTaskViewService,
+	createTaskViewService *taskservice.CreateTaskViewService,
+	updateTaskViewService *taskservice.UpdateTaskViewService,
+	deleteTaskViewService *taskservice.DeleteTaskViewService,
+) *TaskViewHandler {
+	return &TaskViewHandler{
+		ListTaskViewsService:  listTaskViewsService,
+		GetTaskViewService:    getTaskViewService,
+		CreateTaskViewService: createTaskViewService,
+		UpdateTaskViewService: updateTaskViewService,
+		DeleteTaskViewService: deleteTaskViewService,
+	}
+}
+
+type ListTaskViewsResponse = corehttp.HttpSuccessResponseWithData[[]task.TaskViewDto]
+
+// ListTaskViews godoc
+// @Summary List task views
+// @Description Returns the task views owned by the authenticated user and the ones shared in the projects they are a member of.
+// @Tags Task View
+// @Accept json
+// @Param request query taskhttprequests.ListTaskViewsRequest true "Query parameters"
+// @Produce json
+// @Success 200 {object} ListTaskViewsResponse
+// @Failure 400 {object} corehttp.HttpErrorResponse
+// @Failure 401 {object} corehttp.HttpErrorResponse
+// @Failure 403 {object} corehttp.HttpErrorResponse
+// @Failure 500 {object} corehttp.HttpErrorResponse
+// @Router /task-view [get]
+func (h *TaskViewHandler) ListTaskViews(c *gin.Context) {
+	var request taskhttprequests.ListTaskViewsRequest
+	var organizationIdentity *core.Identity = authhttpmiddlewares.GetAuthenticatedUserLastAccessedOrganizationIdentity(c)
+	var authenticatedUserIdentity *core.Identity = authhttpmiddlewares.GetAuthenticatedUserIdentity(c)
+	var input taskservice.ListTaskViewsInput
+
+	if err := request.FromQuery(c); err != nil {
+		corehttp.NewHttpErrorResponse(c, err)
+		return
+	}
+
+	input = request.ToInput()
+	input.OrganizationIdentity = organizationIdentity
+	input.UserIdentity = *authenticatedUserIdentity
+	response, err := h.ListTaskViewsService.Execute(input)
+	if err != nil {
+		corehttp.NewHttpErrorResponse(c, err)
+		return
+	}
+
+	corehttp.NewHttpSuccessResponseWithData(c, http.StatusOK, &response)
+}
+
+type GetTaskViewResponse = corehttp.HttpSuccessResponseWithData[task.TaskViewDto]
+
+// GetTaskView godoc
+// @Summary Get a task view
+// @Description Returns a task view owned by the authenticated user or shared in one of their projects.
+// @Tags Task View
+// @Accept json
+// @Param viewId path string true "Task View ID"
+// @Produce json
+// @Success 200 {object} GetTaskViewResponse
+// @Failure 400 {object} corehttp.HttpErrorResponse
+// @Failure 401 {object} corehttp.HttpErrorResponse
+// @Failure 403 {object} corehttp.HttpErrorResponse
+// @Failure 404 {object} corehttp.HttpErrorResponse
+// @Failure 500 {object} corehttp.HttpErrorResponse
+// @Router /task-view/:viewId [get]
+func (h *TaskViewHandler) GetTaskView(c *gin.Context) {
+	var organizationIdentity *core.Identity = authhttpmiddlewares.GetAuthenticatedUserLastAccessedOrganizationIdentity(c)
+	var authenticatedUserIdentity *core.Identity = authhttpmiddlewares.GetAuthenticatedUserIdentity(c)
+	var input taskservice.GetTaskViewInput = taskservice.GetTaskViewInput{
+		OrganizationIdentity: organizationIdentity,
+		TaskViewIdentity:     core.NewIdentityFromPublic(c.Param("viewId")),
+		UserIdentity:         *authenticatedUserIdentity,
+	}
+
+	response, err := h.GetTaskViewService.Execute(input)
+	if err != nil {
+		corehttp.NewHttpErrorResponse(c, err)
+		return
+	}
+
+	corehttp.NewHttpSuccessResponseWithData(c, http.StatusOK, response)
+}
+
+type CreateTaskViewResponse = corehttp.HttpSuccessResponseWithData[task.TaskViewDto]
+
+// CreateTaskView godoc
+// @Summary Create a task view
+// @Description Saves a named set of task filters, sorting and grouping. Views are private unless shared with a project.
+// @Tags Task View
+// @Accept json
+// @Param request body taskhttprequests.CreateTaskViewRequest true "Request body"
+// @Produce json
+// @Success 200 {object} CreateTaskViewResponse
+// @Failure 400 {object} corehttp.HttpErrorResponse
+// @Failure 401 {object} corehttp.HttpErrorResponse
+// @Failure 403 {object} corehttp.HttpErrorResponse
+// @Failure 404 {object} corehttp.HttpErrorResponse
+// @Failure 500 {object} corehttp.HttpErrorResponse
+// @Router /task-view [post]
+func (h *TaskViewHandler) CreateTaskView(c *gin.Context) {
+	var request taskhttprequests.CreateTaskViewRequest
+	var organizationIdentity *core.Identity = authhttpmiddlewares.GetAuthenticatedUserLastAccessedOrganizationIdentity(c)
+	var authenticatedUserIdentity *core.Identity = authhttpmiddlewares.GetAuthenticatedUserIdentity(c)
+	var input taskservice.CreateTaskViewInput
+
+	if err := c.ShouldBindJSON(&request); err != nil {
+		corehttp.NewHttpErrorResponse(c, err)
+		return
+	}
+
+	input = request.ToInput()
+	input.OrganizationIdentity = organizationIdentity
+	input.UserOwnerIdentity = *authenticatedUserIdentity
+	response, err := h.CreateTaskViewService.Execute(input)
+	if err != nil {
+		corehttp.NewHttpErrorResponse(c, err)
+		return
+	}
+
+	corehttp.NewHttpSuccessResponseWithData(c, http.StatusOK, response)
+}
+
+type UpdateTaskViewResponse = corehttp.HttpSuccessResponseWithData[task.TaskViewDto]
+
+// UpdateTaskView godoc
+// @Summary Update a task view
+// @Description Updates a task view owned by the authenticated user.
+// @Tags Task View
+// @Accept json
+// @Param viewId path string true "Task View ID"
+// @Param request body taskhttprequests.UpdateTaskViewRequest true "Request body"
+// @Produce json
+// @Success 200 {object} UpdateTaskViewResponse
+// @Failure 400 {object} corehttp.HttpErrorResponse
+// @Failure 401 {object} corehttp.HttpErrorResponse
+// @Failure 403 {object} corehttp.HttpErrorResponse
+// @Failure 404 {object} corehttp.HttpErrorResponse
+// @Failure 500 {object} corehttp.HttpErrorResponse
+// @Router /task-view/:viewId [put]
+func (h *TaskViewHandler) UpdateTaskView(c *gin.Context) {
+	var request taskhttprequests.UpdateTaskViewRequest
+	var organizationIdentity *core.Identity = authhttpmiddlewares.GetAuthenticatedUserLastAccessedOrganizationIdentity(c)
+	var authenticatedUserIdentity *core.Identity = authhttpmiddlewares.GetAuthenticatedUserIdentity(c)
+	var input taskservice.UpdateTaskViewInput
+
+	if err := c.ShouldBindJSON(&request); err != nil {
+		corehttp.NewHttpErrorResponse(c, err)
+		return
+	}
+
+	input = request.ToInput()
+	input.OrganizationIdentity = organizationIdentity
+	input.TaskViewIdentity = core.NewIdentityFromPublic(c.Param("viewId"))
+	input.UserEditorIdentity = *authenticatedUserIdentity
+	response, err := h.UpdateTaskViewService.Execute(input)
+	if err != nil {
+		corehttp.NewHttpErrorResponse(c, err)
+		return
+	}
+
+	corehttp.NewHttpSuccessResponseWithData(c, http.StatusOK, response)
+}
+
+type DeleteTaskViewResponse = corehttp.EmptyHttpSuccessResponse
+
+// DeleteTaskView godoc
+// @Summary Delete a task view
+// @Description Deletes a task view owned by the authenticated user.
+// @Tags Task View
+// @Accept json
+// @Param viewId path string true "Task View ID"
+// @Produce json
+// @Success 200 {object} DeleteTaskViewResponse
+// @Failure 400 {object} corehttp.HttpErrorResponse
+// @Failure 401 {object} corehttp.HttpErrorResponse
+// @Failure 403 {object} corehttp.HttpErrorResponse
+// @Failure 404 {object} corehttp.HttpErrorResponse
+// @Failure 500 {object} corehttp.HttpErrorResponse
+// @Router /task-view/:viewId [delete]
+func (h *TaskViewHandler) DeleteTaskView(c *gin.Context) {
+	var organizationIdentity *core.Identity = authhttpmiddlewares.GetAuthenticatedUserLastAccessedOrganizationIdentity(c)
+	var authenticatedUserIdentity *core.Identity = authhttpmiddlewares.GetAuthenticatedUserIdentity(c)
+	var input taskservice.DeleteTaskViewInput = taskservice.DeleteTaskViewInput{
+		OrganizationIdentity: organizationIdentity,
+		TaskViewIdentity:     core.NewIdentityFromPublic(c.Param("viewId")),
+		UserIdentity:         *authenticatedUserIdentity,
+	}
+
+	err := h.DeleteTaskViewService.Execute(input)
+	if err != nil {
+		corehttp.NewHttpErrorResponse(c, err)
+		return
+	}
+
+	corehttp.NewEmptyHttpSuccessResponse(c, http.StatusOK)
+}
+
+func (h *TaskViewHandler) ConfigureRoutes(options corehttp.ConfigureRoutesOptions) *gin.RouterGroup {
+	middlewareOptions := corehttp.MiddlewareOptions{
+		DbConnection: options.DbConnection,
+	}
+
+	g := options.RouterGroup.Group("/task-view")
+	{
+		g.Use(authhttpmiddlewares.AuthMiddleware(middlewareOptions))
+		g.GET("", organizationhttpmiddlewares.UserMustHavePermission("tasks:view", middlewareOptions), h.ListTaskViews)
+		g.POST("", organizationhttpmiddlewares.UserMustHavePermission("tasks:view", middlewareOptions), h.CreateTaskView)
+		g.GET("/:viewId", organizationhttpmiddlewares.UserMustHavePermission("tasks:view", middlewareOptions), h.GetTaskView)
+		g.PUT("/:viewId", organizationhttpmiddlewares.UserMustHavePermission("tasks:view", middlewareOptions), h.UpdateTaskView)
+		g.DELETE("/:viewId", organizationhttpmiddlewares.UserMustHavePermission("tasks:view", middlewareOptions), h.DeleteTaskView)
+	}
+
+	return g
+}
@@ -0,0 +1,45 @@
+package taskrepo
+
+import (
+	"github.com/gabrielmrtt/taski/internal/core"
+	"github.com/gabrielmrtt/taski/internal/task"
+)
+
+type TaskCalendarFeedFilters struct {
+	OrganizationIdentity *core.Identity
+	ProjectIdentity      *core.Identity
+	UserOwnerIdentity    *core.Identity
+	ShowRevoked          bool
+}
+
+type GetTaskCalendarFeedByIdentityParams struct {
+	TaskCalendarFeedIdentity core.Identity
+	OrganizationIdentity     *core.Identity
+}
+
+type GetTaskCalendarFeedByTokenHashParams struct {
+	TokenHash string
+}
+
+type ListTaskCalendarFeedsByParams struct {
+	Filters TaskCalendarFeedFilters
+}
+
+type StoreTaskCalendarFeedParams struct {
+	TaskCalendarFeed *task.TaskCalendarFeed
+}
+
+type UpdateTaskCalendarFeedParams struct {
+	TaskCalendarFeed *task.TaskCalendarFeed
+}
+
+type TaskCalendarFeedRepository interface {
+	SetTransaction(tx core.Transaction) error
+
+	GetTaskCalendarFeedByIdentity(params GetTaskCalendarFeedByIdentityParams) (*task.TaskCalendarFeed, error)
+	GetTaskCalendarFeedByTokenHash(params GetTaskCalendarFeedByTokenHashParams) (*task.TaskCalendarFeed, error)
+	ListTaskCalendarFeedsBy(params ListTaskCalendarFeedsByParams) ([]task.TaskCalendarFeed, error)
+
+	StoreTaskCalendarFeed(params StoreTaskCalendarFeedParams) (*task.TaskCalendarFeed, error)
+	UpdateTaskCalendarFeed(params UpdateTaskCalendarFeedParams) error
+}
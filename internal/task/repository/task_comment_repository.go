@@ -6,10 +6,13 @@ import (
 )
 
 type TaskCommentFilters struct {
-	TaskIdentity   *core.Identity
-	AuthorIdentity *core.Identity
-	CreatedAt      *core.ComparableFilter[int64]
-	UpdatedAt      *core.ComparableFilter[int64]
+	TaskIdentity              *core.Identity
+	ParentTaskCommentIdentity *core.Identity
+	AuthorIdentity            *core.Identity
+	IsReply                   *bool
+	Resolved                  *bool
+	CreatedAt                 *core.ComparableFilter[int64]
+	UpdatedAt                 *core.ComparableFilter[int64]
 }
 
 type GetTaskCommentByIdentityParams struct {
@@ -18,11 +21,15 @@ type GetTaskCommentByIdentityParams struct {
 	RelationsInput      core.RelationsInput
 }
 
+/*
+PaginateTaskCommentsParams loads the replies of each listed comment, oldest first, when IncludeReplies is set.
+*/
 type PaginateTaskCommentsParams struct {
 	Filters        TaskCommentFilters
 	Pagination     core.PaginationInput
 	SortInput      core.SortInput
 	RelationsInput core.RelationsInput
+	IncludeReplies bool
 }
 
 type StoreTaskCommentParams struct {
@@ -37,13 +44,32 @@ type DeleteTaskCommentParams struct {
 	TaskCommentIdentity core.Identity
 }
 
+type AddTaskCommentReactionParams struct {
+	TaskCommentIdentity core.Identity
+	Reaction            *task.TaskCommentReaction
+}
+
+type RemoveTaskCommentReactionParams struct {
+	TaskCommentIdentity core.Identity
+	UserIdentity        core.Identity
+	Emoji               string
+}
+
+type ListTaskCommentVersionsByParams struct {
+	TaskCommentIdentity core.Identity
+}
+
 type TaskCommentRepository interface {
 	SetTransaction(tx core.Transaction) error
 
 	GetTaskCommentByIdentity(params GetTaskCommentByIdentityParams) (*task.TaskComment, error)
 	PaginateTaskCommentsBy(params PaginateTaskCommentsParams) (*core.PaginationOutput[task.TaskComment], error)
+	ListTaskCommentVersionsBy(params ListTaskCommentVersionsByParams) ([]task.TaskCommentVersion, error)
 
 	StoreTaskComment(params StoreTaskCommentParams) (*task.TaskComment, error)
 	UpdateTaskComment(params UpdateTaskCommentParams) error
 	DeleteTaskComment(params DeleteTaskCommentParams) error
+
+	AddTaskCommentReaction(params AddTaskCommentReactionParams) error
+	RemoveTaskCommentReaction(params RemoveTaskCommentReactionParams) error
 }
@@ -0,0 +1,23 @@
+package taskrepo
+
+import (
+	"github.com/gabrielmrtt/taski/internal/core"
+	"github.com/gabrielmrtt/taski/internal/task"
+)
+
+type TaskMentionFilters struct {
+	UserIdentity         core.Identity
+	OrganizationIdentity core.Identity
+	ProjectIdentity      *core.Identity
+}
+
+type PaginateTaskMentionsParams struct {
+	Filters    TaskMentionFilters
+	Pagination core.PaginationInput
+}
+
+type TaskMentionRepository interface {
+	SetTransaction(tx core.Transaction) error
+
+	PaginateTaskMentionsBy(params PaginateTaskMentionsParams) (*core.PaginationOutput[task.TaskMention], error)
+}
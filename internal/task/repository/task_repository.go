@@ -2,9 +2,24 @@ package taskrepo
 
 import (
 	"github.com/gabrielmrtt/taski/internal/core"
+	"github.com/gabrielmrtt/taski/internal/project"
 	"github.com/gabrielmrtt/taski/internal/task"
 )
 
+type TaskCustomFieldFilter struct {
+	CustomFieldIdentity core.Identity
+	Text                *core.ComparableFilter[string]
+	Number              *core.ComparableFilter[float64]
+	Date                *core.ComparableFilter[int64]
+	User                *core.ComparableFilter[string]
+}
+
+type TaskCustomFieldSortInput struct {
+	CustomFieldIdentity core.Identity
+	CustomFieldType     project.ProjectTaskCustomFieldTypes
+	Direction           core.SortDirection
+}
+
 type TaskFilters struct {
 	OrganizationIdentity      *core.Identity
 	AuthenticatedUserIdentity *core.Identity
@@ -19,6 +34,8 @@ type TaskFilters struct {
 	DueDate                   *core.ComparableFilter[int64]
 	Type                      *core.ComparableFilter[task.TaskType]
 	Priority                  *core.ComparableFilter[task.TaskPriorityLevels]
+	CustomFields              []TaskCustomFieldFilter
+	Expression                core.FilterExpression
 }
 
 type GetTaskByIdentityParams struct {
@@ -28,16 +45,32 @@ type GetTaskByIdentityParams struct {
 	RelationsInput       core.RelationsInput
 }
 
+type GetTaskByExternalIdParams struct {
+	ProjectIdentity core.Identity
+	ExternalId      string
+	RelationsInput  core.RelationsInput
+}
+
 type GetTasksByParentTaskIdentityParams struct {
 	ParentTaskIdentity core.Identity
 	RelationsInput     core.RelationsInput
 }
 
 type PaginateTasksParams struct {
-	Filters        TaskFilters
-	Pagination     core.PaginationInput
-	SortInput      core.SortInput
-	RelationsInput core.RelationsInput
+	ShowDeleted          bool
+	Filters              TaskFilters
+	Pagination           core.PaginationInput
+	SortInput            core.SortInput
+	CustomFieldSortInput *TaskCustomFieldSortInput
+	GroupBy              *task.TaskGroupByFields
+	RelationsInput       core.RelationsInput
+}
+
+type GetAdjacentTaskRankParams struct {
+	ProjectIdentity           core.Identity
+	ProjectTaskStatusIdentity *core.Identity
+	Rank                      *string
+	Direction                 core.SortDirection
 }
 
 type StoreTaskParams struct {
@@ -71,8 +104,10 @@ type TaskRepository interface {
 	SetTransaction(tx core.Transaction) error
 
 	GetTaskByIdentity(params GetTaskByIdentityParams) (*task.Task, error)
+	GetTaskByExternalId(params GetTaskByExternalIdParams) (*task.Task, error)
 	GetTasksByParentTaskIdentity(params GetTasksByParentTaskIdentityParams) ([]*task.Task, error)
 	PaginateTasksBy(params PaginateTasksParams) (*core.PaginationOutput[task.Task], error)
+	GetAdjacentTaskRank(params GetAdjacentTaskRankParams) (string, error)
 
 	AddSubTask(params AddSubTaskParams) error
 	UpdateSubTask(params UpdateSubTaskParams) error
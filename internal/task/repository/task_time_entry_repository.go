@@ -0,0 +1,81 @@
+package taskrepo
+
+import (
+	"github.com/gabrielmrtt/taski/internal/core"
+	"github.com/gabrielmrtt/taski/internal/task"
+)
+
+type TaskTimeEntryFilters struct {
+	TaskIdentity *core.Identity
+	UserIdentity *core.Identity
+	StartedAt    *core.ComparableFilter[int64]
+	IsRunning    *bool
+}
+
+type GetTaskTimeEntryByIdentityParams struct {
+	TaskTimeEntryIdentity core.Identity
+	TaskIdentity          *core.Identity
+}
+
+type GetRunningTaskTimeEntryParams struct {
+	UserIdentity core.Identity
+}
+
+type PaginateTaskTimeEntriesParams struct {
+	Filters    TaskTimeEntryFilters
+	Pagination core.PaginationInput
+	SortInput  core.SortInput
+}
+
+type GetTaskTimeTotalsParams struct {
+	TaskIdentity core.Identity
+}
+
+type TaskTimeTotals struct {
+	TrackedSeconds  int64
+	RolledUpSeconds int64
+}
+
+type ReportTaskTimeEntriesParams struct {
+	OrganizationIdentity      *core.Identity
+	AuthenticatedUserIdentity *core.Identity
+	ProjectIdentity           *core.Identity
+	UserIdentity              *core.Identity
+	From                      core.DateTime
+	To                        core.DateTime
+}
+
+type TaskTimeReportRow struct {
+	UserIdentity    core.Identity
+	UserName        string
+	ProjectIdentity core.Identity
+	ProjectName     string
+	Date            string
+	TrackedSeconds  int64
+}
+
+type StoreTaskTimeEntryParams struct {
+	TaskTimeEntry *task.TaskTimeEntry
+}
+
+type UpdateTaskTimeEntryParams struct {
+	TaskTimeEntry *task.TaskTimeEntry
+}
+
+type DeleteTaskTimeEntryParams struct {
+	TaskTimeEntryIdentity core.Identity
+}
+
+type TaskTimeEntryRepository interface {
+	SetTransaction(tx core.Transaction) error
+
+	GetTaskTimeEntryByIdentity(params GetTaskTimeEntryByIdentityParams) (*task.TaskTimeEntry, error)
+	GetRunningTaskTimeEntry(params GetRunningTaskTimeEntryParams) (*task.TaskTimeEntry, error)
+	PaginateTaskTimeEntriesBy(params PaginateTaskTimeEntriesParams) (*core.PaginationOutput[task.TaskTimeEntry], error)
+	GetTaskTimeTotals(params GetTaskTimeTotalsParams) (*TaskTimeTotals, error)
+	ReportTaskTimeEntries(params ReportTaskTimeEntriesParams) ([]TaskTimeReportRow, error)
+
+	StoreTaskTimeEntry(params StoreTaskTimeEntryParams) (*task.TaskTimeEntry, error)
+	UpdateTaskTimeEntry(params UpdateTaskTimeEntryParams) error
+	DeleteTaskTimeEntry(params DeleteTaskTimeEntryParams) error
+}
@@ -0,0 +1,44 @@
+package taskrepo
+
+import (
+	"github.com/gabrielmrtt/taski/internal/core"
+	"github.com/gabrielmrtt/taski/internal/task"
+)
+
+type TaskViewFilters struct {
+	OrganizationIdentity *core.Identity
+	ProjectIdentity      *core.Identity
+	UserIdentity         *core.Identity
+}
+
+type GetTaskViewByIdentityParams struct {
+	TaskViewIdentity     core.Identity
+	OrganizationIdentity *core.Identity
+}
+
+type ListTaskViewsByParams struct {
+	Filters TaskViewFilters
+}
+
+type StoreTaskViewParams struct {
+	TaskView *task.TaskView
+}
+
+type UpdateTaskViewParams struct {
+	TaskView *task.TaskView
+}
+
+type DeleteTaskViewParams struct {
+	TaskViewIdentity core.Identity
+}
+
+type TaskViewRepository interface {
+	SetTransaction(tx core.Transaction) error
+
+	GetTaskViewByIdentity(params GetTaskViewByIdentityParams) (*task.TaskView, error)
+	ListTaskViewsBy(params ListTaskViewsByParams) ([]task.TaskView, error)
+
+	StoreTaskView(params StoreTaskViewParams) (*task.TaskView, error)
+	UpdateTaskView(params UpdateTaskViewParams) error
+	DeleteTaskView(params DeleteTaskViewParams) error
+}
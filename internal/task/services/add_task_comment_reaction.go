@@ -0,0 +1,148 @@
+package taskservice
+
+import (
+	"github.com/gabrielmrtt/taski/internal/core"
+	projectrepo "github.com/gabrielmrtt/taski/internal/project/repository"
+	"github.com/gabrielmrtt/taski/internal/task"
+	taskrepo "github.com/gabrielmrtt/taski/internal/task/repository"
+)
+
+type AddTaskCommentReactionService struct {
+	TaskCommentRepository taskrepo.TaskCommentRepository
+	TaskRepository        taskrepo.TaskRepository
+	ProjectUserRepository projectrepo.ProjectUserRepository
+	TransactionRepository core.TransactionRepository
+}
+
+func NewAddTaskCommentReactionService(
+	taskCommentRepository taskrepo.TaskCommentRepository,
+	taskRepository taskrepo.TaskRepository,
+	projectUserRepository projectrepo.ProjectUserRepository,
+	transactionRepository core.TransactionRepository,
+) *AddTaskCommentReactionService {
+	return &AddTaskCommentReactionService{
+		TaskCommentRepository: taskCommentRepository,
+		TaskRepository:        taskRepository,
+		ProjectUserRepository: projectUserRepository,
+		TransactionRepository: transactionRepository,
+	}
+}
+
+type AddTaskCommentReactionInput struct {
+	TaskIdentity        core.Identity
+	TaskCommentIdentity core.Identity
+	Emoji               string
+	UserIdentity        core.Identity
+}
+
+func (i AddTaskCommentReactionInput) Validate() error {
+	var fields []core.InvalidInputErrorField
+
+	if _, err := task.NewTaskCommentReactionEmoji(i.Emoji); err != nil {
+		fields = append(fields, core.InvalidInputErrorField{
+			Field: "emoji",
+			Error: err.Error(),
+		})
+	}
+
+	if len(fields) > 0 {
+		return core.NewInvalidInputError("invalid input", fields)
+	}
+
+	return nil
+}
+
+/*
+Execute adds the reaction of the user to the comment. Reacting twice with the same emoji keeps a single reaction.
+*/
+func (s *AddTaskCommentReactionService) Execute(input AddTaskCommentReactionInput) error {
+	if err := input.Validate(); err != nil {
+		return err
+	}
+
+	tx, err := s.TransactionRepository.BeginTransaction()
+	if err != nil {
+		return err
+	}
+
+	s.TaskCommentRepository.SetTransaction(tx)
+	s.TaskRepository.SetTransaction(tx)
+	s.ProjectUserRepository.SetTransaction(tx)
+
+	tsk, err := s.TaskRepository.GetTaskByIdentity(taskrepo.GetTaskByIdentityParams{
+		TaskIdentity: input.TaskIdentity,
+	})
+	if err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	if tsk == nil {
+		tx.Rollback()
+		return core.NewNotFoundError("task not found")
+	}
+
+	usr, err := s.ProjectUserRepository.GetProjectUserByIdentity(projectrepo.GetProjectUserByIdentityParams{
+		ProjectIdentity: tsk.ProjectIdentity,
+		UserIdentity:    input.UserIdentity,
+	})
+	if err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	if usr == nil {
+		tx.Rollback()
+		return core.NewNotFoundError("project user not found")
+	}
+
+	comment, err := s.TaskCommentRepository.GetTaskCommentByIdentity(taskrepo.GetTaskCommentByIdentityParams{
+		TaskCommentIdentity: input.TaskCommentIdentity,
+		TaskIdentity:        &input.TaskIdentity,
+	})
+	if err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	if comment == nil {
+		tx.Rollback()
+		return core.NewNotFoundError("task comment not found")
+	}
+
+	if comment.IsDeleted() {
+		tx.Rollback()
+		return core.NewConflictError("task comment is deleted")
+	}
+
+	if comment.HasReaction(input.UserIdentity, input.Emoji) {
+		tx.Rollback()
+		return nil
+	}
+
+	reaction, err := task.NewTaskCommentReaction(task.NewTaskCommentReactionInput{
+		UserIdentity: input.UserIdentity,
+		Emoji:        input.Emoji,
+	})
+	if err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	err = s.TaskCommentRepository.AddTaskCommentReaction(taskrepo.AddTaskCommentReactionParams{
+		TaskCommentIdentity: comment.Identity,
+		Reaction:            reaction,
+	})
+	if err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	return nil
+}
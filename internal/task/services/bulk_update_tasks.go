@@ -0,0 +1,516 @@
+package taskservice
+
+import (
+	"slices"
+	"strings"
+
+	"github.com/gabrielmrtt/taski/internal/core"
+	organizationrepo "github.com/gabrielmrtt/taski/internal/organization/repository"
+	projectrepo "github.com/gabrielmrtt/taski/internal/project/repository"
+	"github.com/gabrielmrtt/taski/internal/role"
+	"github.com/gabrielmrtt/taski/internal/task"
+	taskrepo "github.com/gabrielmrtt/taski/internal/task/repository"
+	"github.com/gabrielmrtt/taski/internal/user"
+)
+
+const maxBulkTasks = 200
+
+type BulkUpdateTasksService struct {
+	TaskRepository                        taskrepo.TaskRepository
+	TaskActionRepository                  taskrepo.TaskActionRepository
+	ProjectTaskStatusRepository           projectrepo.ProjectTaskStatusRepository
+	ProjectTaskStatusTransitionRepository projectrepo.ProjectTaskStatusTransitionRepository
+	ProjectTaskCategoryRepository         projectrepo.ProjectTaskCategoryRepository
+	ProjectUserRepository                 projectrepo.ProjectUserRepository
+	OrganizationUserRepository            organizationrepo.OrganizationUserRepository
+	TransactionRepository                 core.TransactionRepository
+}
+
+func NewBulkUpdateTasksService(
+	taskRepository taskrepo.TaskRepository,
+	taskActionRepository taskrepo.TaskActionRepository,
+	projectTaskStatusRepository projectrepo.ProjectTaskStatusRepository,
+	projectTaskStatusTransitionRepository projectrepo.ProjectTaskStatusTransitionRepository,
+	projectTaskCategoryRepository projectrepo.ProjectTaskCategoryRepository,
+	projectUserRepository projectrepo.ProjectUserRepository,
+	organizationUserRepository organizationrepo.OrganizationUserRepository,
+	transactionRepository core.TransactionRepository,
+) *BulkUpdateTasksService {
+	return &BulkUpdateTasksService{
+		TaskRepository:                        taskRepository,
+		TaskActionRepository:                  taskActionRepository,
+		ProjectTaskStatusRepository:           projectTaskStatusRepository,
+		ProjectTaskStatusTransitionRepository: projectTaskStatusTransitionRepository,
+		ProjectTaskCategoryRepository:         projectTaskCategoryRepository,
+		ProjectUserRepository:                 projectUserRepository,
+		OrganizationUserRepository:            organizationUserRepository,
+		TransactionRepository:                 transactionRepository,
+	}
+}
+
+type BulkUpdateTasksInput struct {
+	OrganizationIdentity core.Identity
+	TaskIdentities       []core.Identity
+	Filter               *string
+	StatusIdentity       *core.Identity
+	CategoryIdentity     *core.Identity
+	PriorityLevel        *task.TaskPriorityLevels
+	AddUserIdentities    []core.Identity
+	RemoveUserIdentities []core.Identity
+	ShiftDueDateDays     *int
+	Complete             *bool
+	Delete               bool
+	UserEditorIdentity   core.Identity
+}
+
+func (i BulkUpdateTasksInput) hasChanges() bool {
+	return i.StatusIdentity != nil ||
+		i.CategoryIdentity != nil ||
+		i.PriorityLevel != nil ||
+		len(i.AddUserIdentities) > 0 ||
+		len(i.RemoveUserIdentities) > 0 ||
+		i.ShiftDueDateDays != nil ||
+		i.Complete != nil
+}
+
+func (i BulkUpdateTasksInput) Validate() error {
+	var fields []core.InvalidInputErrorField
+
+	var hasFilter bool = i.Filter != nil && strings.TrimSpace(*i.Filter) != ""
+	if len(i.TaskIdentities) == 0 && !hasFilter {
+		fields = append(fields, core.InvalidInputErrorField{
+			Field: "taskIds",
+			Error: "task ids or a filter are required",
+		})
+	}
+
+	if len(i.TaskIdentities) > 0 && hasFilter {
+		fields = append(fields, core.InvalidInputErrorField{
+			Field: "filter",
+			Error: "filter cannot be used together with task ids",
+		})
+	}
+
+	if len(i.TaskIdentities) > maxBulkTasks {
+		fields = append(fields, core.InvalidInputErrorField{
+			Field: "taskIds",
+			Error: "at most 200 tasks can be changed at once",
+		})
+	}
+
+	if !i.hasChanges() && !i.Delete {
+		fields = append(fields, core.InvalidInputErrorField{
+			Field: "changes",
+			Error: "at least one change is required",
+		})
+	}
+
+	if i.hasChanges() && i.Delete {
+		fields = append(fields, core.InvalidInputErrorField{
+			Field: "delete",
+			Error: "deleted tasks cannot be changed in the same operation",
+		})
+	}
+
+	if i.PriorityLevel != nil && (*i.PriorityLevel < task.TaskPriorityLevelNone || *i.PriorityLevel > task.TaskPriorityLevelUrgent) {
+		fields = append(fields, core.InvalidInputErrorField{
+			Field: "priorityLevel",
+			Error: "priority level must be between 0 and 5",
+		})
+	}
+
+	if i.ShiftDueDateDays != nil && *i.ShiftDueDateDays == 0 {
+		fields = append(fields, core.InvalidInputErrorField{
+			Field: "shiftDueDateDays",
+			Error: "due date shift cannot be zero",
+		})
+	}
+
+	for _, userIdentity := range i.AddUserIdentities {
+		if slices.ContainsFunc(i.RemoveUserIdentities, userIdentity.Equals) {
+			fields = append(fields, core.InvalidInputErrorField{
+				Field: "removeUserIds",
+				Error: "user " + userIdentity.Public + " cannot be added and removed at once",
+			})
+		}
+	}
+
+	if len(fields) > 0 {
+		return core.NewInvalidInputError("invalid input", fields)
+	}
+
+	return nil
+}
+
+/*
+isTaskBulkItemError reports whether the error only concerns a single task of the bulk operation, in which case it
+is reported for that task while the other tasks are still changed.
+*/
+func isTaskBulkItemError(err error) bool {
+	switch err.(type) {
+	case *core.NotFoundError, *core.UnauthorizedError, *core.ForbiddenError, *core.ConflictError, *core.InvalidInputError:
+		return true
+	}
+
+	return false
+}
+
+func taskBulkItemErrorMessage(err error) string {
+	invalidInputError, ok := err.(*core.InvalidInputError)
+	if !ok || len(invalidInputError.Fields) == 0 {
+		return err.Error()
+	}
+
+	var fields []string = make([]string, len(invalidInputError.Fields))
+	for i, field := range invalidInputError.Fields {
+		fields[i] = field.Field + ": " + field.Error
+	}
+
+	return err.Error() + " (" + strings.Join(fields, ", ") + ")"
+}
+
+func (s *BulkUpdateTasksService) Execute(input BulkUpdateTasksInput) (*task.TaskBulkReportDto, error) {
+	if err := input.Validate(); err != nil {
+		return nil, err
+	}
+
+	tx, err := s.TransactionRepository.BeginTransaction()
+	if err != nil {
+		return nil, err
+	}
+
+	s.TaskRepository.SetTransaction(tx)
+	s.TaskActionRepository.SetTransaction(tx)
+	s.ProjectTaskStatusRepository.SetTransaction(tx)
+	s.ProjectTaskStatusTransitionRepository.SetTransaction(tx)
+	s.ProjectTaskCategoryRepository.SetTransaction(tx)
+	s.ProjectUserRepository.SetTransaction(tx)
+	s.OrganizationUserRepository.SetTransaction(tx)
+
+	organizationUser, err := s.OrganizationUserRepository.GetOrganizationUserByIdentity(organizationrepo.GetOrganizationUserByIdentityParams{
+		OrganizationIdentity: input.OrganizationIdentity,
+		UserIdentity:         input.UserEditorIdentity,
+	})
+	if err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+
+	if organizationUser == nil || !organizationUser.IsActive() {
+		tx.Rollback()
+		return nil, core.NewUnauthorizedError("you're not part of this organization")
+	}
+
+	if input.Delete && !organizationUser.CanExecuteAction(role.TasksDelete) {
+		tx.Rollback()
+		return nil, core.NewUnauthorizedError("you can't execute this action")
+	}
+
+	taskIdentities, tasks, err := s.getTasks(input)
+	if err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+
+	var report *task.TaskBulkReportDto = &task.TaskBulkReportDto{
+		Total:   len(taskIdentities),
+		Results: make([]task.TaskBulkResultDto, 0, len(taskIdentities)),
+	}
+
+	for i, taskIdentity := range taskIdentities {
+		var warnings []string = make([]string, 0)
+		var err error = core.NewNotFoundError("task not found")
+
+		if tasks[i] != nil {
+			warnings, err = s.applyChanges(tasks[i], input)
+		}
+
+		if err != nil && !isTaskBulkItemError(err) {
+			tx.Rollback()
+			return nil, err
+		}
+
+		result := task.TaskBulkResultDto{
+			TaskId:   taskIdentity.Public,
+			Success:  err == nil,
+			Warnings: warnings,
+		}
+
+		if err != nil {
+			message := taskBulkItemErrorMessage(err)
+			result.Error = &message
+			report.Failed++
+		} else {
+			report.Succeeded++
+		}
+
+		report.Results = append(report.Results, result)
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+
+	return report, nil
+}
+
+/*
+getTasks returns the tasks the operation applies to, either the given ones or the ones matching the filter. Tasks
+that cannot be found are returned as nil so they are reported as failures.
+*/
+func (s *BulkUpdateTasksService) getTasks(input BulkUpdateTasksInput) ([]core.Identity, []*task.Task, error) {
+	var taskIdentities []core.Identity = make([]core.Identity, 0)
+	var tasks []*task.Task = make([]*task.Task, 0)
+
+	if len(input.TaskIdentities) > 0 {
+		for _, taskIdentity := range input.TaskIdentities {
+			if slices.ContainsFunc(taskIdentities, taskIdentity.Equals) {
+				continue
+			}
+
+			tsk, err := s.TaskRepository.GetTaskByIdentity(taskrepo.GetTaskByIdentityParams{
+				TaskIdentity:         taskIdentity,
+				OrganizationIdentity: &input.OrganizationIdentity,
+			})
+			if err != nil {
+				return nil, nil, err
+			}
+
+			taskIdentities = append(taskIdentities, taskIdentity)
+			tasks = append(tasks, tsk)
+		}
+
+		return taskIdentities, tasks, nil
+	}
+
+	filterExpression, err := core.ParseFilterExpression(*input.Filter)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if err := core.ResolveFilterExpression(filterExpression, task.TaskFilterableFields); err != nil {
+		return nil, nil, err
+	}
+
+	var page int = 1
+	var perPage int = maxBulkTasks + 1
+	var withTotal bool = false
+	matchingTasks, err := s.TaskRepository.PaginateTasksBy(taskrepo.PaginateTasksParams{
+		Filters: taskrepo.TaskFilters{
+			OrganizationIdentity:      &input.OrganizationIdentity,
+			AuthenticatedUserIdentity: &input.UserEditorIdentity,
+			Expression:                filterExpression,
+		},
+		Pagination: core.PaginationInput{
+			Page:      &page,
+			PerPage:   &perPage,
+			WithTotal: &withTotal,
+		},
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if len(matchingTasks.Data) > maxBulkTasks {
+		return nil, nil, core.NewInvalidInputError("invalid input", []core.InvalidInputErrorField{
+			{
+				Field: "filter",
+				Error: "filter matches more than 200 tasks",
+			},
+		})
+	}
+
+	for i := range matchingTasks.Data {
+		taskIdentities = append(taskIdentities, matchingTasks.Data[i].Identity)
+		tasks = append(tasks, &matchingTasks.Data[i])
+	}
+
+	return taskIdentities, tasks, nil
+}
+
+/*
+applyChanges applies the requested changes to a single task and stores one task action for each kind of change.
+The task is only written once every change has been checked, so a failing task leaves nothing behind.
+*/
+func (s *BulkUpdateTasksService) applyChanges(tsk *task.Task, input BulkUpdateTasksInput) ([]string, error) {
+	var warnings []string = make([]string, 0)
+	var actionTypes []task.TaskActionType = make([]task.TaskActionType, 0)
+	var updated bool = false
+
+	projectUser, err := s.ProjectUserRepository.GetProjectUserByIdentity(projectrepo.GetProjectUserByIdentityParams{
+		ProjectIdentity: tsk.ProjectIdentity,
+		UserIdentity:    input.UserEditorIdentity,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if projectUser == nil || !projectUser.IsActive() {
+		return nil, core.NewUnauthorizedError("you are not a member of the task project")
+	}
+
+	if input.Delete {
+		tsk.Delete()
+		actionTypes = append(actionTypes, task.TaskActionTypeDelete)
+	}
+
+	if input.StatusIdentity != nil && (tsk.Status == nil || !tsk.Status.Identity.Equals(*input.StatusIdentity)) {
+		status, err := s.ProjectTaskStatusRepository.GetProjectTaskStatusByIdentity(projectrepo.GetProjectTaskStatusByIdentityParams{
+			ProjectTaskStatusIdentity: input.StatusIdentity,
+			ProjectIdentity:           &tsk.ProjectIdentity,
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		if status == nil {
+			return nil, core.NewNotFoundError("project task status not found")
+		}
+
+		err = validateTaskStatusTransition(s.ProjectTaskStatusTransitionRepository, s.OrganizationUserRepository, tsk, status, input.OrganizationIdentity, input.UserEditorIdentity)
+		if err != nil {
+			return nil, err
+		}
+
+		err = tsk.ChangeStatus(status, &input.UserEditorIdentity)
+		if err != nil {
+			return nil, err
+		}
+
+		statusWarnings, err := checkTaskStatusWipLimit(s.ProjectTaskStatusRepository, status, tsk)
+		if err != nil {
+			return nil, err
+		}
+
+		warnings = append(warnings, statusWarnings...)
+
+		// Tasks moved by a bulk operation are placed last in their new status.
+		previousRank, err := s.TaskRepository.GetAdjacentTaskRank(taskrepo.GetAdjacentTaskRankParams{
+			ProjectIdentity:           tsk.ProjectIdentity,
+			ProjectTaskStatusIdentity: &status.Identity,
+			Direction:                 core.SortDirectionDesc,
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		err = tsk.ChangeRank(previousRank, "", &input.UserEditorIdentity)
+		if err != nil {
+			return nil, err
+		}
+
+		actionTypes = append(actionTypes, task.TaskActionTypeChangeStatus)
+	}
+
+	if input.CategoryIdentity != nil {
+		category, err := s.ProjectTaskCategoryRepository.GetProjectTaskCategoryByIdentity(projectrepo.GetProjectTaskCategoryByIdentityParams{
+			ProjectTaskCategoryIdentity: input.CategoryIdentity,
+			ProjectIdentity:             &tsk.ProjectIdentity,
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		if category == nil {
+			return nil, core.NewNotFoundError("project task category not found")
+		}
+
+		err = tsk.ChangeCategory(category, &input.UserEditorIdentity)
+		if err != nil {
+			return nil, err
+		}
+
+		updated = true
+	}
+
+	if input.PriorityLevel != nil {
+		err = tsk.ChangePriorityLevel(*input.PriorityLevel, &input.UserEditorIdentity)
+		if err != nil {
+			return nil, err
+		}
+
+		updated = true
+	}
+
+	for _, userIdentity := range input.AddUserIdentities {
+		if tsk.HasUser(userIdentity) {
+			continue
+		}
+
+		assignee, err := s.ProjectUserRepository.GetProjectUserByIdentity(projectrepo.GetProjectUserByIdentityParams{
+			ProjectIdentity: tsk.ProjectIdentity,
+			UserIdentity:    userIdentity,
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		if assignee == nil {
+			return nil, core.NewNotFoundError("project user " + userIdentity.Public + " not found")
+		}
+
+		tsk.AddUser(&task.TaskUser{
+			User: &assignee.User,
+		}, &input.UserEditorIdentity)
+		updated = true
+	}
+
+	for _, userIdentity := range input.RemoveUserIdentities {
+		if !tsk.HasUser(userIdentity) {
+			continue
+		}
+
+		tsk.RemoveUser(&task.TaskUser{
+			User: &user.User{Identity: userIdentity},
+		}, &input.UserEditorIdentity)
+		updated = true
+	}
+
+	if input.ShiftDueDateDays != nil {
+		err = tsk.ShiftDueDate(int64(*input.ShiftDueDateDays)*86400, &input.UserEditorIdentity)
+		if err != nil {
+			return nil, err
+		}
+
+		updated = true
+	}
+
+	if input.Complete != nil && *input.Complete != tsk.IsCompleted() {
+		if *input.Complete {
+			tsk.Complete(&input.UserEditorIdentity)
+			actionTypes = append(actionTypes, task.TaskActionTypeComplete)
+		} else {
+			tsk.Uncomplete()
+			actionTypes = append(actionTypes, task.TaskActionTypeUncomplete)
+		}
+	}
+
+	if updated {
+		actionTypes = append(actionTypes, task.TaskActionTypeUpdate)
+	}
+
+	if len(actionTypes) == 0 {
+		return warnings, nil
+	}
+
+	err = s.TaskRepository.UpdateTask(taskrepo.UpdateTaskParams{
+		Task: tsk,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	for _, actionType := range actionTypes {
+		taskAction := tsk.RegisterAction(actionType, &projectUser.User)
+		_, err = s.TaskActionRepository.StoreTaskAction(taskrepo.StoreTaskActionParams{
+			TaskAction: &taskAction,
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return warnings, nil
+}
@@ -0,0 +1,129 @@
+package taskservice
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/gabrielmrtt/taski/internal/core"
+	"github.com/gabrielmrtt/taski/internal/organization"
+	"github.com/gabrielmrtt/taski/internal/project"
+	projectrepo "github.com/gabrielmrtt/taski/internal/project/repository"
+	"github.com/gabrielmrtt/taski/internal/role"
+	"github.com/gabrielmrtt/taski/internal/task"
+	"github.com/gabrielmrtt/taski/internal/user"
+)
+
+func (r *memoryProjectTaskStatusRepository) SetTransaction(tx core.Transaction) error { return nil }
+
+func (r *memoryProjectTaskStatusRepository) GetProjectTaskStatusByIdentity(params projectrepo.GetProjectTaskStatusByIdentityParams) (*project.ProjectTaskStatus, error) {
+	for _, status := range r.statuses {
+		if status.Identity.Equals(*params.ProjectTaskStatusIdentity) {
+			return status, nil
+		}
+	}
+
+	return nil, nil
+}
+
+func (r *memoryProjectTaskStatusTransitionRepository) SetTransaction(tx core.Transaction) error {
+	return nil
+}
+
+func (r *memoryOrganizationUserRepository) SetTransaction(tx core.Transaction) error { return nil }
+
+type memoryProjectTaskCategoryRepository struct {
+	projectrepo.ProjectTaskCategoryRepository
+}
+
+func (r *memoryProjectTaskCategoryRepository) SetTransaction(tx core.Transaction) error { return nil }
+
+func TestBulkUpdateTasksReportsPartialFailures(t *testing.T) {
+	organizationIdentity := core.NewIdentity("org")
+	projectIdentity := core.NewIdentity("prj")
+	outsideProjectIdentity := core.NewIdentity("prj")
+	editor := user.User{Identity: core.NewIdentity("usr")}
+	member := role.Role{Identity: core.NewIdentity("rol")}
+	admin := role.Role{Identity: core.NewIdentity("rol")}
+
+	todo := &project.ProjectTaskStatus{Identity: core.NewIdentity("pts")}
+	doing := &project.ProjectTaskStatus{Identity: core.NewIdentity("pts")}
+	done := &project.ProjectTaskStatus{Identity: core.NewIdentity("pts"), Name: "Done"}
+
+	moved := &task.Task{Identity: core.NewIdentity("tsk"), ProjectIdentity: projectIdentity, Status: doing}
+	forbidden := &task.Task{Identity: core.NewIdentity("tsk"), ProjectIdentity: projectIdentity, Status: todo}
+	outside := &task.Task{Identity: core.NewIdentity("tsk"), ProjectIdentity: outsideProjectIdentity, Status: doing}
+	missing := core.NewIdentity("tsk")
+
+	taskRepository := &memoryTaskRepository{tasks: []*task.Task{moved, forbidden, outside}}
+	taskActionRepository := &memoryTaskActionRepository{}
+	transactionRepository := &memoryTransactionRepository{}
+
+	service := NewBulkUpdateTasksService(
+		taskRepository,
+		taskActionRepository,
+		&memoryProjectTaskStatusRepository{statuses: []*project.ProjectTaskStatus{todo, doing, done}},
+		&memoryProjectTaskStatusTransitionRepository{transitions: []project.ProjectTaskStatusTransition{
+			{ProjectIdentity: projectIdentity, FromStatusIdentity: &doing.Identity, ToStatusIdentity: done.Identity},
+			{ProjectIdentity: projectIdentity, FromStatusIdentity: &todo.Identity, ToStatusIdentity: done.Identity, RoleIdentities: []core.Identity{admin.Identity}},
+		}},
+		&memoryProjectTaskCategoryRepository{},
+		&memoryProjectUserRepository{outsideProjects: []core.Identity{outsideProjectIdentity}},
+		&memoryOrganizationUserRepository{organizationUsers: []organization.OrganizationUser{
+			{OrganizationIdentity: organizationIdentity, User: editor, Role: member, Status: organization.OrganizationUserStatusActive},
+		}},
+		transactionRepository,
+	)
+
+	priority := task.TaskPriorityLevelUrgent
+	report, err := service.Execute(BulkUpdateTasksInput{
+		OrganizationIdentity: organizationIdentity,
+		TaskIdentities:       []core.Identity{moved.Identity, missing, outside.Identity, forbidden.Identity, moved.Identity},
+		StatusIdentity:       &done.Identity,
+		PriorityLevel:        &priority,
+		UserEditorIdentity:   editor.Identity,
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if !transactionRepository.last().committed {
+		t.Fatal("expected the successful changes to be committed")
+	}
+
+	if report.Total != 4 || report.Succeeded != 1 || report.Failed != 3 {
+		t.Fatalf("expected 4 tasks with 1 success and 3 failures, got %d, %d and %d", report.Total, report.Succeeded, report.Failed)
+	}
+
+	expected := []struct {
+		taskId  string
+		success bool
+		error   string
+	}{
+		{taskId: moved.Identity.Public, success: true},
+		{taskId: missing.Public, error: "task not found"},
+		{taskId: outside.Identity.Public, error: "you are not a member of the task project"},
+		{taskId: forbidden.Identity.Public, error: "you are not allowed to perform this status transition"},
+	}
+
+	for i, result := range report.Results {
+		if result.TaskId != expected[i].taskId || result.Success != expected[i].success {
+			t.Errorf("result %d: expected task %s with success %t, got %s with %t", i, expected[i].taskId, expected[i].success, result.TaskId, result.Success)
+		}
+
+		if expected[i].success != (result.Error == nil) || (result.Error != nil && !strings.Contains(*result.Error, expected[i].error)) {
+			t.Errorf("result %d: expected error %q, got %v", i, expected[i].error, result.Error)
+		}
+	}
+
+	if len(taskRepository.updated) != 1 || !taskRepository.updated[0].Equals(moved.Identity) {
+		t.Errorf("expected only the successful task to be stored, got %v", taskRepository.updated)
+	}
+
+	if moved.Status != done || moved.PriorityLevel != priority {
+		t.Errorf("expected the successful task to be changed, got status %v and priority %d", moved.Status, moved.PriorityLevel)
+	}
+
+	if len(taskActionRepository.actions) != 2 {
+		t.Errorf("expected a status and an update action, got %d actions", len(taskActionRepository.actions))
+	}
+}
@@ -2,33 +2,47 @@ package taskservice
 
 import (
 	"github.com/gabrielmrtt/taski/internal/core"
+	organizationrepo "github.com/gabrielmrtt/taski/internal/organization/repository"
 	"github.com/gabrielmrtt/taski/internal/project"
 	projectrepo "github.com/gabrielmrtt/taski/internal/project/repository"
 	"github.com/gabrielmrtt/taski/internal/task"
 	taskrepo "github.com/gabrielmrtt/taski/internal/task/repository"
+	workspacerepo "github.com/gabrielmrtt/taski/internal/workspace/repository"
 )
 
 type ChangeTaskStatusService struct {
-	TaskRepository              taskrepo.TaskRepository
-	ProjectTaskStatusRepository projectrepo.ProjectTaskStatusRepository
-	TaskActionRepository        taskrepo.TaskActionRepository
-	ProjectUserRepository       projectrepo.ProjectUserRepository
-	TransactionRepository       core.TransactionRepository
+	TaskRepository                        taskrepo.TaskRepository
+	ProjectRepository                     projectrepo.ProjectRepository
+	WorkspaceRepository                   workspacerepo.WorkspaceRepository
+	ProjectTaskStatusRepository           projectrepo.ProjectTaskStatusRepository
+	ProjectTaskStatusTransitionRepository projectrepo.ProjectTaskStatusTransitionRepository
+	TaskActionRepository                  taskrepo.TaskActionRepository
+	ProjectUserRepository                 projectrepo.ProjectUserRepository
+	OrganizationUserRepository            organizationrepo.OrganizationUserRepository
+	TransactionRepository                 core.TransactionRepository
 }
 
 func NewChangeTaskStatusService(
 	taskRepository taskrepo.TaskRepository,
+	projectRepository projectrepo.ProjectRepository,
+	workspaceRepository workspacerepo.WorkspaceRepository,
 	projectTaskStatusRepository projectrepo.ProjectTaskStatusRepository,
+	projectTaskStatusTransitionRepository projectrepo.ProjectTaskStatusTransitionRepository,
 	taskActionRepository taskrepo.TaskActionRepository,
 	projectUserRepository projectrepo.ProjectUserRepository,
+	organizationUserRepository organizationrepo.OrganizationUserRepository,
 	transactionRepository core.TransactionRepository,
 ) *ChangeTaskStatusService {
 	return &ChangeTaskStatusService{
-		TaskRepository:              taskRepository,
-		ProjectTaskStatusRepository: projectTaskStatusRepository,
-		TaskActionRepository:        taskActionRepository,
-		ProjectUserRepository:       projectUserRepository,
-		TransactionRepository:       transactionRepository,
+		TaskRepository:                        taskRepository,
+		ProjectRepository:                     projectRepository,
+		WorkspaceRepository:                   workspaceRepository,
+		ProjectTaskStatusRepository:           projectTaskStatusRepository,
+		ProjectTaskStatusTransitionRepository: projectTaskStatusTransitionRepository,
+		TaskActionRepository:                  taskActionRepository,
+		ProjectUserRepository:                 projectUserRepository,
+		OrganizationUserRepository:            organizationUserRepository,
+		TransactionRepository:                 transactionRepository,
 	}
 }
 
@@ -37,7 +51,14 @@ type ChangeTaskStatusInput struct {
 	TaskIdentity              core.Identity
 	ProjectTaskStatusIdentity *core.Identity
 	AdvanceOrder              bool
+	PreviousTaskIdentity      *core.Identity
+	NextTaskIdentity          *core.Identity
 	ChangedByUserIdentity     core.Identity
+	ExpectedRevision          *int64
+}
+
+func (i ChangeTaskStatusInput) hasPosition() bool {
+	return i.PreviousTaskIdentity != nil || i.NextTaskIdentity != nil
 }
 
 func (i ChangeTaskStatusInput) Validate() error {
@@ -51,7 +72,7 @@ func (i ChangeTaskStatusInput) Validate() error {
 			})
 		}
 	} else {
-		if i.ProjectTaskStatusIdentity == nil {
+		if i.ProjectTaskStatusIdentity == nil && !i.hasPosition() {
 			fields = append(fields, core.InvalidInputErrorField{
 				Field: "project_task_status_identity",
 				Error: "project task status identity is required when advancing order is disabled",
@@ -59,6 +80,20 @@ func (i ChangeTaskStatusInput) Validate() error {
 		}
 	}
 
+	if i.PreviousTaskIdentity != nil && i.PreviousTaskIdentity.Equals(i.TaskIdentity) {
+		fields = append(fields, core.InvalidInputErrorField{
+			Field: "previous_task_identity",
+			Error: "previous task cannot be the task being moved",
+		})
+	}
+
+	if i.NextTaskIdentity != nil && i.NextTaskIdentity.Equals(i.TaskIdentity) {
+		fields = append(fields, core.InvalidInputErrorField{
+			Field: "next_task_identity",
+			Error: "next task cannot be the task being moved",
+		})
+	}
+
 	if len(fields) > 0 {
 		return core.NewInvalidInputError("invalid input", fields)
 	}
@@ -66,20 +101,24 @@ func (i ChangeTaskStatusInput) Validate() error {
 	return nil
 }
 
-func (s *ChangeTaskStatusService) Execute(input ChangeTaskStatusInput) error {
+func (s *ChangeTaskStatusService) Execute(input ChangeTaskStatusInput) (*task.TaskStatusChangeDto, error) {
 	if err := input.Validate(); err != nil {
-		return err
+		return nil, err
 	}
 
 	tx, err := s.TransactionRepository.BeginTransaction()
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	s.TaskRepository.SetTransaction(tx)
+	s.ProjectRepository.SetTransaction(tx)
+	s.WorkspaceRepository.SetTransaction(tx)
 	s.ProjectTaskStatusRepository.SetTransaction(tx)
+	s.ProjectTaskStatusTransitionRepository.SetTransaction(tx)
 	s.TaskActionRepository.SetTransaction(tx)
 	s.ProjectUserRepository.SetTransaction(tx)
+	s.OrganizationUserRepository.SetTransaction(tx)
 
 	tsk, err := s.TaskRepository.GetTaskByIdentity(taskrepo.GetTaskByIdentityParams{
 		TaskIdentity:         input.TaskIdentity,
@@ -87,12 +126,18 @@ func (s *ChangeTaskStatusService) Execute(input ChangeTaskStatusInput) error {
 	})
 	if err != nil {
 		tx.Rollback()
-		return err
+		return nil, err
 	}
 
 	if tsk == nil {
 		tx.Rollback()
-		return core.NewNotFoundError("task not found")
+		return nil, core.NewNotFoundError("task not found")
+	}
+
+	err = core.CheckRevision(input.ExpectedRevision, tsk.Revision, "task")
+	if err != nil {
+		tx.Rollback()
+		return nil, err
 	}
 
 	userChangedBy, err := s.ProjectUserRepository.GetProjectUserByIdentity(projectrepo.GetProjectUserByIdentityParams{
@@ -101,11 +146,11 @@ func (s *ChangeTaskStatusService) Execute(input ChangeTaskStatusInput) error {
 	})
 	if err != nil {
 		tx.Rollback()
-		return err
+		return nil, err
 	}
 	if userChangedBy == nil {
 		tx.Rollback()
-		return core.NewNotFoundError("project user changed by not found")
+		return nil, core.NewNotFoundError("project user changed by not found")
 	}
 
 	projectStatuses, err := s.ProjectTaskStatusRepository.ListProjectTaskStatusesBy(projectrepo.ListProjectTaskStatusesByParams{
@@ -119,12 +164,13 @@ func (s *ChangeTaskStatusService) Execute(input ChangeTaskStatusInput) error {
 	})
 	if err != nil {
 		tx.Rollback()
-		return err
+		return nil, err
 	}
 
-	var nextStatus *project.ProjectTaskStatus = nil
+	var nextStatus *project.ProjectTaskStatus = tsk.Status
 
 	if input.AdvanceOrder {
+		nextStatus = nil
 		for _, status := range projectStatuses {
 			if status.Order != nil {
 				if *status.Order == (*tsk.Status.Order + 1) {
@@ -137,57 +183,344 @@ func (s *ChangeTaskStatusService) Execute(input ChangeTaskStatusInput) error {
 		if nextStatus == nil {
 			nextStatus = &projectStatuses[0]
 		}
-	} else {
-		if input.ProjectTaskStatusIdentity == nil {
-			tx.Rollback()
-			return core.NewConflictError("project task status identity is required")
-		}
-
+	} else if input.ProjectTaskStatusIdentity != nil {
 		projectTaskStatus, err := s.ProjectTaskStatusRepository.GetProjectTaskStatusByIdentity(projectrepo.GetProjectTaskStatusByIdentityParams{
 			ProjectTaskStatusIdentity: input.ProjectTaskStatusIdentity,
 			ProjectIdentity:           &tsk.ProjectIdentity,
 		})
 		if err != nil {
 			tx.Rollback()
-			return err
+			return nil, err
 		}
 
 		if projectTaskStatus == nil {
 			tx.Rollback()
-			return core.NewNotFoundError("project task status not found")
+			return nil, core.NewNotFoundError("project task status not found")
 		}
 
 		nextStatus = projectTaskStatus
 	}
 
-	if nextStatus != nil {
+	var statusRequested bool = input.AdvanceOrder || input.ProjectTaskStatusIdentity != nil
+	var sameStatus bool = (tsk.Status == nil && nextStatus == nil) || (tsk.Status != nil && nextStatus != nil && tsk.Status.Identity.Equals(nextStatus.Identity))
+
+	if statusRequested && !sameStatus && nextStatus != nil {
+		organizationIdentity, err := s.resolveOrganizationIdentity(tsk, input.OrganizationIdentity)
+		if err != nil {
+			tx.Rollback()
+			return nil, err
+		}
+
+		err = validateTaskStatusTransition(s.ProjectTaskStatusTransitionRepository, s.OrganizationUserRepository, tsk, nextStatus, organizationIdentity, input.ChangedByUserIdentity)
+		if err != nil {
+			tx.Rollback()
+			return nil, err
+		}
+	}
+
+	if statusRequested {
 		err = tsk.ChangeStatus(nextStatus, &input.ChangedByUserIdentity)
 		if err != nil {
 			tx.Rollback()
-			return err
+			return nil, err
 		}
+	}
 
-		err = s.TaskRepository.UpdateTask(taskrepo.UpdateTaskParams{Task: tsk})
+	var warnings []string = make([]string, 0)
+	if !sameStatus {
+		warnings, err = checkTaskStatusWipLimit(s.ProjectTaskStatusRepository, nextStatus, tsk)
 		if err != nil {
 			tx.Rollback()
-			return err
+			return nil, err
 		}
 	}
 
-	taskAction := tsk.RegisterAction(task.TaskActionTypeChangeStatus, &userChangedBy.User)
+	if input.hasPosition() || !sameStatus {
+		previousRank, nextRank, err := s.resolveNeighbourRanks(tsk, nextStatus, input)
+		if err != nil {
+			tx.Rollback()
+			return nil, err
+		}
+
+		err = tsk.ChangeRank(previousRank, nextRank, &input.ChangedByUserIdentity)
+		if err != nil {
+			tx.Rollback()
+			return nil, err
+		}
+	}
+
+	err = s.TaskRepository.UpdateTask(taskrepo.UpdateTaskParams{Task: tsk})
+	if err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+
+	var actionType task.TaskActionType = task.TaskActionTypeMove
+	if statusRequested {
+		actionType = task.TaskActionTypeChangeStatus
+	}
+
+	taskAction := tsk.RegisterAction(actionType, &userChangedBy.User)
 	_, err = s.TaskActionRepository.StoreTaskAction(taskrepo.StoreTaskActionParams{
 		TaskAction: &taskAction,
 	})
 	if err != nil {
 		tx.Rollback()
-		return err
+		return nil, err
 	}
 
 	err = tx.Commit()
 	if err != nil {
 		tx.Rollback()
+		return nil, err
+	}
+
+	var statusId *string = nil
+	if tsk.Status != nil {
+		statusId = &tsk.Status.Identity.Public
+	}
+
+	return &task.TaskStatusChangeDto{
+		TaskId:   tsk.Identity.Public,
+		StatusId: statusId,
+		Rank:     tsk.Rank,
+		Warnings: warnings,
+	}, nil
+}
+
+/*
+resolveOrganizationIdentity returns the organization whose roles the workflow is checked against. Callers that do
+not know it leave it empty, and it is loaded from the project the task belongs to.
+*/
+func (s *ChangeTaskStatusService) resolveOrganizationIdentity(tsk *task.Task, organizationIdentity *core.Identity) (core.Identity, error) {
+	if organizationIdentity != nil {
+		return *organizationIdentity, nil
+	}
+
+	prj, err := s.ProjectRepository.GetProjectByIdentity(projectrepo.GetProjectByIdentityParams{
+		ProjectIdentity: tsk.ProjectIdentity,
+	})
+	if err != nil {
+		return core.Identity{}, err
+	}
+
+	if prj == nil {
+		return core.Identity{}, core.NewNotFoundError("project not found")
+	}
+
+	wrk, err := s.WorkspaceRepository.GetWorkspaceByIdentity(workspacerepo.GetWorkspaceByIdentityParams{
+		WorkspaceIdentity: prj.WorkspaceIdentity,
+	})
+	if err != nil {
+		return core.Identity{}, err
+	}
+
+	if wrk == nil {
+		return core.Identity{}, core.NewNotFoundError("workspace not found")
+	}
+
+	return wrk.OrganizationIdentity, nil
+}
+
+/*
+validateTaskStatusTransition checks the project workflow before moving the task to the next status.
+Projects without configured transitions allow any move.
+*/
+func validateTaskStatusTransition(
+	projectTaskStatusTransitionRepository projectrepo.ProjectTaskStatusTransitionRepository,
+	organizationUserRepository organizationrepo.OrganizationUserRepository,
+	tsk *task.Task,
+	nextStatus *project.ProjectTaskStatus,
+	organizationIdentity core.Identity,
+	userIdentity core.Identity,
+) error {
+	transitions, err := projectTaskStatusTransitionRepository.ListProjectTaskStatusTransitionsBy(projectrepo.ListProjectTaskStatusTransitionsByParams{
+		Filters: projectrepo.ProjectTaskStatusTransitionFilters{
+			ProjectIdentity: &tsk.ProjectIdentity,
+		},
+	})
+	if err != nil {
 		return err
 	}
 
+	if len(transitions) == 0 {
+		return nil
+	}
+
+	organizationUser, err := organizationUserRepository.GetOrganizationUserByIdentity(organizationrepo.GetOrganizationUserByIdentityParams{
+		OrganizationIdentity: organizationIdentity,
+		UserIdentity:         userIdentity,
+	})
+	if err != nil {
+		return err
+	}
+
+	if organizationUser == nil {
+		return core.NewNotFoundError("organization user not found")
+	}
+
+	transition, matched := findStatusTransition(transitions, tsk, nextStatus.Identity, organizationUser.Role.Identity)
+	if !matched {
+		return core.NewConflictError("status transition not allowed")
+	}
+
+	if transition == nil {
+		return core.NewForbiddenError("you are not allowed to perform this status transition")
+	}
+
+	missingFields := tsk.MissingRequiredFields(transition.RequiredFields)
+	if len(missingFields) > 0 {
+		var fields []core.InvalidInputErrorField
+		for _, missingField := range missingFields {
+			fields = append(fields, core.InvalidInputErrorField{
+				Field: string(missingField),
+				Error: "field is required to move the task to this status",
+			})
+		}
+
+		return core.NewInvalidInputError("required fields are missing", fields)
+	}
+
 	return nil
 }
+
+/*
+findStatusTransition looks for the transition that moves the task to the given status. The second value reports
+whether any transition covers the move at all, while the returned transition is the one allowed for the role.
+Transitions leaving the current status are preferred over transitions that apply to any status.
+*/
+func findStatusTransition(transitions []project.ProjectTaskStatusTransition, tsk *task.Task, toStatusIdentity core.Identity, roleIdentity core.Identity) (*project.ProjectTaskStatusTransition, bool) {
+	var fromStatusIdentity *core.Identity = nil
+	if tsk.Status != nil {
+		fromStatusIdentity = &tsk.Status.Identity
+	}
+
+	var matched bool = false
+	var allowed *project.ProjectTaskStatusTransition = nil
+
+	for i := range transitions {
+		transition := &transitions[i]
+		if !transition.Matches(fromStatusIdentity, toStatusIdentity) {
+			continue
+		}
+
+		matched = true
+		if !transition.AllowsRole(roleIdentity) {
+			continue
+		}
+
+		if allowed == nil || (allowed.FromStatusIdentity == nil && transition.FromStatusIdentity != nil) {
+			allowed = transition
+		}
+	}
+
+	return allowed, matched
+}
+
+/*
+resolveNeighbourRanks returns the ranks the task must be placed between inside the given status.
+A single neighbour is completed with its adjacent rank and no neighbours at all places the task last.
+*/
+func (s *ChangeTaskStatusService) resolveNeighbourRanks(tsk *task.Task, status *project.ProjectTaskStatus, input ChangeTaskStatusInput) (string, string, error) {
+	var statusIdentity *core.Identity = nil
+	if status != nil {
+		statusIdentity = &status.Identity
+	}
+
+	previousRank, err := s.getNeighbourRank(tsk, statusIdentity, input.PreviousTaskIdentity, "previous task")
+	if err != nil {
+		return "", "", err
+	}
+
+	nextRank, err := s.getNeighbourRank(tsk, statusIdentity, input.NextTaskIdentity, "next task")
+	if err != nil {
+		return "", "", err
+	}
+
+	if input.PreviousTaskIdentity != nil && input.NextTaskIdentity == nil {
+		nextRank, err = s.TaskRepository.GetAdjacentTaskRank(taskrepo.GetAdjacentTaskRankParams{
+			ProjectIdentity:           tsk.ProjectIdentity,
+			ProjectTaskStatusIdentity: statusIdentity,
+			Rank:                      &previousRank,
+			Direction:                 core.SortDirectionAsc,
+		})
+		if err != nil {
+			return "", "", err
+		}
+	}
+
+	if input.NextTaskIdentity != nil && input.PreviousTaskIdentity == nil {
+		previousRank, err = s.TaskRepository.GetAdjacentTaskRank(taskrepo.GetAdjacentTaskRankParams{
+			ProjectIdentity:           tsk.ProjectIdentity,
+			ProjectTaskStatusIdentity: statusIdentity,
+			Rank:                      &nextRank,
+			Direction:                 core.SortDirectionDesc,
+		})
+		if err != nil {
+			return "", "", err
+		}
+	}
+
+	if input.PreviousTaskIdentity == nil && input.NextTaskIdentity == nil {
+		previousRank, err = s.TaskRepository.GetAdjacentTaskRank(taskrepo.GetAdjacentTaskRankParams{
+			ProjectIdentity:           tsk.ProjectIdentity,
+			ProjectTaskStatusIdentity: statusIdentity,
+			Direction:                 core.SortDirectionDesc,
+		})
+		if err != nil {
+			return "", "", err
+		}
+	}
+
+	// The adjacent rank may belong to the moved task itself, which is about to leave its current position.
+	if previousRank == tsk.Rank && previousRank != "" && input.PreviousTaskIdentity == nil {
+		previousRank, err = s.TaskRepository.GetAdjacentTaskRank(taskrepo.GetAdjacentTaskRankParams{
+			ProjectIdentity:           tsk.ProjectIdentity,
+			ProjectTaskStatusIdentity: statusIdentity,
+			Rank:                      &previousRank,
+			Direction:                 core.SortDirectionDesc,
+		})
+		if err != nil {
+			return "", "", err
+		}
+	}
+
+	if nextRank == tsk.Rank && nextRank != "" && input.NextTaskIdentity == nil {
+		nextRank, err = s.TaskRepository.GetAdjacentTaskRank(taskrepo.GetAdjacentTaskRankParams{
+			ProjectIdentity:           tsk.ProjectIdentity,
+			ProjectTaskStatusIdentity: statusIdentity,
+			Rank:                      &nextRank,
+			Direction:                 core.SortDirectionAsc,
+		})
+		if err != nil {
+			return "", "", err
+		}
+	}
+
+	return previousRank, nextRank, nil
+}
+
+func (s *ChangeTaskStatusService) getNeighbourRank(tsk *task.Task, statusIdentity *core.Identity, neighbourIdentity *core.Identity, name string) (string, error) {
+	if neighbourIdentity == nil {
+		return "", nil
+	}
+
+	neighbour, err := s.TaskRepository.GetTaskByIdentity(taskrepo.GetTaskByIdentityParams{
+		TaskIdentity:    *neighbourIdentity,
+		ProjectIdentity: &tsk.ProjectIdentity,
+	})
+	if err != nil {
+		return "", err
+	}
+
+	if neighbour == nil {
+		return "", core.NewNotFoundError(name + " not found")
+	}
+
+	var sameStatus bool = (neighbour.Status == nil && statusIdentity == nil) || (neighbour.Status != nil && statusIdentity != nil && neighbour.Status.Identity.Equals(*statusIdentity))
+	if !sameStatus {
+		return "", core.NewConflictError(name + " is not in the target status")
+	}
+
+	return neighbour.Rank, nil
+}
@@ -0,0 +1,204 @@
+package taskservice
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/gabrielmrtt/taski/internal/core"
+	"github.com/gabrielmrtt/taski/internal/organization"
+	organizationrepo "github.com/gabrielmrtt/taski/internal/organization/repository"
+	"github.com/gabrielmrtt/taski/internal/project"
+	projectrepo "github.com/gabrielmrtt/taski/internal/project/repository"
+	"github.com/gabrielmrtt/taski/internal/role"
+	"github.com/gabrielmrtt/taski/internal/task"
+	"github.com/gabrielmrtt/taski/internal/user"
+	"github.com/gabrielmrtt/taski/internal/workspace"
+	workspacerepo "github.com/gabrielmrtt/taski/internal/workspace/repository"
+)
+
+type memoryProjectTaskStatusTransitionRepository struct {
+	projectrepo.ProjectTaskStatusTransitionRepository
+	transitions []project.ProjectTaskStatusTransition
+}
+
+func (r *memoryProjectTaskStatusTransitionRepository) ListProjectTaskStatusTransitionsBy(params projectrepo.ListProjectTaskStatusTransitionsByParams) ([]project.ProjectTaskStatusTransition, error) {
+	return r.transitions, nil
+}
+
+type memoryOrganizationUserRepository struct {
+	organizationrepo.OrganizationUserRepository
+	organizationUsers []organization.OrganizationUser
+}
+
+func (r *memoryOrganizationUserRepository) GetOrganizationUserByIdentity(params organizationrepo.GetOrganizationUserByIdentityParams) (*organization.OrganizationUser, error) {
+	for _, organizationUser := range r.organizationUsers {
+		if organizationUser.OrganizationIdentity.Equals(params.OrganizationIdentity) && organizationUser.User.Identity.Equals(params.UserIdentity) {
+			return &organizationUser, nil
+		}
+	}
+
+	return nil, nil
+}
+
+type memoryProjectRepository struct {
+	projectrepo.ProjectRepository
+	projects []project.Project
+}
+
+func (r *memoryProjectRepository) GetProjectByIdentity(params projectrepo.GetProjectByIdentityParams) (*project.Project, error) {
+	for _, prj := range r.projects {
+		if prj.Identity.Equals(params.ProjectIdentity) {
+			return &prj, nil
+		}
+	}
+
+	return nil, nil
+}
+
+type memoryWorkspaceRepository struct {
+	workspacerepo.WorkspaceRepository
+	workspaces []workspace.Workspace
+}
+
+func (r *memoryWorkspaceRepository) GetWorkspaceByIdentity(params workspacerepo.GetWorkspaceByIdentityParams) (*workspace.Workspace, error) {
+	for _, wrk := range r.workspaces {
+		if wrk.Identity.Equals(params.WorkspaceIdentity) {
+			return &wrk, nil
+		}
+	}
+
+	return nil, nil
+}
+
+func TestValidateTaskStatusTransition(t *testing.T) {
+	organizationIdentity := core.NewIdentity("org")
+	projectIdentity := core.NewIdentity("prj")
+	todo := project.ProjectTaskStatus{Identity: core.NewIdentity("pts")}
+	doing := project.ProjectTaskStatus{Identity: core.NewIdentity("pts")}
+	done := project.ProjectTaskStatus{Identity: core.NewIdentity("pts")}
+	admin := role.Role{Identity: core.NewIdentity("rol")}
+	member := role.Role{Identity: core.NewIdentity("rol")}
+
+	workflow := []project.ProjectTaskStatusTransition{
+		{ProjectIdentity: projectIdentity, FromStatusIdentity: &todo.Identity, ToStatusIdentity: doing.Identity},
+		{ProjectIdentity: projectIdentity, FromStatusIdentity: &doing.Identity, ToStatusIdentity: done.Identity, RoleIdentities: []core.Identity{admin.Identity}},
+		{ProjectIdentity: projectIdentity, ToStatusIdentity: todo.Identity, RequiredFields: []project.ProjectTaskStatusTransitionRequiredFields{project.ProjectTaskStatusTransitionRequiredFieldDescription}},
+	}
+
+	var conflictError *core.ConflictError
+	var forbiddenError *core.ForbiddenError
+	var invalidInputError *core.InvalidInputError
+
+	tests := []struct {
+		name        string
+		transitions []project.ProjectTaskStatusTransition
+		from        *project.ProjectTaskStatus
+		to          project.ProjectTaskStatus
+		role        role.Role
+		description string
+		expected    interface{}
+	}{
+		{name: "without workflow any move is allowed", from: &todo, to: done, role: member},
+		{name: "transition open to every role", transitions: workflow, from: &todo, to: doing, role: member},
+		{name: "transition restricted to the role", transitions: workflow, from: &doing, to: done, role: admin},
+		{name: "role not allowed", transitions: workflow, from: &doing, to: done, role: member, expected: &forbiddenError},
+		{name: "move not in the workflow", transitions: workflow, from: &todo, to: done, role: admin, expected: &conflictError},
+		{name: "task without status only takes transitions from any status", transitions: workflow, to: doing, role: admin, expected: &conflictError},
+		{name: "transition from any status", transitions: workflow, from: &done, to: todo, role: member, description: "reopened"},
+		{name: "required field missing", transitions: workflow, from: &done, to: todo, role: member, expected: &invalidInputError},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			userIdentity := core.NewIdentity("usr")
+			organizationUserRepository := &memoryOrganizationUserRepository{organizationUsers: []organization.OrganizationUser{
+				{OrganizationIdentity: organizationIdentity, User: user.User{Identity: userIdentity}, Role: tt.role},
+			}}
+
+			tsk := &task.Task{ProjectIdentity: projectIdentity, Status: tt.from, Description: tt.description}
+			err := validateTaskStatusTransition(
+				&memoryProjectTaskStatusTransitionRepository{transitions: tt.transitions},
+				organizationUserRepository,
+				tsk,
+				&tt.to,
+				organizationIdentity,
+				userIdentity,
+			)
+
+			if tt.expected == nil {
+				if err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+
+				return
+			}
+
+			if !errors.As(err, tt.expected) {
+				t.Fatalf("expected %T, got %v", tt.expected, err)
+			}
+		})
+	}
+}
+
+func TestFindStatusTransitionPrefersTransitionsFromCurrentStatus(t *testing.T) {
+	from := project.ProjectTaskStatus{Identity: core.NewIdentity("pts")}
+	to := core.NewIdentity("pts")
+	roleIdentity := core.NewIdentity("rol")
+
+	transitions := []project.ProjectTaskStatusTransition{
+		{ToStatusIdentity: to},
+		{FromStatusIdentity: &from.Identity, ToStatusIdentity: to, RoleIdentities: []core.Identity{roleIdentity}},
+	}
+
+	transition, matched := findStatusTransition(transitions, &task.Task{Status: &from}, to, roleIdentity)
+	if !matched || transition != &transitions[1] {
+		t.Errorf("expected the transition from the current status, got %v", transition)
+	}
+}
+
+func TestResolveOrganizationIdentity(t *testing.T) {
+	organizationIdentity := core.NewIdentity("org")
+	wrk := workspace.Workspace{Identity: core.NewIdentity("wrk"), OrganizationIdentity: organizationIdentity}
+	prj := project.Project{Identity: core.NewIdentity("prj"), WorkspaceIdentity: wrk.Identity}
+	given := core.NewIdentity("org")
+
+	service := &ChangeTaskStatusService{
+		ProjectRepository:   &memoryProjectRepository{projects: []project.Project{prj}},
+		WorkspaceRepository: &memoryWorkspaceRepository{workspaces: []workspace.Workspace{wrk}},
+	}
+
+	tests := []struct {
+		name     string
+		task     *task.Task
+		given    *core.Identity
+		expected core.Identity
+		notFound bool
+	}{
+		{name: "given by the caller", task: &task.Task{ProjectIdentity: prj.Identity}, given: &given, expected: given},
+		{name: "loaded from the project", task: &task.Task{ProjectIdentity: prj.Identity}, expected: organizationIdentity},
+		{name: "project not found", task: &task.Task{ProjectIdentity: core.NewIdentity("prj")}, notFound: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			identity, err := service.resolveOrganizationIdentity(tt.task, tt.given)
+
+			if tt.notFound {
+				var notFoundError *core.NotFoundError
+				if !errors.As(err, &notFoundError) {
+					t.Fatalf("expected a not found error, got %v", err)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if !identity.Equals(tt.expected) {
+				t.Errorf("expected organization %s, got %s", tt.expected.Public, identity.Public)
+			}
+		})
+	}
+}
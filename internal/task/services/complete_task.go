@@ -32,6 +32,7 @@ type CompleteTaskInput struct {
 	OrganizationIdentity  *core.Identity
 	TaskIdentity          core.Identity
 	UserCompleterIdentity core.Identity
+	ExpectedRevision      *int64
 }
 
 func (i CompleteTaskInput) Validate() error { return nil }
@@ -64,6 +65,12 @@ func (s *CompleteTaskService) Execute(input CompleteTaskInput) error {
 		return core.NewNotFoundError("task not found")
 	}
 
+	err = core.CheckRevision(input.ExpectedRevision, tsk.Revision, "task")
+	if err != nil {
+		tx.Rollback()
+		return err
+	}
+
 	userCompleter, err := s.ProjectUserRepository.GetProjectUserByIdentity(projectrepo.GetProjectUserByIdentityParams{
 		ProjectIdentity: tsk.ProjectIdentity,
 		UserIdentity:    input.UserCompleterIdentity,
@@ -2,22 +2,28 @@ package taskservice
 
 import (
 	"strconv"
+	"strings"
 
 	"github.com/gabrielmrtt/taski/internal/core"
 	"github.com/gabrielmrtt/taski/internal/project"
 	projectrepo "github.com/gabrielmrtt/taski/internal/project/repository"
 	"github.com/gabrielmrtt/taski/internal/task"
+	storagerepo "github.com/gabrielmrtt/taski/internal/storage/repository"
+	storageservice "github.com/gabrielmrtt/taski/internal/storage/service"
 	taskrepo "github.com/gabrielmrtt/taski/internal/task/repository"
+	"github.com/gabrielmrtt/taski/pkg/rankutils"
 )
 
 type CreateTaskService struct {
-	TaskRepository                taskrepo.TaskRepository
-	TaskActionRepository          taskrepo.TaskActionRepository
-	ProjectRepository             projectrepo.ProjectRepository
-	ProjectUserRepository         projectrepo.ProjectUserRepository
-	ProjectTaskStatusRepository   projectrepo.ProjectTaskStatusRepository
-	ProjectTaskCategoryRepository projectrepo.ProjectTaskCategoryRepository
-	TransactionRepository         core.TransactionRepository
+	TaskRepository                   taskrepo.TaskRepository
+	TaskActionRepository             taskrepo.TaskActionRepository
+	ProjectRepository                projectrepo.ProjectRepository
+	ProjectUserRepository            projectrepo.ProjectUserRepository
+	ProjectTaskStatusRepository      projectrepo.ProjectTaskStatusRepository
+	ProjectTaskCategoryRepository    projectrepo.ProjectTaskCategoryRepository
+	ProjectTaskCustomFieldRepository projectrepo.ProjectTaskCustomFieldRepository
+	UploadedFileRepository           storagerepo.UploadedFileRepository
+	TransactionRepository            core.TransactionRepository
 }
 
 func NewCreateTaskService(
@@ -27,19 +33,221 @@ func NewCreateTaskService(
 	projectUserRepository projectrepo.ProjectUserRepository,
 	projectTaskStatusRepository projectrepo.ProjectTaskStatusRepository,
 	projectTaskCategoryRepository projectrepo.ProjectTaskCategoryRepository,
+	projectTaskCustomFieldRepository projectrepo.ProjectTaskCustomFieldRepository,
+	uploadedFileRepository storagerepo.UploadedFileRepository,
 	transactionRepository core.TransactionRepository,
 ) *CreateTaskService {
 	return &CreateTaskService{
-		TaskRepository:                taskRepository,
-		TaskActionRepository:          taskActionRepository,
-		ProjectRepository:             projectRepository,
-		ProjectUserRepository:         projectUserRepository,
-		ProjectTaskStatusRepository:   projectTaskStatusRepository,
-		ProjectTaskCategoryRepository: projectTaskCategoryRepository,
-		TransactionRepository:         transactionRepository,
+		TaskRepository:                   taskRepository,
+		TaskActionRepository:             taskActionRepository,
+		ProjectRepository:                projectRepository,
+		ProjectUserRepository:            projectUserRepository,
+		ProjectTaskStatusRepository:      projectTaskStatusRepository,
+		ProjectTaskCategoryRepository:    projectTaskCategoryRepository,
+		ProjectTaskCustomFieldRepository: projectTaskCustomFieldRepository,
+		UploadedFileRepository:           uploadedFileRepository,
+		TransactionRepository:            transactionRepository,
 	}
 }
 
+type TaskCustomFieldValueInput struct {
+	CustomFieldIdentity core.Identity
+	Value               any
+}
+
+/*
+resolveTaskCustomFieldValues parses the custom field inputs against the project custom fields.
+Inputs with a nil value are returned as cleared custom fields.
+*/
+func resolveTaskCustomFieldValues(
+	projectUserRepository projectrepo.ProjectUserRepository,
+	projectIdentity core.Identity,
+	customFields []project.ProjectTaskCustomField,
+	inputs []*TaskCustomFieldValueInput,
+) ([]*task.TaskCustomFieldValue, []*project.ProjectTaskCustomField, error) {
+	var customFieldValues []*task.TaskCustomFieldValue = make([]*task.TaskCustomFieldValue, 0)
+	var clearedCustomFields []*project.ProjectTaskCustomField = make([]*project.ProjectTaskCustomField, 0)
+
+	for _, input := range inputs {
+		var customField *project.ProjectTaskCustomField = nil
+		for i := range customFields {
+			if customFields[i].Identity.Equals(input.CustomFieldIdentity) {
+				customField = &customFields[i]
+				break
+			}
+		}
+
+		if customField == nil {
+			return nil, nil, core.NewNotFoundError("project task custom field not found")
+		}
+
+		if input.Value == nil {
+			clearedCustomFields = append(clearedCustomFields, customField)
+			continue
+		}
+
+		customFieldValue, err := task.NewTaskCustomFieldValue(customField, input.Value)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		if customFieldValue.UserIdentity != nil {
+			projectUser, err := projectUserRepository.GetProjectUserByIdentity(projectrepo.GetProjectUserByIdentityParams{
+				ProjectIdentity: projectIdentity,
+				UserIdentity:    *customFieldValue.UserIdentity,
+			})
+			if err != nil {
+				return nil, nil, err
+			}
+
+			if projectUser == nil {
+				return nil, nil, core.NewNotFoundError("project user not found")
+			}
+		}
+
+		customFieldValues = append(customFieldValues, customFieldValue)
+	}
+
+	return customFieldValues, clearedCustomFields, nil
+}
+
+/*
+resolveTaskReferences reads the mentions and task references of a content written by the author in a task of the
+project. Mentioned users must be active members of the project, and referenced tasks must exist in a project the
+author is an active member of.
+*/
+func resolveTaskReferences(
+	taskRepository taskrepo.TaskRepository,
+	projectUserRepository projectrepo.ProjectUserRepository,
+	projectIdentity core.Identity,
+	userAuthorIdentity core.Identity,
+	field string,
+	content string,
+) ([]task.TaskReference, error) {
+	var fields []core.InvalidInputErrorField
+	references := task.ParseTaskReferences(content, userAuthorIdentity)
+
+	for _, reference := range references {
+		if reference.IsUserMention() {
+			projectUser, err := projectUserRepository.GetProjectUserByIdentity(projectrepo.GetProjectUserByIdentityParams{
+				ProjectIdentity: projectIdentity,
+				UserIdentity:    *reference.UserIdentity,
+			})
+			if err != nil {
+				return nil, err
+			}
+
+			if projectUser == nil || !projectUser.IsActive() {
+				fields = append(fields, core.InvalidInputErrorField{
+					Field: field,
+					Error: "mentioned user " + reference.UserIdentity.Public + " is not a member of the project",
+				})
+			}
+
+			continue
+		}
+
+		referencedTask, err := taskRepository.GetTaskByIdentity(taskrepo.GetTaskByIdentityParams{
+			TaskIdentity: *reference.TaskIdentity,
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		if referencedTask != nil && !referencedTask.IsDeleted() {
+			projectUser, err := projectUserRepository.GetProjectUserByIdentity(projectrepo.GetProjectUserByIdentityParams{
+				ProjectIdentity: referencedTask.ProjectIdentity,
+				UserIdentity:    userAuthorIdentity,
+			})
+			if err != nil {
+				return nil, err
+			}
+
+			if projectUser != nil && projectUser.IsActive() {
+				continue
+			}
+		}
+
+		fields = append(fields, core.InvalidInputErrorField{
+			Field: field,
+			Error: "referenced task " + reference.TaskIdentity.Public + " not found",
+		})
+	}
+
+	if len(fields) > 0 {
+		return nil, core.NewInvalidInputError("invalid references", fields)
+	}
+
+	return references, nil
+}
+
+/*
+checkTaskStatusWipLimit checks whether placing the task in the status exceeds its work in progress limits.
+Exceeded limits are returned as warnings when the status only warns about them, otherwise they are rejected.
+*/
+func checkTaskStatusWipLimit(
+	projectTaskStatusRepository projectrepo.ProjectTaskStatusRepository,
+	status *project.ProjectTaskStatus,
+	tsk *task.Task,
+) ([]string, error) {
+	var warnings []string = make([]string, 0)
+
+	if status == nil || !status.HasWipLimit() {
+		return warnings, nil
+	}
+
+	if status.WipLimit != nil {
+		taskCounts, err := projectTaskStatusRepository.CountProjectTaskStatusTasks(projectrepo.CountProjectTaskStatusTasksParams{
+			ProjectIdentity:           tsk.ProjectIdentity,
+			ProjectTaskStatusIdentity: &status.Identity,
+			ExcludeTaskIdentity:       &tsk.Identity,
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		var taskCount int = 0
+		for _, count := range taskCounts {
+			taskCount += count.TaskCount
+		}
+
+		if status.ExceedsWipLimit(taskCount + 1) {
+			warnings = append(warnings, "status "+status.Name+" reached its work in progress limit of "+strconv.Itoa(int(*status.WipLimit))+" tasks")
+		}
+	}
+
+	if status.WipLimitPerAssignee != nil && len(tsk.Users) > 0 {
+		taskCounts, err := projectTaskStatusRepository.CountProjectTaskStatusTasks(projectrepo.CountProjectTaskStatusTasksParams{
+			ProjectIdentity:           tsk.ProjectIdentity,
+			ProjectTaskStatusIdentity: &status.Identity,
+			ExcludeTaskIdentity:       &tsk.Identity,
+			ByAssignee:                true,
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		for _, taskUser := range tsk.Users {
+			var taskCount int = 0
+			for _, count := range taskCounts {
+				if count.UserIdentity != nil && count.UserIdentity.Internal == taskUser.User.Identity.Internal {
+					taskCount = count.TaskCount
+				}
+			}
+
+			if status.ExceedsWipLimitPerAssignee(taskCount + 1) {
+				warnings = append(warnings, "assignee "+taskUser.User.Identity.Public+" reached the work in progress limit of "+strconv.Itoa(int(*status.WipLimitPerAssignee))+" tasks in status "+status.Name)
+			}
+		}
+	}
+
+	if len(warnings) > 0 && status.WipLimitPolicy != project.ProjectTaskStatusWipLimitPolicyWarn {
+		return nil, core.NewConflictError(strings.Join(warnings, "; "))
+	}
+
+	return warnings, nil
+}
+
 type CreateSubTaskInput struct {
 	Name string
 }
@@ -67,18 +275,29 @@ type CreateTaskInput struct {
 	StatusIdentity       core.Identity
 	CategoryIdentity     *core.Identity
 	ParentTaskIdentity   *core.Identity
+	ExternalId           *string
 	Name                 string
 	Description          string
+	DescriptionFormat    core.ContentFormats
 	EstimatedMinutes     *int16
 	PriorityLevel        task.TaskPriorityLevels
 	DueDate              *core.DateTime
 	SubTasks             []*CreateSubTaskInput
 	Users                []*core.Identity
 	ChildrenTasks        []*core.Identity
+	CustomFields         []*TaskCustomFieldValueInput
 	UserCreatorIdentity  core.Identity
 }
 
 func (i CreateTaskInput) Validate() error {
+	return i.validate(false)
+}
+
+/*
+validate checks the input. Imported tasks keep the due dates they had where they come from, so the import allows due
+dates in the past.
+*/
+func (i CreateTaskInput) validate(allowPastDueDate bool) error {
 	var fields []core.InvalidInputErrorField
 
 	if _, err := core.NewName(i.Name); err != nil {
@@ -95,6 +314,24 @@ func (i CreateTaskInput) Validate() error {
 		})
 	}
 
+	if i.DescriptionFormat != "" {
+		if _, err := core.NewContent(i.Description, i.DescriptionFormat); err != nil {
+			fields = append(fields, core.InvalidInputErrorField{
+				Field: "description format",
+				Error: err.Error(),
+			})
+		}
+	}
+
+	if i.ExternalId != nil {
+		if *i.ExternalId == "" || len(*i.ExternalId) > 255 {
+			fields = append(fields, core.InvalidInputErrorField{
+				Field: "external id",
+				Error: "external id must have between 1 and 255 characters",
+			})
+		}
+	}
+
 	if i.EstimatedMinutes != nil {
 		if *i.EstimatedMinutes < 0 {
 			fields = append(fields, core.InvalidInputErrorField{
@@ -104,7 +341,7 @@ func (i CreateTaskInput) Validate() error {
 		}
 	}
 
-	if i.DueDate != nil {
+	if i.DueDate != nil && !allowPastDueDate {
 		if i.DueDate.IsBefore(core.NewDateTime()) {
 			fields = append(fields, core.InvalidInputErrorField{
 				Field: "due date",
@@ -152,23 +389,47 @@ func (s *CreateTaskService) Execute(input CreateTaskInput) (*task.TaskDto, error
 		return nil, err
 	}
 
+	s.setTransaction(tx)
+
+	taskDto, err := s.create(input)
+	if err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+
+	return taskDto, nil
+}
+
+func (s *CreateTaskService) setTransaction(tx core.Transaction) {
 	s.TaskRepository.SetTransaction(tx)
 	s.ProjectRepository.SetTransaction(tx)
 	s.ProjectTaskStatusRepository.SetTransaction(tx)
 	s.ProjectTaskCategoryRepository.SetTransaction(tx)
 	s.TaskActionRepository.SetTransaction(tx)
+	s.ProjectTaskCustomFieldRepository.SetTransaction(tx)
+	s.UploadedFileRepository.SetTransaction(tx)
+}
 
+/*
+create creates the task of a validated input inside the transaction the repositories were joined to, leaving the
+commit or rollback to the caller. The import creates all of its tasks this way in a single transaction.
+*/
+func (s *CreateTaskService) create(input CreateTaskInput) (*task.TaskDto, error) {
 	prj, err := s.ProjectRepository.GetProjectByIdentity(projectrepo.GetProjectByIdentityParams{
 		ProjectIdentity:      input.ProjectIdentity,
 		OrganizationIdentity: input.OrganizationIdentity,
 	})
 	if err != nil {
-		tx.Rollback()
 		return nil, err
 	}
 
 	if prj == nil {
-		tx.Rollback()
 		return nil, core.NewNotFoundError("project not found")
 	}
 
@@ -177,26 +438,36 @@ func (s *CreateTaskService) Execute(input CreateTaskInput) (*task.TaskDto, error
 		UserIdentity:    input.UserCreatorIdentity,
 	})
 	if err != nil {
-		tx.Rollback()
 		return nil, err
 	}
 
 	if userCreator == nil {
-		tx.Rollback()
 		return nil, core.NewNotFoundError("project user creator not found")
 	}
 
+	if input.ExternalId != nil {
+		existingTask, err := s.TaskRepository.GetTaskByExternalId(taskrepo.GetTaskByExternalIdParams{
+			ProjectIdentity: input.ProjectIdentity,
+			ExternalId:      *input.ExternalId,
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		if existingTask != nil {
+			return nil, core.NewConflictError("task with external id " + *input.ExternalId + " already exists in the project")
+		}
+	}
+
 	status, err := s.ProjectTaskStatusRepository.GetProjectTaskStatusByIdentity(projectrepo.GetProjectTaskStatusByIdentityParams{
 		ProjectIdentity:           &input.ProjectIdentity,
 		ProjectTaskStatusIdentity: &input.StatusIdentity,
 	})
 	if err != nil {
-		tx.Rollback()
 		return nil, err
 	}
 
 	if status == nil {
-		tx.Rollback()
 		return nil, core.NewNotFoundError("project task status not found")
 	}
 
@@ -207,12 +478,10 @@ func (s *CreateTaskService) Execute(input CreateTaskInput) (*task.TaskDto, error
 			ProjectTaskCategoryIdentity: input.CategoryIdentity,
 		})
 		if err != nil {
-			tx.Rollback()
 			return nil, err
 		}
 
 		if category == nil {
-			tx.Rollback()
 			return nil, core.NewNotFoundError("project task category not found")
 		}
 	}
@@ -225,12 +494,10 @@ func (s *CreateTaskService) Execute(input CreateTaskInput) (*task.TaskDto, error
 			ProjectIdentity: &input.ProjectIdentity,
 		})
 		if err != nil {
-			tx.Rollback()
 			return nil, err
 		}
 
 		if parentTask == nil {
-			tx.Rollback()
 			return nil, core.NewNotFoundError("parent task not found")
 		}
 
@@ -243,7 +510,6 @@ func (s *CreateTaskService) Execute(input CreateTaskInput) (*task.TaskDto, error
 			Name: subTaskInput.Name,
 		})
 		if err != nil {
-			tx.Rollback()
 			return nil, err
 		}
 
@@ -257,12 +523,10 @@ func (s *CreateTaskService) Execute(input CreateTaskInput) (*task.TaskDto, error
 			UserIdentity:    *userIdentity,
 		})
 		if err != nil {
-			tx.Rollback()
 			return nil, err
 		}
 
 		if user == nil {
-			tx.Rollback()
 			return nil, core.NewNotFoundError("project user not found")
 		}
 
@@ -278,35 +542,91 @@ func (s *CreateTaskService) Execute(input CreateTaskInput) (*task.TaskDto, error
 			ProjectIdentity: &input.ProjectIdentity,
 		})
 		if err != nil {
-			tx.Rollback()
 			return nil, err
 		}
 
 		if childTask == nil {
-			tx.Rollback()
 			return nil, core.NewNotFoundError("child task not found")
 		}
 
 		childrenTasks = append(childrenTasks, childTask)
 	}
 
+	customFields, err := s.ProjectTaskCustomFieldRepository.ListProjectTaskCustomFieldsBy(projectrepo.ListProjectTaskCustomFieldsByParams{
+		Filters: projectrepo.ProjectTaskCustomFieldFilters{
+			ProjectIdentity: &input.ProjectIdentity,
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	customFieldValues, _, err := resolveTaskCustomFieldValues(s.ProjectUserRepository, input.ProjectIdentity, customFields, input.CustomFields)
+	if err != nil {
+		return nil, err
+	}
+
+	lastRank, err := s.TaskRepository.GetAdjacentTaskRank(taskrepo.GetAdjacentTaskRankParams{
+		ProjectIdentity:           input.ProjectIdentity,
+		ProjectTaskStatusIdentity: &status.Identity,
+		Direction:                 core.SortDirectionDesc,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	rank, err := rankutils.Between(lastRank, "")
+	if err != nil {
+		return nil, core.NewInternalError(err.Error())
+	}
+
 	tsk, err := task.NewTask(task.NewTaskInput{
 		ProjectIdentity:     input.ProjectIdentity,
 		Status:              status,
 		Category:            category,
 		ParentTaskIdentity:  parentTaskIdentity,
+		Rank:                rank,
+		ExternalId:          input.ExternalId,
 		Name:                input.Name,
 		Description:         input.Description,
+		DescriptionFormat:   input.DescriptionFormat,
 		EstimatedMinutes:    input.EstimatedMinutes,
 		PriorityLevel:       input.PriorityLevel,
 		DueDate:             input.DueDate,
 		SubTasks:            subTasks,
 		ChildrenTasks:       childrenTasks,
 		Users:               users,
+		CustomFieldValues:   customFieldValues,
 		UserCreatorIdentity: &input.UserCreatorIdentity,
 	})
 	if err != nil {
-		tx.Rollback()
+		return nil, err
+	}
+
+	err = tsk.ValidateRequiredCustomFields(customFields)
+	if err != nil {
+		return nil, err
+	}
+
+	checkEmbeddedFilesService := storageservice.NewCheckEmbeddedFilesService(s.UploadedFileRepository)
+	err = checkEmbeddedFilesService.Execute(storageservice.CheckEmbeddedFilesInput{
+		Field:        "description",
+		Content:      tsk.FormattedDescription(),
+		UserIdentity: input.UserCreatorIdentity,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	references, err := resolveTaskReferences(s.TaskRepository, s.ProjectUserRepository, input.ProjectIdentity, input.UserCreatorIdentity, "description", input.Description)
+	if err != nil {
+		return nil, err
+	}
+
+	tsk.ChangeReferences(references)
+
+	warnings, err := checkTaskStatusWipLimit(s.ProjectTaskStatusRepository, status, tsk)
+	if err != nil {
 		return nil, err
 	}
 
@@ -314,7 +634,6 @@ func (s *CreateTaskService) Execute(input CreateTaskInput) (*task.TaskDto, error
 		Task: tsk,
 	})
 	if err != nil {
-		tx.Rollback()
 		return nil, err
 	}
 
@@ -324,15 +643,11 @@ func (s *CreateTaskService) Execute(input CreateTaskInput) (*task.TaskDto, error
 		TaskAction: &taskAction,
 	})
 	if err != nil {
-		tx.Rollback()
 		return nil, err
 	}
 
-	err = tx.Commit()
-	if err != nil {
-		tx.Rollback()
-		return nil, err
-	}
+	taskDto := task.TaskToDto(tsk)
+	taskDto.Warnings = warnings
 
-	return task.TaskToDto(tsk), nil
+	return taskDto, nil
 }
@@ -0,0 +1,100 @@
+package taskservice
+
+import (
+	"github.com/gabrielmrtt/taski/internal/core"
+	projectrepo "github.com/gabrielmrtt/taski/internal/project/repository"
+	"github.com/gabrielmrtt/taski/internal/task"
+	taskrepo "github.com/gabrielmrtt/taski/internal/task/repository"
+)
+
+type CreateTaskCalendarFeedService struct {
+	TaskCalendarFeedRepository taskrepo.TaskCalendarFeedRepository
+	ProjectRepository          projectrepo.ProjectRepository
+	ProjectUserRepository      projectrepo.ProjectUserRepository
+	TransactionRepository      core.TransactionRepository
+	FeedBaseUrl                string
+}
+
+func NewCreateTaskCalendarFeedService(
+	taskCalendarFeedRepository taskrepo.TaskCalendarFeedRepository,
+	projectRepository projectrepo.ProjectRepository,
+	projectUserRepository projectrepo.ProjectUserRepository,
+	transactionRepository core.TransactionRepository,
+	feedBaseUrl string,
+) *CreateTaskCalendarFeedService {
+	return &CreateTaskCalendarFeedService{
+		TaskCalendarFeedRepository: taskCalendarFeedRepository,
+		ProjectRepository:          projectRepository,
+		ProjectUserRepository:      projectUserRepository,
+		TransactionRepository:      transactionRepository,
+		FeedBaseUrl:                feedBaseUrl,
+	}
+}
+
+type CreateTaskCalendarFeedInput struct {
+	OrganizationIdentity *core.Identity
+	ProjectIdentity      *core.Identity
+	UserOwnerIdentity    core.Identity
+}
+
+func (i CreateTaskCalendarFeedInput) Validate() error {
+	var fields []core.InvalidInputErrorField
+
+	if i.OrganizationIdentity == nil {
+		fields = append(fields, core.InvalidInputErrorField{
+			Field: "organization_identity",
+			Error: "organization identity is required",
+		})
+	}
+
+	if len(fields) > 0 {
+		return core.NewInvalidInputError("invalid input", fields)
+	}
+
+	return nil
+}
+
+func (s *CreateTaskCalendarFeedService) Execute(input CreateTaskCalendarFeedInput) (*task.TaskCalendarFeedDto, error) {
+	if err := input.Validate(); err != nil {
+		return nil, err
+	}
+
+	tx, err := s.TransactionRepository.BeginTransaction()
+	if err != nil {
+		return nil, err
+	}
+
+	s.TaskCalendarFeedRepository.SetTransaction(tx)
+	s.ProjectRepository.SetTransaction(tx)
+	s.ProjectUserRepository.SetTransaction(tx)
+
+	if input.ProjectIdentity != nil {
+		err = checkTaskViewProjectMembership(s.ProjectRepository, s.ProjectUserRepository, *input.OrganizationIdentity, *input.ProjectIdentity, input.UserOwnerIdentity)
+		if err != nil {
+			tx.Rollback()
+			return nil, err
+		}
+	}
+
+	taskCalendarFeed := task.NewTaskCalendarFeed(task.NewTaskCalendarFeedInput{
+		OrganizationIdentity: *input.OrganizationIdentity,
+		ProjectIdentity:      input.ProjectIdentity,
+		UserOwnerIdentity:    input.UserOwnerIdentity,
+	})
+
+	taskCalendarFeed, err = s.TaskCalendarFeedRepository.StoreTaskCalendarFeed(taskrepo.StoreTaskCalendarFeedParams{
+		TaskCalendarFeed: taskCalendarFeed,
+	})
+	if err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+
+	return task.TaskCalendarFeedWithUrlToDto(taskCalendarFeed, s.FeedBaseUrl), nil
+}
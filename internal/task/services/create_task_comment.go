@@ -40,10 +40,12 @@ func NewCreateTaskCommentService(
 }
 
 type CreateTaskCommentInput struct {
-	TaskIdentity   core.Identity
-	Content        string
-	Files          []core.FileInput
-	AuthorIdentity core.Identity
+	TaskIdentity              core.Identity
+	ParentTaskCommentIdentity *core.Identity
+	Content                   string
+	ContentFormat             core.ContentFormats
+	Files                     []core.FileInput
+	AuthorIdentity            core.Identity
 }
 
 func (i CreateTaskCommentInput) Validate() error {
@@ -56,6 +58,15 @@ func (i CreateTaskCommentInput) Validate() error {
 		})
 	}
 
+	if i.ContentFormat != "" {
+		if _, err := core.NewContent(i.Content, i.ContentFormat); err != nil {
+			fields = append(fields, core.InvalidInputErrorField{
+				Field: "content format",
+				Error: err.Error(),
+			})
+		}
+	}
+
 	if len(fields) > 0 {
 		return core.NewInvalidInputError("invalid input", fields)
 	}
@@ -105,16 +116,54 @@ func (s *CreateTaskCommentService) Execute(input CreateTaskCommentInput) (*task.
 		return nil, core.NewNotFoundError("project user not found")
 	}
 
+	var parentComment *task.TaskComment = nil
+	if input.ParentTaskCommentIdentity != nil {
+		parentComment, err = s.TaskCommentRepository.GetTaskCommentByIdentity(taskrepo.GetTaskCommentByIdentityParams{
+			TaskCommentIdentity: *input.ParentTaskCommentIdentity,
+			TaskIdentity:        &tsk.Identity,
+		})
+		if err != nil {
+			tx.Rollback()
+			return nil, err
+		}
+
+		if parentComment == nil {
+			tx.Rollback()
+			return nil, core.NewNotFoundError("parent task comment not found")
+		}
+	}
+
 	comment, err := task.NewTaskComment(task.NewTaskCommentInput{
-		TaskIdentity: tsk.Identity,
-		Content:      input.Content,
-		Author:       &usr.User,
+		TaskIdentity:      tsk.Identity,
+		ParentTaskComment: parentComment,
+		Content:           input.Content,
+		ContentFormat:     input.ContentFormat,
+		Author:            &usr.User,
 	})
 	if err != nil {
 		tx.Rollback()
 		return nil, err
 	}
 
+	checkEmbeddedFilesService := storageservice.NewCheckEmbeddedFilesService(s.UploadedFileRepository)
+	err = checkEmbeddedFilesService.Execute(storageservice.CheckEmbeddedFilesInput{
+		Field:        "content",
+		Content:      comment.FormattedContent(),
+		UserIdentity: input.AuthorIdentity,
+	})
+	if err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+
+	references, err := resolveTaskReferences(s.TaskRepository, s.ProjectUserRepository, tsk.ProjectIdentity, input.AuthorIdentity, "content", input.Content)
+	if err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+
+	comment.ChangeReferences(references)
+
 	var filePath string = "tasks/" + input.TaskIdentity.Internal.String() + "/comments/" + comment.Identity.Internal.String() + "/files/"
 
 	uploadFileService := storageservice.NewUploadFileService(
@@ -162,5 +211,5 @@ func (s *CreateTaskCommentService) Execute(input CreateTaskCommentInput) (*task.
 		return nil, err
 	}
 
-	return task.TaskCommentToDto(comment), nil
+	return task.TaskCommentToDto(comment, &input.AuthorIdentity), nil
 }
@@ -0,0 +1,194 @@
+package taskservice
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/gabrielmrtt/taski/internal/core"
+	"github.com/gabrielmrtt/taski/internal/project"
+	projectrepo "github.com/gabrielmrtt/taski/internal/project/repository"
+	"github.com/gabrielmrtt/taski/internal/task"
+	"github.com/gabrielmrtt/taski/internal/user"
+)
+
+// memoryProjectTaskStatusRepository counts the tasks of a status the way the database does, per assignee when asked
+type memoryProjectTaskStatusRepository struct {
+	projectrepo.ProjectTaskStatusRepository
+	statuses []*project.ProjectTaskStatus
+	tasks    []*task.Task
+}
+
+func (r *memoryProjectTaskStatusRepository) CountProjectTaskStatusTasks(params projectrepo.CountProjectTaskStatusTasksParams) ([]projectrepo.ProjectTaskStatusTaskCount, error) {
+	var counts []projectrepo.ProjectTaskStatusTaskCount = make([]projectrepo.ProjectTaskStatusTaskCount, 0)
+	var total int = 0
+	var byUser map[string]int = make(map[string]int)
+	var users map[string]core.Identity = make(map[string]core.Identity)
+
+	for _, tsk := range r.tasks {
+		if tsk.Status == nil || !tsk.Status.Identity.Equals(*params.ProjectTaskStatusIdentity) {
+			continue
+		}
+
+		if params.ExcludeTaskIdentity != nil && tsk.Identity.Equals(*params.ExcludeTaskIdentity) {
+			continue
+		}
+
+		total++
+		for _, taskUser := range tsk.Users {
+			byUser[taskUser.User.Identity.Public]++
+			users[taskUser.User.Identity.Public] = taskUser.User.Identity
+		}
+	}
+
+	if !params.ByAssignee {
+		return append(counts, projectrepo.ProjectTaskStatusTaskCount{ProjectTaskStatusIdentity: *params.ProjectTaskStatusIdentity, TaskCount: total}), nil
+	}
+
+	for publicId, count := range byUser {
+		userIdentity := users[publicId]
+		counts = append(counts, projectrepo.ProjectTaskStatusTaskCount{ProjectTaskStatusIdentity: *params.ProjectTaskStatusIdentity, UserIdentity: &userIdentity, TaskCount: count})
+	}
+
+	return counts, nil
+}
+
+func TestCheckTaskStatusWipLimit(t *testing.T) {
+	limit := func(value int16) *int16 { return &value }
+	alice := &user.User{Identity: core.NewIdentity("usr")}
+	bob := &user.User{Identity: core.NewIdentity("usr")}
+
+	newStatus := func(wipLimit *int16, wipLimitPerAssignee *int16, policy project.ProjectTaskStatusWipLimitPolicies) *project.ProjectTaskStatus {
+		return &project.ProjectTaskStatus{
+			Identity:            core.NewIdentity("pts"),
+			Name:                "Doing",
+			WipLimit:            wipLimit,
+			WipLimitPerAssignee: wipLimitPerAssignee,
+			WipLimitPolicy:      policy,
+		}
+	}
+
+	newTask := func(status *project.ProjectTaskStatus, users ...*user.User) *task.Task {
+		var taskUsers []*task.TaskUser = make([]*task.TaskUser, 0)
+		for _, u := range users {
+			taskUsers = append(taskUsers, &task.TaskUser{User: u})
+		}
+
+		return &task.Task{Identity: core.NewIdentity("tsk"), Status: status, Users: taskUsers}
+	}
+
+	tests := []struct {
+		name     string
+		status   *project.ProjectTaskStatus
+		existing []*user.User
+		moving   []*user.User
+		inStatus bool
+		conflict bool
+		warnings int
+	}{
+		{name: "without limit", status: newStatus(nil, nil, project.ProjectTaskStatusWipLimitPolicyReject), existing: []*user.User{alice, alice}},
+		{name: "below the limit", status: newStatus(limit(2), nil, project.ProjectTaskStatusWipLimitPolicyReject), existing: []*user.User{alice}},
+		{name: "limit reached rejects", status: newStatus(limit(2), nil, project.ProjectTaskStatusWipLimitPolicyReject), existing: []*user.User{alice, bob}, conflict: true},
+		{name: "limit reached warns", status: newStatus(limit(2), nil, project.ProjectTaskStatusWipLimitPolicyWarn), existing: []*user.User{alice, bob}, warnings: 1},
+		{name: "task already in the status is not counted twice", status: newStatus(limit(2), nil, project.ProjectTaskStatusWipLimitPolicyReject), existing: []*user.User{alice}, inStatus: true},
+		{name: "assignee limit reached rejects", status: newStatus(nil, limit(1), project.ProjectTaskStatusWipLimitPolicyReject), existing: []*user.User{alice}, moving: []*user.User{alice}, conflict: true},
+		{name: "assignee limit of another user", status: newStatus(nil, limit(1), project.ProjectTaskStatusWipLimitPolicyReject), existing: []*user.User{alice}, moving: []*user.User{bob}},
+		{name: "assignee limit ignores unassigned tasks", status: newStatus(nil, limit(1), project.ProjectTaskStatusWipLimitPolicyReject), existing: []*user.User{alice}},
+		{name: "both limits warn", status: newStatus(limit(1), limit(1), project.ProjectTaskStatusWipLimitPolicyWarn), existing: []*user.User{alice}, moving: []*user.User{alice}, warnings: 2},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var tasks []*task.Task = make([]*task.Task, 0)
+			for _, u := range tt.existing {
+				tasks = append(tasks, newTask(tt.status, u))
+			}
+
+			moving := newTask(nil, tt.moving...)
+			if tt.inStatus {
+				moving.Status = tt.status
+				tasks = append(tasks, moving)
+			}
+
+			warnings, err := checkTaskStatusWipLimit(&memoryProjectTaskStatusRepository{tasks: tasks}, tt.status, moving)
+
+			if tt.conflict {
+				var conflictError *core.ConflictError
+				if !errors.As(err, &conflictError) {
+					t.Fatalf("expected a conflict error, got %v", err)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if len(warnings) != tt.warnings {
+				t.Errorf("expected %d warnings, got %v", tt.warnings, warnings)
+			}
+		})
+	}
+}
+
+func TestResolveTaskReferences(t *testing.T) {
+	projectIdentity := core.NewIdentity("prj")
+	outsideProjectIdentity := core.NewIdentity("prj")
+	author := core.NewIdentity("usr")
+	member := core.NewIdentity("usr")
+	stranger := core.NewIdentity("usr")
+	deletedAt := core.NewDateTime()
+
+	visible := &task.Task{Identity: core.NewIdentity("tsk"), ProjectIdentity: projectIdentity}
+	deleted := &task.Task{Identity: core.NewIdentity("tsk"), ProjectIdentity: projectIdentity, DeletedAt: &deletedAt}
+	hidden := &task.Task{Identity: core.NewIdentity("tsk"), ProjectIdentity: outsideProjectIdentity}
+	missing := core.NewIdentity("tsk")
+
+	taskRepository := &memoryTaskRepository{tasks: []*task.Task{visible, deleted, hidden}}
+	projectUserRepository := &memoryProjectUserRepository{
+		outsideProjects: []core.Identity{outsideProjectIdentity},
+		outsideUsers:    []core.Identity{stranger},
+	}
+
+	tests := []struct {
+		name       string
+		content    string
+		references int
+		invalid    int
+	}{
+		{name: "without references", content: "plain text"},
+		{name: "member and visible task", content: "@" + member.Public + " see #" + visible.Identity.Public, references: 2},
+		{name: "user outside the project", content: "@" + member.Public + " @" + stranger.Public, invalid: 1},
+		{name: "missing task", content: "#" + missing.Public, invalid: 1},
+		{name: "deleted task", content: "#" + deleted.Identity.Public, invalid: 1},
+		{name: "task of a project the author is not in", content: "#" + hidden.Identity.Public, invalid: 1},
+		{name: "every invalid reference is reported", content: "@" + stranger.Public + " #" + missing.Public + " #" + hidden.Identity.Public, invalid: 3},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			references, err := resolveTaskReferences(taskRepository, projectUserRepository, projectIdentity, author, "description", tt.content)
+
+			if tt.invalid > 0 {
+				var invalidInputError *core.InvalidInputError
+				if !errors.As(err, &invalidInputError) {
+					t.Fatalf("expected an invalid input error, got %v", err)
+				}
+
+				if len(invalidInputError.Fields) != tt.invalid {
+					t.Errorf("expected %d invalid references, got %v", tt.invalid, invalidInputError.Fields)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if len(references) != tt.references {
+				t.Errorf("expected %d references, got %d", tt.references, len(references))
+			}
+		})
+	}
+}
@@ -0,0 +1,155 @@
+package taskservice
+
+import (
+	"github.com/gabrielmrtt/taski/internal/core"
+	projectrepo "github.com/gabrielmrtt/taski/internal/project/repository"
+	"github.com/gabrielmrtt/taski/internal/task"
+	taskrepo "github.com/gabrielmrtt/taski/internal/task/repository"
+)
+
+type CreateTaskTimeEntryService struct {
+	TaskTimeEntryRepository taskrepo.TaskTimeEntryRepository
+	TaskRepository          taskrepo.TaskRepository
+	TaskActionRepository    taskrepo.TaskActionRepository
+	ProjectUserRepository   projectrepo.ProjectUserRepository
+	TransactionRepository   core.TransactionRepository
+}
+
+func NewCreateTaskTimeEntryService(
+	taskTimeEntryRepository taskrepo.TaskTimeEntryRepository,
+	taskRepository taskrepo.TaskRepository,
+	taskActionRepository taskrepo.TaskActionRepository,
+	projectUserRepository projectrepo.ProjectUserRepository,
+	transactionRepository core.TransactionRepository,
+) *CreateTaskTimeEntryService {
+	return &CreateTaskTimeEntryService{
+		TaskTimeEntryRepository: taskTimeEntryRepository,
+		TaskRepository:          taskRepository,
+		TaskActionRepository:    taskActionRepository,
+		ProjectUserRepository:   projectUserRepository,
+		TransactionRepository:   transactionRepository,
+	}
+}
+
+type CreateTaskTimeEntryInput struct {
+	OrganizationIdentity *core.Identity
+	TaskIdentity         core.Identity
+	Description          string
+	StartedAt            *core.DateTime
+	EndedAt              *core.DateTime
+	UserIdentity         core.Identity
+}
+
+func (i CreateTaskTimeEntryInput) Validate() error {
+	var fields []core.InvalidInputErrorField
+
+	if i.StartedAt == nil {
+		fields = append(fields, core.InvalidInputErrorField{
+			Field: "startedAt",
+			Error: "started at is required",
+		})
+	}
+
+	if i.EndedAt == nil {
+		fields = append(fields, core.InvalidInputErrorField{
+			Field: "endedAt",
+			Error: "ended at is required",
+		})
+	}
+
+	if i.Description != "" {
+		if _, err := core.NewDescription(i.Description); err != nil {
+			fields = append(fields, core.InvalidInputErrorField{
+				Field: "description",
+				Error: err.Error(),
+			})
+		}
+	}
+
+	if len(fields) > 0 {
+		return core.NewInvalidInputError("invalid input", fields)
+	}
+
+	return nil
+}
+
+func (s *CreateTaskTimeEntryService) Execute(input CreateTaskTimeEntryInput) (*task.TaskTimeEntryDto, error) {
+	if err := input.Validate(); err != nil {
+		return nil, err
+	}
+
+	tx, err := s.TransactionRepository.BeginTransaction()
+	if err != nil {
+		return nil, err
+	}
+
+	s.TaskTimeEntryRepository.SetTransaction(tx)
+	s.TaskRepository.SetTransaction(tx)
+	s.TaskActionRepository.SetTransaction(tx)
+	s.ProjectUserRepository.SetTransaction(tx)
+
+	tsk, err := s.TaskRepository.GetTaskByIdentity(taskrepo.GetTaskByIdentityParams{
+		TaskIdentity:         input.TaskIdentity,
+		OrganizationIdentity: input.OrganizationIdentity,
+	})
+	if err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+
+	if tsk == nil {
+		tx.Rollback()
+		return nil, core.NewNotFoundError("task not found")
+	}
+
+	projectUser, err := s.ProjectUserRepository.GetProjectUserByIdentity(projectrepo.GetProjectUserByIdentityParams{
+		ProjectIdentity: tsk.ProjectIdentity,
+		UserIdentity:    input.UserIdentity,
+	})
+	if err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+
+	if projectUser == nil {
+		tx.Rollback()
+		return nil, core.NewNotFoundError("project user not found")
+	}
+
+	taskTimeEntry, err := task.NewTaskTimeEntry(task.NewTaskTimeEntryInput{
+		TaskIdentity: tsk.Identity,
+		UserIdentity: input.UserIdentity,
+		Description:  input.Description,
+		StartedAt:    *input.StartedAt,
+		EndedAt:      input.EndedAt,
+	})
+	if err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+
+	_, err = s.TaskTimeEntryRepository.StoreTaskTimeEntry(taskrepo.StoreTaskTimeEntryParams{
+		TaskTimeEntry: taskTimeEntry,
+	})
+	if err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+
+	taskAction := tsk.RegisterAction(task.TaskActionTypeAddTimeEntry, &projectUser.User)
+	_, err = s.TaskActionRepository.StoreTaskAction(taskrepo.StoreTaskActionParams{
+		TaskAction: &taskAction,
+	})
+	if err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+
+	return task.TaskTimeEntryToDto(taskTimeEntry), nil
+}
@@ -0,0 +1,151 @@
+package taskservice
+
+import (
+	"github.com/gabrielmrtt/taski/internal/core"
+	projectrepo "github.com/gabrielmrtt/taski/internal/project/repository"
+	"github.com/gabrielmrtt/taski/internal/task"
+	taskrepo "github.com/gabrielmrtt/taski/internal/task/repository"
+)
+
+type CreateTaskViewService struct {
+	TaskViewRepository    taskrepo.TaskViewRepository
+	ProjectRepository     projectrepo.ProjectRepository
+	ProjectUserRepository projectrepo.ProjectUserRepository
+	TransactionRepository core.TransactionRepository
+}
+
+func NewCreateTaskViewService(
+	taskViewRepository taskrepo.TaskViewRepository,
+	projectRepository projectrepo.ProjectRepository,
+	projectUserRepository projectrepo.ProjectUserRepository,
+	transactionRepository core.TransactionRepository,
+) *CreateTaskViewService {
+	return &CreateTaskViewService{
+		TaskViewRepository:    taskViewRepository,
+		ProjectRepository:     projectRepository,
+		ProjectUserRepository: projectUserRepository,
+		TransactionRepository: transactionRepository,
+	}
+}
+
+type CreateTaskViewInput struct {
+	OrganizationIdentity *core.Identity
+	ProjectIdentity      *core.Identity
+	UserOwnerIdentity    core.Identity
+	Name                 string
+	Visibility           task.TaskViewVisibilities
+	Filters              map[string]string
+	SortBy               *string
+	SortDirection        *core.SortDirection
+	GroupBy              *task.TaskGroupByFields
+}
+
+func (i CreateTaskViewInput) Validate() error {
+	var fields []core.InvalidInputErrorField
+
+	if i.OrganizationIdentity == nil {
+		fields = append(fields, core.InvalidInputErrorField{
+			Field: "organization_identity",
+			Error: "organization identity is required",
+		})
+	}
+
+	if _, err := core.NewName(i.Name); err != nil {
+		fields = append(fields, core.InvalidInputErrorField{
+			Field: "name",
+			Error: err.Error(),
+		})
+	}
+
+	if len(fields) > 0 {
+		return core.NewInvalidInputError("invalid input", fields)
+	}
+
+	return nil
+}
+
+func (s *CreateTaskViewService) Execute(input CreateTaskViewInput) (*task.TaskViewDto, error) {
+	if err := input.Validate(); err != nil {
+		return nil, err
+	}
+
+	tx, err := s.TransactionRepository.BeginTransaction()
+	if err != nil {
+		return nil, err
+	}
+
+	s.TaskViewRepository.SetTransaction(tx)
+	s.ProjectRepository.SetTransaction(tx)
+	s.ProjectUserRepository.SetTransaction(tx)
+
+	if input.ProjectIdentity != nil {
+		err = checkTaskViewProjectMembership(s.ProjectRepository, s.ProjectUserRepository, *input.OrganizationIdentity, *input.ProjectIdentity, input.UserOwnerIdentity)
+		if err != nil {
+			tx.Rollback()
+			return nil, err
+		}
+	}
+
+	taskView, err := task.NewTaskView(task.NewTaskViewInput{
+		OrganizationIdentity: *input.OrganizationIdentity,
+		ProjectIdentity:      input.ProjectIdentity,
+		UserOwnerIdentity:    input.UserOwnerIdentity,
+		Name:                 input.Name,
+		Visibility:           input.Visibility,
+		Filters:              input.Filters,
+		SortBy:               input.SortBy,
+		SortDirection:        input.SortDirection,
+		GroupBy:              input.GroupBy,
+	})
+	if err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+
+	taskView, err = s.TaskViewRepository.StoreTaskView(taskrepo.StoreTaskViewParams{
+		TaskView: taskView,
+	})
+	if err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+
+	return task.TaskViewToDto(taskView), nil
+}
+
+/*
+checkTaskViewProjectMembership ensures the project belongs to the organization and the user is an active member of it.
+*/
+func checkTaskViewProjectMembership(projectRepository projectrepo.ProjectRepository, projectUserRepository projectrepo.ProjectUserRepository, organizationIdentity core.Identity, projectIdentity core.Identity, userIdentity core.Identity) error {
+	prj, err := projectRepository.GetProjectByIdentity(projectrepo.GetProjectByIdentityParams{
+		ProjectIdentity:      projectIdentity,
+		OrganizationIdentity: &organizationIdentity,
+	})
+	if err != nil {
+		return err
+	}
+
+	if prj == nil {
+		return core.NewNotFoundError("project not found")
+	}
+
+	projectUser, err := projectUserRepository.GetProjectUserByIdentity(projectrepo.GetProjectUserByIdentityParams{
+		ProjectIdentity: projectIdentity,
+		UserIdentity:    userIdentity,
+	})
+	if err != nil {
+		return err
+	}
+
+	if projectUser == nil || !projectUser.IsActive() {
+		return core.NewUnauthorizedError("user is not a member of the project")
+	}
+
+	return nil
+}
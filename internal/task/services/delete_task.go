@@ -32,6 +32,7 @@ type DeleteTaskInput struct {
 	OrganizationIdentity *core.Identity
 	TaskIdentity         core.Identity
 	UserDeleterIdentity  core.Identity
+	ExpectedRevision     *int64
 }
 
 func (i DeleteTaskInput) Validate() error { return nil }
@@ -64,6 +65,12 @@ func (s *DeleteTaskService) Execute(input DeleteTaskInput) error {
 		return core.NewNotFoundError("task not found")
 	}
 
+	err = core.CheckRevision(input.ExpectedRevision, tsk.Revision, "task")
+	if err != nil {
+		tx.Rollback()
+		return err
+	}
+
 	userDeleter, err := s.ProjectUserRepository.GetProjectUserByIdentity(projectrepo.GetProjectUserByIdentityParams{
 		ProjectIdentity: tsk.ProjectIdentity,
 		UserIdentity:    input.UserDeleterIdentity,
@@ -3,39 +3,31 @@ package taskservice
 import (
 	"github.com/gabrielmrtt/taski/internal/core"
 	projectrepo "github.com/gabrielmrtt/taski/internal/project/repository"
-	storagerepo "github.com/gabrielmrtt/taski/internal/storage/repository"
-	storageservice "github.com/gabrielmrtt/taski/internal/storage/service"
 	"github.com/gabrielmrtt/taski/internal/task"
 	taskrepo "github.com/gabrielmrtt/taski/internal/task/repository"
 )
 
 type DeleteTaskCommentService struct {
-	TaskCommentRepository  taskrepo.TaskCommentRepository
-	TaskRepository         taskrepo.TaskRepository
-	UploadedFileRepository storagerepo.UploadedFileRepository
-	StorageRepository      storagerepo.StorageRepository
-	TaskActionRepository   taskrepo.TaskActionRepository
-	ProjectUserRepository  projectrepo.ProjectUserRepository
-	TransactionRepository  core.TransactionRepository
+	TaskCommentRepository taskrepo.TaskCommentRepository
+	TaskRepository        taskrepo.TaskRepository
+	TaskActionRepository  taskrepo.TaskActionRepository
+	ProjectUserRepository projectrepo.ProjectUserRepository
+	TransactionRepository core.TransactionRepository
 }
 
 func NewDeleteTaskCommentService(
 	taskCommentRepository taskrepo.TaskCommentRepository,
 	taskRepository taskrepo.TaskRepository,
 	projectUserRepository projectrepo.ProjectUserRepository,
-	uploadedFileRepository storagerepo.UploadedFileRepository,
-	storageRepository storagerepo.StorageRepository,
 	taskActionRepository taskrepo.TaskActionRepository,
 	transactionRepository core.TransactionRepository,
 ) *DeleteTaskCommentService {
 	return &DeleteTaskCommentService{
-		TaskCommentRepository:  taskCommentRepository,
-		TaskRepository:         taskRepository,
-		UploadedFileRepository: uploadedFileRepository,
-		StorageRepository:      storageRepository,
-		TaskActionRepository:   taskActionRepository,
-		ProjectUserRepository:  projectUserRepository,
-		TransactionRepository:  transactionRepository,
+		TaskCommentRepository: taskCommentRepository,
+		TaskRepository:        taskRepository,
+		TaskActionRepository:  taskActionRepository,
+		ProjectUserRepository: projectUserRepository,
+		TransactionRepository: transactionRepository,
 	}
 }
 
@@ -47,6 +39,9 @@ type DeleteTaskCommentInput struct {
 
 func (i DeleteTaskCommentInput) Validate() error { return nil }
 
+/*
+Execute leaves a tombstone in place of the comment. Its replies, files and versions are kept.
+*/
 func (s *DeleteTaskCommentService) Execute(input DeleteTaskCommentInput) error {
 	if err := input.Validate(); err != nil {
 		return err
@@ -59,7 +54,6 @@ func (s *DeleteTaskCommentService) Execute(input DeleteTaskCommentInput) error {
 
 	s.TaskCommentRepository.SetTransaction(tx)
 	s.TaskRepository.SetTransaction(tx)
-	s.UploadedFileRepository.SetTransaction(tx)
 	s.TaskActionRepository.SetTransaction(tx)
 	s.ProjectUserRepository.SetTransaction(tx)
 
@@ -104,18 +98,14 @@ func (s *DeleteTaskCommentService) Execute(input DeleteTaskCommentInput) error {
 		return core.NewNotFoundError("task comment not found")
 	}
 
-	deleteFileService := storageservice.NewDeleteFileByIdentityService(s.UploadedFileRepository, s.StorageRepository)
-
-	for _, file := range comment.Files {
-		err = deleteFileService.Execute(file.FileIdentity)
-		if err != nil {
-			tx.Rollback()
-			return err
-		}
+	err = comment.Delete(input.UserDeleterIdentity)
+	if err != nil {
+		tx.Rollback()
+		return err
 	}
 
-	err = s.TaskCommentRepository.DeleteTaskComment(taskrepo.DeleteTaskCommentParams{
-		TaskCommentIdentity: input.TaskCommentIdentity,
+	err = s.TaskCommentRepository.UpdateTaskComment(taskrepo.UpdateTaskCommentParams{
+		TaskComment: comment,
 	})
 	if err != nil {
 		tx.Rollback()
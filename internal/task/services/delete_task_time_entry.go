@@ -0,0 +1,129 @@
+package taskservice
+
+import (
+	"github.com/gabrielmrtt/taski/internal/core"
+	projectrepo "github.com/gabrielmrtt/taski/internal/project/repository"
+	"github.com/gabrielmrtt/taski/internal/task"
+	taskrepo "github.com/gabrielmrtt/taski/internal/task/repository"
+)
+
+type DeleteTaskTimeEntryService struct {
+	TaskTimeEntryRepository taskrepo.TaskTimeEntryRepository
+	TaskRepository          taskrepo.TaskRepository
+	TaskActionRepository    taskrepo.TaskActionRepository
+	ProjectUserRepository   projectrepo.ProjectUserRepository
+	TransactionRepository   core.TransactionRepository
+}
+
+func NewDeleteTaskTimeEntryService(
+	taskTimeEntryRepository taskrepo.TaskTimeEntryRepository,
+	taskRepository taskrepo.TaskRepository,
+	taskActionRepository taskrepo.TaskActionRepository,
+	projectUserRepository projectrepo.ProjectUserRepository,
+	transactionRepository core.TransactionRepository,
+) *DeleteTaskTimeEntryService {
+	return &DeleteTaskTimeEntryService{
+		TaskTimeEntryRepository: taskTimeEntryRepository,
+		TaskRepository:          taskRepository,
+		TaskActionRepository:    taskActionRepository,
+		ProjectUserRepository:   projectUserRepository,
+		TransactionRepository:   transactionRepository,
+	}
+}
+
+type DeleteTaskTimeEntryInput struct {
+	OrganizationIdentity  *core.Identity
+	TaskIdentity          core.Identity
+	TaskTimeEntryIdentity core.Identity
+	UserDeleterIdentity   core.Identity
+}
+
+func (i DeleteTaskTimeEntryInput) Validate() error { return nil }
+
+func (s *DeleteTaskTimeEntryService) Execute(input DeleteTaskTimeEntryInput) error {
+	if err := input.Validate(); err != nil {
+		return err
+	}
+
+	tx, err := s.TransactionRepository.BeginTransaction()
+	if err != nil {
+		return err
+	}
+
+	s.TaskTimeEntryRepository.SetTransaction(tx)
+	s.TaskRepository.SetTransaction(tx)
+	s.TaskActionRepository.SetTransaction(tx)
+	s.ProjectUserRepository.SetTransaction(tx)
+
+	tsk, err := s.TaskRepository.GetTaskByIdentity(taskrepo.GetTaskByIdentityParams{
+		TaskIdentity:         input.TaskIdentity,
+		OrganizationIdentity: input.OrganizationIdentity,
+	})
+	if err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	if tsk == nil {
+		tx.Rollback()
+		return core.NewNotFoundError("task not found")
+	}
+
+	userDeleter, err := s.ProjectUserRepository.GetProjectUserByIdentity(projectrepo.GetProjectUserByIdentityParams{
+		ProjectIdentity: tsk.ProjectIdentity,
+		UserIdentity:    input.UserDeleterIdentity,
+	})
+	if err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	if userDeleter == nil {
+		tx.Rollback()
+		return core.NewNotFoundError("project user deleter not found")
+	}
+
+	taskTimeEntry, err := s.TaskTimeEntryRepository.GetTaskTimeEntryByIdentity(taskrepo.GetTaskTimeEntryByIdentityParams{
+		TaskTimeEntryIdentity: input.TaskTimeEntryIdentity,
+		TaskIdentity:          &tsk.Identity,
+	})
+	if err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	if taskTimeEntry == nil {
+		tx.Rollback()
+		return core.NewNotFoundError("task time entry not found")
+	}
+
+	if !taskTimeEntry.IsOwnedBy(input.UserDeleterIdentity) {
+		tx.Rollback()
+		return core.NewUnauthorizedError("only the owner can delete a time entry")
+	}
+
+	err = s.TaskTimeEntryRepository.DeleteTaskTimeEntry(taskrepo.DeleteTaskTimeEntryParams{
+		TaskTimeEntryIdentity: taskTimeEntry.Identity,
+	})
+	if err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	taskAction := tsk.RegisterAction(task.TaskActionTypeDeleteTimeEntry, &userDeleter.User)
+	_, err = s.TaskActionRepository.StoreTaskAction(taskrepo.StoreTaskActionParams{
+		TaskAction: &taskAction,
+	})
+	if err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,77 @@
+package taskservice
+
+import (
+	"github.com/gabrielmrtt/taski/internal/core"
+	taskrepo "github.com/gabrielmrtt/taski/internal/task/repository"
+)
+
+type DeleteTaskViewService struct {
+	TaskViewRepository    taskrepo.TaskViewRepository
+	TransactionRepository core.TransactionRepository
+}
+
+func NewDeleteTaskViewService(
+	taskViewRepository taskrepo.TaskViewRepository,
+	transactionRepository core.TransactionRepository,
+) *DeleteTaskViewService {
+	return &DeleteTaskViewService{
+		TaskViewRepository:    taskViewRepository,
+		TransactionRepository: transactionRepository,
+	}
+}
+
+type DeleteTaskViewInput struct {
+	OrganizationIdentity *core.Identity
+	TaskViewIdentity     core.Identity
+	UserIdentity         core.Identity
+}
+
+func (i DeleteTaskViewInput) Validate() error { return nil }
+
+func (s *DeleteTaskViewService) Execute(input DeleteTaskViewInput) error {
+	if err := input.Validate(); err != nil {
+		return err
+	}
+
+	tx, err := s.TransactionRepository.BeginTransaction()
+	if err != nil {
+		return err
+	}
+
+	s.TaskViewRepository.SetTransaction(tx)
+
+	taskView, err := s.TaskViewRepository.GetTaskViewByIdentity(taskrepo.GetTaskViewByIdentityParams{
+		TaskViewIdentity:     input.TaskViewIdentity,
+		OrganizationIdentity: input.OrganizationIdentity,
+	})
+	if err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	if taskView == nil {
+		tx.Rollback()
+		return core.NewNotFoundError("task view not found")
+	}
+
+	if !taskView.IsOwnedBy(input.UserIdentity) {
+		tx.Rollback()
+		return core.NewUnauthorizedError("only the owner can delete a task view")
+	}
+
+	err = s.TaskViewRepository.DeleteTaskView(taskrepo.DeleteTaskViewParams{
+		TaskViewIdentity: input.TaskViewIdentity,
+	})
+	if err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	return nil
+}
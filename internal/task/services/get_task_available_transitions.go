@@ -0,0 +1,146 @@
+package taskservice
+
+import (
+	"github.com/gabrielmrtt/taski/internal/core"
+	organizationrepo "github.com/gabrielmrtt/taski/internal/organization/repository"
+	"github.com/gabrielmrtt/taski/internal/project"
+	projectrepo "github.com/gabrielmrtt/taski/internal/project/repository"
+	"github.com/gabrielmrtt/taski/internal/task"
+	taskrepo "github.com/gabrielmrtt/taski/internal/task/repository"
+)
+
+type GetTaskAvailableTransitionsService struct {
+	TaskRepository                        taskrepo.TaskRepository
+	ProjectTaskStatusRepository           projectrepo.ProjectTaskStatusRepository
+	ProjectTaskStatusTransitionRepository projectrepo.ProjectTaskStatusTransitionRepository
+	OrganizationUserRepository            organizationrepo.OrganizationUserRepository
+}
+
+func NewGetTaskAvailableTransitionsService(
+	taskRepository taskrepo.TaskRepository,
+	projectTaskStatusRepository projectrepo.ProjectTaskStatusRepository,
+	projectTaskStatusTransitionRepository projectrepo.ProjectTaskStatusTransitionRepository,
+	organizationUserRepository organizationrepo.OrganizationUserRepository,
+) *GetTaskAvailableTransitionsService {
+	return &GetTaskAvailableTransitionsService{
+		TaskRepository:                        taskRepository,
+		ProjectTaskStatusRepository:           projectTaskStatusRepository,
+		ProjectTaskStatusTransitionRepository: projectTaskStatusTransitionRepository,
+		OrganizationUserRepository:            organizationUserRepository,
+	}
+}
+
+type GetTaskAvailableTransitionsInput struct {
+	OrganizationIdentity *core.Identity
+	TaskIdentity         core.Identity
+	UserIdentity         core.Identity
+}
+
+func (i GetTaskAvailableTransitionsInput) Validate() error {
+	var fields []core.InvalidInputErrorField
+
+	if i.OrganizationIdentity == nil {
+		fields = append(fields, core.InvalidInputErrorField{
+			Field: "organization_identity",
+			Error: "organization identity is required",
+		})
+	}
+
+	if len(fields) > 0 {
+		return core.NewInvalidInputError("invalid input", fields)
+	}
+
+	return nil
+}
+
+func (s *GetTaskAvailableTransitionsService) Execute(input GetTaskAvailableTransitionsInput) ([]task.TaskAvailableTransitionDto, error) {
+	if err := input.Validate(); err != nil {
+		return nil, err
+	}
+
+	tsk, err := s.TaskRepository.GetTaskByIdentity(taskrepo.GetTaskByIdentityParams{
+		TaskIdentity:         input.TaskIdentity,
+		OrganizationIdentity: input.OrganizationIdentity,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if tsk == nil {
+		return nil, core.NewNotFoundError("task not found")
+	}
+
+	organizationUser, err := s.OrganizationUserRepository.GetOrganizationUserByIdentity(organizationrepo.GetOrganizationUserByIdentityParams{
+		OrganizationIdentity: *input.OrganizationIdentity,
+		UserIdentity:         input.UserIdentity,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if organizationUser == nil {
+		return nil, core.NewNotFoundError("organization user not found")
+	}
+
+	projectStatuses, err := s.ProjectTaskStatusRepository.ListProjectTaskStatusesBy(projectrepo.ListProjectTaskStatusesByParams{
+		Filters: projectrepo.ProjectTaskStatusFilters{
+			ProjectIdentity: &tsk.ProjectIdentity,
+		},
+		SortInput: &core.SortInput{
+			By:        &[]string{"order"}[0],
+			Direction: &[]core.SortDirection{"asc"}[0],
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	transitions, err := s.ProjectTaskStatusTransitionRepository.ListProjectTaskStatusTransitionsBy(projectrepo.ListProjectTaskStatusTransitionsByParams{
+		Filters: projectrepo.ProjectTaskStatusTransitionFilters{
+			ProjectIdentity: &tsk.ProjectIdentity,
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var availableTransitions []task.TaskAvailableTransitionDto = make([]task.TaskAvailableTransitionDto, 0)
+	for _, status := range projectStatuses {
+		if status.DeletedAt != nil {
+			continue
+		}
+
+		if tsk.Status != nil && tsk.Status.Identity.Equals(status.Identity) {
+			continue
+		}
+
+		var requiredFields []project.ProjectTaskStatusTransitionRequiredFields = make([]project.ProjectTaskStatusTransitionRequiredFields, 0)
+		if len(transitions) > 0 {
+			transition, _ := findStatusTransition(transitions, tsk, status.Identity, organizationUser.Role.Identity)
+			if transition == nil {
+				continue
+			}
+
+			requiredFields = transition.RequiredFields
+		}
+
+		availableTransitions = append(availableTransitions, task.TaskAvailableTransitionDto{
+			StatusId:       status.Identity.Public,
+			StatusName:     status.Name,
+			StatusColor:    status.Color,
+			RequiredFields: requiredFieldsToStrings(requiredFields),
+			MissingFields:  requiredFieldsToStrings(tsk.MissingRequiredFields(requiredFields)),
+		})
+	}
+
+	return availableTransitions, nil
+}
+
+func requiredFieldsToStrings(requiredFields []project.ProjectTaskStatusTransitionRequiredFields) []string {
+	var values []string = make([]string, 0)
+	for _, requiredField := range requiredFields {
+		values = append(values, string(requiredField))
+	}
+
+	return values
+}
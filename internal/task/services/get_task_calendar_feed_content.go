@@ -0,0 +1,336 @@
+package taskservice
+
+import (
+	"slices"
+	"strconv"
+
+	"github.com/gabrielmrtt/taski/internal/core"
+	organizationrepo "github.com/gabrielmrtt/taski/internal/organization/repository"
+	"github.com/gabrielmrtt/taski/internal/project"
+	projectrepo "github.com/gabrielmrtt/taski/internal/project/repository"
+	"github.com/gabrielmrtt/taski/internal/role"
+	"github.com/gabrielmrtt/taski/internal/task"
+	taskrepo "github.com/gabrielmrtt/taski/internal/task/repository"
+	"github.com/gabrielmrtt/taski/pkg/icalutils"
+)
+
+const taskCalendarFeedPageSize = 100
+
+const taskCalendarFeedProductId = "-//Taski//Task Calendar//EN"
+
+type GetTaskCalendarFeedContentService struct {
+	TaskCalendarFeedRepository taskrepo.TaskCalendarFeedRepository
+	TaskRepository             taskrepo.TaskRepository
+	ProjectRepository          projectrepo.ProjectRepository
+	ProjectUserRepository      projectrepo.ProjectUserRepository
+	OrganizationUserRepository organizationrepo.OrganizationUserRepository
+	WebAppUrl                  string
+}
+
+func NewGetTaskCalendarFeedContentService(
+	taskCalendarFeedRepository taskrepo.TaskCalendarFeedRepository,
+	taskRepository taskrepo.TaskRepository,
+	projectRepository projectrepo.ProjectRepository,
+	projectUserRepository projectrepo.ProjectUserRepository,
+	organizationUserRepository organizationrepo.OrganizationUserRepository,
+	webAppUrl string,
+) *GetTaskCalendarFeedContentService {
+	return &GetTaskCalendarFeedContentService{
+		TaskCalendarFeedRepository: taskCalendarFeedRepository,
+		TaskRepository:             taskRepository,
+		ProjectRepository:          projectRepository,
+		ProjectUserRepository:      projectUserRepository,
+		OrganizationUserRepository: organizationUserRepository,
+		WebAppUrl:                  webAppUrl,
+	}
+}
+
+type GetTaskCalendarFeedContentInput struct {
+	Token     string
+	EntryType task.TaskCalendarFeedEntryTypes
+}
+
+func (i GetTaskCalendarFeedContentInput) Validate() error {
+	var fields []core.InvalidInputErrorField
+
+	if !slices.Contains(task.TaskCalendarFeedEntryTypesArray, i.EntryType) {
+		fields = append(fields, core.InvalidInputErrorField{
+			Field: "type",
+			Error: "type must be event or todo",
+		})
+	}
+
+	if len(fields) > 0 {
+		return core.NewInvalidInputError("invalid input", fields)
+	}
+
+	return nil
+}
+
+/*
+Execute renders the feed as an iCalendar document. Access is checked against the feed owner on every request, so the
+feed shows exactly the tasks the owner would see when listing tasks and stops resolving once they lose access.
+*/
+func (s *GetTaskCalendarFeedContentService) Execute(input GetTaskCalendarFeedContentInput) (string, error) {
+	if err := input.Validate(); err != nil {
+		return "", err
+	}
+
+	taskCalendarFeed, err := s.TaskCalendarFeedRepository.GetTaskCalendarFeedByTokenHash(taskrepo.GetTaskCalendarFeedByTokenHashParams{
+		TokenHash: task.HashTaskCalendarFeedToken(input.Token),
+	})
+	if err != nil {
+		return "", err
+	}
+
+	if taskCalendarFeed == nil || taskCalendarFeed.IsRevoked() {
+		return "", core.NewNotFoundError("task calendar feed not found")
+	}
+
+	organizationUser, err := s.OrganizationUserRepository.GetOrganizationUserByIdentity(organizationrepo.GetOrganizationUserByIdentityParams{
+		OrganizationIdentity: taskCalendarFeed.OrganizationIdentity,
+		UserIdentity:         taskCalendarFeed.UserOwnerIdentity,
+	})
+	if err != nil {
+		return "", err
+	}
+
+	if organizationUser == nil || !organizationUser.IsActive() || !organizationUser.CanExecuteAction(role.TasksView) {
+		return "", core.NewUnauthorizedError("the feed owner can no longer view these tasks")
+	}
+
+	var calendarName string = "Taski tasks"
+	var projects []project.Project = make([]project.Project, 0)
+
+	if taskCalendarFeed.ProjectIdentity != nil {
+		prj, err := s.getFeedProject(taskCalendarFeed)
+		if err != nil {
+			return "", err
+		}
+
+		calendarName = prj.Name
+		projects = append(projects, *prj)
+	} else {
+		projects, err = s.listFeedProjects(taskCalendarFeed)
+		if err != nil {
+			return "", err
+		}
+	}
+
+	tasks, err := s.listFeedTasks(taskCalendarFeed)
+	if err != nil {
+		return "", err
+	}
+
+	writer := icalutils.NewWriter()
+	writer.Begin("VCALENDAR")
+	writer.WriteProperty("VERSION", "2.0")
+	writer.WriteProperty("PRODID", taskCalendarFeedProductId)
+	writer.WriteProperty("CALSCALE", "GREGORIAN")
+	writer.WriteProperty("METHOD", "PUBLISH")
+	writer.WriteText("X-WR-CALNAME", calendarName)
+
+	now := core.NewDateTime()
+
+	if organizationUser.CanExecuteAction(role.ProjectsView) {
+		for _, prj := range projects {
+			s.writeProjectEvent(writer, &prj, now)
+		}
+	}
+
+	for _, tsk := range tasks {
+		s.writeTaskEntry(writer, &tsk, input.EntryType, now)
+	}
+
+	writer.End("VCALENDAR")
+
+	return writer.String(), nil
+}
+
+/*
+getFeedProject returns the project of a project feed, making sure the owner is still an active member of it.
+*/
+func (s *GetTaskCalendarFeedContentService) getFeedProject(taskCalendarFeed *task.TaskCalendarFeed) (*project.Project, error) {
+	prj, err := s.ProjectRepository.GetProjectByIdentity(projectrepo.GetProjectByIdentityParams{
+		ProjectIdentity:      *taskCalendarFeed.ProjectIdentity,
+		OrganizationIdentity: &taskCalendarFeed.OrganizationIdentity,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if prj == nil || prj.IsDeleted() {
+		return nil, core.NewNotFoundError("project not found")
+	}
+
+	projectUser, err := s.ProjectUserRepository.GetProjectUserByIdentity(projectrepo.GetProjectUserByIdentityParams{
+		ProjectIdentity: prj.Identity,
+		UserIdentity:    taskCalendarFeed.UserOwnerIdentity,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if projectUser == nil || !projectUser.IsActive() {
+		return nil, core.NewUnauthorizedError("the feed owner is not a member of the project")
+	}
+
+	return prj, nil
+}
+
+func (s *GetTaskCalendarFeedContentService) listFeedProjects(taskCalendarFeed *task.TaskCalendarFeed) ([]project.Project, error) {
+	var projects []project.Project = make([]project.Project, 0)
+	var perPage int = taskCalendarFeedPageSize
+
+	for page := 1; ; page++ {
+		result, err := s.ProjectRepository.PaginateProjectsBy(projectrepo.PaginateProjectsParams{
+			Filters: projectrepo.ProjectFilters{
+				OrganizationIdentity:      &taskCalendarFeed.OrganizationIdentity,
+				AuthenticatedUserIdentity: &taskCalendarFeed.UserOwnerIdentity,
+			},
+			Pagination: core.PaginationInput{
+				Page:    &page,
+				PerPage: &perPage,
+			},
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		projects = append(projects, result.Data...)
+
+		if !result.HasMore {
+			break
+		}
+	}
+
+	return projects, nil
+}
+
+/*
+listFeedTasks lists the tasks with a due date using the same visibility filters as the task listing.
+*/
+func (s *GetTaskCalendarFeedContentService) listFeedTasks(taskCalendarFeed *task.TaskCalendarFeed) ([]task.Task, error) {
+	var tasks []task.Task = make([]task.Task, 0)
+	var perPage int = taskCalendarFeedPageSize
+	var hasDueDate bool = true
+	var sortBy string = "dueDate"
+
+	for page := 1; ; page++ {
+		result, err := s.TaskRepository.PaginateTasksBy(taskrepo.PaginateTasksParams{
+			Filters: taskrepo.TaskFilters{
+				OrganizationIdentity:      &taskCalendarFeed.OrganizationIdentity,
+				AuthenticatedUserIdentity: &taskCalendarFeed.UserOwnerIdentity,
+				ProjectIdentity:           taskCalendarFeed.ProjectIdentity,
+				DueDate:                   &core.ComparableFilter[int64]{NotNull: &hasDueDate},
+			},
+			Pagination: core.PaginationInput{
+				Page:    &page,
+				PerPage: &perPage,
+			},
+			SortInput: core.SortInput{
+				By: &sortBy,
+			},
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		for _, tsk := range result.Data {
+			if !tsk.IsDeleted() {
+				tasks = append(tasks, tsk)
+			}
+		}
+
+		if !result.HasMore {
+			break
+		}
+	}
+
+	return tasks, nil
+}
+
+func (s *GetTaskCalendarFeedContentService) writeProjectEvent(writer *icalutils.Writer, prj *project.Project, now core.DateTime) {
+	if prj.StartAt == nil && prj.EndAt == nil {
+		return
+	}
+
+	var startAt *core.DateTime = prj.StartAt
+	var endAt *core.DateTime = prj.EndAt
+	if startAt == nil {
+		startAt = endAt
+	}
+
+	if endAt == nil {
+		endAt = startAt
+	}
+
+	var status string = "CONFIRMED"
+	if prj.Status == project.ProjectStatusCancelled {
+		status = "CANCELLED"
+	}
+
+	writer.Begin("VEVENT")
+	writer.WriteText("UID", prj.Identity.Public+"@taski")
+	writer.WriteDateTime("DTSTAMP", now.Value)
+	writer.WriteDateTime("DTSTART", startAt.Value)
+	writer.WriteDateTime("DTEND", endAt.Value)
+	writer.WriteText("SUMMARY", prj.Name)
+	if prj.Description != "" {
+		writer.WriteText("DESCRIPTION", prj.Description)
+	}
+	writer.WriteProperty("STATUS", status)
+	writer.WriteText("URL", s.WebAppUrl+"/project/"+prj.Identity.Public)
+	writer.End("VEVENT")
+}
+
+/*
+writeTaskEntry writes a task as a VTODO due at its due date or as a VEVENT happening at it, since many calendar apps
+ignore to-dos.
+*/
+func (s *GetTaskCalendarFeedContentService) writeTaskEntry(writer *icalutils.Writer, tsk *task.Task, entryType task.TaskCalendarFeedEntryTypes, now core.DateTime) {
+	var component string = "VEVENT"
+	if entryType == task.TaskCalendarFeedEntryTypeTodo {
+		component = "VTODO"
+	}
+
+	writer.Begin(component)
+	writer.WriteText("UID", tsk.Identity.Public+"@taski")
+	writer.WriteDateTime("DTSTAMP", now.Value)
+
+	if entryType == task.TaskCalendarFeedEntryTypeTodo {
+		writer.WriteDateTime("DUE", tsk.DueDate.Value)
+
+		if tsk.IsCompleted() {
+			writer.WriteProperty("STATUS", "COMPLETED")
+			writer.WriteDateTime("COMPLETED", tsk.CompletedAt.Value)
+		} else {
+			writer.WriteProperty("STATUS", "NEEDS-ACTION")
+		}
+	} else {
+		writer.WriteDateTime("DTSTART", tsk.DueDate.Value)
+		writer.WriteDateTime("DTEND", tsk.DueDate.Value)
+		writer.WriteProperty("STATUS", "CONFIRMED")
+		writer.WriteProperty("TRANSP", "TRANSPARENT")
+	}
+
+	writer.WriteText("SUMMARY", tsk.Name)
+	if tsk.Description != "" {
+		writer.WriteText("DESCRIPTION", tsk.Description)
+	}
+
+	if tsk.Status != nil {
+		writer.WriteText("CATEGORIES", tsk.Status.Name)
+	}
+
+	if priority := task.TaskCalendarPriorityLevels[tsk.PriorityLevel]; priority > 0 {
+		writer.WriteProperty("PRIORITY", strconv.Itoa(priority))
+	}
+
+	if tsk.Timestamps.UpdatedAt != nil {
+		writer.WriteDateTime("LAST-MODIFIED", tsk.Timestamps.UpdatedAt.Value)
+	}
+
+	writer.WriteText("URL", s.WebAppUrl+"/task/"+tsk.Identity.Public)
+	writer.End(component)
+}
@@ -39,6 +39,18 @@ func (s *GetTaskHistoryService) Execute(input GetTaskHistoryInput) (*core.Pagina
 		return nil, err
 	}
 
+	tsk, err := s.TaskRepository.GetTaskByIdentity(taskrepo.GetTaskByIdentityParams{
+		TaskIdentity:         input.TaskIdentity,
+		OrganizationIdentity: input.OrganizationIdentity,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if tsk == nil {
+		return nil, core.NewNotFoundError("task not found")
+	}
+
 	taskActions, err := s.TaskActionRepository.PaginateTaskActionsBy(taskrepo.PaginateTaskActionsParams{
 		Filters: taskrepo.TaskActionFilters{
 			TaskIdentity: &input.TaskIdentity,
@@ -57,9 +69,11 @@ func (s *GetTaskHistoryService) Execute(input GetTaskHistoryInput) (*core.Pagina
 	}
 
 	return &core.PaginationOutput[task.TaskActionDto]{
-		Data:    taskActionsDto,
-		Page:    taskActions.Page,
-		HasMore: taskActions.HasMore,
-		Total:   taskActions.Total,
+		Data:       taskActionsDto,
+		Page:       taskActions.Page,
+		HasMore:    taskActions.HasMore,
+		Total:      taskActions.Total,
+		NextCursor: taskActions.NextCursor,
+		PrevCursor: taskActions.PrevCursor,
 	}, nil
 }
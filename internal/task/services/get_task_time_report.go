@@ -0,0 +1,98 @@
+package taskservice
+
+import (
+	"github.com/gabrielmrtt/taski/internal/core"
+	"github.com/gabrielmrtt/taski/internal/task"
+	taskrepo "github.com/gabrielmrtt/taski/internal/task/repository"
+)
+
+type GetTaskTimeReportService struct {
+	TaskTimeEntryRepository taskrepo.TaskTimeEntryRepository
+}
+
+func NewGetTaskTimeReportService(
+	taskTimeEntryRepository taskrepo.TaskTimeEntryRepository,
+) *GetTaskTimeReportService {
+	return &GetTaskTimeReportService{
+		TaskTimeEntryRepository: taskTimeEntryRepository,
+	}
+}
+
+type GetTaskTimeReportInput struct {
+	OrganizationIdentity      *core.Identity
+	AuthenticatedUserIdentity *core.Identity
+	ProjectIdentity           *core.Identity
+	UserIdentity              *core.Identity
+	From                      *core.DateTime
+	To                        *core.DateTime
+}
+
+func (i GetTaskTimeReportInput) Validate() error {
+	var fields []core.InvalidInputErrorField
+
+	if i.From == nil {
+		fields = append(fields, core.InvalidInputErrorField{
+			Field: "from",
+			Error: "from is required",
+		})
+	}
+
+	if i.To == nil {
+		fields = append(fields, core.InvalidInputErrorField{
+			Field: "to",
+			Error: "to is required",
+		})
+	}
+
+	if i.From != nil && i.To != nil && !i.From.IsBefore(*i.To) {
+		fields = append(fields, core.InvalidInputErrorField{
+			Field: "to",
+			Error: "to must be after from",
+		})
+	}
+
+	if len(fields) > 0 {
+		return core.NewInvalidInputError("invalid input", fields)
+	}
+
+	return nil
+}
+
+func (s *GetTaskTimeReportService) Execute(input GetTaskTimeReportInput) (*task.TaskTimeReportDto, error) {
+	if err := input.Validate(); err != nil {
+		return nil, err
+	}
+
+	rows, err := s.TaskTimeEntryRepository.ReportTaskTimeEntries(taskrepo.ReportTaskTimeEntriesParams{
+		OrganizationIdentity:      input.OrganizationIdentity,
+		AuthenticatedUserIdentity: input.AuthenticatedUserIdentity,
+		ProjectIdentity:           input.ProjectIdentity,
+		UserIdentity:              input.UserIdentity,
+		From:                      *input.From,
+		To:                        *input.To,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var trackedSeconds int64 = 0
+	var rowsDto []*task.TaskTimeReportRowDto = make([]*task.TaskTimeReportRowDto, len(rows))
+	for i, row := range rows {
+		rowsDto[i] = &task.TaskTimeReportRowDto{
+			UserId:         row.UserIdentity.Public,
+			UserName:       row.UserName,
+			ProjectId:      row.ProjectIdentity.Public,
+			ProjectName:    row.ProjectName,
+			Date:           row.Date,
+			TrackedSeconds: row.TrackedSeconds,
+		}
+		trackedSeconds += row.TrackedSeconds
+	}
+
+	return &task.TaskTimeReportDto{
+		From:           input.From.ToRFC3339(),
+		To:             input.To.ToRFC3339(),
+		Rows:           rowsDto,
+		TrackedSeconds: trackedSeconds,
+	}, nil
+}
@@ -0,0 +1,66 @@
+package taskservice
+
+import (
+	"github.com/gabrielmrtt/taski/internal/core"
+	"github.com/gabrielmrtt/taski/internal/task"
+	taskrepo "github.com/gabrielmrtt/taski/internal/task/repository"
+)
+
+type GetTaskTimeSummaryService struct {
+	TaskTimeEntryRepository taskrepo.TaskTimeEntryRepository
+	TaskRepository          taskrepo.TaskRepository
+}
+
+func NewGetTaskTimeSummaryService(
+	taskTimeEntryRepository taskrepo.TaskTimeEntryRepository,
+	taskRepository taskrepo.TaskRepository,
+) *GetTaskTimeSummaryService {
+	return &GetTaskTimeSummaryService{
+		TaskTimeEntryRepository: taskTimeEntryRepository,
+		TaskRepository:          taskRepository,
+	}
+}
+
+type GetTaskTimeSummaryInput struct {
+	OrganizationIdentity *core.Identity
+	TaskIdentity         core.Identity
+}
+
+func (i GetTaskTimeSummaryInput) Validate() error { return nil }
+
+func (s *GetTaskTimeSummaryService) Execute(input GetTaskTimeSummaryInput) (*task.TaskTimeSummaryDto, error) {
+	if err := input.Validate(); err != nil {
+		return nil, err
+	}
+
+	tsk, err := s.TaskRepository.GetTaskByIdentity(taskrepo.GetTaskByIdentityParams{
+		TaskIdentity:         input.TaskIdentity,
+		OrganizationIdentity: input.OrganizationIdentity,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if tsk == nil {
+		return nil, core.NewNotFoundError("task not found")
+	}
+
+	totals, err := s.TaskTimeEntryRepository.GetTaskTimeTotals(taskrepo.GetTaskTimeTotalsParams{
+		TaskIdentity: tsk.Identity,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var estimatedMinutes int16 = 0
+	if tsk.EstimatedMinutes != nil {
+		estimatedMinutes = *tsk.EstimatedMinutes
+	}
+
+	return &task.TaskTimeSummaryDto{
+		TaskId:           tsk.Identity.Public,
+		EstimatedMinutes: estimatedMinutes,
+		TrackedSeconds:   totals.TrackedSeconds,
+		RolledUpSeconds:  totals.RolledUpSeconds,
+	}, nil
+}
@@ -0,0 +1,72 @@
+package taskservice
+
+import (
+	"github.com/gabrielmrtt/taski/internal/core"
+	projectrepo "github.com/gabrielmrtt/taski/internal/project/repository"
+	"github.com/gabrielmrtt/taski/internal/task"
+	taskrepo "github.com/gabrielmrtt/taski/internal/task/repository"
+)
+
+type GetTaskViewService struct {
+	TaskViewRepository    taskrepo.TaskViewRepository
+	ProjectUserRepository projectrepo.ProjectUserRepository
+}
+
+func NewGetTaskViewService(
+	taskViewRepository taskrepo.TaskViewRepository,
+	projectUserRepository projectrepo.ProjectUserRepository,
+) *GetTaskViewService {
+	return &GetTaskViewService{
+		TaskViewRepository:    taskViewRepository,
+		ProjectUserRepository: projectUserRepository,
+	}
+}
+
+type GetTaskViewInput struct {
+	OrganizationIdentity *core.Identity
+	TaskViewIdentity     core.Identity
+	UserIdentity         core.Identity
+}
+
+func (i GetTaskViewInput) Validate() error { return nil }
+
+func (s *GetTaskViewService) Execute(input GetTaskViewInput) (*task.TaskViewDto, error) {
+	if err := input.Validate(); err != nil {
+		return nil, err
+	}
+
+	taskView, err := s.TaskViewRepository.GetTaskViewByIdentity(taskrepo.GetTaskViewByIdentityParams{
+		TaskViewIdentity:     input.TaskViewIdentity,
+		OrganizationIdentity: input.OrganizationIdentity,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if taskView == nil {
+		return nil, core.NewNotFoundError("task view not found")
+	}
+
+	if taskView.IsOwnedBy(input.UserIdentity) {
+		return task.TaskViewToDto(taskView), nil
+	}
+
+	// Views that are not shared are reported as missing to anyone but their owner.
+	if !taskView.IsShared() {
+		return nil, core.NewNotFoundError("task view not found")
+	}
+
+	projectUser, err := s.ProjectUserRepository.GetProjectUserByIdentity(projectrepo.GetProjectUserByIdentityParams{
+		ProjectIdentity: *taskView.ProjectIdentity,
+		UserIdentity:    input.UserIdentity,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if projectUser == nil || !projectUser.IsActive() {
+		return nil, core.NewNotFoundError("task view not found")
+	}
+
+	return task.TaskViewToDto(taskView), nil
+}
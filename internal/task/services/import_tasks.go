@@ -0,0 +1,808 @@
+package taskservice
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gabrielmrtt/taski/internal/core"
+	"github.com/gabrielmrtt/taski/internal/project"
+	projectrepo "github.com/gabrielmrtt/taski/internal/project/repository"
+	"github.com/gabrielmrtt/taski/internal/task"
+	taskrepo "github.com/gabrielmrtt/taski/internal/task/repository"
+	"github.com/gabrielmrtt/taski/pkg/datetimeutils"
+)
+
+const maxImportTasks = 1000
+
+const taskImportColor = "#9E9E9E"
+
+const taskImportRowSavepoint = "task_import_row"
+
+type ImportTasksService struct {
+	TaskRepository                taskrepo.TaskRepository
+	ProjectRepository             projectrepo.ProjectRepository
+	ProjectTaskStatusRepository   projectrepo.ProjectTaskStatusRepository
+	ProjectTaskCategoryRepository projectrepo.ProjectTaskCategoryRepository
+	CreateTaskService             *CreateTaskService
+	TransactionRepository         core.TransactionRepository
+}
+
+func NewImportTasksService(
+	taskRepository taskrepo.TaskRepository,
+	projectRepository projectrepo.ProjectRepository,
+	projectTaskStatusRepository projectrepo.ProjectTaskStatusRepository,
+	projectTaskCategoryRepository projectrepo.ProjectTaskCategoryRepository,
+	createTaskService *CreateTaskService,
+	transactionRepository core.TransactionRepository,
+) *ImportTasksService {
+	return &ImportTasksService{
+		TaskRepository:                taskRepository,
+		ProjectRepository:             projectRepository,
+		ProjectTaskStatusRepository:   projectTaskStatusRepository,
+		ProjectTaskCategoryRepository: projectTaskCategoryRepository,
+		CreateTaskService:             createTaskService,
+		TransactionRepository:         transactionRepository,
+	}
+}
+
+type ImportTasksInput struct {
+	OrganizationIdentity core.Identity
+	ProjectIdentity      core.Identity
+	UserCreatorIdentity  core.Identity
+	Format               task.TaskImportFormats
+	Content              []byte
+	ColumnMapping        map[string]string
+	DryRun               bool
+}
+
+func (i ImportTasksInput) Validate() error {
+	var fields []core.InvalidInputErrorField
+
+	if !slices.Contains(task.TaskImportFormatsArray, i.Format) {
+		fields = append(fields, core.InvalidInputErrorField{
+			Field: "format",
+			Error: "format must be csv or json",
+		})
+	}
+
+	if len(bytes.TrimSpace(i.Content)) == 0 {
+		fields = append(fields, core.InvalidInputErrorField{
+			Field: "content",
+			Error: "content cannot be empty",
+		})
+	}
+
+	if len(i.ColumnMapping) > 0 && i.Format != task.TaskImportFormatCsv {
+		fields = append(fields, core.InvalidInputErrorField{
+			Field: "column mapping",
+			Error: "column mapping is only allowed for csv imports",
+		})
+	}
+
+	for field, column := range i.ColumnMapping {
+		if !slices.Contains(task.TaskImportFields, field) {
+			fields = append(fields, core.InvalidInputErrorField{
+				Field: "column mapping",
+				Error: "unknown field " + field + ", valid fields are: " + strings.Join(task.TaskImportFields, ", "),
+			})
+		}
+
+		if strings.TrimSpace(column) == "" {
+			fields = append(fields, core.InvalidInputErrorField{
+				Field: "column mapping",
+				Error: "field " + field + " must be mapped to a column",
+			})
+		}
+	}
+
+	if len(fields) > 0 {
+		return core.NewInvalidInputError("invalid input", fields)
+	}
+
+	return nil
+}
+
+/*
+taskImportRow is a task read from the import content. Values that could not be parsed are kept as row errors so that
+they are reported with the row instead of failing the whole import.
+*/
+type taskImportRow struct {
+	Row              int
+	ExternalId       *string
+	ParentExternalId *string
+	Name             string
+	Description      string
+	Status           *string
+	Category         *string
+	PriorityLevel    task.TaskPriorityLevels
+	EstimatedMinutes *int16
+	DueDate          *core.DateTime
+	SubTasks         []string
+	Errors           []string
+}
+
+type taskImportJsonTask struct {
+	ExternalId       *string  `json:"externalId"`
+	ParentExternalId *string  `json:"parentExternalId"`
+	Name             string   `json:"name"`
+	Description      string   `json:"description"`
+	Status           *string  `json:"status"`
+	Category         *string  `json:"category"`
+	Priority         any      `json:"priority"`
+	EstimatedMinutes any      `json:"estimatedMinutes"`
+	DueDate          *string  `json:"dueDate"`
+	SubTasks         []string `json:"subTasks"`
+}
+
+type taskImportJsonDocument struct {
+	Tasks []taskImportJsonTask `json:"tasks"`
+}
+
+func invalidTaskImportContentError(message string) error {
+	return core.NewInvalidInputError("invalid import content", []core.InvalidInputErrorField{
+		{
+			Field: "content",
+			Error: message,
+		},
+	})
+}
+
+func optionalTaskImportValue(value string) *string {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return nil
+	}
+
+	return &value
+}
+
+func newTaskImportRow(row int, values map[string]string, subTasks []string) *taskImportRow {
+	var importRow *taskImportRow = &taskImportRow{
+		Row:              row,
+		ExternalId:       optionalTaskImportValue(values["externalId"]),
+		ParentExternalId: optionalTaskImportValue(values["parentExternalId"]),
+		Name:             strings.TrimSpace(values["name"]),
+		Description:      strings.TrimSpace(values["description"]),
+		Status:           optionalTaskImportValue(values["status"]),
+		Category:         optionalTaskImportValue(values["category"]),
+		PriorityLevel:    task.TaskPriorityLevelNone,
+		SubTasks:         make([]string, 0),
+		Errors:           make([]string, 0),
+	}
+
+	for _, subTask := range subTasks {
+		if subTask = strings.TrimSpace(subTask); subTask != "" {
+			importRow.SubTasks = append(importRow.SubTasks, subTask)
+		}
+	}
+
+	if priority := optionalTaskImportValue(values["priority"]); priority != nil {
+		if priorityLevel, ok := task.TaskPriorityLevelNames[strings.ToLower(*priority)]; ok {
+			importRow.PriorityLevel = priorityLevel
+		} else if priorityLevel, err := strconv.ParseInt(*priority, 10, 8); err == nil && priorityLevel >= int64(task.TaskPriorityLevelNone) && priorityLevel <= int64(task.TaskPriorityLevelUrgent) {
+			importRow.PriorityLevel = task.TaskPriorityLevels(priorityLevel)
+		} else {
+			importRow.Errors = append(importRow.Errors, "priority must be a level between 0 and 5 or one of none, low, medium, high, critical, urgent")
+		}
+	}
+
+	if estimatedMinutes := optionalTaskImportValue(values["estimatedMinutes"]); estimatedMinutes != nil {
+		minutes, err := strconv.ParseInt(*estimatedMinutes, 10, 16)
+		if err != nil {
+			importRow.Errors = append(importRow.Errors, "estimated minutes must be a whole number of minutes")
+		} else {
+			value := int16(minutes)
+			importRow.EstimatedMinutes = &value
+		}
+	}
+
+	if dueDate := optionalTaskImportValue(values["dueDate"]); dueDate != nil {
+		if parsedTime, err := time.Parse(time.DateOnly, *dueDate); err == nil {
+			importRow.DueDate = &core.DateTime{Value: datetimeutils.TimeToEpoch(parsedTime)}
+		} else if datetimeutils.IsValidRFC3339(*dueDate) {
+			importRow.DueDate = &core.DateTime{Value: datetimeutils.RFC3339ToEpoch(*dueDate)}
+		} else {
+			importRow.Errors = append(importRow.Errors, "due date must be written as 2006-01-02 or as an RFC 3339 date")
+		}
+	}
+
+	return importRow
+}
+
+/*
+parseTaskImportCsv reads the tasks of a CSV document. The first line holds the column names, which are matched to the
+import fields by the column mapping or, for unmapped fields, by the field name itself. Sub-tasks are separated by
+semicolons.
+*/
+func parseTaskImportCsv(content []byte, columnMapping map[string]string) ([]*taskImportRow, error) {
+	var reader *csv.Reader = csv.NewReader(bytes.NewReader(content))
+	reader.FieldsPerRecord = -1
+	reader.TrimLeadingSpace = true
+
+	header, err := reader.Read()
+	if err != nil {
+		return nil, invalidTaskImportContentError("the csv header could not be read: " + err.Error())
+	}
+
+	var columns map[string]int = make(map[string]int)
+	for _, field := range task.TaskImportFields {
+		column, mapped := columnMapping[field]
+		if !mapped {
+			column = field
+		}
+
+		index := slices.IndexFunc(header, func(name string) bool {
+			return strings.EqualFold(strings.TrimSpace(name), strings.TrimSpace(column))
+		})
+
+		if index == -1 {
+			if mapped {
+				return nil, core.NewInvalidInputError("invalid input", []core.InvalidInputErrorField{
+					{
+						Field: "column mapping",
+						Error: "column " + column + " mapped to field " + field + " does not exist",
+					},
+				})
+			}
+
+			continue
+		}
+
+		columns[field] = index
+	}
+
+	if _, ok := columns["name"]; !ok {
+		return nil, core.NewInvalidInputError("invalid input", []core.InvalidInputErrorField{
+			{
+				Field: "column mapping",
+				Error: "a column must be mapped to the name field",
+			},
+		})
+	}
+
+	var rows []*taskImportRow = make([]*taskImportRow, 0)
+	for {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+
+		if err != nil {
+			return nil, invalidTaskImportContentError(err.Error())
+		}
+
+		var values map[string]string = make(map[string]string, len(columns))
+		for field, index := range columns {
+			if index < len(record) {
+				values[field] = record[index]
+			}
+		}
+
+		line, _ := reader.FieldPos(0)
+		rows = append(rows, newTaskImportRow(line, values, strings.Split(values["subTasks"], ";")))
+	}
+
+	return rows, nil
+}
+
+/*
+parseTaskImportJson reads the tasks of a JSON document, either an object with a tasks list or the list itself.
+*/
+func parseTaskImportJson(content []byte) ([]*taskImportRow, error) {
+	var document taskImportJsonDocument
+
+	if bytes.HasPrefix(bytes.TrimSpace(content), []byte("[")) {
+		if err := json.Unmarshal(content, &document.Tasks); err != nil {
+			return nil, invalidTaskImportContentError(err.Error())
+		}
+	} else if err := json.Unmarshal(content, &document); err != nil {
+		return nil, invalidTaskImportContentError(err.Error())
+	}
+
+	var rows []*taskImportRow = make([]*taskImportRow, len(document.Tasks))
+	for i, jsonTask := range document.Tasks {
+		var values map[string]string = map[string]string{
+			"name":        jsonTask.Name,
+			"description": jsonTask.Description,
+		}
+
+		for field, value := range map[string]*string{
+			"externalId":       jsonTask.ExternalId,
+			"parentExternalId": jsonTask.ParentExternalId,
+			"status":           jsonTask.Status,
+			"category":         jsonTask.Category,
+			"dueDate":          jsonTask.DueDate,
+		} {
+			if value != nil {
+				values[field] = *value
+			}
+		}
+
+		if jsonTask.Priority != nil {
+			values["priority"] = fmt.Sprint(jsonTask.Priority)
+		}
+
+		if jsonTask.EstimatedMinutes != nil {
+			values["estimatedMinutes"] = fmt.Sprint(jsonTask.EstimatedMinutes)
+		}
+
+		rows[i] = newTaskImportRow(i+1, values, jsonTask.SubTasks)
+	}
+
+	return rows, nil
+}
+
+/*
+orderTaskImportRows places the rows after the rows of their parent tasks, so that parents are created first.
+Rows repeating an external id already used by a previous row are rejected.
+*/
+func orderTaskImportRows(rows []*taskImportRow) []*taskImportRow {
+	var rowsByExternalId map[string]*taskImportRow = make(map[string]*taskImportRow)
+	for _, row := range rows {
+		if row.ExternalId == nil {
+			continue
+		}
+
+		if _, ok := rowsByExternalId[*row.ExternalId]; ok {
+			row.Errors = append(row.Errors, "external id "+*row.ExternalId+" is used by a previous row")
+			continue
+		}
+
+		rowsByExternalId[*row.ExternalId] = row
+	}
+
+	var orderedRows []*taskImportRow = make([]*taskImportRow, 0, len(rows))
+	var visited map[*taskImportRow]bool = make(map[*taskImportRow]bool, len(rows))
+
+	var visit func(row *taskImportRow)
+	visit = func(row *taskImportRow) {
+		if visited[row] {
+			return
+		}
+
+		visited[row] = true
+
+		if row.ParentExternalId != nil {
+			if parentRow, ok := rowsByExternalId[*row.ParentExternalId]; ok {
+				visit(parentRow)
+			}
+		}
+
+		orderedRows = append(orderedRows, row)
+	}
+
+	for _, row := range rows {
+		visit(row)
+	}
+
+	return orderedRows
+}
+
+type taskImportState struct {
+	DefaultStatus  *project.ProjectTaskStatus
+	Statuses       map[string]*project.ProjectTaskStatus
+	Categories     map[string]*project.ProjectTaskCategory
+	TaskIdentities map[string]core.Identity
+	Report         *task.TaskImportReportDto
+}
+
+/*
+resolveStatus returns the project status with the given name, creating it when the project does not have one yet.
+Rows without a status use the default status of the project.
+*/
+func (s *ImportTasksService) resolveStatus(input ImportTasksInput, state *taskImportState, name *string) (*project.ProjectTaskStatus, error) {
+	if name == nil {
+		if state.DefaultStatus == nil {
+			return nil, core.NewNotFoundError("the task has no status and the project has no default task status")
+		}
+
+		return state.DefaultStatus, nil
+	}
+
+	if status, ok := state.Statuses[*name]; ok {
+		return status, nil
+	}
+
+	statuses, err := s.ProjectTaskStatusRepository.ListProjectTaskStatusesBy(projectrepo.ListProjectTaskStatusesByParams{
+		Filters: projectrepo.ProjectTaskStatusFilters{
+			ProjectIdentity: &input.ProjectIdentity,
+			Name:            &core.ComparableFilter[string]{Equals: name},
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if len(statuses) > 0 {
+		state.Statuses[*name] = &statuses[0]
+		return &statuses[0], nil
+	}
+
+	lastOrder, err := s.ProjectTaskStatusRepository.GetLastTaskStatusOrder(projectrepo.GetLastTaskStatusOrderParams{
+		ProjectIdentity: &input.ProjectIdentity,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	lastOrder++
+
+	status, err := project.NewProjectTaskStatus(project.NewProjectTaskStatusInput{
+		ProjectIdentity: input.ProjectIdentity,
+		Name:            *name,
+		Color:           taskImportColor,
+		Order:           &lastOrder,
+		WipLimitPolicy:  project.ProjectTaskStatusWipLimitPolicyReject,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	status, err = s.ProjectTaskStatusRepository.StoreProjectTaskStatus(projectrepo.StoreProjectTaskStatusParams{ProjectTaskStatus: status})
+	if err != nil {
+		return nil, err
+	}
+
+	state.Statuses[*name] = status
+	state.Report.CreatedStatuses = append(state.Report.CreatedStatuses, *name)
+
+	return status, nil
+}
+
+/*
+resolveCategory returns the project category with the given name, creating it when the project does not have one
+yet.
+*/
+func (s *ImportTasksService) resolveCategory(input ImportTasksInput, state *taskImportState, name *string) (*project.ProjectTaskCategory, error) {
+	if name == nil {
+		return nil, nil
+	}
+
+	if category, ok := state.Categories[*name]; ok {
+		return category, nil
+	}
+
+	perPage := 1
+	categories, err := s.ProjectTaskCategoryRepository.PaginateProjectTaskCategoryBy(projectrepo.PaginateProjectTaskCategoryParams{
+		Filters: projectrepo.ProjectTaskCategoryFilters{
+			ProjectIdentity: &input.ProjectIdentity,
+			Name:            &core.ComparableFilter[string]{Equals: name},
+		},
+		Pagination: core.PaginationInput{
+			PerPage: &perPage,
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if len(categories.Data) > 0 {
+		state.Categories[*name] = &categories.Data[0]
+		return &categories.Data[0], nil
+	}
+
+	category, err := project.NewProjectTaskCategory(project.NewProjectTaskCategoryInput{
+		ProjectIdentity: input.ProjectIdentity,
+		Name:            *name,
+		Color:           taskImportColor,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	category, err = s.ProjectTaskCategoryRepository.StoreProjectTaskCategory(projectrepo.StoreProjectTaskCategoryParams{ProjectTaskCategory: category})
+	if err != nil {
+		return nil, err
+	}
+
+	state.Categories[*name] = category
+	state.Report.CreatedCategories = append(state.Report.CreatedCategories, *name)
+
+	return category, nil
+}
+
+/*
+importRow creates the task of a row. Rows whose external id was already imported are skipped. Errors concerning only
+the row are reported in the result, any other error is returned and aborts the import.
+*/
+func (s *ImportTasksService) importRow(input ImportTasksInput, state *taskImportState, row *taskImportRow) (task.TaskImportRowResultDto, error) {
+	var result task.TaskImportRowResultDto = task.TaskImportRowResultDto{
+		Row:        row.Row,
+		ExternalId: row.ExternalId,
+		Action:     string(task.TaskImportRowActionFailed),
+		Errors:     row.Errors,
+	}
+
+	if len(row.Errors) > 0 {
+		return result, nil
+	}
+
+	if row.ExternalId != nil {
+		existingTask, err := s.TaskRepository.GetTaskByExternalId(taskrepo.GetTaskByExternalIdParams{
+			ProjectIdentity: input.ProjectIdentity,
+			ExternalId:      *row.ExternalId,
+		})
+		if err != nil {
+			return result, err
+		}
+
+		if existingTask != nil {
+			state.TaskIdentities[*row.ExternalId] = existingTask.Identity
+			result.Action = string(task.TaskImportRowActionSkipped)
+			result.TaskId = &existingTask.Identity.Public
+			return result, nil
+		}
+	}
+
+	var parentTaskIdentity *core.Identity = nil
+	if row.ParentExternalId != nil {
+		identity, ok := state.TaskIdentities[*row.ParentExternalId]
+		if !ok {
+			parentTask, err := s.TaskRepository.GetTaskByExternalId(taskrepo.GetTaskByExternalIdParams{
+				ProjectIdentity: input.ProjectIdentity,
+				ExternalId:      *row.ParentExternalId,
+			})
+			if err != nil {
+				return result, err
+			}
+
+			if parentTask == nil {
+				result.Errors = append(result.Errors, "parent task with external id "+*row.ParentExternalId+" not found")
+				return result, nil
+			}
+
+			identity = parentTask.Identity
+		}
+
+		parentTaskIdentity = &identity
+	}
+
+	status, err := s.resolveStatus(input, state, row.Status)
+	if err != nil {
+		if !isTaskBulkItemError(err) {
+			return result, err
+		}
+
+		result.Errors = append(result.Errors, taskBulkItemErrorMessage(err))
+		return result, nil
+	}
+
+	category, err := s.resolveCategory(input, state, row.Category)
+	if err != nil {
+		if !isTaskBulkItemError(err) {
+			return result, err
+		}
+
+		result.Errors = append(result.Errors, taskBulkItemErrorMessage(err))
+		return result, nil
+	}
+
+	var categoryIdentity *core.Identity = nil
+	if category != nil {
+		categoryIdentity = &category.Identity
+	}
+
+	var subTasks []*CreateSubTaskInput = make([]*CreateSubTaskInput, len(row.SubTasks))
+	for i, subTask := range row.SubTasks {
+		subTasks[i] = &CreateSubTaskInput{Name: subTask}
+	}
+
+	var createTaskInput CreateTaskInput = CreateTaskInput{
+		OrganizationIdentity: &input.OrganizationIdentity,
+		ProjectIdentity:      input.ProjectIdentity,
+		StatusIdentity:       status.Identity,
+		CategoryIdentity:     categoryIdentity,
+		ParentTaskIdentity:   parentTaskIdentity,
+		ExternalId:           row.ExternalId,
+		Name:                 row.Name,
+		Description:          row.Description,
+		EstimatedMinutes:     row.EstimatedMinutes,
+		PriorityLevel:        row.PriorityLevel,
+		DueDate:              row.DueDate,
+		SubTasks:             subTasks,
+		UserCreatorIdentity:  input.UserCreatorIdentity,
+	}
+
+	err = createTaskInput.validate(true)
+	if err != nil {
+		result.Errors = append(result.Errors, taskBulkItemErrorMessage(err))
+		return result, nil
+	}
+
+	taskDto, err := s.CreateTaskService.create(createTaskInput)
+	if err != nil {
+		if !isTaskBulkItemError(err) {
+			return result, err
+		}
+
+		result.Errors = append(result.Errors, taskBulkItemErrorMessage(err))
+		return result, nil
+	}
+
+	if row.ExternalId != nil {
+		state.TaskIdentities[*row.ExternalId] = core.NewIdentityFromPublic(taskDto.Id)
+	}
+
+	result.Action = string(task.TaskImportRowActionCreated)
+	result.Warnings = taskDto.Warnings
+
+	if !input.DryRun {
+		result.TaskId = &taskDto.Id
+	}
+
+	return result, nil
+}
+
+/*
+importRowInSavepoint imports a row inside a savepoint of the import transaction. A failed row is rolled back to the
+savepoint, so the statuses, categories and partial writes of the row are not committed with the other rows.
+*/
+func (s *ImportTasksService) importRowInSavepoint(tx core.Transaction, input ImportTasksInput, state *taskImportState, row *taskImportRow) (task.TaskImportRowResultDto, error) {
+	var createdStatuses int = len(state.Report.CreatedStatuses)
+	var createdCategories int = len(state.Report.CreatedCategories)
+
+	err := tx.Savepoint(taskImportRowSavepoint)
+	if err != nil {
+		return task.TaskImportRowResultDto{}, err
+	}
+
+	result, err := s.importRow(input, state, row)
+	if err != nil {
+		return result, err
+	}
+
+	if task.TaskImportRowActions(result.Action) != task.TaskImportRowActionFailed {
+		err = tx.ReleaseSavepoint(taskImportRowSavepoint)
+		return result, err
+	}
+
+	err = tx.RollbackToSavepoint(taskImportRowSavepoint)
+	if err != nil {
+		return result, err
+	}
+
+	for _, name := range state.Report.CreatedStatuses[createdStatuses:] {
+		delete(state.Statuses, name)
+	}
+
+	for _, name := range state.Report.CreatedCategories[createdCategories:] {
+		delete(state.Categories, name)
+	}
+
+	state.Report.CreatedStatuses = state.Report.CreatedStatuses[:createdStatuses]
+	state.Report.CreatedCategories = state.Report.CreatedCategories[:createdCategories]
+
+	return result, nil
+}
+
+/*
+setTransaction shares the import transaction with the repositories of the create task service, whose tasks are created
+inside it instead of in transactions of their own. This lets a dry run roll back every task, status and category at
+once.
+*/
+func (s *ImportTasksService) setTransaction(tx core.Transaction) {
+	s.TaskRepository.SetTransaction(tx)
+	s.ProjectRepository.SetTransaction(tx)
+	s.ProjectTaskStatusRepository.SetTransaction(tx)
+	s.ProjectTaskCategoryRepository.SetTransaction(tx)
+	s.CreateTaskService.setTransaction(tx)
+}
+
+func (s *ImportTasksService) Execute(input ImportTasksInput) (*task.TaskImportReportDto, error) {
+	if err := input.Validate(); err != nil {
+		return nil, err
+	}
+
+	var content []byte = bytes.TrimPrefix(input.Content, []byte("\xef\xbb\xbf"))
+	var rows []*taskImportRow
+	var err error
+
+	if input.Format == task.TaskImportFormatCsv {
+		rows, err = parseTaskImportCsv(content, input.ColumnMapping)
+	} else {
+		rows, err = parseTaskImportJson(content)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if len(rows) == 0 {
+		return nil, invalidTaskImportContentError("content has no tasks to import")
+	}
+
+	if len(rows) > maxImportTasks {
+		return nil, invalidTaskImportContentError("at most " + strconv.Itoa(maxImportTasks) + " tasks can be imported at once")
+	}
+
+	tx, err := s.TransactionRepository.BeginTransaction()
+	if err != nil {
+		return nil, err
+	}
+
+	s.setTransaction(tx)
+
+	prj, err := s.ProjectRepository.GetProjectByIdentity(projectrepo.GetProjectByIdentityParams{
+		ProjectIdentity:      input.ProjectIdentity,
+		OrganizationIdentity: &input.OrganizationIdentity,
+	})
+	if err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+
+	if prj == nil {
+		tx.Rollback()
+		return nil, core.NewNotFoundError("project not found")
+	}
+
+	isDefault := true
+	defaultStatus, err := s.ProjectTaskStatusRepository.GetProjectTaskStatusByIdentity(projectrepo.GetProjectTaskStatusByIdentityParams{
+		ProjectIdentity: &prj.Identity,
+		IsDefault:       &isDefault,
+	})
+	if err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+
+	var state *taskImportState = &taskImportState{
+		DefaultStatus:  defaultStatus,
+		Statuses:       make(map[string]*project.ProjectTaskStatus),
+		Categories:     make(map[string]*project.ProjectTaskCategory),
+		TaskIdentities: make(map[string]core.Identity),
+		Report: &task.TaskImportReportDto{
+			DryRun:            input.DryRun,
+			Total:             len(rows),
+			CreatedStatuses:   make([]string, 0),
+			CreatedCategories: make([]string, 0),
+			Results:           make([]task.TaskImportRowResultDto, 0, len(rows)),
+		},
+	}
+
+	for _, row := range orderTaskImportRows(rows) {
+		result, err := s.importRowInSavepoint(tx, input, state, row)
+		if err != nil {
+			tx.Rollback()
+			return nil, err
+		}
+
+		switch task.TaskImportRowActions(result.Action) {
+		case task.TaskImportRowActionCreated:
+			state.Report.Created++
+		case task.TaskImportRowActionSkipped:
+			state.Report.Skipped++
+		default:
+			state.Report.Failed++
+		}
+
+		state.Report.Results = append(state.Report.Results, result)
+	}
+
+	slices.SortFunc(state.Report.Results, func(a, b task.TaskImportRowResultDto) int {
+		return a.Row - b.Row
+	})
+
+	if input.DryRun {
+		err = tx.Rollback()
+		if err != nil {
+			return nil, err
+		}
+
+		return state.Report, nil
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+
+	return state.Report, nil
+}
@@ -0,0 +1,59 @@
+package taskservice
+
+import (
+	"github.com/gabrielmrtt/taski/internal/core"
+	"github.com/gabrielmrtt/taski/internal/task"
+	taskrepo "github.com/gabrielmrtt/taski/internal/task/repository"
+)
+
+type ListMyTaskMentionsService struct {
+	TaskMentionRepository taskrepo.TaskMentionRepository
+}
+
+func NewListMyTaskMentionsService(taskMentionRepository taskrepo.TaskMentionRepository) *ListMyTaskMentionsService {
+	return &ListMyTaskMentionsService{
+		TaskMentionRepository: taskMentionRepository,
+	}
+}
+
+type ListMyTaskMentionsInput struct {
+	OrganizationIdentity core.Identity
+	ProjectIdentity      *core.Identity
+	UserIdentity         core.Identity
+	Pagination           core.PaginationInput
+}
+
+func (i ListMyTaskMentionsInput) Validate() error { return nil }
+
+/*
+Execute lists where the user was mentioned, in task descriptions and comments of the organization, newest first.
+*/
+func (s *ListMyTaskMentionsService) Execute(input ListMyTaskMentionsInput) (*core.PaginationOutput[task.TaskMentionDto], error) {
+	if err := input.Validate(); err != nil {
+		return nil, err
+	}
+
+	taskMentions, err := s.TaskMentionRepository.PaginateTaskMentionsBy(taskrepo.PaginateTaskMentionsParams{
+		Filters: taskrepo.TaskMentionFilters{
+			UserIdentity:         input.UserIdentity,
+			OrganizationIdentity: input.OrganizationIdentity,
+			ProjectIdentity:      input.ProjectIdentity,
+		},
+		Pagination: input.Pagination,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var taskMentionsDto []task.TaskMentionDto = make([]task.TaskMentionDto, 0)
+	for _, taskMention := range taskMentions.Data {
+		taskMentionsDto = append(taskMentionsDto, *task.TaskMentionToDto(&taskMention))
+	}
+
+	return &core.PaginationOutput[task.TaskMentionDto]{
+		Data:    taskMentionsDto,
+		Page:    taskMentions.Page,
+		HasMore: taskMentions.HasMore,
+		Total:   taskMentions.Total,
+	}, nil
+}
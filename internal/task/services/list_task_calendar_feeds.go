@@ -0,0 +1,51 @@
+package taskservice
+
+import (
+	"github.com/gabrielmrtt/taski/internal/core"
+	"github.com/gabrielmrtt/taski/internal/task"
+	taskrepo "github.com/gabrielmrtt/taski/internal/task/repository"
+)
+
+type ListTaskCalendarFeedsService struct {
+	TaskCalendarFeedRepository taskrepo.TaskCalendarFeedRepository
+}
+
+func NewListTaskCalendarFeedsService(
+	taskCalendarFeedRepository taskrepo.TaskCalendarFeedRepository,
+) *ListTaskCalendarFeedsService {
+	return &ListTaskCalendarFeedsService{
+		TaskCalendarFeedRepository: taskCalendarFeedRepository,
+	}
+}
+
+type ListTaskCalendarFeedsInput struct {
+	OrganizationIdentity *core.Identity
+	ProjectIdentity      *core.Identity
+	UserOwnerIdentity    core.Identity
+}
+
+func (i ListTaskCalendarFeedsInput) Validate() error { return nil }
+
+func (s *ListTaskCalendarFeedsService) Execute(input ListTaskCalendarFeedsInput) ([]task.TaskCalendarFeedDto, error) {
+	if err := input.Validate(); err != nil {
+		return nil, err
+	}
+
+	taskCalendarFeeds, err := s.TaskCalendarFeedRepository.ListTaskCalendarFeedsBy(taskrepo.ListTaskCalendarFeedsByParams{
+		Filters: taskrepo.TaskCalendarFeedFilters{
+			OrganizationIdentity: input.OrganizationIdentity,
+			ProjectIdentity:      input.ProjectIdentity,
+			UserOwnerIdentity:    &input.UserOwnerIdentity,
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var taskCalendarFeedDtos []task.TaskCalendarFeedDto = make([]task.TaskCalendarFeedDto, 0)
+	for _, taskCalendarFeed := range taskCalendarFeeds {
+		taskCalendarFeedDtos = append(taskCalendarFeedDtos, *task.TaskCalendarFeedToDto(&taskCalendarFeed))
+	}
+
+	return taskCalendarFeedDtos, nil
+}
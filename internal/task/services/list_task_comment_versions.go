@@ -0,0 +1,77 @@
+package taskservice
+
+import (
+	"github.com/gabrielmrtt/taski/internal/core"
+	"github.com/gabrielmrtt/taski/internal/task"
+	taskrepo "github.com/gabrielmrtt/taski/internal/task/repository"
+)
+
+type ListTaskCommentVersionsService struct {
+	TaskCommentRepository taskrepo.TaskCommentRepository
+	TaskRepository        taskrepo.TaskRepository
+}
+
+func NewListTaskCommentVersionsService(
+	taskCommentRepository taskrepo.TaskCommentRepository,
+	taskRepository taskrepo.TaskRepository,
+) *ListTaskCommentVersionsService {
+	return &ListTaskCommentVersionsService{
+		TaskCommentRepository: taskCommentRepository,
+		TaskRepository:        taskRepository,
+	}
+}
+
+type ListTaskCommentVersionsInput struct {
+	OrganizationIdentity *core.Identity
+	TaskIdentity         core.Identity
+	TaskCommentIdentity  core.Identity
+}
+
+func (i ListTaskCommentVersionsInput) Validate() error { return nil }
+
+/*
+Execute lists every version of the comment content, oldest first. Versions of deleted comments are listed too.
+*/
+func (s *ListTaskCommentVersionsService) Execute(input ListTaskCommentVersionsInput) ([]task.TaskCommentVersionDto, error) {
+	if err := input.Validate(); err != nil {
+		return nil, err
+	}
+
+	tsk, err := s.TaskRepository.GetTaskByIdentity(taskrepo.GetTaskByIdentityParams{
+		TaskIdentity:         input.TaskIdentity,
+		OrganizationIdentity: input.OrganizationIdentity,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if tsk == nil {
+		return nil, core.NewNotFoundError("task not found")
+	}
+
+	comment, err := s.TaskCommentRepository.GetTaskCommentByIdentity(taskrepo.GetTaskCommentByIdentityParams{
+		TaskCommentIdentity: input.TaskCommentIdentity,
+		TaskIdentity:        &tsk.Identity,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if comment == nil {
+		return nil, core.NewNotFoundError("task comment not found")
+	}
+
+	versions, err := s.TaskCommentRepository.ListTaskCommentVersionsBy(taskrepo.ListTaskCommentVersionsByParams{
+		TaskCommentIdentity: comment.Identity,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var versionsDto []task.TaskCommentVersionDto = make([]task.TaskCommentVersionDto, len(versions))
+	for i, version := range versions {
+		versionsDto[i] = *task.TaskCommentVersionToDto(&version)
+	}
+
+	return versionsDto, nil
+}
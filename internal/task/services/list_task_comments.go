@@ -9,7 +9,6 @@ import (
 type ListTaskCommentsService struct {
 	TaskCommentRepository taskrepo.TaskCommentRepository
 	TaskRepository        taskrepo.TaskRepository
-	TransactionRepository core.TransactionRepository
 }
 
 func NewListTaskCommentsService(
@@ -22,12 +21,18 @@ func NewListTaskCommentsService(
 	}
 }
 
+/*
+ListTaskCommentsInput lists the comments of a task. When Threaded is set, only comment threads are paginated and each
+one is returned with its replies.
+*/
 type ListTaskCommentsInput struct {
-	TaskIdentity   core.Identity
-	Filters        taskrepo.TaskCommentFilters
-	Pagination     core.PaginationInput
-	SortInput      core.SortInput
-	RelationsInput core.RelationsInput
+	TaskIdentity       core.Identity
+	Filters            taskrepo.TaskCommentFilters
+	Threaded           bool
+	Pagination         core.PaginationInput
+	SortInput          core.SortInput
+	RelationsInput     core.RelationsInput
+	UserViewerIdentity *core.Identity
 }
 
 func (i ListTaskCommentsInput) Validate() error { return nil }
@@ -37,41 +42,38 @@ func (s *ListTaskCommentsService) Execute(input ListTaskCommentsInput) (*core.Pa
 		return nil, err
 	}
 
-	tx, err := s.TransactionRepository.BeginTransaction()
-	if err != nil {
-		return nil, err
-	}
-
-	s.TaskCommentRepository.SetTransaction(tx)
-	s.TaskRepository.SetTransaction(tx)
-
 	tsk, err := s.TaskRepository.GetTaskByIdentity(taskrepo.GetTaskByIdentityParams{
 		TaskIdentity: input.TaskIdentity,
 	})
 	if err != nil {
-		tx.Rollback()
 		return nil, err
 	}
 
 	if tsk == nil {
-		tx.Rollback()
 		return nil, core.NewNotFoundError("task not found")
 	}
 
+	input.Filters.TaskIdentity = &tsk.Identity
+
+	if input.Threaded {
+		isReply := false
+		input.Filters.IsReply = &isReply
+	}
+
 	comments, err := s.TaskCommentRepository.PaginateTaskCommentsBy(taskrepo.PaginateTaskCommentsParams{
 		Filters:        input.Filters,
 		Pagination:     input.Pagination,
 		SortInput:      input.SortInput,
 		RelationsInput: input.RelationsInput,
+		IncludeReplies: input.Threaded,
 	})
 	if err != nil {
-		tx.Rollback()
 		return nil, err
 	}
 
 	var commentsDto []task.TaskCommentDto = make([]task.TaskCommentDto, len(comments.Data))
 	for i, comment := range comments.Data {
-		commentsDto[i] = *task.TaskCommentToDto(&comment)
+		commentsDto[i] = *task.TaskCommentToDto(&comment, input.UserViewerIdentity)
 	}
 
 	return &core.PaginationOutput[task.TaskCommentDto]{
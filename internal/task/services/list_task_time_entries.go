@@ -0,0 +1,73 @@
+package taskservice
+
+import (
+	"github.com/gabrielmrtt/taski/internal/core"
+	"github.com/gabrielmrtt/taski/internal/task"
+	taskrepo "github.com/gabrielmrtt/taski/internal/task/repository"
+)
+
+type ListTaskTimeEntriesService struct {
+	TaskTimeEntryRepository taskrepo.TaskTimeEntryRepository
+	TaskRepository          taskrepo.TaskRepository
+}
+
+func NewListTaskTimeEntriesService(
+	taskTimeEntryRepository taskrepo.TaskTimeEntryRepository,
+	taskRepository taskrepo.TaskRepository,
+) *ListTaskTimeEntriesService {
+	return &ListTaskTimeEntriesService{
+		TaskTimeEntryRepository: taskTimeEntryRepository,
+		TaskRepository:          taskRepository,
+	}
+}
+
+type ListTaskTimeEntriesInput struct {
+	OrganizationIdentity *core.Identity
+	TaskIdentity         core.Identity
+	Filters              taskrepo.TaskTimeEntryFilters
+	Pagination           core.PaginationInput
+	SortInput            core.SortInput
+}
+
+func (i ListTaskTimeEntriesInput) Validate() error { return nil }
+
+func (s *ListTaskTimeEntriesService) Execute(input ListTaskTimeEntriesInput) (*core.PaginationOutput[task.TaskTimeEntryDto], error) {
+	if err := input.Validate(); err != nil {
+		return nil, err
+	}
+
+	tsk, err := s.TaskRepository.GetTaskByIdentity(taskrepo.GetTaskByIdentityParams{
+		TaskIdentity:         input.TaskIdentity,
+		OrganizationIdentity: input.OrganizationIdentity,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if tsk == nil {
+		return nil, core.NewNotFoundError("task not found")
+	}
+
+	input.Filters.TaskIdentity = &tsk.Identity
+
+	taskTimeEntries, err := s.TaskTimeEntryRepository.PaginateTaskTimeEntriesBy(taskrepo.PaginateTaskTimeEntriesParams{
+		Filters:    input.Filters,
+		Pagination: input.Pagination,
+		SortInput:  input.SortInput,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var taskTimeEntriesDto []task.TaskTimeEntryDto = make([]task.TaskTimeEntryDto, len(taskTimeEntries.Data))
+	for i, taskTimeEntry := range taskTimeEntries.Data {
+		taskTimeEntriesDto[i] = *task.TaskTimeEntryToDto(&taskTimeEntry)
+	}
+
+	return &core.PaginationOutput[task.TaskTimeEntryDto]{
+		Data:    taskTimeEntriesDto,
+		Page:    taskTimeEntries.Page,
+		HasMore: taskTimeEntries.HasMore,
+		Total:   taskTimeEntries.Total,
+	}, nil
+}
@@ -0,0 +1,51 @@
+package taskservice
+
+import (
+	"github.com/gabrielmrtt/taski/internal/core"
+	"github.com/gabrielmrtt/taski/internal/task"
+	taskrepo "github.com/gabrielmrtt/taski/internal/task/repository"
+)
+
+type ListTaskViewsService struct {
+	TaskViewRepository taskrepo.TaskViewRepository
+}
+
+func NewListTaskViewsService(
+	taskViewRepository taskrepo.TaskViewRepository,
+) *ListTaskViewsService {
+	return &ListTaskViewsService{
+		TaskViewRepository: taskViewRepository,
+	}
+}
+
+type ListTaskViewsInput struct {
+	OrganizationIdentity *core.Identity
+	ProjectIdentity      *core.Identity
+	UserIdentity         core.Identity
+}
+
+func (i ListTaskViewsInput) Validate() error { return nil }
+
+func (s *ListTaskViewsService) Execute(input ListTaskViewsInput) ([]task.TaskViewDto, error) {
+	if err := input.Validate(); err != nil {
+		return nil, err
+	}
+
+	taskViews, err := s.TaskViewRepository.ListTaskViewsBy(taskrepo.ListTaskViewsByParams{
+		Filters: taskrepo.TaskViewFilters{
+			OrganizationIdentity: input.OrganizationIdentity,
+			ProjectIdentity:      input.ProjectIdentity,
+			UserIdentity:         &input.UserIdentity,
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var taskViewDtos []task.TaskViewDto = make([]task.TaskViewDto, 0)
+	for _, taskView := range taskViews {
+		taskViewDtos = append(taskViewDtos, *task.TaskViewToDto(&taskView))
+	}
+
+	return taskViewDtos, nil
+}
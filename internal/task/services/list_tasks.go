@@ -1,42 +1,251 @@
 package taskservice
 
 import (
+	"slices"
+	"strconv"
+	"strings"
+
 	"github.com/gabrielmrtt/taski/internal/core"
+	"github.com/gabrielmrtt/taski/internal/project"
+	projectrepo "github.com/gabrielmrtt/taski/internal/project/repository"
 	"github.com/gabrielmrtt/taski/internal/task"
 	taskrepo "github.com/gabrielmrtt/taski/internal/task/repository"
 )
 
+const customFieldSortPrefix = "customFields."
+
+var customFieldFilterOperators = []string{"eq", "not", "like", "in", "gt", "gte", "lt", "lte"}
+
 type ListTasksService struct {
-	TaskRepository taskrepo.TaskRepository
+	TaskRepository                   taskrepo.TaskRepository
+	ProjectTaskCustomFieldRepository projectrepo.ProjectTaskCustomFieldRepository
 }
 
 func NewListTasksService(
 	taskRepository taskrepo.TaskRepository,
+	projectTaskCustomFieldRepository projectrepo.ProjectTaskCustomFieldRepository,
 ) *ListTasksService {
 	return &ListTasksService{
-		TaskRepository: taskRepository,
+		TaskRepository:                   taskRepository,
+		ProjectTaskCustomFieldRepository: projectTaskCustomFieldRepository,
 	}
 }
 
+type TaskCustomFieldFilterInput struct {
+	CustomFieldIdentity core.Identity
+	Operator            string
+	Value               string
+}
+
 type ListTasksInput struct {
-	Filters        taskrepo.TaskFilters
-	Pagination     core.PaginationInput
-	SortInput      core.SortInput
-	RelationsInput core.RelationsInput
+	Filters            taskrepo.TaskFilters
+	CustomFieldFilters []*TaskCustomFieldFilterInput
+	Filter             *string
+	Pagination         core.PaginationInput
+	SortInput          core.SortInput
+	GroupBy            *task.TaskGroupByFields
+	RelationsInput     core.RelationsInput
+}
+
+func (i ListTasksInput) Validate() error {
+	var fields []core.InvalidInputErrorField
+
+	for _, customFieldFilter := range i.CustomFieldFilters {
+		if !slices.Contains(customFieldFilterOperators, customFieldFilter.Operator) {
+			fields = append(fields, core.InvalidInputErrorField{
+				Field: "customFields." + customFieldFilter.CustomFieldIdentity.Public,
+				Error: "invalid filter operator " + customFieldFilter.Operator,
+			})
+		}
+	}
+
+	if i.SortInput.Direction != nil && *i.SortInput.Direction != core.SortDirectionAsc && *i.SortInput.Direction != core.SortDirectionDesc {
+		fields = append(fields, core.InvalidInputErrorField{
+			Field: "sortDirection",
+			Error: "sort direction must be asc or desc",
+		})
+	}
+
+	if i.GroupBy != nil && !slices.Contains(task.TaskGroupByFieldsArray, *i.GroupBy) {
+		fields = append(fields, core.InvalidInputErrorField{
+			Field: "groupBy",
+			Error: "tasks cannot be grouped by " + string(*i.GroupBy),
+		})
+	}
+
+	if len(fields) > 0 {
+		return core.NewInvalidInputError("invalid input", fields)
+	}
+
+	return nil
 }
 
-func (i ListTasksInput) Validate() error { return nil }
+/*
+newCustomFieldComparableFilter builds a comparable filter from a raw query value, parsing each value with parse.
+*/
+func newCustomFieldComparableFilter[T any](operator string, rawValue string, parse func(string) (T, error)) (*core.ComparableFilter[T], error) {
+	var filter *core.ComparableFilter[T] = &core.ComparableFilter[T]{}
+
+	if operator == "in" {
+		var values []T = make([]T, 0)
+		for _, rawItem := range strings.Split(rawValue, ",") {
+			value, err := parse(strings.TrimSpace(rawItem))
+			if err != nil {
+				return nil, err
+			}
+
+			values = append(values, value)
+		}
+
+		filter.In = &values
+		return filter, nil
+	}
+
+	value, err := parse(rawValue)
+	if err != nil {
+		return nil, err
+	}
+
+	switch operator {
+	case "eq":
+		filter.Equals = &value
+	case "not":
+		negate := true
+		filter.Equals = &value
+		filter.Negate = &negate
+	case "like":
+		filter.Like = &value
+	case "gt":
+		filter.GreaterThan = &value
+	case "gte":
+		filter.GreaterThanOrEqual = &value
+	case "lt":
+		filter.LessThan = &value
+	case "lte":
+		filter.LessThanOrEqual = &value
+	}
+
+	return filter, nil
+}
+
+func (s *ListTasksService) getCustomField(customFieldIdentity core.Identity, projectIdentity *core.Identity) (*project.ProjectTaskCustomField, error) {
+	customField, err := s.ProjectTaskCustomFieldRepository.GetProjectTaskCustomFieldByIdentity(projectrepo.GetProjectTaskCustomFieldByIdentityParams{
+		ProjectTaskCustomFieldIdentity: customFieldIdentity,
+		ProjectIdentity:                projectIdentity,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if customField == nil || customField.IsDeleted() {
+		return nil, core.NewNotFoundError("project task custom field not found")
+	}
+
+	return customField, nil
+}
+
+func (s *ListTasksService) buildCustomFieldFilter(customField *project.ProjectTaskCustomField, input *TaskCustomFieldFilterInput) (*taskrepo.TaskCustomFieldFilter, error) {
+	var err error
+	var customFieldFilter *taskrepo.TaskCustomFieldFilter = &taskrepo.TaskCustomFieldFilter{
+		CustomFieldIdentity: customField.Identity,
+	}
+
+	switch customField.Type {
+	case project.ProjectTaskCustomFieldTypeNumber:
+		customFieldFilter.Number, err = newCustomFieldComparableFilter(input.Operator, input.Value, func(value string) (float64, error) {
+			return strconv.ParseFloat(value, 64)
+		})
+	case project.ProjectTaskCustomFieldTypeDate:
+		customFieldFilter.Date, err = newCustomFieldComparableFilter(input.Operator, input.Value, func(value string) (int64, error) {
+			date, err := core.NewDateTimeFromRFC3339(value)
+			return date.Value, err
+		})
+	case project.ProjectTaskCustomFieldTypeUser:
+		customFieldFilter.User, err = newCustomFieldComparableFilter(input.Operator, input.Value, func(value string) (string, error) {
+			userIdentity := core.NewIdentityFromPublic(value)
+			if userIdentity.IsEmpty() {
+				return "", core.NewInternalError("invalid user id")
+			}
+
+			return userIdentity.Internal.String(), nil
+		})
+	default:
+		customFieldFilter.Text, err = newCustomFieldComparableFilter(input.Operator, input.Value, func(value string) (string, error) {
+			return value, nil
+		})
+	}
+
+	if err != nil {
+		return nil, core.NewInvalidInputError("invalid input", []core.InvalidInputErrorField{
+			{
+				Field: "customFields." + customField.Identity.Public,
+				Error: "invalid filter value for custom field of type " + string(customField.Type),
+			},
+		})
+	}
+
+	return customFieldFilter, nil
+}
 
 func (s *ListTasksService) Execute(input ListTasksInput) (*core.PaginationOutput[task.TaskDto], error) {
 	if err := input.Validate(); err != nil {
 		return nil, err
 	}
 
+	if input.Filter != nil {
+		filterExpression, err := core.ParseFilterExpression(*input.Filter)
+		if err != nil {
+			return nil, err
+		}
+
+		if err := core.ResolveFilterExpression(filterExpression, task.TaskFilterableFields); err != nil {
+			return nil, err
+		}
+
+		input.Filters.Expression = filterExpression
+	}
+
+	for _, customFieldFilterInput := range input.CustomFieldFilters {
+		customField, err := s.getCustomField(customFieldFilterInput.CustomFieldIdentity, input.Filters.ProjectIdentity)
+		if err != nil {
+			return nil, err
+		}
+
+		customFieldFilter, err := s.buildCustomFieldFilter(customField, customFieldFilterInput)
+		if err != nil {
+			return nil, err
+		}
+
+		input.Filters.CustomFields = append(input.Filters.CustomFields, *customFieldFilter)
+	}
+
+	var customFieldSortInput *taskrepo.TaskCustomFieldSortInput = nil
+	if input.SortInput.By != nil && strings.HasPrefix(*input.SortInput.By, customFieldSortPrefix) {
+		customField, err := s.getCustomField(core.NewIdentityFromPublic(strings.TrimPrefix(*input.SortInput.By, customFieldSortPrefix)), input.Filters.ProjectIdentity)
+		if err != nil {
+			return nil, err
+		}
+
+		var sortDirection core.SortDirection = core.SortDirectionAsc
+		if input.SortInput.Direction != nil {
+			sortDirection = *input.SortInput.Direction
+		}
+
+		customFieldSortInput = &taskrepo.TaskCustomFieldSortInput{
+			CustomFieldIdentity: customField.Identity,
+			CustomFieldType:     customField.Type,
+			Direction:           sortDirection,
+		}
+		input.SortInput.By = nil
+	}
+
 	tasks, err := s.TaskRepository.PaginateTasksBy(taskrepo.PaginateTasksParams{
-		Filters:        input.Filters,
-		Pagination:     input.Pagination,
-		SortInput:      input.SortInput,
-		RelationsInput: input.RelationsInput,
+		Filters:              input.Filters,
+		Pagination:           input.Pagination,
+		SortInput:            input.SortInput,
+		CustomFieldSortInput: customFieldSortInput,
+		GroupBy:              input.GroupBy,
+		RelationsInput:       input.RelationsInput,
 	})
 	if err != nil {
 		return nil, err
@@ -48,9 +257,11 @@ func (s *ListTasksService) Execute(input ListTasksInput) (*core.PaginationOutput
 	}
 
 	return &core.PaginationOutput[task.TaskDto]{
-		Data:    tasksDto,
-		Page:    tasks.Page,
-		HasMore: tasks.HasMore,
-		Total:   tasks.Total,
+		Data:       tasksDto,
+		Page:       tasks.Page,
+		HasMore:    tasks.HasMore,
+		Total:      tasks.Total,
+		NextCursor: tasks.NextCursor,
+		PrevCursor: tasks.PrevCursor,
 	}, nil
 }
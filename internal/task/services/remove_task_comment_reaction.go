@@ -0,0 +1,116 @@
+package taskservice
+
+import (
+	"github.com/gabrielmrtt/taski/internal/core"
+	projectrepo "github.com/gabrielmrtt/taski/internal/project/repository"
+	taskrepo "github.com/gabrielmrtt/taski/internal/task/repository"
+)
+
+type RemoveTaskCommentReactionService struct {
+	TaskCommentRepository taskrepo.TaskCommentRepository
+	TaskRepository        taskrepo.TaskRepository
+	ProjectUserRepository projectrepo.ProjectUserRepository
+	TransactionRepository core.TransactionRepository
+}
+
+func NewRemoveTaskCommentReactionService(
+	taskCommentRepository taskrepo.TaskCommentRepository,
+	taskRepository taskrepo.TaskRepository,
+	projectUserRepository projectrepo.ProjectUserRepository,
+	transactionRepository core.TransactionRepository,
+) *RemoveTaskCommentReactionService {
+	return &RemoveTaskCommentReactionService{
+		TaskCommentRepository: taskCommentRepository,
+		TaskRepository:        taskRepository,
+		ProjectUserRepository: projectUserRepository,
+		TransactionRepository: transactionRepository,
+	}
+}
+
+type RemoveTaskCommentReactionInput struct {
+	TaskIdentity        core.Identity
+	TaskCommentIdentity core.Identity
+	Emoji               string
+	UserIdentity        core.Identity
+}
+
+func (i RemoveTaskCommentReactionInput) Validate() error { return nil }
+
+func (s *RemoveTaskCommentReactionService) Execute(input RemoveTaskCommentReactionInput) error {
+	if err := input.Validate(); err != nil {
+		return err
+	}
+
+	tx, err := s.TransactionRepository.BeginTransaction()
+	if err != nil {
+		return err
+	}
+
+	s.TaskCommentRepository.SetTransaction(tx)
+	s.TaskRepository.SetTransaction(tx)
+	s.ProjectUserRepository.SetTransaction(tx)
+
+	tsk, err := s.TaskRepository.GetTaskByIdentity(taskrepo.GetTaskByIdentityParams{
+		TaskIdentity: input.TaskIdentity,
+	})
+	if err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	if tsk == nil {
+		tx.Rollback()
+		return core.NewNotFoundError("task not found")
+	}
+
+	usr, err := s.ProjectUserRepository.GetProjectUserByIdentity(projectrepo.GetProjectUserByIdentityParams{
+		ProjectIdentity: tsk.ProjectIdentity,
+		UserIdentity:    input.UserIdentity,
+	})
+	if err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	if usr == nil {
+		tx.Rollback()
+		return core.NewNotFoundError("project user not found")
+	}
+
+	comment, err := s.TaskCommentRepository.GetTaskCommentByIdentity(taskrepo.GetTaskCommentByIdentityParams{
+		TaskCommentIdentity: input.TaskCommentIdentity,
+		TaskIdentity:        &input.TaskIdentity,
+	})
+	if err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	if comment == nil {
+		tx.Rollback()
+		return core.NewNotFoundError("task comment not found")
+	}
+
+	if !comment.HasReaction(input.UserIdentity, input.Emoji) {
+		tx.Rollback()
+		return core.NewNotFoundError("task comment reaction not found")
+	}
+
+	err = s.TaskCommentRepository.RemoveTaskCommentReaction(taskrepo.RemoveTaskCommentReactionParams{
+		TaskCommentIdentity: comment.Identity,
+		UserIdentity:        input.UserIdentity,
+		Emoji:               input.Emoji,
+	})
+	if err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	return nil
+}
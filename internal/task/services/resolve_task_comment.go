@@ -0,0 +1,128 @@
+package taskservice
+
+import (
+	"github.com/gabrielmrtt/taski/internal/core"
+	projectrepo "github.com/gabrielmrtt/taski/internal/project/repository"
+	"github.com/gabrielmrtt/taski/internal/task"
+	taskrepo "github.com/gabrielmrtt/taski/internal/task/repository"
+)
+
+type ResolveTaskCommentService struct {
+	TaskCommentRepository taskrepo.TaskCommentRepository
+	TaskRepository        taskrepo.TaskRepository
+	ProjectUserRepository projectrepo.ProjectUserRepository
+	TaskActionRepository  taskrepo.TaskActionRepository
+	TransactionRepository core.TransactionRepository
+}
+
+func NewResolveTaskCommentService(
+	taskCommentRepository taskrepo.TaskCommentRepository,
+	taskRepository taskrepo.TaskRepository,
+	projectUserRepository projectrepo.ProjectUserRepository,
+	taskActionRepository taskrepo.TaskActionRepository,
+	transactionRepository core.TransactionRepository,
+) *ResolveTaskCommentService {
+	return &ResolveTaskCommentService{
+		TaskCommentRepository: taskCommentRepository,
+		TaskRepository:        taskRepository,
+		ProjectUserRepository: projectUserRepository,
+		TaskActionRepository:  taskActionRepository,
+		TransactionRepository: transactionRepository,
+	}
+}
+
+type ResolveTaskCommentInput struct {
+	TaskIdentity         core.Identity
+	TaskCommentIdentity  core.Identity
+	UserResolverIdentity core.Identity
+}
+
+func (i ResolveTaskCommentInput) Validate() error { return nil }
+
+func (s *ResolveTaskCommentService) Execute(input ResolveTaskCommentInput) error {
+	if err := input.Validate(); err != nil {
+		return err
+	}
+
+	tx, err := s.TransactionRepository.BeginTransaction()
+	if err != nil {
+		return err
+	}
+
+	s.TaskCommentRepository.SetTransaction(tx)
+	s.TaskRepository.SetTransaction(tx)
+	s.TaskActionRepository.SetTransaction(tx)
+	s.ProjectUserRepository.SetTransaction(tx)
+
+	tsk, err := s.TaskRepository.GetTaskByIdentity(taskrepo.GetTaskByIdentityParams{
+		TaskIdentity: input.TaskIdentity,
+	})
+	if err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	if tsk == nil {
+		tx.Rollback()
+		return core.NewNotFoundError("task not found")
+	}
+
+	userResolver, err := s.ProjectUserRepository.GetProjectUserByIdentity(projectrepo.GetProjectUserByIdentityParams{
+		ProjectIdentity: tsk.ProjectIdentity,
+		UserIdentity:    input.UserResolverIdentity,
+	})
+	if err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	if userResolver == nil {
+		tx.Rollback()
+		return core.NewNotFoundError("project user resolver not found")
+	}
+
+	comment, err := s.TaskCommentRepository.GetTaskCommentByIdentity(taskrepo.GetTaskCommentByIdentityParams{
+		TaskCommentIdentity: input.TaskCommentIdentity,
+		TaskIdentity:        &input.TaskIdentity,
+	})
+	if err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	if comment == nil {
+		tx.Rollback()
+		return core.NewNotFoundError("task comment not found")
+	}
+
+	err = comment.Resolve(input.UserResolverIdentity)
+	if err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	err = s.TaskCommentRepository.UpdateTaskComment(taskrepo.UpdateTaskCommentParams{
+		TaskComment: comment,
+	})
+	if err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	taskAction := tsk.RegisterAction(task.TaskActionTypeResolveComment, &userResolver.User)
+	_, err = s.TaskActionRepository.StoreTaskAction(taskrepo.StoreTaskActionParams{
+		TaskAction: &taskAction,
+	})
+	if err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	return nil
+}
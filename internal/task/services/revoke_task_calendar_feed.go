@@ -0,0 +1,75 @@
+package taskservice
+
+import (
+	"github.com/gabrielmrtt/taski/internal/core"
+	taskrepo "github.com/gabrielmrtt/taski/internal/task/repository"
+)
+
+type RevokeTaskCalendarFeedService struct {
+	TaskCalendarFeedRepository taskrepo.TaskCalendarFeedRepository
+	TransactionRepository      core.TransactionRepository
+}
+
+func NewRevokeTaskCalendarFeedService(
+	taskCalendarFeedRepository taskrepo.TaskCalendarFeedRepository,
+	transactionRepository core.TransactionRepository,
+) *RevokeTaskCalendarFeedService {
+	return &RevokeTaskCalendarFeedService{
+		TaskCalendarFeedRepository: taskCalendarFeedRepository,
+		TransactionRepository:      transactionRepository,
+	}
+}
+
+type RevokeTaskCalendarFeedInput struct {
+	OrganizationIdentity     *core.Identity
+	TaskCalendarFeedIdentity core.Identity
+	UserIdentity             core.Identity
+}
+
+func (i RevokeTaskCalendarFeedInput) Validate() error { return nil }
+
+func (s *RevokeTaskCalendarFeedService) Execute(input RevokeTaskCalendarFeedInput) error {
+	if err := input.Validate(); err != nil {
+		return err
+	}
+
+	tx, err := s.TransactionRepository.BeginTransaction()
+	if err != nil {
+		return err
+	}
+
+	s.TaskCalendarFeedRepository.SetTransaction(tx)
+
+	taskCalendarFeed, err := s.TaskCalendarFeedRepository.GetTaskCalendarFeedByIdentity(taskrepo.GetTaskCalendarFeedByIdentityParams{
+		TaskCalendarFeedIdentity: input.TaskCalendarFeedIdentity,
+		OrganizationIdentity:     input.OrganizationIdentity,
+	})
+	if err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	// Feeds are secret to their owner, so other users are told they do not exist.
+	if taskCalendarFeed == nil || taskCalendarFeed.IsRevoked() || !taskCalendarFeed.IsOwnedBy(input.UserIdentity) {
+		tx.Rollback()
+		return core.NewNotFoundError("task calendar feed not found")
+	}
+
+	taskCalendarFeed.Revoke()
+
+	err = s.TaskCalendarFeedRepository.UpdateTaskCalendarFeed(taskrepo.UpdateTaskCalendarFeedParams{
+		TaskCalendarFeed: taskCalendarFeed,
+	})
+	if err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,139 @@
+package taskservice
+
+import (
+	"github.com/gabrielmrtt/taski/internal/core"
+	projectrepo "github.com/gabrielmrtt/taski/internal/project/repository"
+	"github.com/gabrielmrtt/taski/internal/task"
+	taskrepo "github.com/gabrielmrtt/taski/internal/task/repository"
+)
+
+type StartTaskTimerService struct {
+	TaskTimeEntryRepository taskrepo.TaskTimeEntryRepository
+	TaskRepository          taskrepo.TaskRepository
+	ProjectUserRepository   projectrepo.ProjectUserRepository
+	TransactionRepository   core.TransactionRepository
+}
+
+func NewStartTaskTimerService(
+	taskTimeEntryRepository taskrepo.TaskTimeEntryRepository,
+	taskRepository taskrepo.TaskRepository,
+	projectUserRepository projectrepo.ProjectUserRepository,
+	transactionRepository core.TransactionRepository,
+) *StartTaskTimerService {
+	return &StartTaskTimerService{
+		TaskTimeEntryRepository: taskTimeEntryRepository,
+		TaskRepository:          taskRepository,
+		ProjectUserRepository:   projectUserRepository,
+		TransactionRepository:   transactionRepository,
+	}
+}
+
+type StartTaskTimerInput struct {
+	OrganizationIdentity *core.Identity
+	TaskIdentity         core.Identity
+	Description          string
+	UserIdentity         core.Identity
+}
+
+func (i StartTaskTimerInput) Validate() error {
+	var fields []core.InvalidInputErrorField
+
+	if i.Description != "" {
+		if _, err := core.NewDescription(i.Description); err != nil {
+			fields = append(fields, core.InvalidInputErrorField{
+				Field: "description",
+				Error: err.Error(),
+			})
+		}
+	}
+
+	if len(fields) > 0 {
+		return core.NewInvalidInputError("invalid input", fields)
+	}
+
+	return nil
+}
+
+func (s *StartTaskTimerService) Execute(input StartTaskTimerInput) (*task.TaskTimeEntryDto, error) {
+	if err := input.Validate(); err != nil {
+		return nil, err
+	}
+
+	tx, err := s.TransactionRepository.BeginTransaction()
+	if err != nil {
+		return nil, err
+	}
+
+	s.TaskTimeEntryRepository.SetTransaction(tx)
+	s.TaskRepository.SetTransaction(tx)
+	s.ProjectUserRepository.SetTransaction(tx)
+
+	tsk, err := s.TaskRepository.GetTaskByIdentity(taskrepo.GetTaskByIdentityParams{
+		TaskIdentity:         input.TaskIdentity,
+		OrganizationIdentity: input.OrganizationIdentity,
+	})
+	if err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+
+	if tsk == nil {
+		tx.Rollback()
+		return nil, core.NewNotFoundError("task not found")
+	}
+
+	projectUser, err := s.ProjectUserRepository.GetProjectUserByIdentity(projectrepo.GetProjectUserByIdentityParams{
+		ProjectIdentity: tsk.ProjectIdentity,
+		UserIdentity:    input.UserIdentity,
+	})
+	if err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+
+	if projectUser == nil {
+		tx.Rollback()
+		return nil, core.NewNotFoundError("project user not found")
+	}
+
+	runningTaskTimeEntry, err := s.TaskTimeEntryRepository.GetRunningTaskTimeEntry(taskrepo.GetRunningTaskTimeEntryParams{
+		UserIdentity: input.UserIdentity,
+	})
+	if err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+
+	if runningTaskTimeEntry != nil {
+		tx.Rollback()
+		return nil, core.NewConflictError("user already has a running timer")
+	}
+
+	taskTimeEntry, err := task.NewTaskTimeEntry(task.NewTaskTimeEntryInput{
+		TaskIdentity: tsk.Identity,
+		UserIdentity: input.UserIdentity,
+		Description:  input.Description,
+		StartedAt:    core.NewDateTime(),
+		EndedAt:      nil,
+	})
+	if err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+
+	_, err = s.TaskTimeEntryRepository.StoreTaskTimeEntry(taskrepo.StoreTaskTimeEntryParams{
+		TaskTimeEntry: taskTimeEntry,
+	})
+	if err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+
+	return task.TaskTimeEntryToDto(taskTimeEntry), nil
+}
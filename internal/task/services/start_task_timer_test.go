@@ -0,0 +1,213 @@
+package taskservice
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/gabrielmrtt/taski/internal/core"
+	"github.com/gabrielmrtt/taski/internal/project"
+	projectrepo "github.com/gabrielmrtt/taski/internal/project/repository"
+	"github.com/gabrielmrtt/taski/internal/task"
+	taskrepo "github.com/gabrielmrtt/taski/internal/task/repository"
+	"github.com/gabrielmrtt/taski/internal/user"
+)
+
+type memoryTransaction struct {
+	committed  bool
+	rolledBack bool
+}
+
+func (t *memoryTransaction) Commit() error {
+	t.committed = true
+	return nil
+}
+
+func (t *memoryTransaction) Rollback() error {
+	t.rolledBack = true
+	return nil
+}
+
+func (t *memoryTransaction) IsClosed() bool                        { return t.committed || t.rolledBack }
+func (t *memoryTransaction) Savepoint(name string) error           { return nil }
+func (t *memoryTransaction) RollbackToSavepoint(name string) error { return nil }
+func (t *memoryTransaction) ReleaseSavepoint(name string) error    { return nil }
+
+type memoryTransactionRepository struct {
+	transactions []*memoryTransaction
+}
+
+func (r *memoryTransactionRepository) BeginTransaction() (core.Transaction, error) {
+	tx := &memoryTransaction{}
+	r.transactions = append(r.transactions, tx)
+	return tx, nil
+}
+
+func (r *memoryTransactionRepository) last() *memoryTransaction {
+	return r.transactions[len(r.transactions)-1]
+}
+
+type memoryTaskRepository struct {
+	taskrepo.TaskRepository
+	tasks   []*task.Task
+	updated []core.Identity
+}
+
+func (r *memoryTaskRepository) SetTransaction(tx core.Transaction) error { return nil }
+
+func (r *memoryTaskRepository) GetAdjacentTaskRank(params taskrepo.GetAdjacentTaskRankParams) (string, error) {
+	return "", nil
+}
+
+func (r *memoryTaskRepository) UpdateTask(params taskrepo.UpdateTaskParams) error {
+	r.updated = append(r.updated, params.Task.Identity)
+	return nil
+}
+
+func (r *memoryTaskRepository) GetTaskByIdentity(params taskrepo.GetTaskByIdentityParams) (*task.Task, error) {
+	for _, tsk := range r.tasks {
+		if tsk.Identity.Equals(params.TaskIdentity) {
+			return tsk, nil
+		}
+	}
+
+	return nil, nil
+}
+
+// memoryProjectUserRepository makes every user an active member of every project but the outside ones
+type memoryProjectUserRepository struct {
+	projectrepo.ProjectUserRepository
+	outsideProjects []core.Identity
+	outsideUsers    []core.Identity
+}
+
+func (r *memoryProjectUserRepository) SetTransaction(tx core.Transaction) error { return nil }
+
+func (r *memoryProjectUserRepository) GetProjectUserByIdentity(params projectrepo.GetProjectUserByIdentityParams) (*project.ProjectUser, error) {
+	for _, projectIdentity := range r.outsideProjects {
+		if projectIdentity.Equals(params.ProjectIdentity) {
+			return nil, nil
+		}
+	}
+
+	for _, userIdentity := range r.outsideUsers {
+		if userIdentity.Equals(params.UserIdentity) {
+			return nil, nil
+		}
+	}
+
+	return &project.ProjectUser{ProjectIdentity: params.ProjectIdentity, User: user.User{Identity: params.UserIdentity}, Status: project.ProjectUserStatusActive}, nil
+}
+
+type memoryTaskActionRepository struct {
+	taskrepo.TaskActionRepository
+	actions []task.TaskAction
+}
+
+func (r *memoryTaskActionRepository) SetTransaction(tx core.Transaction) error { return nil }
+
+func (r *memoryTaskActionRepository) StoreTaskAction(params taskrepo.StoreTaskActionParams) (*task.TaskAction, error) {
+	r.actions = append(r.actions, *params.TaskAction)
+	return params.TaskAction, nil
+}
+
+type memoryTaskTimeEntryRepository struct {
+	taskrepo.TaskTimeEntryRepository
+	entries []*task.TaskTimeEntry
+}
+
+func (r *memoryTaskTimeEntryRepository) SetTransaction(tx core.Transaction) error { return nil }
+
+func (r *memoryTaskTimeEntryRepository) GetRunningTaskTimeEntry(params taskrepo.GetRunningTaskTimeEntryParams) (*task.TaskTimeEntry, error) {
+	for _, entry := range r.entries {
+		if entry.IsOwnedBy(params.UserIdentity) && entry.IsRunning() {
+			return entry, nil
+		}
+	}
+
+	return nil, nil
+}
+
+func (r *memoryTaskTimeEntryRepository) StoreTaskTimeEntry(params taskrepo.StoreTaskTimeEntryParams) (*task.TaskTimeEntry, error) {
+	r.entries = append(r.entries, params.TaskTimeEntry)
+	return params.TaskTimeEntry, nil
+}
+
+func (r *memoryTaskTimeEntryRepository) UpdateTaskTimeEntry(params taskrepo.UpdateTaskTimeEntryParams) error {
+	return nil
+}
+
+func TestTaskTimerRunsOncePerUser(t *testing.T) {
+	first := &task.Task{Identity: core.NewIdentity("tsk"), ProjectIdentity: core.NewIdentity("prj")}
+	second := &task.Task{Identity: core.NewIdentity("tsk"), ProjectIdentity: first.ProjectIdentity}
+	alice := core.NewIdentity("usr")
+	bob := core.NewIdentity("usr")
+
+	taskRepository := &memoryTaskRepository{tasks: []*task.Task{first, second}}
+	timeEntryRepository := &memoryTaskTimeEntryRepository{}
+	transactionRepository := &memoryTransactionRepository{}
+	startService := NewStartTaskTimerService(timeEntryRepository, taskRepository, &memoryProjectUserRepository{}, transactionRepository)
+	stopService := NewStopTaskTimerService(timeEntryRepository, taskRepository, &memoryTaskActionRepository{}, &memoryProjectUserRepository{}, transactionRepository)
+
+	var conflictError *core.ConflictError
+	var notFoundError *core.NotFoundError
+
+	steps := []struct {
+		name     string
+		stop     bool
+		task     *task.Task
+		user     core.Identity
+		expected interface{}
+	}{
+		{name: "first timer starts", task: first, user: alice},
+		{name: "second timer on another task conflicts", task: second, user: alice, expected: &conflictError},
+		{name: "second timer on the same task conflicts", task: first, user: alice, expected: &conflictError},
+		{name: "other users run their own timer", task: first, user: bob},
+		{name: "stopping another task finds no timer", stop: true, task: second, user: alice, expected: &notFoundError},
+		{name: "running timer stops", stop: true, task: first, user: alice},
+		{name: "a new timer starts once stopped", task: second, user: alice},
+	}
+
+	for _, step := range steps {
+		var err error
+		if step.stop {
+			_, err = stopService.Execute(StopTaskTimerInput{TaskIdentity: step.task.Identity, UserIdentity: step.user})
+		} else {
+			_, err = startService.Execute(StartTaskTimerInput{TaskIdentity: step.task.Identity, UserIdentity: step.user})
+		}
+
+		if step.expected == nil {
+			if err != nil {
+				t.Fatalf("%s: unexpected error: %v", step.name, err)
+			}
+
+			if !transactionRepository.last().committed {
+				t.Fatalf("%s: expected the transaction to be committed", step.name)
+			}
+
+			continue
+		}
+
+		if !errors.As(err, step.expected) {
+			t.Fatalf("%s: expected %T, got %v", step.name, step.expected, err)
+		}
+
+		if !transactionRepository.last().rolledBack {
+			t.Fatalf("%s: expected the transaction to be rolled back", step.name)
+		}
+	}
+
+	var running int
+	for _, entry := range timeEntryRepository.entries {
+		if entry.IsOwnedBy(alice) && entry.IsRunning() {
+			running++
+
+			if !entry.TaskIdentity.Equals(second.Identity) {
+				t.Errorf("expected the running timer on the second task, got %s", entry.TaskIdentity.Public)
+			}
+		}
+	}
+
+	if running != 1 || len(timeEntryRepository.entries) != 3 {
+		t.Errorf("expected 3 entries with 1 running for the user, got %d entries and %d running", len(timeEntryRepository.entries), running)
+	}
+}
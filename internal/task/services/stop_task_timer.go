@@ -0,0 +1,128 @@
+package taskservice
+
+import (
+	"github.com/gabrielmrtt/taski/internal/core"
+	projectrepo "github.com/gabrielmrtt/taski/internal/project/repository"
+	"github.com/gabrielmrtt/taski/internal/task"
+	taskrepo "github.com/gabrielmrtt/taski/internal/task/repository"
+)
+
+type StopTaskTimerService struct {
+	TaskTimeEntryRepository taskrepo.TaskTimeEntryRepository
+	TaskRepository          taskrepo.TaskRepository
+	TaskActionRepository    taskrepo.TaskActionRepository
+	ProjectUserRepository   projectrepo.ProjectUserRepository
+	TransactionRepository   core.TransactionRepository
+}
+
+func NewStopTaskTimerService(
+	taskTimeEntryRepository taskrepo.TaskTimeEntryRepository,
+	taskRepository taskrepo.TaskRepository,
+	taskActionRepository taskrepo.TaskActionRepository,
+	projectUserRepository projectrepo.ProjectUserRepository,
+	transactionRepository core.TransactionRepository,
+) *StopTaskTimerService {
+	return &StopTaskTimerService{
+		TaskTimeEntryRepository: taskTimeEntryRepository,
+		TaskRepository:          taskRepository,
+		TaskActionRepository:    taskActionRepository,
+		ProjectUserRepository:   projectUserRepository,
+		TransactionRepository:   transactionRepository,
+	}
+}
+
+type StopTaskTimerInput struct {
+	OrganizationIdentity *core.Identity
+	TaskIdentity         core.Identity
+	UserIdentity         core.Identity
+}
+
+func (i StopTaskTimerInput) Validate() error { return nil }
+
+func (s *StopTaskTimerService) Execute(input StopTaskTimerInput) (*task.TaskTimeEntryDto, error) {
+	if err := input.Validate(); err != nil {
+		return nil, err
+	}
+
+	tx, err := s.TransactionRepository.BeginTransaction()
+	if err != nil {
+		return nil, err
+	}
+
+	s.TaskTimeEntryRepository.SetTransaction(tx)
+	s.TaskRepository.SetTransaction(tx)
+	s.TaskActionRepository.SetTransaction(tx)
+	s.ProjectUserRepository.SetTransaction(tx)
+
+	tsk, err := s.TaskRepository.GetTaskByIdentity(taskrepo.GetTaskByIdentityParams{
+		TaskIdentity:         input.TaskIdentity,
+		OrganizationIdentity: input.OrganizationIdentity,
+	})
+	if err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+
+	if tsk == nil {
+		tx.Rollback()
+		return nil, core.NewNotFoundError("task not found")
+	}
+
+	projectUser, err := s.ProjectUserRepository.GetProjectUserByIdentity(projectrepo.GetProjectUserByIdentityParams{
+		ProjectIdentity: tsk.ProjectIdentity,
+		UserIdentity:    input.UserIdentity,
+	})
+	if err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+
+	if projectUser == nil {
+		tx.Rollback()
+		return nil, core.NewNotFoundError("project user not found")
+	}
+
+	taskTimeEntry, err := s.TaskTimeEntryRepository.GetRunningTaskTimeEntry(taskrepo.GetRunningTaskTimeEntryParams{
+		UserIdentity: input.UserIdentity,
+	})
+	if err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+
+	if taskTimeEntry == nil || !taskTimeEntry.TaskIdentity.Equals(tsk.Identity) {
+		tx.Rollback()
+		return nil, core.NewNotFoundError("no running timer for this task")
+	}
+
+	err = taskTimeEntry.Stop()
+	if err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+
+	err = s.TaskTimeEntryRepository.UpdateTaskTimeEntry(taskrepo.UpdateTaskTimeEntryParams{
+		TaskTimeEntry: taskTimeEntry,
+	})
+	if err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+
+	taskAction := tsk.RegisterAction(task.TaskActionTypeAddTimeEntry, &projectUser.User)
+	_, err = s.TaskActionRepository.StoreTaskAction(taskrepo.StoreTaskActionParams{
+		TaskAction: &taskAction,
+	})
+	if err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+
+	return task.TaskTimeEntryToDto(taskTimeEntry), nil
+}
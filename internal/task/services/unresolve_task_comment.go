@@ -0,0 +1,128 @@
+package taskservice
+
+import (
+	"github.com/gabrielmrtt/taski/internal/core"
+	projectrepo "github.com/gabrielmrtt/taski/internal/project/repository"
+	"github.com/gabrielmrtt/taski/internal/task"
+	taskrepo "github.com/gabrielmrtt/taski/internal/task/repository"
+)
+
+type UnresolveTaskCommentService struct {
+	TaskCommentRepository taskrepo.TaskCommentRepository
+	TaskRepository        taskrepo.TaskRepository
+	ProjectUserRepository projectrepo.ProjectUserRepository
+	TaskActionRepository  taskrepo.TaskActionRepository
+	TransactionRepository core.TransactionRepository
+}
+
+func NewUnresolveTaskCommentService(
+	taskCommentRepository taskrepo.TaskCommentRepository,
+	taskRepository taskrepo.TaskRepository,
+	projectUserRepository projectrepo.ProjectUserRepository,
+	taskActionRepository taskrepo.TaskActionRepository,
+	transactionRepository core.TransactionRepository,
+) *UnresolveTaskCommentService {
+	return &UnresolveTaskCommentService{
+		TaskCommentRepository: taskCommentRepository,
+		TaskRepository:        taskRepository,
+		ProjectUserRepository: projectUserRepository,
+		TaskActionRepository:  taskActionRepository,
+		TransactionRepository: transactionRepository,
+	}
+}
+
+type UnresolveTaskCommentInput struct {
+	TaskIdentity        core.Identity
+	TaskCommentIdentity core.Identity
+	UserEditorIdentity  core.Identity
+}
+
+func (i UnresolveTaskCommentInput) Validate() error { return nil }
+
+func (s *UnresolveTaskCommentService) Execute(input UnresolveTaskCommentInput) error {
+	if err := input.Validate(); err != nil {
+		return err
+	}
+
+	tx, err := s.TransactionRepository.BeginTransaction()
+	if err != nil {
+		return err
+	}
+
+	s.TaskCommentRepository.SetTransaction(tx)
+	s.TaskRepository.SetTransaction(tx)
+	s.TaskActionRepository.SetTransaction(tx)
+	s.ProjectUserRepository.SetTransaction(tx)
+
+	tsk, err := s.TaskRepository.GetTaskByIdentity(taskrepo.GetTaskByIdentityParams{
+		TaskIdentity: input.TaskIdentity,
+	})
+	if err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	if tsk == nil {
+		tx.Rollback()
+		return core.NewNotFoundError("task not found")
+	}
+
+	userEditor, err := s.ProjectUserRepository.GetProjectUserByIdentity(projectrepo.GetProjectUserByIdentityParams{
+		ProjectIdentity: tsk.ProjectIdentity,
+		UserIdentity:    input.UserEditorIdentity,
+	})
+	if err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	if userEditor == nil {
+		tx.Rollback()
+		return core.NewNotFoundError("project user editor not found")
+	}
+
+	comment, err := s.TaskCommentRepository.GetTaskCommentByIdentity(taskrepo.GetTaskCommentByIdentityParams{
+		TaskCommentIdentity: input.TaskCommentIdentity,
+		TaskIdentity:        &input.TaskIdentity,
+	})
+	if err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	if comment == nil {
+		tx.Rollback()
+		return core.NewNotFoundError("task comment not found")
+	}
+
+	err = comment.Unresolve()
+	if err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	err = s.TaskCommentRepository.UpdateTaskComment(taskrepo.UpdateTaskCommentParams{
+		TaskComment: comment,
+	})
+	if err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	taskAction := tsk.RegisterAction(task.TaskActionTypeUnresolveComment, &userEditor.User)
+	_, err = s.TaskActionRepository.StoreTaskAction(taskrepo.StoreTaskActionParams{
+		TaskAction: &taskAction,
+	})
+	if err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	return nil
+}
@@ -4,15 +4,19 @@ import (
 	"github.com/gabrielmrtt/taski/internal/core"
 	projectrepo "github.com/gabrielmrtt/taski/internal/project/repository"
 	"github.com/gabrielmrtt/taski/internal/task"
+	storagerepo "github.com/gabrielmrtt/taski/internal/storage/repository"
+	storageservice "github.com/gabrielmrtt/taski/internal/storage/service"
 	taskrepo "github.com/gabrielmrtt/taski/internal/task/repository"
 )
 
 type UpdateTaskService struct {
-	TaskRepository                taskrepo.TaskRepository
-	TaskActionRepository          taskrepo.TaskActionRepository
-	ProjectTaskCategoryRepository projectrepo.ProjectTaskCategoryRepository
-	ProjectUserRepository         projectrepo.ProjectUserRepository
-	TransactionRepository         core.TransactionRepository
+	TaskRepository                   taskrepo.TaskRepository
+	TaskActionRepository             taskrepo.TaskActionRepository
+	ProjectTaskCategoryRepository    projectrepo.ProjectTaskCategoryRepository
+	ProjectUserRepository            projectrepo.ProjectUserRepository
+	ProjectTaskCustomFieldRepository projectrepo.ProjectTaskCustomFieldRepository
+	UploadedFileRepository           storagerepo.UploadedFileRepository
+	TransactionRepository            core.TransactionRepository
 }
 
 func NewUpdateTaskService(
@@ -20,14 +24,18 @@ func NewUpdateTaskService(
 	taskActionRepository taskrepo.TaskActionRepository,
 	projectTaskCategoryRepository projectrepo.ProjectTaskCategoryRepository,
 	projectUserRepository projectrepo.ProjectUserRepository,
+	projectTaskCustomFieldRepository projectrepo.ProjectTaskCustomFieldRepository,
+	uploadedFileRepository storagerepo.UploadedFileRepository,
 	transactionRepository core.TransactionRepository,
 ) *UpdateTaskService {
 	return &UpdateTaskService{
-		TaskRepository:                taskRepository,
-		TaskActionRepository:          taskActionRepository,
-		ProjectTaskCategoryRepository: projectTaskCategoryRepository,
-		ProjectUserRepository:         projectUserRepository,
-		TransactionRepository:         transactionRepository,
+		TaskRepository:                   taskRepository,
+		TaskActionRepository:             taskActionRepository,
+		ProjectTaskCategoryRepository:    projectTaskCategoryRepository,
+		ProjectUserRepository:            projectUserRepository,
+		ProjectTaskCustomFieldRepository: projectTaskCustomFieldRepository,
+		UploadedFileRepository:           uploadedFileRepository,
+		TransactionRepository:            transactionRepository,
 	}
 }
 
@@ -39,12 +47,15 @@ type UpdateTaskInput struct {
 	ParentTaskIdentity   *core.Identity
 	Name                 *string
 	Description          *string
+	DescriptionFormat    *core.ContentFormats
 	EstimatedMinutes     *int16
 	PriorityLevel        *task.TaskPriorityLevels
 	DueDate              *core.DateTime
 	Users                []*core.Identity
 	ChildrenTasks        []*core.Identity
+	CustomFields         []*TaskCustomFieldValueInput
 	UserEditorIdentity   core.Identity
+	ExpectedRevision     *int64
 }
 
 func (i UpdateTaskInput) Validate() error {
@@ -70,6 +81,15 @@ func (i UpdateTaskInput) Validate() error {
 		}
 	}
 
+	if i.DescriptionFormat != nil {
+		if _, err := core.NewContent("", *i.DescriptionFormat); err != nil {
+			fields = append(fields, core.InvalidInputErrorField{
+				Field: "description format",
+				Error: err.Error(),
+			})
+		}
+	}
+
 	if i.EstimatedMinutes != nil {
 		if *i.EstimatedMinutes < 0 {
 			fields = append(fields, core.InvalidInputErrorField{
@@ -108,6 +128,8 @@ func (s *UpdateTaskService) Execute(input UpdateTaskInput) error {
 	s.TaskRepository.SetTransaction(tx)
 	s.ProjectTaskCategoryRepository.SetTransaction(tx)
 	s.TaskActionRepository.SetTransaction(tx)
+	s.ProjectTaskCustomFieldRepository.SetTransaction(tx)
+	s.UploadedFileRepository.SetTransaction(tx)
 
 	tsk, err := s.TaskRepository.GetTaskByIdentity(taskrepo.GetTaskByIdentityParams{
 		TaskIdentity:         input.TaskIdentity,
@@ -123,6 +145,12 @@ func (s *UpdateTaskService) Execute(input UpdateTaskInput) error {
 		return core.NewNotFoundError("task not found")
 	}
 
+	err = core.CheckRevision(input.ExpectedRevision, tsk.Revision, "task")
+	if err != nil {
+		tx.Rollback()
+		return err
+	}
+
 	userEditor, err := s.ProjectUserRepository.GetProjectUserByIdentity(projectrepo.GetProjectUserByIdentityParams{
 		ProjectIdentity: tsk.ProjectIdentity,
 		UserIdentity:    input.UserEditorIdentity,
@@ -167,12 +195,44 @@ func (s *UpdateTaskService) Execute(input UpdateTaskInput) error {
 		}
 	}
 
-	if input.Description != nil {
-		err = tsk.ChangeDescription(*input.Description, &input.UserEditorIdentity)
+	if input.Description != nil || input.DescriptionFormat != nil {
+		previousDescription := tsk.FormattedDescription()
+
+		description := tsk.Description
+		if input.Description != nil {
+			description = *input.Description
+		}
+
+		var descriptionFormat core.ContentFormats = ""
+		if input.DescriptionFormat != nil {
+			descriptionFormat = *input.DescriptionFormat
+		}
+
+		err = tsk.ChangeDescription(description, descriptionFormat, &input.UserEditorIdentity)
+		if err != nil {
+			tx.Rollback()
+			return err
+		}
+
+		checkEmbeddedFilesService := storageservice.NewCheckEmbeddedFilesService(s.UploadedFileRepository)
+		err = checkEmbeddedFilesService.Execute(storageservice.CheckEmbeddedFilesInput{
+			Field:           "description",
+			Content:         tsk.FormattedDescription(),
+			PreviousContent: &previousDescription,
+			UserIdentity:    input.UserEditorIdentity,
+		})
+		if err != nil {
+			tx.Rollback()
+			return err
+		}
+
+		references, err := resolveTaskReferences(s.TaskRepository, s.ProjectUserRepository, tsk.ProjectIdentity, input.UserEditorIdentity, "description", description)
 		if err != nil {
 			tx.Rollback()
 			return err
 		}
+
+		tsk.ChangeReferences(references)
 	}
 
 	if input.EstimatedMinutes != nil {
@@ -200,7 +260,7 @@ func (s *UpdateTaskService) Execute(input UpdateTaskInput) error {
 	}
 
 	if input.Users != nil {
-		tsk.ClearUsers()
+		tsk.ClearUsers(&input.UserEditorIdentity)
 		for _, userIdentity := range input.Users {
 			user, err := s.ProjectUserRepository.GetProjectUserByIdentity(projectrepo.GetProjectUserByIdentityParams{
 				ProjectIdentity: tsk.ProjectIdentity,
@@ -218,7 +278,7 @@ func (s *UpdateTaskService) Execute(input UpdateTaskInput) error {
 
 			tsk.AddUser(&task.TaskUser{
 				User: &user.User,
-			})
+			}, &input.UserEditorIdentity)
 		}
 	}
 
@@ -272,6 +332,36 @@ func (s *UpdateTaskService) Execute(input UpdateTaskInput) error {
 		tsk.ParentTaskIdentity = input.ParentTaskIdentity
 	}
 
+	if input.CustomFields != nil {
+		customFields, err := s.ProjectTaskCustomFieldRepository.ListProjectTaskCustomFieldsBy(projectrepo.ListProjectTaskCustomFieldsByParams{
+			Filters: projectrepo.ProjectTaskCustomFieldFilters{
+				ProjectIdentity: &tsk.ProjectIdentity,
+			},
+		})
+		if err != nil {
+			tx.Rollback()
+			return err
+		}
+
+		customFieldValues, clearedCustomFields, err := resolveTaskCustomFieldValues(s.ProjectUserRepository, tsk.ProjectIdentity, customFields, input.CustomFields)
+		if err != nil {
+			tx.Rollback()
+			return err
+		}
+
+		for _, customField := range clearedCustomFields {
+			err = tsk.RemoveCustomFieldValue(customField, &input.UserEditorIdentity)
+			if err != nil {
+				tx.Rollback()
+				return err
+			}
+		}
+
+		for _, customFieldValue := range customFieldValues {
+			tsk.SetCustomFieldValue(customFieldValue, &input.UserEditorIdentity)
+		}
+	}
+
 	err = s.TaskRepository.UpdateTask(taskrepo.UpdateTaskParams{
 		Task: tsk,
 	})
@@ -43,6 +43,7 @@ type UpdateTaskCommentInput struct {
 	TaskIdentity        core.Identity
 	TaskCommentIdentity core.Identity
 	Content             *string
+	ContentFormat       *core.ContentFormats
 	Files               []core.FileInput
 	UserEditorIdentity  core.Identity
 }
@@ -59,6 +60,15 @@ func (i UpdateTaskCommentInput) Validate() error {
 		}
 	}
 
+	if i.ContentFormat != nil {
+		if _, err := core.NewContent("", *i.ContentFormat); err != nil {
+			fields = append(fields, core.InvalidInputErrorField{
+				Field: "content format",
+				Error: err.Error(),
+			})
+		}
+	}
+
 	if len(fields) > 0 {
 		return core.NewInvalidInputError("invalid input", fields)
 	}
@@ -121,12 +131,49 @@ func (s *UpdateTaskCommentService) Execute(input UpdateTaskCommentInput) error {
 		return core.NewNotFoundError("task comment not found")
 	}
 
-	if input.Content != nil {
-		err = comment.ChangeContent(*input.Content)
+	if comment.IsDeleted() {
+		tx.Rollback()
+		return core.NewConflictError("task comment is deleted")
+	}
+
+	if input.Content != nil || input.ContentFormat != nil {
+		previousContent := comment.FormattedContent()
+
+		content := comment.Content
+		if input.Content != nil {
+			content = *input.Content
+		}
+
+		var contentFormat core.ContentFormats = ""
+		if input.ContentFormat != nil {
+			contentFormat = *input.ContentFormat
+		}
+
+		err = comment.ChangeContent(content, contentFormat, &input.UserEditorIdentity)
+		if err != nil {
+			tx.Rollback()
+			return err
+		}
+
+		checkEmbeddedFilesService := storageservice.NewCheckEmbeddedFilesService(s.UploadedFileRepository)
+		err = checkEmbeddedFilesService.Execute(storageservice.CheckEmbeddedFilesInput{
+			Field:           "content",
+			Content:         comment.FormattedContent(),
+			PreviousContent: &previousContent,
+			UserIdentity:    input.UserEditorIdentity,
+		})
 		if err != nil {
 			tx.Rollback()
 			return err
 		}
+
+		references, err := resolveTaskReferences(s.TaskRepository, s.ProjectUserRepository, tsk.ProjectIdentity, input.UserEditorIdentity, "content", content)
+		if err != nil {
+			tx.Rollback()
+			return err
+		}
+
+		comment.ChangeReferences(references)
 	}
 
 	if len(input.Files) > 0 {
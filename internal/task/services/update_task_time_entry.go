@@ -0,0 +1,175 @@
+package taskservice
+
+import (
+	"github.com/gabrielmrtt/taski/internal/core"
+	projectrepo "github.com/gabrielmrtt/taski/internal/project/repository"
+	"github.com/gabrielmrtt/taski/internal/task"
+	taskrepo "github.com/gabrielmrtt/taski/internal/task/repository"
+)
+
+type UpdateTaskTimeEntryService struct {
+	TaskTimeEntryRepository taskrepo.TaskTimeEntryRepository
+	TaskRepository          taskrepo.TaskRepository
+	TaskActionRepository    taskrepo.TaskActionRepository
+	ProjectUserRepository   projectrepo.ProjectUserRepository
+	TransactionRepository   core.TransactionRepository
+}
+
+func NewUpdateTaskTimeEntryService(
+	taskTimeEntryRepository taskrepo.TaskTimeEntryRepository,
+	taskRepository taskrepo.TaskRepository,
+	taskActionRepository taskrepo.TaskActionRepository,
+	projectUserRepository projectrepo.ProjectUserRepository,
+	transactionRepository core.TransactionRepository,
+) *UpdateTaskTimeEntryService {
+	return &UpdateTaskTimeEntryService{
+		TaskTimeEntryRepository: taskTimeEntryRepository,
+		TaskRepository:          taskRepository,
+		TaskActionRepository:    taskActionRepository,
+		ProjectUserRepository:   projectUserRepository,
+		TransactionRepository:   transactionRepository,
+	}
+}
+
+type UpdateTaskTimeEntryInput struct {
+	OrganizationIdentity  *core.Identity
+	TaskIdentity          core.Identity
+	TaskTimeEntryIdentity core.Identity
+	Description           *string
+	StartedAt             *core.DateTime
+	EndedAt               *core.DateTime
+	UserEditorIdentity    core.Identity
+}
+
+func (i UpdateTaskTimeEntryInput) Validate() error {
+	var fields []core.InvalidInputErrorField
+
+	if i.Description != nil && *i.Description != "" {
+		if _, err := core.NewDescription(*i.Description); err != nil {
+			fields = append(fields, core.InvalidInputErrorField{
+				Field: "description",
+				Error: err.Error(),
+			})
+		}
+	}
+
+	if len(fields) > 0 {
+		return core.NewInvalidInputError("invalid input", fields)
+	}
+
+	return nil
+}
+
+func (s *UpdateTaskTimeEntryService) Execute(input UpdateTaskTimeEntryInput) error {
+	if err := input.Validate(); err != nil {
+		return err
+	}
+
+	tx, err := s.TransactionRepository.BeginTransaction()
+	if err != nil {
+		return err
+	}
+
+	s.TaskTimeEntryRepository.SetTransaction(tx)
+	s.TaskRepository.SetTransaction(tx)
+	s.TaskActionRepository.SetTransaction(tx)
+	s.ProjectUserRepository.SetTransaction(tx)
+
+	tsk, err := s.TaskRepository.GetTaskByIdentity(taskrepo.GetTaskByIdentityParams{
+		TaskIdentity:         input.TaskIdentity,
+		OrganizationIdentity: input.OrganizationIdentity,
+	})
+	if err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	if tsk == nil {
+		tx.Rollback()
+		return core.NewNotFoundError("task not found")
+	}
+
+	userEditor, err := s.ProjectUserRepository.GetProjectUserByIdentity(projectrepo.GetProjectUserByIdentityParams{
+		ProjectIdentity: tsk.ProjectIdentity,
+		UserIdentity:    input.UserEditorIdentity,
+	})
+	if err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	if userEditor == nil {
+		tx.Rollback()
+		return core.NewNotFoundError("project user editor not found")
+	}
+
+	taskTimeEntry, err := s.TaskTimeEntryRepository.GetTaskTimeEntryByIdentity(taskrepo.GetTaskTimeEntryByIdentityParams{
+		TaskTimeEntryIdentity: input.TaskTimeEntryIdentity,
+		TaskIdentity:          &tsk.Identity,
+	})
+	if err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	if taskTimeEntry == nil {
+		tx.Rollback()
+		return core.NewNotFoundError("task time entry not found")
+	}
+
+	if !taskTimeEntry.IsOwnedBy(input.UserEditorIdentity) {
+		tx.Rollback()
+		return core.NewUnauthorizedError("only the owner can update a time entry")
+	}
+
+	if input.Description != nil {
+		err = taskTimeEntry.ChangeDescription(*input.Description)
+		if err != nil {
+			tx.Rollback()
+			return err
+		}
+	}
+
+	if input.StartedAt != nil || input.EndedAt != nil {
+		startedAt := taskTimeEntry.StartedAt
+		if input.StartedAt != nil {
+			startedAt = *input.StartedAt
+		}
+
+		endedAt := taskTimeEntry.EndedAt
+		if input.EndedAt != nil {
+			endedAt = input.EndedAt
+		}
+
+		err = taskTimeEntry.ChangePeriod(startedAt, endedAt)
+		if err != nil {
+			tx.Rollback()
+			return err
+		}
+	}
+
+	err = s.TaskTimeEntryRepository.UpdateTaskTimeEntry(taskrepo.UpdateTaskTimeEntryParams{
+		TaskTimeEntry: taskTimeEntry,
+	})
+	if err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	taskAction := tsk.RegisterAction(task.TaskActionTypeUpdateTimeEntry, &userEditor.User)
+	_, err = s.TaskActionRepository.StoreTaskAction(taskrepo.StoreTaskActionParams{
+		TaskAction: &taskAction,
+	})
+	if err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,184 @@
+package taskservice
+
+import (
+	"github.com/gabrielmrtt/taski/internal/core"
+	projectrepo "github.com/gabrielmrtt/taski/internal/project/repository"
+	"github.com/gabrielmrtt/taski/internal/task"
+	taskrepo "github.com/gabrielmrtt/taski/internal/task/repository"
+)
+
+type UpdateTaskViewService struct {
+	TaskViewRepository    taskrepo.TaskViewRepository
+	ProjectRepository     projectrepo.ProjectRepository
+	ProjectUserRepository projectrepo.ProjectUserRepository
+	TransactionRepository core.TransactionRepository
+}
+
+func NewUpdateTaskViewService(
+	taskViewRepository taskrepo.TaskViewRepository,
+	projectRepository projectrepo.ProjectRepository,
+	projectUserRepository projectrepo.ProjectUserRepository,
+	transactionRepository core.TransactionRepository,
+) *UpdateTaskViewService {
+	return &UpdateTaskViewService{
+		TaskViewRepository:    taskViewRepository,
+		ProjectRepository:     projectRepository,
+		ProjectUserRepository: projectUserRepository,
+		TransactionRepository: transactionRepository,
+	}
+}
+
+type UpdateTaskViewInput struct {
+	OrganizationIdentity *core.Identity
+	TaskViewIdentity     core.Identity
+	UserEditorIdentity   core.Identity
+	Name                 *string
+	Visibility           *task.TaskViewVisibilities
+	ProjectIdentity      *core.Identity
+	Filters              *map[string]string
+	SortBy               *string
+	SortDirection        *core.SortDirection
+	GroupBy              *task.TaskGroupByFields
+}
+
+func (i UpdateTaskViewInput) Validate() error {
+	var fields []core.InvalidInputErrorField
+
+	if i.OrganizationIdentity == nil {
+		fields = append(fields, core.InvalidInputErrorField{
+			Field: "organization_identity",
+			Error: "organization identity is required",
+		})
+	}
+
+	if i.Name != nil {
+		if _, err := core.NewName(*i.Name); err != nil {
+			fields = append(fields, core.InvalidInputErrorField{
+				Field: "name",
+				Error: err.Error(),
+			})
+		}
+	}
+
+	if len(fields) > 0 {
+		return core.NewInvalidInputError("invalid input", fields)
+	}
+
+	return nil
+}
+
+func (s *UpdateTaskViewService) Execute(input UpdateTaskViewInput) (*task.TaskViewDto, error) {
+	if err := input.Validate(); err != nil {
+		return nil, err
+	}
+
+	tx, err := s.TransactionRepository.BeginTransaction()
+	if err != nil {
+		return nil, err
+	}
+
+	s.TaskViewRepository.SetTransaction(tx)
+	s.ProjectRepository.SetTransaction(tx)
+	s.ProjectUserRepository.SetTransaction(tx)
+
+	taskView, err := s.TaskViewRepository.GetTaskViewByIdentity(taskrepo.GetTaskViewByIdentityParams{
+		TaskViewIdentity:     input.TaskViewIdentity,
+		OrganizationIdentity: input.OrganizationIdentity,
+	})
+	if err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+
+	if taskView == nil {
+		tx.Rollback()
+		return nil, core.NewNotFoundError("task view not found")
+	}
+
+	if !taskView.IsOwnedBy(input.UserEditorIdentity) {
+		tx.Rollback()
+		return nil, core.NewUnauthorizedError("only the owner can update a task view")
+	}
+
+	if input.Name != nil {
+		err = taskView.ChangeName(*input.Name)
+		if err != nil {
+			tx.Rollback()
+			return nil, err
+		}
+	}
+
+	if input.Visibility != nil || input.ProjectIdentity != nil {
+		visibility := taskView.Visibility
+		if input.Visibility != nil {
+			visibility = *input.Visibility
+		}
+
+		projectIdentity := taskView.ProjectIdentity
+		if input.ProjectIdentity != nil {
+			err = checkTaskViewProjectMembership(s.ProjectRepository, s.ProjectUserRepository, *input.OrganizationIdentity, *input.ProjectIdentity, input.UserEditorIdentity)
+			if err != nil {
+				tx.Rollback()
+				return nil, err
+			}
+
+			projectIdentity = input.ProjectIdentity
+		}
+
+		err = taskView.ChangeVisibility(visibility, projectIdentity)
+		if err != nil {
+			tx.Rollback()
+			return nil, err
+		}
+	}
+
+	if input.Filters != nil {
+		err = taskView.ChangeFilters(*input.Filters)
+		if err != nil {
+			tx.Rollback()
+			return nil, err
+		}
+	}
+
+	if input.SortBy != nil || input.SortDirection != nil {
+		sortBy := taskView.SortBy
+		if input.SortBy != nil {
+			sortBy = input.SortBy
+		}
+
+		sortDirection := taskView.SortDirection
+		if input.SortDirection != nil {
+			sortDirection = input.SortDirection
+		}
+
+		err = taskView.ChangeSort(sortBy, sortDirection)
+		if err != nil {
+			tx.Rollback()
+			return nil, err
+		}
+	}
+
+	if input.GroupBy != nil {
+		err = taskView.ChangeGroupBy(input.GroupBy)
+		if err != nil {
+			tx.Rollback()
+			return nil, err
+		}
+	}
+
+	err = s.TaskViewRepository.UpdateTaskView(taskrepo.UpdateTaskViewParams{
+		TaskView: taskView,
+	})
+	if err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+
+	return task.TaskViewToDto(taskView), nil
+}
@@ -2,6 +2,7 @@ package task
 
 import (
 	"strings"
+	"unicode"
 
 	"github.com/gabrielmrtt/taski/internal/core"
 	"golang.org/x/net/html"
@@ -47,3 +48,47 @@ func (c TaskCommentContent) Validate() error {
 
 	return nil
 }
+
+type TaskCommentReactionEmoji struct {
+	Value string
+}
+
+func NewTaskCommentReactionEmoji(value string) (TaskCommentReactionEmoji, error) {
+	e := TaskCommentReactionEmoji{Value: value}
+	if err := e.Validate(); err != nil {
+		return TaskCommentReactionEmoji{}, err
+	}
+	return e, nil
+}
+
+/*
+Validate accepts a single emoji or emoji sequence: letters, spaces and control characters are rejected, and at least
+one symbol, or the keycap mark of sequences like 1️⃣, must be present.
+*/
+func (e TaskCommentReactionEmoji) Validate() error {
+	field := core.InvalidInputErrorField{
+		Field: "emoji",
+		Error: "emoji must be a single emoji",
+	}
+
+	if e.Value == "" || len(e.Value) > TaskCommentReactionEmojiMaxLength {
+		return core.NewInvalidInputError("emoji must be a single emoji", []core.InvalidInputErrorField{field})
+	}
+
+	var hasSymbol bool = false
+	for _, r := range e.Value {
+		if unicode.IsLetter(r) || unicode.IsSpace(r) || unicode.IsControl(r) {
+			return core.NewInvalidInputError("emoji must be a single emoji", []core.InvalidInputErrorField{field})
+		}
+
+		if unicode.Is(unicode.So, r) || r == '\u20E3' {
+			hasSymbol = true
+		}
+	}
+
+	if !hasSymbol {
+		return core.NewInvalidInputError("emoji must be a single emoji", []core.InvalidInputErrorField{field})
+	}
+
+	return nil
+}
@@ -113,6 +113,26 @@ func (t *TeamUserTable) ToEntity() *team.TeamUser {
 	}
 }
 
+var teamSortableFields = coredatabase.SortableFields{
+	Fields: map[string]string{
+		"name":      "team.name",
+		"status":    "team.status",
+		"createdAt": "team.created_at",
+		"updatedAt": "team.updated_at",
+	},
+	IdColumn: "team.internal_id",
+}
+
+var teamIncludableRelations = coredatabase.IncludableRelations{
+	Relations: []string{
+		"Members.User",
+		"Creator",
+		"Editor",
+		"Organization",
+	},
+	MaxDepth: 2,
+}
+
 type TeamBunRepository struct {
 	db *bun.DB
 	tx *coredatabase.TransactionBun
@@ -165,9 +185,13 @@ func (r *TeamBunRepository) GetTeamByIdentity(params teamrepo.GetTeamByIdentityP
 
 	selectQuery = selectQuery.Model(team)
 	selectQuery = selectQuery.Relation("Members.User").Relation("Members.User.Credentials").Relation("Members.User.Data")
-	selectQuery = coredatabase.ApplyRelations(selectQuery, params.RelationsInput)
+	selectQuery, err := coredatabase.ApplyRelations(selectQuery, teamIncludableRelations, params.RelationsInput)
+	if err != nil {
+		return nil, err
+	}
+
 	selectQuery = selectQuery.Where("internal_id = ?", params.TeamIdentity.Internal.String())
-	err := selectQuery.Scan(context.Background())
+	err = selectQuery.Scan(context.Background())
 	if err != nil {
 		if err == sql.ErrNoRows {
 			return nil, nil
@@ -197,14 +221,22 @@ func (r *TeamBunRepository) PaginateTeamsBy(params teamrepo.PaginateTeamsParams)
 
 	selectQuery = selectQuery.Model(&teams)
 	selectQuery = selectQuery.Relation("Members.User").Relation("Members.User.Credentials").Relation("Members.User.Data")
-	selectQuery = coredatabase.ApplyRelations(selectQuery, params.RelationsInput)
+	selectQuery, err := coredatabase.ApplyRelations(selectQuery, teamIncludableRelations, params.RelationsInput)
+	if err != nil {
+		return nil, err
+	}
+
 	selectQuery = r.applyFilters(selectQuery, params.Filters)
 	countBeforePagination, err := selectQuery.Count(context.Background())
 	if err != nil {
 		return nil, err
 	}
 
-	selectQuery = coredatabase.ApplySort(selectQuery, params.SortInput)
+	selectQuery, err = coredatabase.ApplySort(selectQuery, teamSortableFields, params.SortInput)
+	if err != nil {
+		return nil, err
+	}
+
 	selectQuery = coredatabase.ApplyPagination(selectQuery, params.Pagination)
 	err = selectQuery.Scan(context.Background())
 	if err != nil {
@@ -94,6 +94,24 @@ type UserDataTable struct {
 	ProfilePictureInternalId *string `bun:"profile_picture_internal_id,type:uuid"`
 }
 
+var userSortableFields = coredatabase.SortableFields{
+	Fields: map[string]string{
+		"name":      "(SELECT user_data.display_name FROM user_data WHERE user_data.user_internal_id = users.internal_id)",
+		"email":     "(SELECT user_credentials.email FROM user_credentials WHERE user_credentials.user_internal_id = users.internal_id)",
+		"status":    "users.status",
+		"createdAt": "users.created_at",
+		"updatedAt": "users.updated_at",
+	},
+	IdColumn: "users.internal_id",
+}
+
+var userIncludableRelations = coredatabase.IncludableRelations{
+	Relations: []string{
+		"Data",
+	},
+	MaxDepth: 1,
+}
+
 type UserBunRepository struct {
 	db *bun.DB
 	tx *coredatabase.TransactionBun
@@ -156,9 +174,13 @@ func (r *UserBunRepository) GetUserByIdentity(params userrepo.GetUserByIdentityP
 
 	selectQuery = selectQuery.Model(user)
 	selectQuery = selectQuery.Relation("Credentials").Relation("Data")
-	selectQuery = coredatabase.ApplyRelations(selectQuery, params.RelationsInput)
+	selectQuery, err := coredatabase.ApplyRelations(selectQuery, userIncludableRelations, params.RelationsInput)
+	if err != nil {
+		return nil, err
+	}
+
 	selectQuery = selectQuery.Where("users.internal_id = ?", params.UserIdentity.Internal)
-	err := selectQuery.Scan(context.Background())
+	err = selectQuery.Scan(context.Background())
 	if err != nil {
 		if err == sql.ErrNoRows {
 			return nil, nil
@@ -186,9 +208,13 @@ func (r *UserBunRepository) GetUserByEmail(params userrepo.GetUserByEmailParams)
 
 	selectQuery = selectQuery.Model(user)
 	selectQuery = selectQuery.Relation("Credentials").Relation("Data")
-	selectQuery = coredatabase.ApplyRelations(selectQuery, params.RelationsInput)
+	selectQuery, err := coredatabase.ApplyRelations(selectQuery, userIncludableRelations, params.RelationsInput)
+	if err != nil {
+		return nil, err
+	}
+
 	selectQuery = selectQuery.Where("credentials.email = ?", params.Email)
-	err := selectQuery.Scan(context.Background())
+	err = selectQuery.Scan(context.Background())
 	if err != nil {
 		if err == sql.ErrNoRows {
 			return nil, nil
@@ -226,14 +252,22 @@ func (r *UserBunRepository) PaginateUsersBy(params userrepo.PaginateUsersParams)
 
 	selectQuery = selectQuery.Model(&users)
 	selectQuery = selectQuery.Relation("Credentials").Relation("Data")
-	selectQuery = coredatabase.ApplyRelations(selectQuery, params.RelationsInput)
+	selectQuery, err := coredatabase.ApplyRelations(selectQuery, userIncludableRelations, params.RelationsInput)
+	if err != nil {
+		return nil, err
+	}
+
 	selectQuery = r.applyFilters(selectQuery, params.Filters)
 	countBeforePagination, err := selectQuery.Count(context.Background())
 	if err != nil {
 		return nil, err
 	}
 
-	selectQuery = coredatabase.ApplySort(selectQuery, params.SortInput)
+	selectQuery, err = coredatabase.ApplySort(selectQuery, userSortableFields, params.SortInput)
+	if err != nil {
+		return nil, err
+	}
+
 	selectQuery = coredatabase.ApplyPagination(selectQuery, params.Pagination)
 	err = selectQuery.Scan(context.Background())
 	if err != nil {
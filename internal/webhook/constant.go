@@ -0,0 +1,110 @@
+package webhook
+
+import "github.com/gabrielmrtt/taski/internal/task"
+
+const WebhookIdentityPrefix = "whk"
+
+const WebhookDeliveryIdentityPrefix = "whd"
+
+const WebhookEventIdentityPrefix = "whe"
+
+const WebhookSecretLength = 40
+
+type WebhookStatuses string
+
+const (
+	WebhookStatusActive   WebhookStatuses = "active"
+	WebhookStatusInactive WebhookStatuses = "inactive"
+)
+
+type WebhookEventTypes string
+
+const (
+	WebhookEventTypeAll                       WebhookEventTypes = "*"
+	WebhookEventTypePing                      WebhookEventTypes = "ping"
+	WebhookEventTypeProjectCreated            WebhookEventTypes = "project_created"
+	WebhookEventTypeProjectUpdated            WebhookEventTypes = "project_updated"
+	WebhookEventTypeProjectDeleted            WebhookEventTypes = "project_deleted"
+	WebhookEventTypeProjectMemberAdded        WebhookEventTypes = "project_member_added"
+	WebhookEventTypeProjectMemberRemoved      WebhookEventTypes = "project_member_removed"
+	WebhookEventTypeOrganizationMemberInvited WebhookEventTypes = "organization_member_invited"
+	WebhookEventTypeOrganizationMemberJoined  WebhookEventTypes = "organization_member_joined"
+	WebhookEventTypeOrganizationMemberRemoved WebhookEventTypes = "organization_member_removed"
+)
+
+/*
+WebhookEventTypesArray lists the event types a webhook can subscribe to. Task events use the task action types.
+*/
+var WebhookEventTypesArray = []WebhookEventTypes{
+	WebhookEventTypeAll,
+	WebhookEventTypes(task.TaskActionTypeCreate),
+	WebhookEventTypes(task.TaskActionTypeUpdate),
+	WebhookEventTypes(task.TaskActionTypeDelete),
+	WebhookEventTypes(task.TaskActionTypeChangeStatus),
+	WebhookEventTypes(task.TaskActionTypeMove),
+	WebhookEventTypes(task.TaskActionTypeComplete),
+	WebhookEventTypes(task.TaskActionTypeUncomplete),
+	WebhookEventTypes(task.TaskActionTypeAddSubTask),
+	WebhookEventTypes(task.TaskActionTypeRemoveSubTask),
+	WebhookEventTypes(task.TaskActionTypeUpdateSubTask),
+	WebhookEventTypes(task.TaskActionTypeSubTaskComplete),
+	WebhookEventTypes(task.TaskActionTypeSubTaskUncomplete),
+	WebhookEventTypes(task.TaskActionTypeAddComment),
+	WebhookEventTypes(task.TaskActionTypeUpdateComment),
+	WebhookEventTypes(task.TaskActionTypeDeleteComment),
+	WebhookEventTypes(task.TaskActionTypeResolveComment),
+	WebhookEventTypes(task.TaskActionTypeUnresolveComment),
+	WebhookEventTypes(task.TaskActionTypeAddTimeEntry),
+	WebhookEventTypes(task.TaskActionTypeUpdateTimeEntry),
+	WebhookEventTypes(task.TaskActionTypeDeleteTimeEntry),
+	WebhookEventTypeProjectCreated,
+	WebhookEventTypeProjectUpdated,
+	WebhookEventTypeProjectDeleted,
+	WebhookEventTypeProjectMemberAdded,
+	WebhookEventTypeProjectMemberRemoved,
+	WebhookEventTypeOrganizationMemberInvited,
+	WebhookEventTypeOrganizationMemberJoined,
+	WebhookEventTypeOrganizationMemberRemoved,
+}
+
+type WebhookDeliveryStatuses string
+
+const (
+	WebhookDeliveryStatusPending   WebhookDeliveryStatuses = "pending"
+	WebhookDeliveryStatusSucceeded WebhookDeliveryStatuses = "succeeded"
+	WebhookDeliveryStatusFailed    WebhookDeliveryStatuses = "failed"
+)
+
+var WebhookDeliveryStatusesArray = []WebhookDeliveryStatuses{
+	WebhookDeliveryStatusPending,
+	WebhookDeliveryStatusSucceeded,
+	WebhookDeliveryStatusFailed,
+}
+
+/*
+Failed deliveries are retried with exponential backoff: the n-th retry waits WebhookDeliveryBackoffSeconds * 2^(n-1)
+seconds. A delivery is marked as failed after WebhookDeliveryMaxAttempts attempts.
+*/
+const (
+	WebhookDeliveryMaxAttempts          = 6
+	WebhookDeliveryBackoffSeconds       = 30
+	WebhookDeliveryTimeoutSeconds       = 10
+	WebhookDeliveryLeaseSeconds         = 60
+	WebhookDeliveryMaxResponseBodyBytes = 4096
+)
+
+/*
+The dispatcher looks for due deliveries every WebhookDispatcherIntervalSeconds and sends at most
+WebhookDispatcherBatchSize of them per run.
+*/
+const (
+	WebhookDispatcherIntervalSeconds = 5
+	WebhookDispatcherBatchSize       = 50
+)
+
+const (
+	WebhookEventHeader     = "X-Taski-Event"
+	WebhookDeliveryHeader  = "X-Taski-Delivery"
+	WebhookTimestampHeader = "X-Taski-Timestamp"
+	WebhookSignatureHeader = "X-Taski-Signature"
+)
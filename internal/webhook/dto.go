@@ -0,0 +1,156 @@
+package webhook
+
+import (
+	"github.com/gabrielmrtt/taski/internal/organization"
+	"github.com/gabrielmrtt/taski/internal/project"
+	"github.com/gabrielmrtt/taski/internal/task"
+	"github.com/gabrielmrtt/taski/internal/user"
+)
+
+type WebhookDto struct {
+	Id            string   `json:"id"`
+	Url           string   `json:"url"`
+	Secret        *string  `json:"secret,omitempty"`
+	Events        []string `json:"events"`
+	Status        string   `json:"status"`
+	UserCreatorId *string  `json:"userCreatorId"`
+	UserEditorId  *string  `json:"userEditorId"`
+	CreatedAt     string   `json:"createdAt"`
+	UpdatedAt     *string  `json:"updatedAt"`
+}
+
+func WebhookToDto(webhook *Webhook) *WebhookDto {
+	var events []string = make([]string, 0)
+	for _, event := range webhook.Events {
+		events = append(events, string(event))
+	}
+
+	var userCreatorId *string = nil
+	if webhook.UserCreatorIdentity != nil {
+		userCreatorId = &webhook.UserCreatorIdentity.Public
+	}
+
+	var userEditorId *string = nil
+	if webhook.UserEditorIdentity != nil {
+		userEditorId = &webhook.UserEditorIdentity.Public
+	}
+
+	var updatedAt *string = nil
+	if webhook.Timestamps.UpdatedAt != nil {
+		updatedAtString := webhook.Timestamps.UpdatedAt.ToRFC3339()
+		updatedAt = &updatedAtString
+	}
+
+	return &WebhookDto{
+		Id:            webhook.Identity.Public,
+		Url:           webhook.Url,
+		Events:        events,
+		Status:        string(webhook.Status),
+		UserCreatorId: userCreatorId,
+		UserEditorId:  userEditorId,
+		CreatedAt:     webhook.Timestamps.CreatedAt.ToRFC3339(),
+		UpdatedAt:     updatedAt,
+	}
+}
+
+/*
+WebhookWithSecretToDto includes the signing secret, which is only returned when the webhook is created.
+*/
+func WebhookWithSecretToDto(webhook *Webhook) *WebhookDto {
+	webhookDto := WebhookToDto(webhook)
+	webhookDto.Secret = &webhook.Secret
+	return webhookDto
+}
+
+type WebhookDeliveryDto struct {
+	Id             string  `json:"id"`
+	WebhookId      string  `json:"webhookId"`
+	EventId        string  `json:"eventId"`
+	EventType      string  `json:"eventType"`
+	Payload        string  `json:"payload"`
+	Status         string  `json:"status"`
+	Attempts       int16   `json:"attempts"`
+	NextAttemptAt  *string `json:"nextAttemptAt"`
+	LastAttemptAt  *string `json:"lastAttemptAt"`
+	ResponseStatus *int    `json:"responseStatus"`
+	Error          *string `json:"error"`
+	CreatedAt      string  `json:"createdAt"`
+	CompletedAt    *string `json:"completedAt"`
+}
+
+func WebhookDeliveryToDto(webhookDelivery *WebhookDelivery) *WebhookDeliveryDto {
+	var nextAttemptAt *string = nil
+	if webhookDelivery.NextAttemptAt != nil {
+		nextAttemptAtString := webhookDelivery.NextAttemptAt.ToRFC3339()
+		nextAttemptAt = &nextAttemptAtString
+	}
+
+	var lastAttemptAt *string = nil
+	if webhookDelivery.LastAttemptAt != nil {
+		lastAttemptAtString := webhookDelivery.LastAttemptAt.ToRFC3339()
+		lastAttemptAt = &lastAttemptAtString
+	}
+
+	var completedAt *string = nil
+	if webhookDelivery.CompletedAt != nil {
+		completedAtString := webhookDelivery.CompletedAt.ToRFC3339()
+		completedAt = &completedAtString
+	}
+
+	return &WebhookDeliveryDto{
+		Id:             webhookDelivery.Identity.Public,
+		WebhookId:      webhookDelivery.WebhookIdentity.Public,
+		EventId:        webhookDelivery.EventId,
+		EventType:      string(webhookDelivery.EventType),
+		Payload:        webhookDelivery.Payload,
+		Status:         string(webhookDelivery.Status),
+		Attempts:       webhookDelivery.Attempts,
+		NextAttemptAt:  nextAttemptAt,
+		LastAttemptAt:  lastAttemptAt,
+		ResponseStatus: webhookDelivery.ResponseStatus,
+		Error:          webhookDelivery.Error,
+		CreatedAt:      webhookDelivery.CreatedAt.ToRFC3339(),
+		CompletedAt:    completedAt,
+	}
+}
+
+/*
+WebhookEventDto is the JSON body sent to webhooks. Id identifies the event and is kept across redeliveries so
+receivers can deduplicate.
+*/
+type WebhookEventDto struct {
+	Id             string      `json:"id"`
+	Type           string      `json:"type"`
+	OrganizationId string      `json:"organizationId"`
+	CreatedAt      string      `json:"createdAt"`
+	Data           interface{} `json:"data"`
+}
+
+func WebhookEventToDto(webhookEvent *WebhookEvent) *WebhookEventDto {
+	return &WebhookEventDto{
+		Id:             webhookEvent.Identity.Public,
+		Type:           string(webhookEvent.Type),
+		OrganizationId: webhookEvent.OrganizationIdentity.Public,
+		CreatedAt:      webhookEvent.CreatedAt.ToRFC3339(),
+		Data:           webhookEvent.Data,
+	}
+}
+
+type WebhookTaskEventDataDto struct {
+	Task   *task.TaskDto       `json:"task"`
+	Action *task.TaskActionDto `json:"action"`
+}
+
+type WebhookProjectEventDataDto struct {
+	Project *project.ProjectDto `json:"project"`
+}
+
+type WebhookProjectMemberEventDataDto struct {
+	ProjectId string        `json:"projectId"`
+	User      *user.UserDto `json:"user"`
+	Status    string        `json:"status"`
+}
+
+type WebhookOrganizationMemberEventDataDto struct {
+	Member *organization.OrganizationUserDto `json:"member"`
+}
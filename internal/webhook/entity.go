@@ -0,0 +1,265 @@
+package webhook
+
+import (
+	"fmt"
+	"slices"
+
+	"github.com/gabrielmrtt/taski/internal/core"
+	"github.com/gabrielmrtt/taski/pkg/hashutils"
+	"github.com/gabrielmrtt/taski/pkg/stringutils"
+)
+
+/*
+Webhook is an organization subscription that receives a signed HTTP request for every event of the subscribed types.
+*/
+type Webhook struct {
+	Identity             core.Identity
+	OrganizationIdentity core.Identity
+	Url                  string
+	Secret               string
+	Events               []WebhookEventTypes
+	Status               WebhookStatuses
+	UserCreatorIdentity  *core.Identity
+	UserEditorIdentity   *core.Identity
+	Timestamps           core.Timestamps
+}
+
+type NewWebhookInput struct {
+	OrganizationIdentity core.Identity
+	Url                  string
+	Events               []WebhookEventTypes
+	UserCreatorIdentity  *core.Identity
+}
+
+func NewWebhook(input NewWebhookInput) (*Webhook, error) {
+	now := core.NewDateTime()
+
+	webhook := &Webhook{
+		Identity:             core.NewIdentity(WebhookIdentityPrefix),
+		OrganizationIdentity: input.OrganizationIdentity,
+		Secret:               "whsec_" + stringutils.GenerateUniqueString(WebhookSecretLength),
+		Status:               WebhookStatusActive,
+		UserCreatorIdentity:  input.UserCreatorIdentity,
+		UserEditorIdentity:   nil,
+		Timestamps: core.Timestamps{
+			CreatedAt: &now,
+			UpdatedAt: nil,
+		},
+	}
+
+	if err := webhook.ChangeUrl(input.Url, input.UserCreatorIdentity); err != nil {
+		return nil, err
+	}
+
+	if err := webhook.ChangeEvents(input.Events, input.UserCreatorIdentity); err != nil {
+		return nil, err
+	}
+
+	webhook.UserEditorIdentity = nil
+	webhook.Timestamps.UpdatedAt = nil
+	return webhook, nil
+}
+
+func (w *Webhook) ChangeUrl(url string, userEditorIdentity *core.Identity) error {
+	if _, err := NewWebhookUrl(url); err != nil {
+		return err
+	}
+
+	w.Url = url
+	w.UserEditorIdentity = userEditorIdentity
+	now := core.NewDateTime()
+	w.Timestamps.UpdatedAt = &now
+	return nil
+}
+
+func (w *Webhook) ChangeEvents(events []WebhookEventTypes, userEditorIdentity *core.Identity) error {
+	var fields []core.InvalidInputErrorField
+
+	if len(events) == 0 {
+		fields = append(fields, core.InvalidInputErrorField{
+			Field: "events",
+			Error: "at least one event is required",
+		})
+	}
+
+	for _, event := range events {
+		if !slices.Contains(WebhookEventTypesArray, event) {
+			fields = append(fields, core.InvalidInputErrorField{
+				Field: "events",
+				Error: "event " + string(event) + " is not supported",
+			})
+		}
+	}
+
+	if len(fields) > 0 {
+		return core.NewInvalidInputError("invalid events", fields)
+	}
+
+	w.Events = events
+	w.UserEditorIdentity = userEditorIdentity
+	now := core.NewDateTime()
+	w.Timestamps.UpdatedAt = &now
+	return nil
+}
+
+func (w *Webhook) Activate(userEditorIdentity *core.Identity) {
+	w.Status = WebhookStatusActive
+	w.UserEditorIdentity = userEditorIdentity
+	now := core.NewDateTime()
+	w.Timestamps.UpdatedAt = &now
+}
+
+func (w *Webhook) Deactivate(userEditorIdentity *core.Identity) {
+	w.Status = WebhookStatusInactive
+	w.UserEditorIdentity = userEditorIdentity
+	now := core.NewDateTime()
+	w.Timestamps.UpdatedAt = &now
+}
+
+func (w *Webhook) IsActive() bool {
+	return w.Status == WebhookStatusActive
+}
+
+func (w *Webhook) IsSubscribedTo(eventType WebhookEventTypes) bool {
+	if eventType == WebhookEventTypePing {
+		return true
+	}
+
+	return slices.Contains(w.Events, WebhookEventTypeAll) || slices.Contains(w.Events, eventType)
+}
+
+/*
+Sign returns the value of the signature header: an HMAC-SHA256 of "<timestamp>.<payload>" keyed with the webhook
+secret. Receivers recompute it to check the request came from Taski and reject old timestamps to prevent replays.
+*/
+func (w *Webhook) Sign(timestamp int64, payload string) string {
+	return "sha256=" + hashutils.HmacSha256(w.Secret, fmt.Sprintf("%d.%s", timestamp, payload))
+}
+
+/*
+WebhookDelivery is one attempt chain to deliver an event to a webhook. Redelivering an event creates a new delivery
+with the same event id and payload so the log keeps every attempt.
+*/
+type WebhookDelivery struct {
+	Identity        core.Identity
+	WebhookIdentity core.Identity
+	EventId         string
+	EventType       WebhookEventTypes
+	Payload         string
+	Status          WebhookDeliveryStatuses
+	Attempts        int16
+	NextAttemptAt   *core.DateTime
+	LastAttemptAt   *core.DateTime
+	ResponseStatus  *int
+	Error           *string
+	CreatedAt       core.DateTime
+	CompletedAt     *core.DateTime
+}
+
+type NewWebhookDeliveryInput struct {
+	WebhookIdentity core.Identity
+	EventId         string
+	EventType       WebhookEventTypes
+	Payload         string
+}
+
+func NewWebhookDelivery(input NewWebhookDeliveryInput) *WebhookDelivery {
+	now := core.NewDateTime()
+
+	return &WebhookDelivery{
+		Identity:        core.NewIdentity(WebhookDeliveryIdentityPrefix),
+		WebhookIdentity: input.WebhookIdentity,
+		EventId:         input.EventId,
+		EventType:       input.EventType,
+		Payload:         input.Payload,
+		Status:          WebhookDeliveryStatusPending,
+		Attempts:        0,
+		NextAttemptAt:   &now,
+		CreatedAt:       now,
+	}
+}
+
+/*
+RecordAttempt stores the outcome of a delivery attempt. 2xx responses complete the delivery; anything else schedules
+a retry with exponential backoff until the maximum number of attempts is reached. Only the status of the response is
+kept, its body is never stored so the delivery log cannot be used to read what a webhook url answers.
+*/
+func (d *WebhookDelivery) RecordAttempt(responseStatus *int, deliveryError *string) {
+	now := core.NewDateTime()
+
+	d.Attempts++
+	d.LastAttemptAt = &now
+	d.ResponseStatus = responseStatus
+	d.Error = deliveryError
+
+	if deliveryError == nil && responseStatus != nil && *responseStatus >= 200 && *responseStatus < 300 {
+		d.Status = WebhookDeliveryStatusSucceeded
+		d.NextAttemptAt = nil
+		d.CompletedAt = &now
+		return
+	}
+
+	if d.Attempts >= WebhookDeliveryMaxAttempts {
+		d.Status = WebhookDeliveryStatusFailed
+		d.NextAttemptAt = nil
+		d.CompletedAt = &now
+		return
+	}
+
+	nextAttemptAt := core.DateTime{Value: now.Value + int64(WebhookDeliveryBackoffSeconds)<<(d.Attempts-1)}
+	d.Status = WebhookDeliveryStatusPending
+	d.NextAttemptAt = &nextAttemptAt
+}
+
+/*
+Fail completes the delivery without sending it, for example when its webhook was deactivated.
+*/
+func (d *WebhookDelivery) Fail(reason string) {
+	now := core.NewDateTime()
+
+	d.Status = WebhookDeliveryStatusFailed
+	d.Error = &reason
+	d.NextAttemptAt = nil
+	d.CompletedAt = &now
+}
+
+func (d *WebhookDelivery) Redeliver() *WebhookDelivery {
+	return NewWebhookDelivery(NewWebhookDeliveryInput{
+		WebhookIdentity: d.WebhookIdentity,
+		EventId:         d.EventId,
+		EventType:       d.EventType,
+		Payload:         d.Payload,
+	})
+}
+
+func (d *WebhookDelivery) IsPending() bool {
+	return d.Status == WebhookDeliveryStatusPending
+}
+
+/*
+WebhookEvent is something that happened in an organization. Publishing it creates one delivery for every active webhook
+of the organization subscribed to its type.
+*/
+type WebhookEvent struct {
+	Identity             core.Identity
+	Type                 WebhookEventTypes
+	OrganizationIdentity core.Identity
+	Data                 interface{}
+	CreatedAt            core.DateTime
+}
+
+type NewWebhookEventInput struct {
+	Type                 WebhookEventTypes
+	OrganizationIdentity core.Identity
+	Data                 interface{}
+}
+
+func NewWebhookEvent(input NewWebhookEventInput) *WebhookEvent {
+	return &WebhookEvent{
+		Identity:             core.NewIdentity(WebhookEventIdentityPrefix),
+		Type:                 input.Type,
+		OrganizationIdentity: input.OrganizationIdentity,
+		Data:                 input.Data,
+		CreatedAt:            core.NewDateTime(),
+	}
+}
@@ -0,0 +1,95 @@
+package webhook
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"testing"
+)
+
+func TestWebhookSign(t *testing.T) {
+	tests := []struct {
+		name      string
+		secret    string
+		timestamp int64
+		payload   string
+	}{
+		{name: "event payload", secret: "whsec_test", timestamp: 1700000000, payload: `{"type":"task_created"}`},
+		{name: "empty payload", secret: "whsec_test", timestamp: 1700000000, payload: ""},
+		{name: "other secret", secret: "whsec_other", timestamp: 1700000000, payload: `{"type":"task_created"}`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			wh := &Webhook{Secret: tt.secret}
+
+			mac := hmac.New(sha256.New, []byte(tt.secret))
+			mac.Write([]byte("1700000000." + tt.payload))
+			expected := "sha256=" + hex.EncodeToString(mac.Sum(nil))
+
+			if got := wh.Sign(tt.timestamp, tt.payload); got != expected {
+				t.Errorf("expected %s, got %s", expected, got)
+			}
+
+			if wh.Sign(tt.timestamp+1, tt.payload) == expected {
+				t.Error("expected the signature to depend on the timestamp")
+			}
+		})
+	}
+}
+
+func TestWebhookDeliveryRecordAttempt(t *testing.T) {
+	statusOk := 204
+	statusError := 500
+	deliveryError := "connection refused"
+
+	tests := []struct {
+		name           string
+		attempts       int16
+		responseStatus *int
+		deliveryError  *string
+		expectedStatus WebhookDeliveryStatuses
+		expectedDelay  int64
+	}{
+		{name: "success", attempts: 0, responseStatus: &statusOk, expectedStatus: WebhookDeliveryStatusSucceeded},
+		{name: "first failure", attempts: 0, responseStatus: &statusError, expectedStatus: WebhookDeliveryStatusPending, expectedDelay: WebhookDeliveryBackoffSeconds},
+		{name: "second failure", attempts: 1, responseStatus: &statusError, expectedStatus: WebhookDeliveryStatusPending, expectedDelay: WebhookDeliveryBackoffSeconds * 2},
+		{name: "network error", attempts: 2, deliveryError: &deliveryError, expectedStatus: WebhookDeliveryStatusPending, expectedDelay: WebhookDeliveryBackoffSeconds * 4},
+		{name: "error with success status", attempts: 0, responseStatus: &statusOk, deliveryError: &deliveryError, expectedStatus: WebhookDeliveryStatusPending, expectedDelay: WebhookDeliveryBackoffSeconds},
+		{name: "last retry", attempts: WebhookDeliveryMaxAttempts - 2, responseStatus: &statusError, expectedStatus: WebhookDeliveryStatusPending, expectedDelay: WebhookDeliveryBackoffSeconds << (WebhookDeliveryMaxAttempts - 2)},
+		{name: "attempts exhausted", attempts: WebhookDeliveryMaxAttempts - 1, responseStatus: &statusError, expectedStatus: WebhookDeliveryStatusFailed},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			delivery := NewWebhookDelivery(NewWebhookDeliveryInput{EventType: WebhookEventTypePing, Payload: "{}"})
+			delivery.Attempts = tt.attempts
+
+			delivery.RecordAttempt(tt.responseStatus, tt.deliveryError)
+
+			if delivery.Attempts != tt.attempts+1 {
+				t.Errorf("expected %d attempts, got %d", tt.attempts+1, delivery.Attempts)
+			}
+
+			if delivery.Status != tt.expectedStatus {
+				t.Fatalf("expected status %s, got %s", tt.expectedStatus, delivery.Status)
+			}
+
+			if tt.expectedStatus != WebhookDeliveryStatusPending {
+				if delivery.NextAttemptAt != nil || delivery.CompletedAt == nil {
+					t.Error("expected a completed delivery without a next attempt")
+				}
+
+				return
+			}
+
+			if delivery.NextAttemptAt == nil {
+				t.Fatal("expected a next attempt")
+			}
+
+			if delay := delivery.NextAttemptAt.Value - delivery.LastAttemptAt.Value; delay != tt.expectedDelay {
+				t.Errorf("expected a delay of %d seconds, got %d", tt.expectedDelay, delay)
+			}
+		})
+	}
+}
@@ -0,0 +1,91 @@
+package webhookinfra
+
+import (
+	"log"
+	"time"
+
+	"github.com/gabrielmrtt/taski/config"
+	coredatabase "github.com/gabrielmrtt/taski/internal/core/database"
+	corehttp "github.com/gabrielmrtt/taski/internal/core/http"
+	"github.com/gabrielmrtt/taski/internal/webhook"
+	webhookdatabase "github.com/gabrielmrtt/taski/internal/webhook/infra/database"
+	webhookhttp "github.com/gabrielmrtt/taski/internal/webhook/infra/http"
+	webhooksender "github.com/gabrielmrtt/taski/internal/webhook/infra/sender"
+	webhookservice "github.com/gabrielmrtt/taski/internal/webhook/service"
+	"github.com/gin-gonic/gin"
+	"github.com/uptrace/bun"
+)
+
+type BootstrapInfraOptions struct {
+	RouterGroup  *gin.RouterGroup
+	DbConnection *bun.DB
+}
+
+func BootstrapInfra(options BootstrapInfraOptions) {
+	webhook.AllowPrivateWebhookAddresses(config.GetInstance().WebhookAllowPrivateAddresses)
+
+	webhookRepository := webhookdatabase.NewWebhookBunRepository(options.DbConnection)
+	webhookDeliveryRepository := webhookdatabase.NewWebhookDeliveryBunRepository(options.DbConnection)
+	transactionRepository := coredatabase.NewTransactionBunRepository(options.DbConnection)
+
+	listWebhooksService := webhookservice.NewListWebhooksService(webhookRepository)
+	getWebhookService := webhookservice.NewGetWebhookService(webhookRepository)
+	createWebhookService := webhookservice.NewCreateWebhookService(webhookRepository, transactionRepository)
+	updateWebhookService := webhookservice.NewUpdateWebhookService(webhookRepository, transactionRepository)
+	deleteWebhookService := webhookservice.NewDeleteWebhookService(webhookRepository, transactionRepository)
+	pingWebhookService := webhookservice.NewPingWebhookService(webhookRepository, webhookDeliveryRepository, transactionRepository)
+	listWebhookDeliveriesService := webhookservice.NewListWebhookDeliveriesService(webhookRepository, webhookDeliveryRepository)
+	redeliverWebhookDeliveryService := webhookservice.NewRedeliverWebhookDeliveryService(webhookRepository, webhookDeliveryRepository, transactionRepository)
+
+	configureRoutesOptions := corehttp.ConfigureRoutesOptions{
+		DbConnection: options.DbConnection,
+		RouterGroup:  options.RouterGroup,
+	}
+
+	webhookHandler := webhookhttp.NewWebhookHandler(listWebhooksService, getWebhookService, createWebhookService, updateWebhookService, deleteWebhookService, pingWebhookService, listWebhookDeliveriesService, redeliverWebhookDeliveryService)
+	webhookHandler.ConfigureRoutes(configureRoutesOptions)
+
+	// The dispatcher gets its own repositories so it never shares a transaction with request handlers.
+	deliverWebhooksService := webhookservice.NewDeliverWebhooksService(
+		webhookdatabase.NewWebhookBunRepository(options.DbConnection),
+		webhookdatabase.NewWebhookDeliveryBunRepository(options.DbConnection),
+		webhooksender.NewHttpWebhookSender(webhooksender.HttpWebhookSenderOptions{
+			TimeoutSeconds:        webhook.WebhookDeliveryTimeoutSeconds,
+			MaxResponseBodySize:   webhook.WebhookDeliveryMaxResponseBodyBytes,
+			AllowPrivateAddresses: config.GetInstance().WebhookAllowPrivateAddresses,
+		}),
+	)
+
+	go dispatchWebhookDeliveries(deliverWebhooksService)
+}
+
+/*
+NewPublishWebhookEventService builds the service other modules use to publish webhook events from their repositories.
+*/
+func NewPublishWebhookEventService(connection *bun.DB) *webhookservice.PublishWebhookEventService {
+	return webhookservice.NewPublishWebhookEventService(
+		webhookdatabase.NewWebhookBunRepository(connection),
+		webhookdatabase.NewWebhookDeliveryBunRepository(connection),
+	)
+}
+
+func dispatchWebhookDeliveries(deliverWebhooksService *webhookservice.DeliverWebhooksService) {
+	ticker := time.NewTicker(webhook.WebhookDispatcherIntervalSeconds * time.Second)
+	defer ticker.Stop()
+
+	for range ticker.C {
+		for {
+			delivered, err := deliverWebhooksService.Execute(webhookservice.DeliverWebhooksInput{
+				Limit: webhook.WebhookDispatcherBatchSize,
+			})
+			if err != nil {
+				log.Printf("Failed to deliver webhooks: %v", err)
+				break
+			}
+
+			if delivered < webhook.WebhookDispatcherBatchSize {
+				break
+			}
+		}
+	}
+}
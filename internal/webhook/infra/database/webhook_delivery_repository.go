@@ -0,0 +1,313 @@
+package webhookdatabase
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/gabrielmrtt/taski/internal/core"
+	coredatabase "github.com/gabrielmrtt/taski/internal/core/database"
+	"github.com/gabrielmrtt/taski/internal/webhook"
+	webhookrepo "github.com/gabrielmrtt/taski/internal/webhook/repository"
+	"github.com/google/uuid"
+	"github.com/uptrace/bun"
+)
+
+type WebhookDeliveryTable struct {
+	bun.BaseModel `bun:"table:webhook_delivery,alias:webhook_delivery"`
+
+	InternalId        string  `bun:"internal_id,pk,notnull,type:uuid"`
+	PublicId          string  `bun:"public_id,notnull,type:varchar(510)"`
+	WebhookInternalId string  `bun:"webhook_internal_id,notnull,type:uuid"`
+	EventId           string  `bun:"event_id,notnull,type:varchar(510)"`
+	EventType         string  `bun:"event_type,notnull,type:varchar(100)"`
+	Payload           string  `bun:"payload,notnull,type:text"`
+	Status            string  `bun:"status,notnull,type:varchar(50)"`
+	Attempts          int16   `bun:"attempts,notnull,type:smallint"`
+	NextAttemptAt     *int64  `bun:"next_attempt_at,type:bigint"`
+	LastAttemptAt     *int64  `bun:"last_attempt_at,type:bigint"`
+	ResponseStatus    *int    `bun:"response_status,type:integer"`
+	Error             *string `bun:"error,type:text"`
+	CreatedAt         int64   `bun:"created_at,notnull,type:bigint"`
+	CompletedAt       *int64  `bun:"completed_at,type:bigint"`
+}
+
+func (t *WebhookDeliveryTable) ToEntity() *webhook.WebhookDelivery {
+	var nextAttemptAt *core.DateTime = nil
+	if t.NextAttemptAt != nil {
+		nextAttemptAt = &core.DateTime{Value: *t.NextAttemptAt}
+	}
+
+	var lastAttemptAt *core.DateTime = nil
+	if t.LastAttemptAt != nil {
+		lastAttemptAt = &core.DateTime{Value: *t.LastAttemptAt}
+	}
+
+	var completedAt *core.DateTime = nil
+	if t.CompletedAt != nil {
+		completedAt = &core.DateTime{Value: *t.CompletedAt}
+	}
+
+	return &webhook.WebhookDelivery{
+		Identity:        core.NewIdentityFromInternal(uuid.MustParse(t.InternalId), webhook.WebhookDeliveryIdentityPrefix),
+		WebhookIdentity: core.NewIdentityFromInternal(uuid.MustParse(t.WebhookInternalId), webhook.WebhookIdentityPrefix),
+		EventId:         t.EventId,
+		EventType:       webhook.WebhookEventTypes(t.EventType),
+		Payload:         t.Payload,
+		Status:          webhook.WebhookDeliveryStatuses(t.Status),
+		Attempts:        t.Attempts,
+		NextAttemptAt:   nextAttemptAt,
+		LastAttemptAt:   lastAttemptAt,
+		ResponseStatus:  t.ResponseStatus,
+		Error:           t.Error,
+		CreatedAt:       core.DateTime{Value: t.CreatedAt},
+		CompletedAt:     completedAt,
+	}
+}
+
+func webhookDeliveryToTable(delivery *webhook.WebhookDelivery) *WebhookDeliveryTable {
+	var nextAttemptAt *int64 = nil
+	if delivery.NextAttemptAt != nil {
+		nextAttemptAt = &delivery.NextAttemptAt.Value
+	}
+
+	var lastAttemptAt *int64 = nil
+	if delivery.LastAttemptAt != nil {
+		lastAttemptAt = &delivery.LastAttemptAt.Value
+	}
+
+	var completedAt *int64 = nil
+	if delivery.CompletedAt != nil {
+		completedAt = &delivery.CompletedAt.Value
+	}
+
+	return &WebhookDeliveryTable{
+		InternalId:        delivery.Identity.Internal.String(),
+		PublicId:          delivery.Identity.Public,
+		WebhookInternalId: delivery.WebhookIdentity.Internal.String(),
+		EventId:           delivery.EventId,
+		EventType:         string(delivery.EventType),
+		Payload:           delivery.Payload,
+		Status:            string(delivery.Status),
+		Attempts:          delivery.Attempts,
+		NextAttemptAt:     nextAttemptAt,
+		LastAttemptAt:     lastAttemptAt,
+		ResponseStatus:    delivery.ResponseStatus,
+		Error:             delivery.Error,
+		CreatedAt:         delivery.CreatedAt.Value,
+		CompletedAt:       completedAt,
+	}
+}
+
+type WebhookDeliveryBunRepository struct {
+	db *bun.DB
+	tx *coredatabase.TransactionBun
+}
+
+func NewWebhookDeliveryBunRepository(connection *bun.DB) *WebhookDeliveryBunRepository {
+	return &WebhookDeliveryBunRepository{db: connection, tx: nil}
+}
+
+func (r *WebhookDeliveryBunRepository) SetTransaction(tx core.Transaction) error {
+	if r.tx != nil && !r.tx.IsClosed() {
+		return nil
+	}
+
+	r.tx = tx.(*coredatabase.TransactionBun)
+	return nil
+}
+
+func (r *WebhookDeliveryBunRepository) applyFilters(selectQuery *bun.SelectQuery, filters webhookrepo.WebhookDeliveryFilters) *bun.SelectQuery {
+	selectQuery = selectQuery.Where("webhook_delivery.webhook_internal_id = ?", filters.WebhookIdentity.Internal.String())
+
+	if filters.EventType != nil {
+		selectQuery = coredatabase.ApplyComparableFilter(selectQuery, "webhook_delivery.event_type", filters.EventType)
+	}
+
+	if filters.Status != nil {
+		selectQuery = coredatabase.ApplyComparableFilter(selectQuery, "webhook_delivery.status", filters.Status)
+	}
+
+	if filters.CreatedAt != nil {
+		selectQuery = coredatabase.ApplyComparableFilter(selectQuery, "webhook_delivery.created_at", filters.CreatedAt)
+	}
+
+	return selectQuery
+}
+
+func (r *WebhookDeliveryBunRepository) GetWebhookDeliveryByIdentity(params webhookrepo.GetWebhookDeliveryByIdentityParams) (*webhook.WebhookDelivery, error) {
+	var delivery *WebhookDeliveryTable = new(WebhookDeliveryTable)
+	var selectQuery *bun.SelectQuery
+
+	if r.tx != nil && !r.tx.IsClosed() {
+		selectQuery = r.tx.Tx.NewSelect()
+	} else {
+		selectQuery = r.db.NewSelect()
+	}
+
+	selectQuery = selectQuery.Model(delivery)
+	selectQuery = selectQuery.Where("webhook_delivery.internal_id = ?", params.WebhookDeliveryIdentity.Internal.String())
+
+	if params.WebhookIdentity != nil {
+		selectQuery = selectQuery.Where("webhook_delivery.webhook_internal_id = ?", params.WebhookIdentity.Internal.String())
+	}
+
+	err := selectQuery.Scan(context.Background())
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil
+		}
+
+		return nil, err
+	}
+
+	if delivery.InternalId == "" {
+		return nil, nil
+	}
+
+	return delivery.ToEntity(), nil
+}
+
+func (r *WebhookDeliveryBunRepository) PaginateWebhookDeliveriesBy(params webhookrepo.PaginateWebhookDeliveriesParams) (*core.PaginationOutput[webhook.WebhookDelivery], error) {
+	var deliveries []*WebhookDeliveryTable = make([]*WebhookDeliveryTable, 0)
+	var selectQuery *bun.SelectQuery
+	var perPage int = 10
+	var page int = 1
+
+	if params.Pagination.PerPage != nil {
+		perPage = *params.Pagination.PerPage
+	}
+
+	if params.Pagination.Page != nil {
+		page = *params.Pagination.Page
+	}
+
+	if r.tx != nil && !r.tx.IsClosed() {
+		selectQuery = r.tx.Tx.NewSelect()
+	} else {
+		selectQuery = r.db.NewSelect()
+	}
+
+	selectQuery = selectQuery.Model(&deliveries)
+	selectQuery = r.applyFilters(selectQuery, params.Filters)
+	countBeforePagination, err := selectQuery.Count(context.Background())
+	if err != nil {
+		return nil, err
+	}
+
+	selectQuery = selectQuery.Order("webhook_delivery.created_at DESC", "webhook_delivery.internal_id DESC")
+	selectQuery = coredatabase.ApplyPagination(selectQuery, params.Pagination)
+	err = selectQuery.Scan(context.Background())
+	if err != nil && err != sql.ErrNoRows {
+		return nil, err
+	}
+
+	var deliveryEntities []webhook.WebhookDelivery = make([]webhook.WebhookDelivery, 0)
+	for _, delivery := range deliveries {
+		deliveryEntities = append(deliveryEntities, *delivery.ToEntity())
+	}
+
+	return &core.PaginationOutput[webhook.WebhookDelivery]{
+		Data:    deliveryEntities,
+		Page:    page,
+		HasMore: core.HasMorePages(page, countBeforePagination, perPage),
+		Total:   countBeforePagination,
+	}, nil
+}
+
+func (r *WebhookDeliveryBunRepository) ClaimDueWebhookDeliveries(params webhookrepo.ClaimDueWebhookDeliveriesParams) ([]webhook.WebhookDelivery, error) {
+	var deliveries []WebhookDeliveryTable = make([]WebhookDeliveryTable, 0)
+	var rawQuery *bun.RawQuery
+
+	// SKIP LOCKED lets several API instances claim deliveries at the same time without picking the same rows.
+	query := `
+		UPDATE webhook_delivery SET next_attempt_at = ?
+		WHERE internal_id IN (
+			SELECT internal_id FROM webhook_delivery
+			WHERE status = ? AND next_attempt_at <= ?
+			ORDER BY next_attempt_at ASC
+			LIMIT ?
+			FOR UPDATE SKIP LOCKED
+		)
+		RETURNING *
+	`
+
+	if r.tx != nil && !r.tx.IsClosed() {
+		rawQuery = r.tx.Tx.NewRaw(query, params.LeaseUntil, string(webhook.WebhookDeliveryStatusPending), params.Now, params.Limit)
+	} else {
+		rawQuery = r.db.NewRaw(query, params.LeaseUntil, string(webhook.WebhookDeliveryStatusPending), params.Now, params.Limit)
+	}
+
+	err := rawQuery.Scan(context.Background(), &deliveries)
+	if err != nil && err != sql.ErrNoRows {
+		return nil, err
+	}
+
+	var deliveryEntities []webhook.WebhookDelivery = make([]webhook.WebhookDelivery, 0)
+	for _, delivery := range deliveries {
+		deliveryEntities = append(deliveryEntities, *delivery.ToEntity())
+	}
+
+	return deliveryEntities, nil
+}
+
+func (r *WebhookDeliveryBunRepository) StoreWebhookDelivery(params webhookrepo.StoreWebhookDeliveryParams) (*webhook.WebhookDelivery, error) {
+	var tx bun.Tx
+	var shouldCommit bool = false
+
+	if r.tx != nil && !r.tx.IsClosed() {
+		tx = *r.tx.Tx
+	} else {
+		var err error
+		tx, err = r.db.BeginTx(context.Background(), nil)
+		shouldCommit = true
+
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	_, err := tx.NewInsert().Model(webhookDeliveryToTable(params.WebhookDelivery)).Exec(context.Background())
+	if err != nil {
+		return nil, err
+	}
+
+	if shouldCommit {
+		err = tx.Commit()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return params.WebhookDelivery, nil
+}
+
+func (r *WebhookDeliveryBunRepository) UpdateWebhookDelivery(params webhookrepo.UpdateWebhookDeliveryParams) error {
+	var tx bun.Tx
+	var shouldCommit bool = false
+
+	if r.tx != nil && !r.tx.IsClosed() {
+		tx = *r.tx.Tx
+	} else {
+		var err error
+		tx, err = r.db.BeginTx(context.Background(), nil)
+		shouldCommit = true
+
+		if err != nil {
+			return err
+		}
+	}
+
+	_, err := tx.NewUpdate().Model(webhookDeliveryToTable(params.WebhookDelivery)).Where("webhook_delivery.internal_id = ?", params.WebhookDelivery.Identity.Internal.String()).Exec(context.Background())
+	if err != nil {
+		return err
+	}
+
+	if shouldCommit {
+		err = tx.Commit()
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
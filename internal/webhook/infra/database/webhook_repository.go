@@ -0,0 +1,389 @@
+package webhookdatabase
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/gabrielmrtt/taski/internal/core"
+	coredatabase "github.com/gabrielmrtt/taski/internal/core/database"
+	"github.com/gabrielmrtt/taski/internal/organization"
+	"github.com/gabrielmrtt/taski/internal/user"
+	"github.com/gabrielmrtt/taski/internal/webhook"
+	webhookrepo "github.com/gabrielmrtt/taski/internal/webhook/repository"
+	"github.com/google/uuid"
+	"github.com/uptrace/bun"
+)
+
+type WebhookTable struct {
+	bun.BaseModel `bun:"table:webhook,alias:webhook"`
+
+	InternalId             string   `bun:"internal_id,pk,notnull,type:uuid"`
+	PublicId               string   `bun:"public_id,notnull,type:varchar(510)"`
+	OrganizationInternalId string   `bun:"organization_internal_id,notnull,type:uuid"`
+	Url                    string   `bun:"url,notnull,type:varchar(2048)"`
+	Secret                 string   `bun:"secret,notnull,type:varchar(255)"`
+	Events                 []string `bun:"events,notnull,type:jsonb"`
+	Status                 string   `bun:"status,notnull,type:varchar(50)"`
+	UserCreatorInternalId  *string  `bun:"user_creator_internal_id,type:uuid"`
+	UserEditorInternalId   *string  `bun:"user_editor_internal_id,type:uuid"`
+	CreatedAt              int64    `bun:"created_at,notnull,type:bigint"`
+	UpdatedAt              *int64   `bun:"updated_at,type:bigint"`
+}
+
+func (t *WebhookTable) ToEntity() *webhook.Webhook {
+	var userCreatorIdentity *core.Identity = nil
+	var userEditorIdentity *core.Identity = nil
+
+	if t.UserCreatorInternalId != nil {
+		identity := core.NewIdentityFromInternal(uuid.MustParse(*t.UserCreatorInternalId), user.UserIdentityPrefix)
+		userCreatorIdentity = &identity
+	}
+
+	if t.UserEditorInternalId != nil {
+		identity := core.NewIdentityFromInternal(uuid.MustParse(*t.UserEditorInternalId), user.UserIdentityPrefix)
+		userEditorIdentity = &identity
+	}
+
+	var events []webhook.WebhookEventTypes = make([]webhook.WebhookEventTypes, 0)
+	for _, event := range t.Events {
+		events = append(events, webhook.WebhookEventTypes(event))
+	}
+
+	createdAt := core.DateTime{Value: t.CreatedAt}
+	var updatedAt *core.DateTime = nil
+	if t.UpdatedAt != nil {
+		updatedAt = &core.DateTime{Value: *t.UpdatedAt}
+	}
+
+	return &webhook.Webhook{
+		Identity:             core.NewIdentityFromInternal(uuid.MustParse(t.InternalId), webhook.WebhookIdentityPrefix),
+		OrganizationIdentity: core.NewIdentityFromInternal(uuid.MustParse(t.OrganizationInternalId), organization.OrganizationIdentityPrefix),
+		Url:                  t.Url,
+		Secret:               t.Secret,
+		Events:               events,
+		Status:               webhook.WebhookStatuses(t.Status),
+		UserCreatorIdentity:  userCreatorIdentity,
+		UserEditorIdentity:   userEditorIdentity,
+		Timestamps: core.Timestamps{
+			CreatedAt: &createdAt,
+			UpdatedAt: updatedAt,
+		},
+	}
+}
+
+func webhookToTable(wh *webhook.Webhook) *WebhookTable {
+	var userCreatorInternalId *string = nil
+	if wh.UserCreatorIdentity != nil {
+		internalId := wh.UserCreatorIdentity.Internal.String()
+		userCreatorInternalId = &internalId
+	}
+
+	var userEditorInternalId *string = nil
+	if wh.UserEditorIdentity != nil {
+		internalId := wh.UserEditorIdentity.Internal.String()
+		userEditorInternalId = &internalId
+	}
+
+	var events []string = make([]string, 0)
+	for _, event := range wh.Events {
+		events = append(events, string(event))
+	}
+
+	var createdAt int64 = 0
+	if wh.Timestamps.CreatedAt != nil {
+		createdAt = wh.Timestamps.CreatedAt.Value
+	}
+
+	var updatedAt *int64 = nil
+	if wh.Timestamps.UpdatedAt != nil {
+		updatedAt = &wh.Timestamps.UpdatedAt.Value
+	}
+
+	return &WebhookTable{
+		InternalId:             wh.Identity.Internal.String(),
+		PublicId:               wh.Identity.Public,
+		OrganizationInternalId: wh.OrganizationIdentity.Internal.String(),
+		Url:                    wh.Url,
+		Secret:                 wh.Secret,
+		Events:                 events,
+		Status:                 string(wh.Status),
+		UserCreatorInternalId:  userCreatorInternalId,
+		UserEditorInternalId:   userEditorInternalId,
+		CreatedAt:              createdAt,
+		UpdatedAt:              updatedAt,
+	}
+}
+
+var webhookSortableFields = coredatabase.SortableFields{
+	Fields: map[string]string{
+		"url":       "webhook.url",
+		"status":    "webhook.status",
+		"createdAt": "webhook.created_at",
+		"updatedAt": "webhook.updated_at",
+	},
+	IdColumn: "webhook.internal_id",
+}
+
+type WebhookBunRepository struct {
+	db *bun.DB
+	tx *coredatabase.TransactionBun
+}
+
+func NewWebhookBunRepository(connection *bun.DB) *WebhookBunRepository {
+	return &WebhookBunRepository{db: connection, tx: nil}
+}
+
+func (r *WebhookBunRepository) SetTransaction(tx core.Transaction) error {
+	if r.tx != nil && !r.tx.IsClosed() {
+		return nil
+	}
+
+	r.tx = tx.(*coredatabase.TransactionBun)
+	return nil
+}
+
+func (r *WebhookBunRepository) applyFilters(selectQuery *bun.SelectQuery, filters webhookrepo.WebhookFilters) *bun.SelectQuery {
+	if filters.OrganizationIdentity != nil {
+		selectQuery = selectQuery.Where("webhook.organization_internal_id = ?", filters.OrganizationIdentity.Internal.String())
+	}
+
+	if filters.WorkspaceIdentity != nil {
+		selectQuery = selectQuery.Where("webhook.organization_internal_id IN (SELECT workspace.organization_internal_id FROM workspace WHERE workspace.internal_id = ?)", filters.WorkspaceIdentity.Internal.String())
+	}
+
+	if filters.ProjectIdentity != nil {
+		selectQuery = selectQuery.Where("webhook.organization_internal_id IN (SELECT workspace.organization_internal_id FROM workspace INNER JOIN project ON project.workspace_internal_id = workspace.internal_id WHERE project.internal_id = ?)", filters.ProjectIdentity.Internal.String())
+	}
+
+	if filters.TaskIdentity != nil {
+		selectQuery = selectQuery.Where("webhook.organization_internal_id IN (SELECT workspace.organization_internal_id FROM workspace INNER JOIN project ON project.workspace_internal_id = workspace.internal_id INNER JOIN task ON task.project_internal_id = project.internal_id WHERE task.internal_id = ?)", filters.TaskIdentity.Internal.String())
+	}
+
+	if filters.Status != nil {
+		selectQuery = coredatabase.ApplyComparableFilter(selectQuery, "webhook.status", filters.Status)
+	}
+
+	return selectQuery
+}
+
+func (r *WebhookBunRepository) GetWebhookByIdentity(params webhookrepo.GetWebhookByIdentityParams) (*webhook.Webhook, error) {
+	var wh *WebhookTable = new(WebhookTable)
+	var selectQuery *bun.SelectQuery
+
+	if r.tx != nil && !r.tx.IsClosed() {
+		selectQuery = r.tx.Tx.NewSelect()
+	} else {
+		selectQuery = r.db.NewSelect()
+	}
+
+	selectQuery = selectQuery.Model(wh)
+	selectQuery = selectQuery.Where("webhook.internal_id = ?", params.WebhookIdentity.Internal.String())
+
+	if params.OrganizationIdentity != nil {
+		selectQuery = selectQuery.Where("webhook.organization_internal_id = ?", params.OrganizationIdentity.Internal.String())
+	}
+
+	err := selectQuery.Scan(context.Background())
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil
+		}
+
+		return nil, err
+	}
+
+	if wh.InternalId == "" {
+		return nil, nil
+	}
+
+	return wh.ToEntity(), nil
+}
+
+func (r *WebhookBunRepository) PaginateWebhooksBy(params webhookrepo.PaginateWebhooksParams) (*core.PaginationOutput[webhook.Webhook], error) {
+	var webhooks []*WebhookTable = make([]*WebhookTable, 0)
+	var selectQuery *bun.SelectQuery
+	var perPage int = 10
+	var page int = 1
+
+	if params.Pagination.PerPage != nil {
+		perPage = *params.Pagination.PerPage
+	}
+
+	if params.Pagination.Page != nil {
+		page = *params.Pagination.Page
+	}
+
+	if r.tx != nil && !r.tx.IsClosed() {
+		selectQuery = r.tx.Tx.NewSelect()
+	} else {
+		selectQuery = r.db.NewSelect()
+	}
+
+	selectQuery = selectQuery.Model(&webhooks)
+	selectQuery = r.applyFilters(selectQuery, params.Filters)
+	countBeforePagination, err := selectQuery.Count(context.Background())
+	if err != nil {
+		return nil, err
+	}
+
+	selectQuery, err = coredatabase.ApplySort(selectQuery, webhookSortableFields, params.SortInput)
+	if err != nil {
+		return nil, err
+	}
+
+	selectQuery = coredatabase.ApplyPagination(selectQuery, params.Pagination)
+	err = selectQuery.Scan(context.Background())
+	if err != nil && err != sql.ErrNoRows {
+		return nil, err
+	}
+
+	var webhookEntities []webhook.Webhook = make([]webhook.Webhook, 0)
+	for _, wh := range webhooks {
+		webhookEntities = append(webhookEntities, *wh.ToEntity())
+	}
+
+	return &core.PaginationOutput[webhook.Webhook]{
+		Data:    webhookEntities,
+		Page:    page,
+		HasMore: core.HasMorePages(page, countBeforePagination, perPage),
+		Total:   countBeforePagination,
+	}, nil
+}
+
+func (r *WebhookBunRepository) ListWebhooksBy(params webhookrepo.ListWebhooksByParams) ([]webhook.Webhook, error) {
+	var webhooks []WebhookTable = make([]WebhookTable, 0)
+	var selectQuery *bun.SelectQuery
+
+	if r.tx != nil && !r.tx.IsClosed() {
+		selectQuery = r.tx.Tx.NewSelect()
+	} else {
+		selectQuery = r.db.NewSelect()
+	}
+
+	selectQuery = selectQuery.Model(&webhooks)
+	selectQuery = r.applyFilters(selectQuery, params.Filters)
+	selectQuery = selectQuery.Order("webhook.created_at ASC")
+	err := selectQuery.Scan(context.Background())
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return []webhook.Webhook{}, nil
+		}
+
+		return nil, err
+	}
+
+	var webhookEntities []webhook.Webhook = make([]webhook.Webhook, 0)
+	for _, wh := range webhooks {
+		webhookEntities = append(webhookEntities, *wh.ToEntity())
+	}
+
+	return webhookEntities, nil
+}
+
+func (r *WebhookBunRepository) HasWebhooksBy(params webhookrepo.HasWebhooksByParams) (bool, error) {
+	var selectQuery *bun.SelectQuery
+
+	if r.tx != nil && !r.tx.IsClosed() {
+		selectQuery = r.tx.Tx.NewSelect()
+	} else {
+		selectQuery = r.db.NewSelect()
+	}
+
+	selectQuery = selectQuery.Model((*WebhookTable)(nil))
+	selectQuery = r.applyFilters(selectQuery, params.Filters)
+
+	return selectQuery.Exists(context.Background())
+}
+
+func (r *WebhookBunRepository) StoreWebhook(params webhookrepo.StoreWebhookParams) (*webhook.Webhook, error) {
+	var tx bun.Tx
+	var shouldCommit bool = false
+
+	if r.tx != nil && !r.tx.IsClosed() {
+		tx = *r.tx.Tx
+	} else {
+		var err error
+		tx, err = r.db.BeginTx(context.Background(), nil)
+		shouldCommit = true
+
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	_, err := tx.NewInsert().Model(webhookToTable(params.Webhook)).Exec(context.Background())
+	if err != nil {
+		return nil, err
+	}
+
+	if shouldCommit {
+		err = tx.Commit()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return params.Webhook, nil
+}
+
+func (r *WebhookBunRepository) UpdateWebhook(params webhookrepo.UpdateWebhookParams) error {
+	var tx bun.Tx
+	var shouldCommit bool = false
+
+	if r.tx != nil && !r.tx.IsClosed() {
+		tx = *r.tx.Tx
+	} else {
+		var err error
+		tx, err = r.db.BeginTx(context.Background(), nil)
+		shouldCommit = true
+
+		if err != nil {
+			return err
+		}
+	}
+
+	_, err := tx.NewUpdate().Model(webhookToTable(params.Webhook)).Where("webhook.internal_id = ?", params.Webhook.Identity.Internal.String()).Exec(context.Background())
+	if err != nil {
+		return err
+	}
+
+	if shouldCommit {
+		err = tx.Commit()
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (r *WebhookBunRepository) DeleteWebhook(params webhookrepo.DeleteWebhookParams) error {
+	var tx bun.Tx
+	var shouldCommit bool = false
+
+	if r.tx != nil && !r.tx.IsClosed() {
+		tx = *r.tx.Tx
+	} else {
+		var err error
+		tx, err = r.db.BeginTx(context.Background(), nil)
+		shouldCommit = true
+
+		if err != nil {
+			return err
+		}
+	}
+
+	_, err := tx.NewDelete().Model(&WebhookTable{}).Where("internal_id = ?", params.WebhookIdentity.Internal.String()).Exec(context.Background())
+	if err != nil && err != sql.ErrNoRows {
+		return err
+	}
+
+	if shouldCommit {
+		err = tx.Commit()
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,45 @@
+package webhookevents
+
+import (
+	"github.com/gabrielmrtt/taski/internal/core"
+	projectrepo "github.com/gabrielmrtt/taski/internal/project/repository"
+	workspacerepo "github.com/gabrielmrtt/taski/internal/workspace/repository"
+)
+
+/*
+projectOrganizationIdentity resolves the organization a project belongs to through its workspace. It returns nil when
+the project or its workspace no longer exist.
+*/
+func projectOrganizationIdentity(
+	projectRepository projectrepo.ProjectRepository,
+	workspaceRepository workspacerepo.WorkspaceRepository,
+	projectIdentity core.Identity,
+) (*core.Identity, error) {
+	prj, err := projectRepository.GetProjectByIdentity(projectrepo.GetProjectByIdentityParams{
+		ProjectIdentity: projectIdentity,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if prj == nil {
+		return nil, nil
+	}
+
+	return workspaceOrganizationIdentity(workspaceRepository, prj.WorkspaceIdentity)
+}
+
+func workspaceOrganizationIdentity(workspaceRepository workspacerepo.WorkspaceRepository, workspaceIdentity core.Identity) (*core.Identity, error) {
+	wrk, err := workspaceRepository.GetWorkspaceByIdentity(workspacerepo.GetWorkspaceByIdentityParams{
+		WorkspaceIdentity: workspaceIdentity,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if wrk == nil {
+		return nil, nil
+	}
+
+	return &wrk.OrganizationIdentity, nil
+}
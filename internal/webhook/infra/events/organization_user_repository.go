@@ -0,0 +1,119 @@
+package webhookevents
+
+import (
+	"github.com/gabrielmrtt/taski/internal/core"
+	"github.com/gabrielmrtt/taski/internal/organization"
+	organizationrepo "github.com/gabrielmrtt/taski/internal/organization/repository"
+	"github.com/gabrielmrtt/taski/internal/webhook"
+	webhookservice "github.com/gabrielmrtt/taski/internal/webhook/service"
+)
+
+/*
+OrganizationUserRepository publishes organization_member_invited, organization_member_joined and
+organization_member_removed from the changes of the organization user status. Other updates, like the last access
+date, publish nothing.
+*/
+type OrganizationUserRepository struct {
+	organizationrepo.OrganizationUserRepository
+	PublishWebhookEventService *webhookservice.PublishWebhookEventService
+}
+
+func NewOrganizationUserRepository(
+	organizationUserRepository organizationrepo.OrganizationUserRepository,
+	publishWebhookEventService *webhookservice.PublishWebhookEventService,
+) *OrganizationUserRepository {
+	return &OrganizationUserRepository{
+		OrganizationUserRepository: organizationUserRepository,
+		PublishWebhookEventService: publishWebhookEventService,
+	}
+}
+
+func (r *OrganizationUserRepository) SetTransaction(tx core.Transaction) error {
+	if err := r.OrganizationUserRepository.SetTransaction(tx); err != nil {
+		return err
+	}
+
+	return r.PublishWebhookEventService.SetTransaction(tx)
+}
+
+func (r *OrganizationUserRepository) publish(organizationUser *organization.OrganizationUser, eventType webhook.WebhookEventTypes) error {
+	return r.PublishWebhookEventService.Execute(webhookservice.PublishWebhookEventInput{
+		OrganizationIdentity: organizationUser.OrganizationIdentity,
+		EventType:            eventType,
+		Data: webhook.WebhookOrganizationMemberEventDataDto{
+			Member: organization.OrganizationUserToDto(organizationUser),
+		},
+	})
+}
+
+func (r *OrganizationUserRepository) StoreOrganizationUser(params organizationrepo.StoreOrganizationUserParams) (*organization.OrganizationUser, error) {
+	organizationUser, err := r.OrganizationUserRepository.StoreOrganizationUser(params)
+	if err != nil {
+		return nil, err
+	}
+
+	var eventType webhook.WebhookEventTypes
+	switch organizationUser.Status {
+	case organization.OrganizationUserStatusInvited:
+		eventType = webhook.WebhookEventTypeOrganizationMemberInvited
+	case organization.OrganizationUserStatusActive:
+		eventType = webhook.WebhookEventTypeOrganizationMemberJoined
+	default:
+		return organizationUser, nil
+	}
+
+	if err := r.publish(organizationUser, eventType); err != nil {
+		return nil, err
+	}
+
+	return organizationUser, nil
+}
+
+func (r *OrganizationUserRepository) UpdateOrganizationUser(params organizationrepo.UpdateOrganizationUserParams) error {
+	previousOrganizationUser, err := r.OrganizationUserRepository.GetOrganizationUserByIdentity(organizationrepo.GetOrganizationUserByIdentityParams{
+		OrganizationIdentity: params.OrganizationUser.OrganizationIdentity,
+		UserIdentity:         params.OrganizationUser.User.Identity,
+	})
+	if err != nil {
+		return err
+	}
+
+	if err := r.OrganizationUserRepository.UpdateOrganizationUser(params); err != nil {
+		return err
+	}
+
+	if previousOrganizationUser == nil || previousOrganizationUser.Status == params.OrganizationUser.Status {
+		return nil
+	}
+
+	switch {
+	case params.OrganizationUser.Status == organization.OrganizationUserStatusInvited:
+		return r.publish(params.OrganizationUser, webhook.WebhookEventTypeOrganizationMemberInvited)
+	case params.OrganizationUser.IsActive():
+		return r.publish(params.OrganizationUser, webhook.WebhookEventTypeOrganizationMemberJoined)
+	case previousOrganizationUser.IsActive():
+		return r.publish(params.OrganizationUser, webhook.WebhookEventTypeOrganizationMemberRemoved)
+	}
+
+	return nil
+}
+
+func (r *OrganizationUserRepository) DeleteOrganizationUser(params organizationrepo.DeleteOrganizationUserParams) error {
+	organizationUser, err := r.OrganizationUserRepository.GetOrganizationUserByIdentity(organizationrepo.GetOrganizationUserByIdentityParams{
+		OrganizationIdentity: params.OrganizationIdentity,
+		UserIdentity:         params.UserIdentity,
+	})
+	if err != nil {
+		return err
+	}
+
+	if err := r.OrganizationUserRepository.DeleteOrganizationUser(params); err != nil {
+		return err
+	}
+
+	if organizationUser != nil && organizationUser.IsActive() {
+		return r.publish(organizationUser, webhook.WebhookEventTypeOrganizationMemberRemoved)
+	}
+
+	return nil
+}
@@ -0,0 +1,112 @@
+package webhookevents
+
+import (
+	"github.com/gabrielmrtt/taski/internal/core"
+	"github.com/gabrielmrtt/taski/internal/project"
+	projectrepo "github.com/gabrielmrtt/taski/internal/project/repository"
+	"github.com/gabrielmrtt/taski/internal/webhook"
+	webhookrepo "github.com/gabrielmrtt/taski/internal/webhook/repository"
+	webhookservice "github.com/gabrielmrtt/taski/internal/webhook/service"
+	workspacerepo "github.com/gabrielmrtt/taski/internal/workspace/repository"
+)
+
+/*
+ProjectRepository publishes the project_created, project_updated and project_deleted webhook events.
+*/
+type ProjectRepository struct {
+	projectrepo.ProjectRepository
+	WorkspaceRepository        workspacerepo.WorkspaceRepository
+	PublishWebhookEventService *webhookservice.PublishWebhookEventService
+}
+
+func NewProjectRepository(
+	projectRepository projectrepo.ProjectRepository,
+	workspaceRepository workspacerepo.WorkspaceRepository,
+	publishWebhookEventService *webhookservice.PublishWebhookEventService,
+) *ProjectRepository {
+	return &ProjectRepository{
+		ProjectRepository:          projectRepository,
+		WorkspaceRepository:        workspaceRepository,
+		PublishWebhookEventService: publishWebhookEventService,
+	}
+}
+
+func (r *ProjectRepository) SetTransaction(tx core.Transaction) error {
+	if err := r.ProjectRepository.SetTransaction(tx); err != nil {
+		return err
+	}
+
+	r.WorkspaceRepository.SetTransaction(tx)
+	return r.PublishWebhookEventService.SetTransaction(tx)
+}
+
+func (r *ProjectRepository) publish(prj *project.Project, eventType webhook.WebhookEventTypes) error {
+	hasWebhooks, err := r.PublishWebhookEventService.HasActiveWebhooks(webhookrepo.WebhookFilters{
+		WorkspaceIdentity: &prj.WorkspaceIdentity,
+	})
+	if err != nil {
+		return err
+	}
+
+	if !hasWebhooks {
+		return nil
+	}
+
+	organizationIdentity, err := workspaceOrganizationIdentity(r.WorkspaceRepository, prj.WorkspaceIdentity)
+	if err != nil {
+		return err
+	}
+
+	if organizationIdentity == nil {
+		return nil
+	}
+
+	return r.PublishWebhookEventService.Execute(webhookservice.PublishWebhookEventInput{
+		OrganizationIdentity: *organizationIdentity,
+		EventType:            eventType,
+		Data: webhook.WebhookProjectEventDataDto{
+			Project: project.ProjectToDto(prj),
+		},
+	})
+}
+
+func (r *ProjectRepository) StoreProject(params projectrepo.StoreProjectParams) (*project.Project, error) {
+	prj, err := r.ProjectRepository.StoreProject(params)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := r.publish(prj, webhook.WebhookEventTypeProjectCreated); err != nil {
+		return nil, err
+	}
+
+	return prj, nil
+}
+
+func (r *ProjectRepository) UpdateProject(params projectrepo.UpdateProjectParams) error {
+	if err := r.ProjectRepository.UpdateProject(params); err != nil {
+		return err
+	}
+
+	return r.publish(params.Project, webhook.WebhookEventTypeProjectUpdated)
+}
+
+func (r *ProjectRepository) DeleteProject(params projectrepo.DeleteProjectParams) error {
+	prj, err := r.ProjectRepository.GetProjectByIdentity(projectrepo.GetProjectByIdentityParams{
+		ProjectIdentity: params.ProjectIdentity,
+	})
+	if err != nil {
+		return err
+	}
+
+	if err := r.ProjectRepository.DeleteProject(params); err != nil {
+		return err
+	}
+
+	if prj == nil {
+		return nil
+	}
+
+	prj.Delete()
+	return r.publish(prj, webhook.WebhookEventTypeProjectDeleted)
+}
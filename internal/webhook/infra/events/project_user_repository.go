@@ -0,0 +1,164 @@
+package webhookevents
+
+import (
+	"github.com/gabrielmrtt/taski/internal/core"
+	"github.com/gabrielmrtt/taski/internal/project"
+	projectrepo "github.com/gabrielmrtt/taski/internal/project/repository"
+	"github.com/gabrielmrtt/taski/internal/user"
+	"github.com/gabrielmrtt/taski/internal/webhook"
+	webhookrepo "github.com/gabrielmrtt/taski/internal/webhook/repository"
+	webhookservice "github.com/gabrielmrtt/taski/internal/webhook/service"
+	workspacerepo "github.com/gabrielmrtt/taski/internal/workspace/repository"
+)
+
+/*
+ProjectUserRepository publishes project_member_added when a user becomes an active member of a project and
+project_member_removed when an active member is deactivated or removed. Invitations are not published.
+*/
+type ProjectUserRepository struct {
+	projectrepo.ProjectUserRepository
+	ProjectRepository          projectrepo.ProjectRepository
+	WorkspaceRepository        workspacerepo.WorkspaceRepository
+	PublishWebhookEventService *webhookservice.PublishWebhookEventService
+}
+
+func NewProjectUserRepository(
+	projectUserRepository projectrepo.ProjectUserRepository,
+	projectRepository projectrepo.ProjectRepository,
+	workspaceRepository workspacerepo.WorkspaceRepository,
+	publishWebhookEventService *webhookservice.PublishWebhookEventService,
+) *ProjectUserRepository {
+	return &ProjectUserRepository{
+		ProjectUserRepository:      projectUserRepository,
+		ProjectRepository:          projectRepository,
+		WorkspaceRepository:        workspaceRepository,
+		PublishWebhookEventService: publishWebhookEventService,
+	}
+}
+
+func (r *ProjectUserRepository) SetTransaction(tx core.Transaction) error {
+	if err := r.ProjectUserRepository.SetTransaction(tx); err != nil {
+		return err
+	}
+
+	r.ProjectRepository.SetTransaction(tx)
+	r.WorkspaceRepository.SetTransaction(tx)
+	return r.PublishWebhookEventService.SetTransaction(tx)
+}
+
+func (r *ProjectUserRepository) publish(projectUser *project.ProjectUser, eventType webhook.WebhookEventTypes) error {
+	hasWebhooks, err := r.PublishWebhookEventService.HasActiveWebhooks(webhookrepo.WebhookFilters{
+		ProjectIdentity: &projectUser.ProjectIdentity,
+	})
+	if err != nil {
+		return err
+	}
+
+	if !hasWebhooks {
+		return nil
+	}
+
+	organizationIdentity, err := projectOrganizationIdentity(r.ProjectRepository, r.WorkspaceRepository, projectUser.ProjectIdentity)
+	if err != nil {
+		return err
+	}
+
+	if organizationIdentity == nil {
+		return nil
+	}
+
+	return r.PublishWebhookEventService.Execute(webhookservice.PublishWebhookEventInput{
+		OrganizationIdentity: *organizationIdentity,
+		EventType:            eventType,
+		Data: webhook.WebhookProjectMemberEventDataDto{
+			ProjectId: projectUser.ProjectIdentity.Public,
+			User:      user.UserToDto(&projectUser.User),
+			Status:    string(projectUser.Status),
+		},
+	})
+}
+
+func (r *ProjectUserRepository) StoreProjectUser(params projectrepo.StoreProjectUserParams) (*project.ProjectUser, error) {
+	projectUser, err := r.ProjectUserRepository.StoreProjectUser(params)
+	if err != nil {
+		return nil, err
+	}
+
+	if projectUser.IsActive() {
+		if err := r.publish(projectUser, webhook.WebhookEventTypeProjectMemberAdded); err != nil {
+			return nil, err
+		}
+	}
+
+	return projectUser, nil
+}
+
+func (r *ProjectUserRepository) UpdateProjectUser(params projectrepo.UpdateProjectUserParams) error {
+	previousProjectUser, err := r.ProjectUserRepository.GetProjectUserByIdentity(projectrepo.GetProjectUserByIdentityParams{
+		ProjectIdentity: params.ProjectUser.ProjectIdentity,
+		UserIdentity:    params.ProjectUser.User.Identity,
+	})
+	if err != nil {
+		return err
+	}
+
+	if err := r.ProjectUserRepository.UpdateProjectUser(params); err != nil {
+		return err
+	}
+
+	wasActive := previousProjectUser != nil && previousProjectUser.IsActive()
+	if !wasActive && params.ProjectUser.IsActive() {
+		return r.publish(params.ProjectUser, webhook.WebhookEventTypeProjectMemberAdded)
+	}
+
+	if wasActive && !params.ProjectUser.IsActive() {
+		return r.publish(params.ProjectUser, webhook.WebhookEventTypeProjectMemberRemoved)
+	}
+
+	return nil
+}
+
+func (r *ProjectUserRepository) DeleteProjectUser(params projectrepo.DeleteProjectUserParams) error {
+	projectUser, err := r.ProjectUserRepository.GetProjectUserByIdentity(projectrepo.GetProjectUserByIdentityParams{
+		ProjectIdentity: params.ProjectIdentity,
+		UserIdentity:    params.UserIdentity,
+	})
+	if err != nil {
+		return err
+	}
+
+	if err := r.ProjectUserRepository.DeleteProjectUser(params); err != nil {
+		return err
+	}
+
+	if projectUser != nil && projectUser.IsActive() {
+		return r.publish(projectUser, webhook.WebhookEventTypeProjectMemberRemoved)
+	}
+
+	return nil
+}
+
+func (r *ProjectUserRepository) DeleteAllByUserIdentity(params projectrepo.DeleteAllByUserIdentityParams) error {
+	projectUsers, err := r.ProjectUserRepository.GetProjectUsersByUserIdentity(projectrepo.GetProjectUsersByUserIdentityParams{
+		UserIdentity: params.UserIdentity,
+	})
+	if err != nil {
+		return err
+	}
+
+	if err := r.ProjectUserRepository.DeleteAllByUserIdentity(params); err != nil {
+		return err
+	}
+
+	for _, projectUser := range projectUsers {
+		if !projectUser.IsActive() {
+			continue
+		}
+
+		if err := r.publish(&projectUser, webhook.WebhookEventTypeProjectMemberRemoved); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,109 @@
+package webhookevents
+
+import (
+	"github.com/gabrielmrtt/taski/internal/core"
+	projectrepo "github.com/gabrielmrtt/taski/internal/project/repository"
+	"github.com/gabrielmrtt/taski/internal/task"
+	taskrepo "github.com/gabrielmrtt/taski/internal/task/repository"
+	"github.com/gabrielmrtt/taski/internal/webhook"
+	webhookrepo "github.com/gabrielmrtt/taski/internal/webhook/repository"
+	webhookservice "github.com/gabrielmrtt/taski/internal/webhook/service"
+	workspacerepo "github.com/gabrielmrtt/taski/internal/workspace/repository"
+)
+
+/*
+TaskActionRepository publishes a webhook event for every task action stored, using the action type as event type.
+Every task change registers an action, so this covers all task events without touching the task services. The task,
+project and workspace are only loaded when the organization has an active webhook.
+*/
+type TaskActionRepository struct {
+	taskrepo.TaskActionRepository
+	TaskRepository             taskrepo.TaskRepository
+	ProjectRepository          projectrepo.ProjectRepository
+	WorkspaceRepository        workspacerepo.WorkspaceRepository
+	PublishWebhookEventService *webhookservice.PublishWebhookEventService
+}
+
+func NewTaskActionRepository(
+	taskActionRepository taskrepo.TaskActionRepository,
+	taskRepository taskrepo.TaskRepository,
+	projectRepository projectrepo.ProjectRepository,
+	workspaceRepository workspacerepo.WorkspaceRepository,
+	publishWebhookEventService *webhookservice.PublishWebhookEventService,
+) *TaskActionRepository {
+	return &TaskActionRepository{
+		TaskActionRepository:       taskActionRepository,
+		TaskRepository:             taskRepository,
+		ProjectRepository:          projectRepository,
+		WorkspaceRepository:        workspaceRepository,
+		PublishWebhookEventService: publishWebhookEventService,
+	}
+}
+
+func (r *TaskActionRepository) SetTransaction(tx core.Transaction) error {
+	if err := r.TaskActionRepository.SetTransaction(tx); err != nil {
+		return err
+	}
+
+	r.TaskRepository.SetTransaction(tx)
+	r.ProjectRepository.SetTransaction(tx)
+	r.WorkspaceRepository.SetTransaction(tx)
+	return r.PublishWebhookEventService.SetTransaction(tx)
+}
+
+func (r *TaskActionRepository) StoreTaskAction(params taskrepo.StoreTaskActionParams) (*task.TaskAction, error) {
+	taskAction, err := r.TaskActionRepository.StoreTaskAction(params)
+	if err != nil {
+		return nil, err
+	}
+
+	hasWebhooks, err := r.PublishWebhookEventService.HasActiveWebhooks(webhookrepo.WebhookFilters{
+		TaskIdentity: &taskAction.TaskIdentity,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if !hasWebhooks {
+		return taskAction, nil
+	}
+
+	tsk, err := r.TaskRepository.GetTaskByIdentity(taskrepo.GetTaskByIdentityParams{
+		TaskIdentity: taskAction.TaskIdentity,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if tsk == nil {
+		return taskAction, nil
+	}
+
+	organizationIdentity, err := projectOrganizationIdentity(r.ProjectRepository, r.WorkspaceRepository, tsk.ProjectIdentity)
+	if err != nil {
+		return nil, err
+	}
+
+	if organizationIdentity == nil {
+		return taskAction, nil
+	}
+
+	var taskActionDto *task.TaskActionDto = nil
+	if taskAction.User != nil {
+		taskActionDto = task.TaskActionToDto(taskAction)
+	}
+
+	err = r.PublishWebhookEventService.Execute(webhookservice.PublishWebhookEventInput{
+		OrganizationIdentity: *organizationIdentity,
+		EventType:            webhook.WebhookEventTypes(taskAction.Type),
+		Data: webhook.WebhookTaskEventDataDto{
+			Task:   task.TaskToDto(tsk),
+			Action: taskActionDto,
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return taskAction, nil
+}
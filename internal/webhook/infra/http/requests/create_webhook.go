@@ -0,0 +1,23 @@
+package webhookhttprequests
+
+import (
+	"github.com/gabrielmrtt/taski/internal/webhook"
+	webhookservice "github.com/gabrielmrtt/taski/internal/webhook/service"
+)
+
+type CreateWebhookRequest struct {
+	Url    string   `json:"url"`
+	Events []string `json:"events"`
+}
+
+func (r *CreateWebhookRequest) ToInput() webhookservice.CreateWebhookInput {
+	var events []webhook.WebhookEventTypes = make([]webhook.WebhookEventTypes, 0)
+	for _, event := range r.Events {
+		events = append(events, webhook.WebhookEventTypes(event))
+	}
+
+	return webhookservice.CreateWebhookInput{
+		Url:    r.Url,
+		Events: events,
+	}
+}
@@ -0,0 +1,52 @@
+package webhookhttprequests
+
+import (
+	"github.com/gabrielmrtt/taski/internal/core"
+	"github.com/gabrielmrtt/taski/internal/webhook"
+	webhookrepo "github.com/gabrielmrtt/taski/internal/webhook/repository"
+	webhookservice "github.com/gabrielmrtt/taski/internal/webhook/service"
+	"github.com/gin-gonic/gin"
+	"github.com/gorilla/schema"
+)
+
+type ListWebhookDeliveriesRequest struct {
+	Status    *string `json:"status" schema:"status"`
+	EventType *string `json:"eventType" schema:"eventType"`
+	Page      *int    `json:"page" schema:"page"`
+	PerPage   *int    `json:"perPage" schema:"perPage"`
+}
+
+func (r *ListWebhookDeliveriesRequest) FromQuery(ctx *gin.Context) error {
+	schemaDecoder := schema.NewDecoder()
+	schemaDecoder.IgnoreUnknownKeys(true)
+	return schemaDecoder.Decode(r, ctx.Request.URL.Query())
+}
+
+func (r *ListWebhookDeliveriesRequest) ToInput() webhookservice.ListWebhookDeliveriesInput {
+	var statusFilter *core.ComparableFilter[webhook.WebhookDeliveryStatuses] = nil
+	if r.Status != nil {
+		deliveryStatus := webhook.WebhookDeliveryStatuses(*r.Status)
+		statusFilter = &core.ComparableFilter[webhook.WebhookDeliveryStatuses]{
+			Equals: &deliveryStatus,
+		}
+	}
+
+	var eventTypeFilter *core.ComparableFilter[webhook.WebhookEventTypes] = nil
+	if r.EventType != nil {
+		eventType := webhook.WebhookEventTypes(*r.EventType)
+		eventTypeFilter = &core.ComparableFilter[webhook.WebhookEventTypes]{
+			Equals: &eventType,
+		}
+	}
+
+	return webhookservice.ListWebhookDeliveriesInput{
+		Filters: webhookrepo.WebhookDeliveryFilters{
+			Status:    statusFilter,
+			EventType: eventTypeFilter,
+		},
+		Pagination: core.PaginationInput{
+			Page:    r.Page,
+			PerPage: r.PerPage,
+		},
+	}
+}
@@ -0,0 +1,53 @@
+package webhookhttprequests
+
+import (
+	"github.com/gabrielmrtt/taski/internal/core"
+	"github.com/gabrielmrtt/taski/internal/webhook"
+	webhookrepo "github.com/gabrielmrtt/taski/internal/webhook/repository"
+	webhookservice "github.com/gabrielmrtt/taski/internal/webhook/service"
+	"github.com/gin-gonic/gin"
+	"github.com/gorilla/schema"
+)
+
+type ListWebhooksRequest struct {
+	Status        *string `json:"status" schema:"status"`
+	Page          *int    `json:"page" schema:"page"`
+	PerPage       *int    `json:"perPage" schema:"perPage"`
+	SortBy        *string `json:"sortBy" schema:"sortBy"`
+	SortDirection *string `json:"sortDirection" schema:"sortDirection"`
+}
+
+func (r *ListWebhooksRequest) FromQuery(ctx *gin.Context) error {
+	schemaDecoder := schema.NewDecoder()
+	schemaDecoder.IgnoreUnknownKeys(true)
+	return schemaDecoder.Decode(r, ctx.Request.URL.Query())
+}
+
+func (r *ListWebhooksRequest) ToInput() webhookservice.ListWebhooksInput {
+	var sortDirection core.SortDirection
+	if r.SortDirection != nil {
+		sortDirection = core.SortDirection(*r.SortDirection)
+	}
+
+	var statusFilter *core.ComparableFilter[webhook.WebhookStatuses] = nil
+	if r.Status != nil {
+		webhookStatus := webhook.WebhookStatuses(*r.Status)
+		statusFilter = &core.ComparableFilter[webhook.WebhookStatuses]{
+			Equals: &webhookStatus,
+		}
+	}
+
+	return webhookservice.ListWebhooksInput{
+		Filters: webhookrepo.WebhookFilters{
+			Status: statusFilter,
+		},
+		Pagination: core.PaginationInput{
+			Page:    r.Page,
+			PerPage: r.PerPage,
+		},
+		SortInput: core.SortInput{
+			By:        r.SortBy,
+			Direction: &sortDirection,
+		},
+	}
+}
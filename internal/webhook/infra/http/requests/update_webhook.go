@@ -0,0 +1,34 @@
+package webhookhttprequests
+
+import (
+	"github.com/gabrielmrtt/taski/internal/webhook"
+	webhookservice "github.com/gabrielmrtt/taski/internal/webhook/service"
+)
+
+type UpdateWebhookRequest struct {
+	Url    *string   `json:"url"`
+	Events *[]string `json:"events"`
+	Status *string   `json:"status"`
+}
+
+func (r *UpdateWebhookRequest) ToInput() webhookservice.UpdateWebhookInput {
+	var events []webhook.WebhookEventTypes = nil
+	if r.Events != nil {
+		events = make([]webhook.WebhookEventTypes, 0)
+		for _, event := range *r.Events {
+			events = append(events, webhook.WebhookEventTypes(event))
+		}
+	}
+
+	var status *webhook.WebhookStatuses = nil
+	if r.Status != nil {
+		webhookStatus := webhook.WebhookStatuses(*r.Status)
+		status = &webhookStatus
+	}
+
+	return webhookservice.UpdateWebhookInput{
+		Url:    r.Url,
+		Events: events,
+		Status: status,
+	}
+}
@@ -0,0 +1,355 @@
+package webhookhttp
+
+import (
+	"net/http"
+
+	authhttpmiddlewares "github.com/gabrielmrtt/taski/internal/auth/infra/http/middlewares"
+	"github.com/gabrielmrtt/taski/internal/core"
+	corehttp "github.com/gabrielmrtt/taski/internal/core/http"
+	organizationhttpmiddlewares "github.com/gabrielmrtt/taski/internal/organization/infra/http/middlewares"
+	"github.com/gabrielmrtt/taski/internal/webhook"
+	webhookhttprequests "github.com/gabrielmrtt/taski/internal/webhook/infra/http/requests"
+	webhookservice "github.com/gabrielmrtt/taski/internal/webhook/service"
+	"github.com/gin-gonic/gin"
+)
+
+type WebhookHandler struct {
+	ListWebhooksService             *webhookservice.ListWebhooksService
+	GetWebhookService               *webhookservice.GetWebhookService
+	CreateWebhookService            *webhookservice.CreateWebhookService
+	UpdateWebhookService            *webhookservice.UpdateWebhookService
+	DeleteWebhookService            *webhookservice.DeleteWebhookService
+	PingWebhookService              *webhookservice.PingWebhookService
+	ListWebhookDeliveriesService    *webhookservice.ListWebhookDeliveriesService
+	RedeliverWebhookDeliveryService *webhookservice.RedeliverWebhookDeliveryService
+}
+
+func NewWebhookHandler(
+	listWebhooksService *webhookservice.ListWebhooksService,
+	getWebhookService *webhookservice.GetWebhookService,
+	createWebhookService *webhookservice.CreateWebhookService,
+	updateWebhookService *webhookservice.UpdateWebhookService,
+	deleteWebhookService *webhookservice.DeleteWebhookService,
+	pingWebhookService *webhookservice.PingWebhookService,
+	listWebhookDeliveriesService *webhookservice.ListWebhookDeliveriesService,
+	redeliverWebhookDeliveryService *webhookservice.RedeliverWebhookDeliveryService,
+) *WebhookHandler {
+	return &WebhookHandler{
+		ListWebhooksService:             listWebhooksService,
+		GetWebhookService:               getWebhookService,
+		CreateWebhookService:            createWebhookService,
+		UpdateWebhookService:            updateWebhookService,
+		DeleteWebhookService:            deleteWebhookService,
+		PingWebhookService:              pingWebhookService,
+		ListWebhookDeliveriesService:    listWebhookDeliveriesService,
+		RedeliverWebhookDeliveryService: redeliverWebhookDeliveryService,
+	}
+}
+
+type ListWebhooksResponse = corehttp.HttpSuccessResponseWithData[core.PaginationOutput[webhook.WebhookDto]]
+
+// ListWebhooks godoc
+// @Summary List webhooks
+// @Description Lists the webhooks of the authenticated user's organization. Secrets are not returned.
+// @Tags Webhook
+// @Accept json
+// @Param request query webhookhttprequests.ListWebhooksRequest true "Query parameters"
+// @Produce json
+// @Success 200 {object} ListWebhooksResponse
+// @Failure 400 {object} corehttp.HttpErrorResponse
+// @Failure 401 {object} corehttp.HttpErrorResponse
+// @Failure 403 {object} corehttp.HttpErrorResponse
+// @Failure 500 {object} corehttp.HttpErrorResponse
+// @Router /webhook [get]
+func (h *WebhookHandler) ListWebhooks(c *gin.Context) {
+	var request webhookhttprequests.ListWebhooksRequest
+	var organizationIdentity *core.Identity = authhttpmiddlewares.GetAuthenticatedUserLastAccessedOrganizationIdentity(c)
+	var input webhookservice.ListWebhooksInput
+
+	if err := request.FromQuery(c); err != nil {
+		corehttp.NewHttpErrorResponse(c, err)
+		return
+	}
+
+	input = request.ToInput()
+	input.Filters.OrganizationIdentity = organizationIdentity
+	response, err := h.ListWebhooksService.Execute(input)
+	if err != nil {
+		corehttp.NewHttpErrorResponse(c, err)
+		return
+	}
+
+	corehttp.NewHttpSuccessResponseWithData(c, http.StatusOK, response)
+}
+
+type GetWebhookResponse = corehttp.HttpSuccessResponseWithData[webhook.WebhookDto]
+
+// GetWebhook godoc
+// @Summary Get a webhook
+// @Description Returns a webhook of the authenticated user's organization. The secret is not returned.
+// @Tags Webhook
+// @Accept json
+// @Param webhookId path string true "Webhook ID"
+// @Produce json
+// @Success 200 {object} GetWebhookResponse
+// @Failure 400 {object} corehttp.HttpErrorResponse
+// @Failure 401 {object} corehttp.HttpErrorResponse
+// @Failure 403 {object} corehttp.HttpErrorResponse
+// @Failure 404 {object} corehttp.HttpErrorResponse
+// @Failure 500 {object} corehttp.HttpErrorResponse
+// @Router /webhook/:webhookId [get]
+func (h *WebhookHandler) GetWebhook(c *gin.Context) {
+	var organizationIdentity *core.Identity = authhttpmiddlewares.GetAuthenticatedUserLastAccessedOrganizationIdentity(c)
+	var input webhookservice.GetWebhookInput = webhookservice.GetWebhookInput{
+		WebhookIdentity:      core.NewIdentityFromPublic(c.Param("webhookId")),
+		OrganizationIdentity: *organizationIdentity,
+	}
+
+	response, err := h.GetWebhookService.Execute(input)
+	if err != nil {
+		corehttp.NewHttpErrorResponse(c, err)
+		return
+	}
+
+	corehttp.NewHttpSuccessResponseWithData(c, http.StatusOK, response)
+}
+
+type CreateWebhookResponse = corehttp.HttpSuccessResponseWithData[webhook.WebhookDto]
+
+// CreateWebhook godoc
+// @Summary Create a webhook
+// @Description Subscribes an URL to organization events. Use "*" to receive every event. The response contains the signing secret, which is only shown once: requests carry an X-Taski-Signature header with "sha256=" followed by the hex HMAC-SHA256 of "<X-Taski-Timestamp>.<body>" keyed with it.
+// @Tags Webhook
+// @Accept json
+// @Param request body webhookhttprequests.CreateWebhookRequest true "Request body"
+// @Produce json
+// @Success 200 {object} CreateWebhookResponse
+// @Failure 400 {object} corehttp.HttpErrorResponse
+// @Failure 401 {object} corehttp.HttpErrorResponse
+// @Failure 403 {object} corehttp.HttpErrorResponse
+// @Failure 500 {object} corehttp.HttpErrorResponse
+// @Router /webhook [post]
+func (h *WebhookHandler) CreateWebhook(c *gin.Context) {
+	var request webhookhttprequests.CreateWebhookRequest
+	var organizationIdentity *core.Identity = authhttpmiddlewares.GetAuthenticatedUserLastAccessedOrganizationIdentity(c)
+	var authenticatedUserIdentity *core.Identity = authhttpmiddlewares.GetAuthenticatedUserIdentity(c)
+	var input webhookservice.CreateWebhookInput
+
+	if err := c.ShouldBindJSON(&request); err != nil {
+		corehttp.NewHttpErrorResponse(c, err)
+		return
+	}
+
+	input = request.ToInput()
+	input.OrganizationIdentity = *organizationIdentity
+	input.UserCreatorIdentity = *authenticatedUserIdentity
+	response, err := h.CreateWebhookService.Execute(input)
+	if err != nil {
+		corehttp.NewHttpErrorResponse(c, err)
+		return
+	}
+
+	corehttp.NewHttpSuccessResponseWithData(c, http.StatusOK, response)
+}
+
+type UpdateWebhookResponse = corehttp.EmptyHttpSuccessResponse
+
+// UpdateWebhook godoc
+// @Summary Update a webhook
+// @Description Changes the URL, the subscribed events or the status of a webhook. Inactive webhooks receive no events and their pending deliveries fail.
+// @Tags Webhook
+// @Accept json
+// @Param webhookId path string true "Webhook ID"
+// @Param request body webhookhttprequests.UpdateWebhookRequest true "Request body"
+// @Produce json
+// @Success 200 {object} UpdateWebhookResponse
+// @Failure 400 {object} corehttp.HttpErrorResponse
+// @Failure 401 {object} corehttp.HttpErrorResponse
+// @Failure 403 {object} corehttp.HttpErrorResponse
+// @Failure 404 {object} corehttp.HttpErrorResponse
+// @Failure 500 {object} corehttp.HttpErrorResponse
+// @Router /webhook/:webhookId [put]
+func (h *WebhookHandler) UpdateWebhook(c *gin.Context) {
+	var request webhookhttprequests.UpdateWebhookRequest
+	var organizationIdentity *core.Identity = authhttpmiddlewares.GetAuthenticatedUserLastAccessedOrganizationIdentity(c)
+	var authenticatedUserIdentity *core.Identity = authhttpmiddlewares.GetAuthenticatedUserIdentity(c)
+	var input webhookservice.UpdateWebhookInput
+
+	if err := c.ShouldBindJSON(&request); err != nil {
+		corehttp.NewHttpErrorResponse(c, err)
+		return
+	}
+
+	input = request.ToInput()
+	input.WebhookIdentity = core.NewIdentityFromPublic(c.Param("webhookId"))
+	input.OrganizationIdentity = *organizationIdentity
+	input.UserEditorIdentity = *authenticatedUserIdentity
+	err := h.UpdateWebhookService.Execute(input)
+	if err != nil {
+		corehttp.NewHttpErrorResponse(c, err)
+		return
+	}
+
+	corehttp.NewEmptyHttpSuccessResponse(c, http.StatusOK)
+}
+
+type DeleteWebhookResponse = corehttp.EmptyHttpSuccessResponse
+
+// DeleteWebhook godoc
+// @Summary Delete a webhook
+// @Description Deletes a webhook and its delivery log.
+// @Tags Webhook
+// @Accept json
+// @Param webhookId path string true "Webhook ID"
+// @Produce json
+// @Success 200 {object} DeleteWebhookResponse
+// @Failure 400 {object} corehttp.HttpErrorResponse
+// @Failure 401 {object} corehttp.HttpErrorResponse
+// @Failure 403 {object} corehttp.HttpErrorResponse
+// @Failure 404 {object} corehttp.HttpErrorResponse
+// @Failure 500 {object} corehttp.HttpErrorResponse
+// @Router /webhook/:webhookId [delete]
+func (h *WebhookHandler) DeleteWebhook(c *gin.Context) {
+	var organizationIdentity *core.Identity = authhttpmiddlewares.GetAuthenticatedUserLastAccessedOrganizationIdentity(c)
+	var input webhookservice.DeleteWebhookInput = webhookservice.DeleteWebhookInput{
+		WebhookIdentity:      core.NewIdentityFromPublic(c.Param("webhookId")),
+		OrganizationIdentity: *organizationIdentity,
+	}
+
+	err := h.DeleteWebhookService.Execute(input)
+	if err != nil {
+		corehttp.NewHttpErrorResponse(c, err)
+		return
+	}
+
+	corehttp.NewEmptyHttpSuccessResponse(c, http.StatusOK)
+}
+
+type PingWebhookResponse = corehttp.HttpSuccessResponseWithData[webhook.WebhookDeliveryDto]
+
+// PingWebhook godoc
+// @Summary Ping a webhook
+// @Description Queues a ping event to the webhook to test its receiver. The returned delivery can be followed in the delivery log.
+// @Tags Webhook
+// @Accept json
+// @Param webhookId path string true "Webhook ID"
+// @Produce json
+// @Success 200 {object} PingWebhookResponse
+// @Failure 400 {object} corehttp.HttpErrorResponse
+// @Failure 401 {object} corehttp.HttpErrorResponse
+// @Failure 403 {object} corehttp.HttpErrorResponse
+// @Failure 404 {object} corehttp.HttpErrorResponse
+// @Failure 409 {object} corehttp.HttpErrorResponse
+// @Failure 500 {object} corehttp.HttpErrorResponse
+// @Router /webhook/:webhookId/ping [post]
+func (h *WebhookHandler) PingWebhook(c *gin.Context) {
+	var organizationIdentity *core.Identity = authhttpmiddlewares.GetAuthenticatedUserLastAccessedOrganizationIdentity(c)
+	var input webhookservice.PingWebhookInput = webhookservice.PingWebhookInput{
+		WebhookIdentity:      core.NewIdentityFromPublic(c.Param("webhookId")),
+		OrganizationIdentity: *organizationIdentity,
+	}
+
+	response, err := h.PingWebhookService.Execute(input)
+	if err != nil {
+		corehttp.NewHttpErrorResponse(c, err)
+		return
+	}
+
+	corehttp.NewHttpSuccessResponseWithData(c, http.StatusOK, response)
+}
+
+type ListWebhookDeliveriesResponse = corehttp.HttpSuccessResponseWithData[core.PaginationOutput[webhook.WebhookDeliveryDto]]
+
+// ListWebhookDeliveries godoc
+// @Summary List webhook deliveries
+// @Description Returns the delivery log of a webhook, newest first, with the payload sent and the last response received.
+// @Tags Webhook
+// @Accept json
+// @Param webhookId path string true "Webhook ID"
+// @Param request query webhookhttprequests.ListWebhookDeliveriesRequest true "Query parameters"
+// @Produce json
+// @Success 200 {object} ListWebhookDeliveriesResponse
+// @Failure 400 {object} corehttp.HttpErrorResponse
+// @Failure 401 {object} corehttp.HttpErrorResponse
+// @Failure 403 {object} corehttp.HttpErrorResponse
+// @Failure 404 {object} corehttp.HttpErrorResponse
+// @Failure 500 {object} corehttp.HttpErrorResponse
+// @Router /webhook/:webhookId/delivery [get]
+func (h *WebhookHandler) ListWebhookDeliveries(c *gin.Context) {
+	var request webhookhttprequests.ListWebhookDeliveriesRequest
+	var organizationIdentity *core.Identity = authhttpmiddlewares.GetAuthenticatedUserLastAccessedOrganizationIdentity(c)
+	var input webhookservice.ListWebhookDeliveriesInput
+
+	if err := request.FromQuery(c); err != nil {
+		corehttp.NewHttpErrorResponse(c, err)
+		return
+	}
+
+	input = request.ToInput()
+	input.WebhookIdentity = core.NewIdentityFromPublic(c.Param("webhookId"))
+	input.OrganizationIdentity = *organizationIdentity
+	response, err := h.ListWebhookDeliveriesService.Execute(input)
+	if err != nil {
+		corehttp.NewHttpErrorResponse(c, err)
+		return
+	}
+
+	corehttp.NewHttpSuccessResponseWithData(c, http.StatusOK, response)
+}
+
+type RedeliverWebhookDeliveryResponse = corehttp.HttpSuccessResponseWithData[webhook.WebhookDeliveryDto]
+
+// RedeliverWebhookDelivery godoc
+// @Summary Redeliver a webhook delivery
+// @Description Queues the event of a completed delivery again as a new delivery with the same event id and payload.
+// @Tags Webhook
+// @Accept json
+// @Param webhookId path string true "Webhook ID"
+// @Param deliveryId path string true "Webhook Delivery ID"
+// @Produce json
+// @Success 200 {object} RedeliverWebhookDeliveryResponse
+// @Failure 400 {object} corehttp.HttpErrorResponse
+// @Failure 401 {object} corehttp.HttpErrorResponse
+// @Failure 403 {object} corehttp.HttpErrorResponse
+// @Failure 404 {object} corehttp.HttpErrorResponse
+// @Failure 409 {object} corehttp.HttpErrorResponse
+// @Failure 500 {object} corehttp.HttpErrorResponse
+// @Router /webhook/:webhookId/delivery/:deliveryId/redeliver [post]
+func (h *WebhookHandler) RedeliverWebhookDelivery(c *gin.Context) {
+	var organizationIdentity *core.Identity = authhttpmiddlewares.GetAuthenticatedUserLastAccessedOrganizationIdentity(c)
+	var input webhookservice.RedeliverWebhookDeliveryInput = webhookservice.RedeliverWebhookDeliveryInput{
+		WebhookIdentity:         core.NewIdentityFromPublic(c.Param("webhookId")),
+		WebhookDeliveryIdentity: core.NewIdentityFromPublic(c.Param("deliveryId")),
+		OrganizationIdentity:    *organizationIdentity,
+	}
+
+	response, err := h.RedeliverWebhookDeliveryService.Execute(input)
+	if err != nil {
+		corehttp.NewHttpErrorResponse(c, err)
+		return
+	}
+
+	corehttp.NewHttpSuccessResponseWithData(c, http.StatusOK, response)
+}
+
+func (h *WebhookHandler) ConfigureRoutes(options corehttp.ConfigureRoutesOptions) *gin.RouterGroup {
+	middlewareOptions := corehttp.MiddlewareOptions{
+		DbConnection: options.DbConnection,
+	}
+
+	g := options.RouterGroup.Group("/webhook")
+	{
+		g.Use(authhttpmiddlewares.AuthMiddleware(middlewareOptions))
+
+		g.GET("", organizationhttpmiddlewares.UserMustHavePermission("organizations:update", middlewareOptions), h.ListWebhooks)
+		g.POST("", organizationhttpmiddlewares.UserMustHavePermission("organizations:update", middlewareOptions), h.CreateWebhook)
+		g.GET("/:webhookId", organizationhttpmiddlewares.UserMustHavePermission("organizations:update", middlewareOptions), h.GetWebhook)
+		g.PUT("/:webhookId", organizationhttpmiddlewares.UserMustHavePermission("organizations:update", middlewareOptions), h.UpdateWebhook)
+		g.DELETE("/:webhookId", organizationhttpmiddlewares.UserMustHavePermission("organizations:update", middlewareOptions), h.DeleteWebhook)
+		g.POST("/:webhookId/ping", organizationhttpmiddlewares.UserMustHavePermission("organizations:update", middlewareOptions), h.PingWebhook)
+		g.GET("/:webhookId/delivery", organizationhttpmiddlewares.UserMustHavePermission("organizations:update", middlewareOptions), h.ListWebhookDeliveries)
+		g.POST("/:webhookId/delivery/:deliveryId/redeliver", organizationhttpmiddlewares.UserMustHavePermission("organizations:update", middlewareOptions), h.RedeliverWebhookDelivery)
+	}
+
+	return g
+}
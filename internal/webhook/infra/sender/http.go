@@ -0,0 +1,102 @@
+package webhooksender
+
+import (
+	"errors"
+	"io"
+	"net"
+	"net/http"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/gabrielmrtt/taski/internal/webhook"
+)
+
+/*
+HttpWebhookSenderOptions configures the sender. AllowPrivateAddresses turns off the check of the dialed addresses, to
+test webhooks against a local sink; it must stay off in production.
+*/
+type HttpWebhookSenderOptions struct {
+	TimeoutSeconds        int64
+	MaxResponseBodySize   int64
+	AllowPrivateAddresses bool
+}
+
+type HttpWebhookSender struct {
+	Client              *http.Client
+	MaxResponseBodySize int64
+}
+
+func NewHttpWebhookSender(options HttpWebhookSenderOptions) *HttpWebhookSender {
+	dialer := &net.Dialer{
+		Timeout: time.Duration(options.TimeoutSeconds) * time.Second,
+		Control: controlWebhookDial,
+	}
+
+	if options.AllowPrivateAddresses {
+		dialer.Control = nil
+	}
+
+	return &HttpWebhookSender{
+		Client: &http.Client{
+			Timeout: time.Duration(options.TimeoutSeconds) * time.Second,
+			// Webhooks are dialed directly, a proxy would dial the address on behalf of the sender and bypass its checks.
+			Transport: &http.Transport{
+				Proxy:                 nil,
+				DialContext:           dialer.DialContext,
+				TLSHandshakeTimeout:   time.Duration(options.TimeoutSeconds) * time.Second,
+				ResponseHeaderTimeout: time.Duration(options.TimeoutSeconds) * time.Second,
+			},
+			// Redirects are not followed so a webhook cannot be bounced to an URL that was never registered.
+			CheckRedirect: func(req *http.Request, via []*http.Request) error {
+				return http.ErrUseLastResponse
+			},
+		},
+		MaxResponseBodySize: options.MaxResponseBodySize,
+	}
+}
+
+func (s *HttpWebhookSender) Send(request webhook.WebhookRequest) (*webhook.WebhookResponse, error) {
+	httpRequest, err := http.NewRequest(http.MethodPost, request.Url, strings.NewReader(request.Body))
+	if err != nil {
+		return nil, err
+	}
+
+	for key, value := range request.Headers {
+		httpRequest.Header.Set(key, value)
+	}
+
+	httpResponse, err := s.Client.Do(httpRequest)
+	if err != nil {
+		return nil, err
+	}
+	defer httpResponse.Body.Close()
+
+	// The body is only read, up to its maximum size, to let the connection be reused
+	_, err = io.Copy(io.Discard, io.LimitReader(httpResponse.Body, s.MaxResponseBodySize))
+	if err != nil {
+		return nil, err
+	}
+
+	return &webhook.WebhookResponse{
+		StatusCode: httpResponse.StatusCode,
+	}, nil
+}
+
+/*
+controlWebhookDial runs right before every connection is made, with the address the host name was resolved to. Checking
+it here rather than the url alone keeps a host name from resolving, or being rebound, to a private address.
+*/
+func controlWebhookDial(network string, address string, c syscall.RawConn) error {
+	host, _, err := net.SplitHostPort(address)
+	if err != nil {
+		return err
+	}
+
+	ip := net.ParseIP(host)
+	if ip == nil || !webhook.IsPublicWebhookIp(ip) {
+		return errors.New("webhook url resolves to a non public address")
+	}
+
+	return nil
+}
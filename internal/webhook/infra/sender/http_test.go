@@ -0,0 +1,33 @@
+package webhooksender
+
+import "testing"
+
+func TestControlWebhookDial(t *testing.T) {
+	tests := []struct {
+		name    string
+		address string
+		allowed bool
+	}{
+		{name: "public ipv4", address: "93.184.216.34:443", allowed: true},
+		{name: "public ipv6", address: "[2606:2800:220:1:248:1893:25c8:1946]:443", allowed: true},
+		{name: "loopback", address: "127.0.0.1:80"},
+		{name: "loopback ipv6", address: "[::1]:80"},
+		{name: "private", address: "192.168.0.10:8080"},
+		{name: "metadata service", address: "169.254.169.254:80"},
+		{name: "unresolved host", address: "example.com:443"},
+		{name: "without port", address: "93.184.216.34"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := controlWebhookDial("tcp", tt.address, nil)
+			if tt.allowed && err != nil {
+				t.Errorf("expected %s to be dialed, got %v", tt.address, err)
+			}
+
+			if !tt.allowed && err == nil {
+				t.Errorf("expected %s to be refused", tt.address)
+			}
+		})
+	}
+}
@@ -0,0 +1,52 @@
+package webhookrepo
+
+import (
+	"github.com/gabrielmrtt/taski/internal/core"
+	"github.com/gabrielmrtt/taski/internal/webhook"
+)
+
+type WebhookDeliveryFilters struct {
+	WebhookIdentity core.Identity
+	EventType       *core.ComparableFilter[webhook.WebhookEventTypes]
+	Status          *core.ComparableFilter[webhook.WebhookDeliveryStatuses]
+	CreatedAt       *core.ComparableFilter[int64]
+}
+
+type GetWebhookDeliveryByIdentityParams struct {
+	WebhookDeliveryIdentity core.Identity
+	WebhookIdentity         *core.Identity
+}
+
+type PaginateWebhookDeliveriesParams struct {
+	Filters    WebhookDeliveryFilters
+	Pagination core.PaginationInput
+}
+
+/*
+ClaimDueWebhookDeliveriesParams claims up to Limit pending deliveries due at Now by pushing their next attempt to
+LeaseUntil, so concurrent workers do not send the same delivery twice.
+*/
+type ClaimDueWebhookDeliveriesParams struct {
+	Now        int64
+	LeaseUntil int64
+	Limit      int
+}
+
+type StoreWebhookDeliveryParams struct {
+	WebhookDelivery *webhook.WebhookDelivery
+}
+
+type UpdateWebhookDeliveryParams struct {
+	WebhookDelivery *webhook.WebhookDelivery
+}
+
+type WebhookDeliveryRepository interface {
+	SetTransaction(tx core.Transaction) error
+
+	GetWebhookDeliveryByIdentity(params GetWebhookDeliveryByIdentityParams) (*webhook.WebhookDelivery, error)
+	PaginateWebhookDeliveriesBy(params PaginateWebhookDeliveriesParams) (*core.PaginationOutput[webhook.WebhookDelivery], error)
+	ClaimDueWebhookDeliveries(params ClaimDueWebhookDeliveriesParams) ([]webhook.WebhookDelivery, error)
+
+	StoreWebhookDelivery(params StoreWebhookDeliveryParams) (*webhook.WebhookDelivery, error)
+	UpdateWebhookDelivery(params UpdateWebhookDeliveryParams) error
+}
@@ -0,0 +1,62 @@
+package webhookrepo
+
+import (
+	"github.com/gabrielmrtt/taski/internal/core"
+	"github.com/gabrielmrtt/taski/internal/webhook"
+)
+
+/*
+WebhookFilters narrows webhooks by organization. WorkspaceIdentity, ProjectIdentity and TaskIdentity select the
+webhooks of the organization owning that workspace, project or task, without loading it first.
+*/
+type WebhookFilters struct {
+	OrganizationIdentity *core.Identity
+	WorkspaceIdentity    *core.Identity
+	ProjectIdentity      *core.Identity
+	TaskIdentity         *core.Identity
+	Status               *core.ComparableFilter[webhook.WebhookStatuses]
+}
+
+type GetWebhookByIdentityParams struct {
+	WebhookIdentity      core.Identity
+	OrganizationIdentity *core.Identity
+}
+
+type PaginateWebhooksParams struct {
+	Filters    WebhookFilters
+	SortInput  core.SortInput
+	Pagination core.PaginationInput
+}
+
+type ListWebhooksByParams struct {
+	Filters WebhookFilters
+}
+
+type HasWebhooksByParams struct {
+	Filters WebhookFilters
+}
+
+type StoreWebhookParams struct {
+	Webhook *webhook.Webhook
+}
+
+type UpdateWebhookParams struct {
+	Webhook *webhook.Webhook
+}
+
+type DeleteWebhookParams struct {
+	WebhookIdentity core.Identity
+}
+
+type WebhookRepository interface {
+	SetTransaction(tx core.Transaction) error
+
+	GetWebhookByIdentity(params GetWebhookByIdentityParams) (*webhook.Webhook, error)
+	PaginateWebhooksBy(params PaginateWebhooksParams) (*core.PaginationOutput[webhook.Webhook], error)
+	ListWebhooksBy(params ListWebhooksByParams) ([]webhook.Webhook, error)
+	HasWebhooksBy(params HasWebhooksByParams) (bool, error)
+
+	StoreWebhook(params StoreWebhookParams) (*webhook.Webhook, error)
+	UpdateWebhook(params UpdateWebhookParams) error
+	DeleteWebhook(params DeleteWebhookParams) error
+}
@@ -0,0 +1,19 @@
+package webhook
+
+type WebhookRequest struct {
+	Url     string
+	Headers map[string]string
+	Body    string
+}
+
+type WebhookResponse struct {
+	StatusCode int
+}
+
+/*
+WebhookSender sends a webhook request. Non-2xx responses are returned as responses; an error means the request could
+not be completed at all (connection refused, timeout...).
+*/
+type WebhookSender interface {
+	Send(request WebhookRequest) (*WebhookResponse, error)
+}
@@ -0,0 +1,94 @@
+package webhookservice
+
+import (
+	"github.com/gabrielmrtt/taski/internal/core"
+	"github.com/gabrielmrtt/taski/internal/webhook"
+	webhookrepo "github.com/gabrielmrtt/taski/internal/webhook/repository"
+)
+
+type CreateWebhookService struct {
+	WebhookRepository     webhookrepo.WebhookRepository
+	TransactionRepository core.TransactionRepository
+}
+
+func NewCreateWebhookService(
+	webhookRepository webhookrepo.WebhookRepository,
+	transactionRepository core.TransactionRepository,
+) *CreateWebhookService {
+	return &CreateWebhookService{
+		WebhookRepository:     webhookRepository,
+		TransactionRepository: transactionRepository,
+	}
+}
+
+type CreateWebhookInput struct {
+	OrganizationIdentity core.Identity
+	UserCreatorIdentity  core.Identity
+	Url                  string
+	Events               []webhook.WebhookEventTypes
+}
+
+func (i CreateWebhookInput) Validate() error {
+	var fields []core.InvalidInputErrorField
+
+	if _, err := webhook.NewWebhookUrl(i.Url); err != nil {
+		fields = append(fields, core.InvalidInputErrorField{
+			Field: "url",
+			Error: err.Error(),
+		})
+	}
+
+	if len(i.Events) == 0 {
+		fields = append(fields, core.InvalidInputErrorField{
+			Field: "events",
+			Error: "at least one event is required",
+		})
+	}
+
+	if len(fields) > 0 {
+		return core.NewInvalidInputError("invalid input", fields)
+	}
+
+	return nil
+}
+
+/*
+Execute creates the webhook and returns it with its signing secret. The secret is not returned again afterwards.
+*/
+func (s *CreateWebhookService) Execute(input CreateWebhookInput) (*webhook.WebhookDto, error) {
+	if err := input.Validate(); err != nil {
+		return nil, err
+	}
+
+	tx, err := s.TransactionRepository.BeginTransaction()
+	if err != nil {
+		return nil, err
+	}
+
+	s.WebhookRepository.SetTransaction(tx)
+
+	wh, err := webhook.NewWebhook(webhook.NewWebhookInput{
+		OrganizationIdentity: input.OrganizationIdentity,
+		Url:                  input.Url,
+		Events:               input.Events,
+		UserCreatorIdentity:  &input.UserCreatorIdentity,
+	})
+	if err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+
+	_, err = s.WebhookRepository.StoreWebhook(webhookrepo.StoreWebhookParams{Webhook: wh})
+	if err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+
+	return webhook.WebhookWithSecretToDto(wh), nil
+}
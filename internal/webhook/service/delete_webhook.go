@@ -0,0 +1,74 @@
+package webhookservice
+
+import (
+	"github.com/gabrielmrtt/taski/internal/core"
+	webhookrepo "github.com/gabrielmrtt/taski/internal/webhook/repository"
+)
+
+type DeleteWebhookService struct {
+	WebhookRepository     webhookrepo.WebhookRepository
+	TransactionRepository core.TransactionRepository
+}
+
+func NewDeleteWebhookService(
+	webhookRepository webhookrepo.WebhookRepository,
+	transactionRepository core.TransactionRepository,
+) *DeleteWebhookService {
+	return &DeleteWebhookService{
+		WebhookRepository:     webhookRepository,
+		TransactionRepository: transactionRepository,
+	}
+}
+
+type DeleteWebhookInput struct {
+	WebhookIdentity      core.Identity
+	OrganizationIdentity core.Identity
+}
+
+func (i DeleteWebhookInput) Validate() error {
+	return nil
+}
+
+/*
+Execute deletes the webhook together with its delivery log.
+*/
+func (s *DeleteWebhookService) Execute(input DeleteWebhookInput) error {
+	if err := input.Validate(); err != nil {
+		return err
+	}
+
+	tx, err := s.TransactionRepository.BeginTransaction()
+	if err != nil {
+		return err
+	}
+
+	s.WebhookRepository.SetTransaction(tx)
+
+	wh, err := s.WebhookRepository.GetWebhookByIdentity(webhookrepo.GetWebhookByIdentityParams{
+		WebhookIdentity:      input.WebhookIdentity,
+		OrganizationIdentity: &input.OrganizationIdentity,
+	})
+	if err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	if wh == nil {
+		tx.Rollback()
+		return core.NewNotFoundError("webhook not found")
+	}
+
+	err = s.WebhookRepository.DeleteWebhook(webhookrepo.DeleteWebhookParams{WebhookIdentity: wh.Identity})
+	if err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,114 @@
+package webhookservice
+
+import (
+	"strconv"
+
+	"github.com/gabrielmrtt/taski/internal/core"
+	"github.com/gabrielmrtt/taski/internal/webhook"
+	webhookrepo "github.com/gabrielmrtt/taski/internal/webhook/repository"
+)
+
+type DeliverWebhooksService struct {
+	WebhookRepository         webhookrepo.WebhookRepository
+	WebhookDeliveryRepository webhookrepo.WebhookDeliveryRepository
+	WebhookSender             webhook.WebhookSender
+}
+
+func NewDeliverWebhooksService(
+	webhookRepository webhookrepo.WebhookRepository,
+	webhookDeliveryRepository webhookrepo.WebhookDeliveryRepository,
+	webhookSender webhook.WebhookSender,
+) *DeliverWebhooksService {
+	return &DeliverWebhooksService{
+		WebhookRepository:         webhookRepository,
+		WebhookDeliveryRepository: webhookDeliveryRepository,
+		WebhookSender:             webhookSender,
+	}
+}
+
+type DeliverWebhooksInput struct {
+	Limit int
+}
+
+func (i DeliverWebhooksInput) Validate() error {
+	var fields []core.InvalidInputErrorField
+
+	if i.Limit <= 0 {
+		fields = append(fields, core.InvalidInputErrorField{
+			Field: "limit",
+			Error: "limit must be greater than 0",
+		})
+	}
+
+	if len(fields) > 0 {
+		return core.NewInvalidInputError("invalid input", fields)
+	}
+
+	return nil
+}
+
+/*
+Execute sends the deliveries that are due and returns how many were attempted. Claimed deliveries are leased for
+WebhookDeliveryLeaseSeconds, so a delivery interrupted by a crash is picked up again once the lease expires.
+*/
+func (s *DeliverWebhooksService) Execute(input DeliverWebhooksInput) (int, error) {
+	if err := input.Validate(); err != nil {
+		return 0, err
+	}
+
+	now := core.NewDateTime()
+	deliveries, err := s.WebhookDeliveryRepository.ClaimDueWebhookDeliveries(webhookrepo.ClaimDueWebhookDeliveriesParams{
+		Now:        now.Value,
+		LeaseUntil: now.Value + webhook.WebhookDeliveryLeaseSeconds,
+		Limit:      input.Limit,
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	for _, delivery := range deliveries {
+		if err := s.deliver(&delivery); err != nil {
+			return 0, err
+		}
+	}
+
+	return len(deliveries), nil
+}
+
+func (s *DeliverWebhooksService) deliver(delivery *webhook.WebhookDelivery) error {
+	wh, err := s.WebhookRepository.GetWebhookByIdentity(webhookrepo.GetWebhookByIdentityParams{
+		WebhookIdentity: delivery.WebhookIdentity,
+	})
+	if err != nil {
+		return err
+	}
+
+	if wh == nil || !wh.IsActive() {
+		delivery.Fail("webhook is inactive")
+		return s.WebhookDeliveryRepository.UpdateWebhookDelivery(webhookrepo.UpdateWebhookDeliveryParams{WebhookDelivery: delivery})
+	}
+
+	timestamp := core.NewDateTime().Value
+	response, err := s.WebhookSender.Send(webhook.WebhookRequest{
+		Url: wh.Url,
+		Headers: map[string]string{
+			"Content-Type":                 "application/json",
+			"User-Agent":                   "Taski-Webhook",
+			webhook.WebhookEventHeader:     string(delivery.EventType),
+			webhook.WebhookDeliveryHeader:  delivery.Identity.Public,
+			webhook.WebhookTimestampHeader: strconv.FormatInt(timestamp, 10),
+			webhook.WebhookSignatureHeader: wh.Sign(timestamp, delivery.Payload),
+		},
+		Body: delivery.Payload,
+	})
+
+	if err != nil {
+		deliveryError := err.Error()
+		delivery.RecordAttempt(nil, &deliveryError)
+	} else {
+		responseStatus := response.StatusCode
+		delivery.RecordAttempt(&responseStatus, nil)
+	}
+
+	return s.WebhookDeliveryRepository.UpdateWebhookDelivery(webhookrepo.UpdateWebhookDeliveryParams{WebhookDelivery: delivery})
+}
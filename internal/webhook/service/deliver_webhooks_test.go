@@ -0,0 +1,228 @@
+package webhookservice
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	"github.com/gabrielmrtt/taski/internal/core"
+	"github.com/gabrielmrtt/taski/internal/webhook"
+	webhooksender "github.com/gabrielmrtt/taski/internal/webhook/infra/sender"
+	webhookrepo "github.com/gabrielmrtt/taski/internal/webhook/repository"
+)
+
+type memoryWebhookRepository struct {
+	webhookrepo.WebhookRepository
+	webhooks []webhook.Webhook
+}
+
+func (r *memoryWebhookRepository) GetWebhookByIdentity(params webhookrepo.GetWebhookByIdentityParams) (*webhook.Webhook, error) {
+	for _, wh := range r.webhooks {
+		if wh.Identity.Equals(params.WebhookIdentity) {
+			return &wh, nil
+		}
+	}
+
+	return nil, nil
+}
+
+type memoryWebhookDeliveryRepository struct {
+	webhookrepo.WebhookDeliveryRepository
+	deliveries []webhook.WebhookDelivery
+}
+
+func (r *memoryWebhookDeliveryRepository) ClaimDueWebhookDeliveries(params webhookrepo.ClaimDueWebhookDeliveriesParams) ([]webhook.WebhookDelivery, error) {
+	var claimed []webhook.WebhookDelivery = make([]webhook.WebhookDelivery, 0)
+	for _, delivery := range r.deliveries {
+		if delivery.IsPending() && delivery.NextAttemptAt != nil && delivery.NextAttemptAt.Value <= params.Now && len(claimed) < params.Limit {
+			claimed = append(claimed, delivery)
+		}
+	}
+
+	return claimed, nil
+}
+
+func (r *memoryWebhookDeliveryRepository) UpdateWebhookDelivery(params webhookrepo.UpdateWebhookDeliveryParams) error {
+	for i, delivery := range r.deliveries {
+		if delivery.Identity.Equals(params.WebhookDelivery.Identity) {
+			r.deliveries[i] = *params.WebhookDelivery
+		}
+	}
+
+	return nil
+}
+
+// webhookSink is a local receiver answering with the given statuses in turn and recording what it receives
+type webhookSink struct {
+	mutex    sync.Mutex
+	statuses []int
+	requests []*http.Request
+	bodies   []string
+}
+
+func (s *webhookSink) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	body, _ := io.ReadAll(r.Body)
+	s.requests = append(s.requests, r)
+	s.bodies = append(s.bodies, string(body))
+
+	status := s.statuses[0]
+	if len(s.statuses) > 1 {
+		s.statuses = s.statuses[1:]
+	}
+
+	w.WriteHeader(status)
+	w.Write([]byte("response bodies are never stored"))
+}
+
+func TestDeliverWebhooksToLocalSink(t *testing.T) {
+	tests := []struct {
+		name             string
+		statuses         []int
+		runs             int
+		expectedRequests int
+		expectedStatus   webhook.WebhookDeliveryStatuses
+		expectedAttempts int16
+	}{
+		{name: "delivered", statuses: []int{http.StatusNoContent}, runs: 1, expectedRequests: 1, expectedStatus: webhook.WebhookDeliveryStatusSucceeded, expectedAttempts: 1},
+		{name: "server error is retried later", statuses: []int{http.StatusInternalServerError}, runs: 2, expectedRequests: 1, expectedStatus: webhook.WebhookDeliveryStatusPending, expectedAttempts: 1},
+		{name: "retry succeeds once due", statuses: []int{http.StatusBadGateway, http.StatusOK}, runs: 2, expectedRequests: 2, expectedStatus: webhook.WebhookDeliveryStatusSucceeded, expectedAttempts: 2},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sink := &webhookSink{statuses: tt.statuses}
+			server := httptest.NewServer(sink)
+			defer server.Close()
+
+			webhook.AllowPrivateWebhookAddresses(true)
+			defer webhook.AllowPrivateWebhookAddresses(false)
+
+			wh, err := webhook.NewWebhook(webhook.NewWebhookInput{
+				OrganizationIdentity: core.NewIdentity("org"),
+				Url:                  server.URL,
+				Events:               []webhook.WebhookEventTypes{webhook.WebhookEventTypeAll},
+			})
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			payload := `{"type":"task_created"}`
+			delivery := webhook.NewWebhookDelivery(webhook.NewWebhookDeliveryInput{
+				WebhookIdentity: wh.Identity,
+				EventId:         "evt_1",
+				EventType:       webhook.WebhookEventTypes("task_created"),
+				Payload:         payload,
+			})
+
+			deliveryRepository := &memoryWebhookDeliveryRepository{deliveries: []webhook.WebhookDelivery{*delivery}}
+			service := NewDeliverWebhooksService(
+				&memoryWebhookRepository{webhooks: []webhook.Webhook{*wh}},
+				deliveryRepository,
+				webhooksender.NewHttpWebhookSender(webhooksender.HttpWebhookSenderOptions{
+					TimeoutSeconds:        5,
+					MaxResponseBodySize:   1024,
+					AllowPrivateAddresses: true,
+				}),
+			)
+
+			for run := 0; run < tt.runs; run++ {
+				if _, err := service.Execute(DeliverWebhooksInput{Limit: 10}); err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+
+				// The second run only resends when the retry is due, which the last case simulates by waiting it out
+				if len(tt.statuses) > 1 && deliveryRepository.deliveries[0].IsPending() {
+					now := core.NewDateTime()
+					deliveryRepository.deliveries[0].NextAttemptAt = &now
+				}
+			}
+
+			if len(sink.requests) != tt.expectedRequests {
+				t.Fatalf("expected %d requests, got %d", tt.expectedRequests, len(sink.requests))
+			}
+
+			for i, request := range sink.requests {
+				if sink.bodies[i] != payload {
+					t.Errorf("expected body %s, got %s", payload, sink.bodies[i])
+				}
+
+				mac := hmac.New(sha256.New, []byte(wh.Secret))
+				mac.Write([]byte(request.Header.Get(webhook.WebhookTimestampHeader) + "." + payload))
+				expectedSignature := "sha256=" + hex.EncodeToString(mac.Sum(nil))
+				if signature := request.Header.Get(webhook.WebhookSignatureHeader); signature != expectedSignature {
+					t.Errorf("expected signature %s, got %s", expectedSignature, signature)
+				}
+
+				if event := request.Header.Get(webhook.WebhookEventHeader); event != "task_created" {
+					t.Errorf("expected event header task_created, got %s", event)
+				}
+
+				if deliveryId := request.Header.Get(webhook.WebhookDeliveryHeader); deliveryId != delivery.Identity.Public {
+					t.Errorf("expected delivery header %s, got %s", delivery.Identity.Public, deliveryId)
+				}
+			}
+
+			stored := deliveryRepository.deliveries[0]
+			if stored.Status != tt.expectedStatus {
+				t.Errorf("expected status %s, got %s", tt.expectedStatus, stored.Status)
+			}
+
+			if stored.Attempts != tt.expectedAttempts {
+				t.Errorf("expected %d attempts, got %d", tt.expectedAttempts, stored.Attempts)
+			}
+
+			if stored.IsPending() && (stored.NextAttemptAt == nil || stored.NextAttemptAt.Value <= stored.LastAttemptAt.Value) {
+				t.Errorf("expected the retry to be scheduled after the last attempt, got %v", stored.NextAttemptAt)
+			}
+		})
+	}
+}
+
+func TestHttpWebhookSenderRefusesLocalSinkByDefault(t *testing.T) {
+	server := httptest.NewServer(&webhookSink{statuses: []int{http.StatusOK}})
+	defer server.Close()
+
+	tests := []struct {
+		name                  string
+		allowPrivateAddresses bool
+		valid                 bool
+	}{
+		{name: "default", allowPrivateAddresses: false},
+		{name: "private addresses allowed", allowPrivateAddresses: true, valid: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sender := webhooksender.NewHttpWebhookSender(webhooksender.HttpWebhookSenderOptions{
+				TimeoutSeconds:        5,
+				MaxResponseBodySize:   1024,
+				AllowPrivateAddresses: tt.allowPrivateAddresses,
+			})
+
+			_, err := sender.Send(webhook.WebhookRequest{Url: server.URL, Body: "{}"})
+			if tt.valid && err != nil {
+				t.Errorf("unexpected error: %v", err)
+			}
+
+			if !tt.valid && err == nil {
+				t.Error("expected the local sink to be refused")
+			}
+
+			webhook.AllowPrivateWebhookAddresses(tt.allowPrivateAddresses)
+			defer webhook.AllowPrivateWebhookAddresses(false)
+
+			_, err = webhook.NewWebhookUrl(server.URL)
+			if tt.valid != (err == nil) {
+				t.Errorf("expected url validation to pass %t, got %v", tt.valid, err)
+			}
+		})
+	}
+}
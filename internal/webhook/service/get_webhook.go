@@ -0,0 +1,46 @@
+package webhookservice
+
+import (
+	"github.com/gabrielmrtt/taski/internal/core"
+	"github.com/gabrielmrtt/taski/internal/webhook"
+	webhookrepo "github.com/gabrielmrtt/taski/internal/webhook/repository"
+)
+
+type GetWebhookService struct {
+	WebhookRepository webhookrepo.WebhookRepository
+}
+
+func NewGetWebhookService(webhookRepository webhookrepo.WebhookRepository) *GetWebhookService {
+	return &GetWebhookService{
+		WebhookRepository: webhookRepository,
+	}
+}
+
+type GetWebhookInput struct {
+	WebhookIdentity      core.Identity
+	OrganizationIdentity core.Identity
+}
+
+func (i GetWebhookInput) Validate() error {
+	return nil
+}
+
+func (s *GetWebhookService) Execute(input GetWebhookInput) (*webhook.WebhookDto, error) {
+	if err := input.Validate(); err != nil {
+		return nil, err
+	}
+
+	wh, err := s.WebhookRepository.GetWebhookByIdentity(webhookrepo.GetWebhookByIdentityParams{
+		WebhookIdentity:      input.WebhookIdentity,
+		OrganizationIdentity: &input.OrganizationIdentity,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if wh == nil {
+		return nil, core.NewNotFoundError("webhook not found")
+	}
+
+	return webhook.WebhookToDto(wh), nil
+}
@@ -0,0 +1,72 @@
+package webhookservice
+
+import (
+	"github.com/gabrielmrtt/taski/internal/core"
+	"github.com/gabrielmrtt/taski/internal/webhook"
+	webhookrepo "github.com/gabrielmrtt/taski/internal/webhook/repository"
+)
+
+type ListWebhookDeliveriesService struct {
+	WebhookRepository         webhookrepo.WebhookRepository
+	WebhookDeliveryRepository webhookrepo.WebhookDeliveryRepository
+}
+
+func NewListWebhookDeliveriesService(
+	webhookRepository webhookrepo.WebhookRepository,
+	webhookDeliveryRepository webhookrepo.WebhookDeliveryRepository,
+) *ListWebhookDeliveriesService {
+	return &ListWebhookDeliveriesService{
+		WebhookRepository:         webhookRepository,
+		WebhookDeliveryRepository: webhookDeliveryRepository,
+	}
+}
+
+type ListWebhookDeliveriesInput struct {
+	WebhookIdentity      core.Identity
+	OrganizationIdentity core.Identity
+	Filters              webhookrepo.WebhookDeliveryFilters
+	Pagination           core.PaginationInput
+}
+
+func (i ListWebhookDeliveriesInput) Validate() error {
+	return nil
+}
+
+func (s *ListWebhookDeliveriesService) Execute(input ListWebhookDeliveriesInput) (*core.PaginationOutput[webhook.WebhookDeliveryDto], error) {
+	if err := input.Validate(); err != nil {
+		return nil, err
+	}
+
+	wh, err := s.WebhookRepository.GetWebhookByIdentity(webhookrepo.GetWebhookByIdentityParams{
+		WebhookIdentity:      input.WebhookIdentity,
+		OrganizationIdentity: &input.OrganizationIdentity,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if wh == nil {
+		return nil, core.NewNotFoundError("webhook not found")
+	}
+
+	input.Filters.WebhookIdentity = wh.Identity
+	deliveries, err := s.WebhookDeliveryRepository.PaginateWebhookDeliveriesBy(webhookrepo.PaginateWebhookDeliveriesParams{
+		Filters:    input.Filters,
+		Pagination: input.Pagination,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var deliveriesDto []webhook.WebhookDeliveryDto = make([]webhook.WebhookDeliveryDto, 0)
+	for _, delivery := range deliveries.Data {
+		deliveriesDto = append(deliveriesDto, *webhook.WebhookDeliveryToDto(&delivery))
+	}
+
+	return &core.PaginationOutput[webhook.WebhookDeliveryDto]{
+		Data:    deliveriesDto,
+		Page:    deliveries.Page,
+		HasMore: deliveries.HasMore,
+		Total:   deliveries.Total,
+	}, nil
+}
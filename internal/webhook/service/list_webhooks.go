@@ -0,0 +1,54 @@
+package webhookservice
+
+import (
+	"github.com/gabrielmrtt/taski/internal/core"
+	"github.com/gabrielmrtt/taski/internal/webhook"
+	webhookrepo "github.com/gabrielmrtt/taski/internal/webhook/repository"
+)
+
+type ListWebhooksService struct {
+	WebhookRepository webhookrepo.WebhookRepository
+}
+
+func NewListWebhooksService(webhookRepository webhookrepo.WebhookRepository) *ListWebhooksService {
+	return &ListWebhooksService{
+		WebhookRepository: webhookRepository,
+	}
+}
+
+type ListWebhooksInput struct {
+	Filters    webhookrepo.WebhookFilters
+	SortInput  core.SortInput
+	Pagination core.PaginationInput
+}
+
+func (i ListWebhooksInput) Validate() error {
+	return nil
+}
+
+func (s *ListWebhooksService) Execute(input ListWebhooksInput) (*core.PaginationOutput[webhook.WebhookDto], error) {
+	if err := input.Validate(); err != nil {
+		return nil, err
+	}
+
+	webhooks, err := s.WebhookRepository.PaginateWebhooksBy(webhookrepo.PaginateWebhooksParams{
+		Filters:    input.Filters,
+		SortInput:  input.SortInput,
+		Pagination: input.Pagination,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var webhooksDto []webhook.WebhookDto = make([]webhook.WebhookDto, 0)
+	for _, wh := range webhooks.Data {
+		webhooksDto = append(webhooksDto, *webhook.WebhookToDto(&wh))
+	}
+
+	return &core.PaginationOutput[webhook.WebhookDto]{
+		Data:    webhooksDto,
+		Page:    webhooks.Page,
+		HasMore: webhooks.HasMore,
+		Total:   webhooks.Total,
+	}, nil
+}
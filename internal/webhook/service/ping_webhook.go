@@ -0,0 +1,99 @@
+package webhookservice
+
+import (
+	"github.com/gabrielmrtt/taski/internal/core"
+	"github.com/gabrielmrtt/taski/internal/webhook"
+	webhookrepo "github.com/gabrielmrtt/taski/internal/webhook/repository"
+)
+
+type PingWebhookService struct {
+	WebhookRepository         webhookrepo.WebhookRepository
+	WebhookDeliveryRepository webhookrepo.WebhookDeliveryRepository
+	TransactionRepository     core.TransactionRepository
+}
+
+func NewPingWebhookService(
+	webhookRepository webhookrepo.WebhookRepository,
+	webhookDeliveryRepository webhookrepo.WebhookDeliveryRepository,
+	transactionRepository core.TransactionRepository,
+) *PingWebhookService {
+	return &PingWebhookService{
+		WebhookRepository:         webhookRepository,
+		WebhookDeliveryRepository: webhookDeliveryRepository,
+		TransactionRepository:     transactionRepository,
+	}
+}
+
+type PingWebhookInput struct {
+	WebhookIdentity      core.Identity
+	OrganizationIdentity core.Identity
+}
+
+func (i PingWebhookInput) Validate() error {
+	return nil
+}
+
+/*
+Execute queues a ping event to the webhook so its receiver and signature check can be tested. Pings are sent even
+when the webhook is not subscribed to them.
+*/
+func (s *PingWebhookService) Execute(input PingWebhookInput) (*webhook.WebhookDeliveryDto, error) {
+	if err := input.Validate(); err != nil {
+		return nil, err
+	}
+
+	tx, err := s.TransactionRepository.BeginTransaction()
+	if err != nil {
+		return nil, err
+	}
+
+	s.WebhookRepository.SetTransaction(tx)
+	s.WebhookDeliveryRepository.SetTransaction(tx)
+
+	wh, err := s.WebhookRepository.GetWebhookByIdentity(webhookrepo.GetWebhookByIdentityParams{
+		WebhookIdentity:      input.WebhookIdentity,
+		OrganizationIdentity: &input.OrganizationIdentity,
+	})
+	if err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+
+	if wh == nil {
+		tx.Rollback()
+		return nil, core.NewNotFoundError("webhook not found")
+	}
+
+	if !wh.IsActive() {
+		tx.Rollback()
+		return nil, core.NewConflictError("webhook is inactive")
+	}
+
+	event := webhook.NewWebhookEvent(webhook.NewWebhookEventInput{
+		Type:                 webhook.WebhookEventTypePing,
+		OrganizationIdentity: wh.OrganizationIdentity,
+		Data: map[string]interface{}{
+			"webhookId": wh.Identity.Public,
+		},
+	})
+
+	delivery, err := newWebhookEventDelivery(wh, event)
+	if err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+
+	_, err = s.WebhookDeliveryRepository.StoreWebhookDelivery(webhookrepo.StoreWebhookDeliveryParams{WebhookDelivery: delivery})
+	if err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+
+	return webhook.WebhookDeliveryToDto(delivery), nil
+}
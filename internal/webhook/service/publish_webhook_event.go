@@ -0,0 +1,122 @@
+package webhookservice
+
+import (
+	"encoding/json"
+
+	"github.com/gabrielmrtt/taski/internal/core"
+	"github.com/gabrielmrtt/taski/internal/webhook"
+	webhookrepo "github.com/gabrielmrtt/taski/internal/webhook/repository"
+)
+
+/*
+PublishWebhookEventService queues a delivery of an event to the webhooks subscribed to it. It does not open its own
+transaction: callers set theirs so the deliveries are only queued when the change that caused the event is committed.
+*/
+type PublishWebhookEventService struct {
+	WebhookRepository         webhookrepo.WebhookRepository
+	WebhookDeliveryRepository webhookrepo.WebhookDeliveryRepository
+}
+
+func NewPublishWebhookEventService(
+	webhookRepository webhookrepo.WebhookRepository,
+	webhookDeliveryRepository webhookrepo.WebhookDeliveryRepository,
+) *PublishWebhookEventService {
+	return &PublishWebhookEventService{
+		WebhookRepository:         webhookRepository,
+		WebhookDeliveryRepository: webhookDeliveryRepository,
+	}
+}
+
+func (s *PublishWebhookEventService) SetTransaction(tx core.Transaction) error {
+	if err := s.WebhookRepository.SetTransaction(tx); err != nil {
+		return err
+	}
+
+	return s.WebhookDeliveryRepository.SetTransaction(tx)
+}
+
+/*
+HasActiveWebhooks tells whether an active webhook matches the filters. Publishers call it before loading what an event
+carries, so changes in organizations without webhooks cost a single query.
+*/
+func (s *PublishWebhookEventService) HasActiveWebhooks(filters webhookrepo.WebhookFilters) (bool, error) {
+	activeStatus := webhook.WebhookStatusActive
+	filters.Status = &core.ComparableFilter[webhook.WebhookStatuses]{Equals: &activeStatus}
+
+	return s.WebhookRepository.HasWebhooksBy(webhookrepo.HasWebhooksByParams{Filters: filters})
+}
+
+type PublishWebhookEventInput struct {
+	OrganizationIdentity core.Identity
+	EventType            webhook.WebhookEventTypes
+	Data                 interface{}
+}
+
+func (i PublishWebhookEventInput) Validate() error {
+	return nil
+}
+
+func (s *PublishWebhookEventService) Execute(input PublishWebhookEventInput) error {
+	if err := input.Validate(); err != nil {
+		return err
+	}
+
+	activeStatus := webhook.WebhookStatusActive
+	webhooks, err := s.WebhookRepository.ListWebhooksBy(webhookrepo.ListWebhooksByParams{
+		Filters: webhookrepo.WebhookFilters{
+			OrganizationIdentity: &input.OrganizationIdentity,
+			Status:               &core.ComparableFilter[webhook.WebhookStatuses]{Equals: &activeStatus},
+		},
+	})
+	if err != nil {
+		return err
+	}
+
+	var subscribedWebhooks []webhook.Webhook = make([]webhook.Webhook, 0)
+	for _, wh := range webhooks {
+		if wh.IsSubscribedTo(input.EventType) {
+			subscribedWebhooks = append(subscribedWebhooks, wh)
+		}
+	}
+
+	if len(subscribedWebhooks) == 0 {
+		return nil
+	}
+
+	event := webhook.NewWebhookEvent(webhook.NewWebhookEventInput{
+		Type:                 input.EventType,
+		OrganizationIdentity: input.OrganizationIdentity,
+		Data:                 input.Data,
+	})
+
+	for _, wh := range subscribedWebhooks {
+		delivery, err := newWebhookEventDelivery(&wh, event)
+		if err != nil {
+			return err
+		}
+
+		_, err = s.WebhookDeliveryRepository.StoreWebhookDelivery(webhookrepo.StoreWebhookDeliveryParams{WebhookDelivery: delivery})
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+/*
+newWebhookEventDelivery serializes the event once per delivery so the exact signed body is kept in the delivery log.
+*/
+func newWebhookEventDelivery(wh *webhook.Webhook, event *webhook.WebhookEvent) (*webhook.WebhookDelivery, error) {
+	payload, err := json.Marshal(webhook.WebhookEventToDto(event))
+	if err != nil {
+		return nil, core.NewInternalError(err.Error())
+	}
+
+	return webhook.NewWebhookDelivery(webhook.NewWebhookDeliveryInput{
+		WebhookIdentity: wh.Identity,
+		EventId:         event.Identity.Public,
+		EventType:       event.Type,
+		Payload:         string(payload),
+	}), nil
+}
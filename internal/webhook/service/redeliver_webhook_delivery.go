@@ -0,0 +1,105 @@
+package webhookservice
+
+import (
+	"github.com/gabrielmrtt/taski/internal/core"
+	"github.com/gabrielmrtt/taski/internal/webhook"
+	webhookrepo "github.com/gabrielmrtt/taski/internal/webhook/repository"
+)
+
+type RedeliverWebhookDeliveryService struct {
+	WebhookRepository         webhookrepo.WebhookRepository
+	WebhookDeliveryRepository webhookrepo.WebhookDeliveryRepository
+	TransactionRepository     core.TransactionRepository
+}
+
+func NewRedeliverWebhookDeliveryService(
+	webhookRepository webhookrepo.WebhookRepository,
+	webhookDeliveryRepository webhookrepo.WebhookDeliveryRepository,
+	transactionRepository core.TransactionRepository,
+) *RedeliverWebhookDeliveryService {
+	return &RedeliverWebhookDeliveryService{
+		WebhookRepository:         webhookRepository,
+		WebhookDeliveryRepository: webhookDeliveryRepository,
+		TransactionRepository:     transactionRepository,
+	}
+}
+
+type RedeliverWebhookDeliveryInput struct {
+	WebhookIdentity         core.Identity
+	WebhookDeliveryIdentity core.Identity
+	OrganizationIdentity    core.Identity
+}
+
+func (i RedeliverWebhookDeliveryInput) Validate() error {
+	return nil
+}
+
+/*
+Execute queues the event of a delivery again, with the same event id and payload, as a new delivery.
+*/
+func (s *RedeliverWebhookDeliveryService) Execute(input RedeliverWebhookDeliveryInput) (*webhook.WebhookDeliveryDto, error) {
+	if err := input.Validate(); err != nil {
+		return nil, err
+	}
+
+	tx, err := s.TransactionRepository.BeginTransaction()
+	if err != nil {
+		return nil, err
+	}
+
+	s.WebhookRepository.SetTransaction(tx)
+	s.WebhookDeliveryRepository.SetTransaction(tx)
+
+	wh, err := s.WebhookRepository.GetWebhookByIdentity(webhookrepo.GetWebhookByIdentityParams{
+		WebhookIdentity:      input.WebhookIdentity,
+		OrganizationIdentity: &input.OrganizationIdentity,
+	})
+	if err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+
+	if wh == nil {
+		tx.Rollback()
+		return nil, core.NewNotFoundError("webhook not found")
+	}
+
+	if !wh.IsActive() {
+		tx.Rollback()
+		return nil, core.NewConflictError("webhook is inactive")
+	}
+
+	delivery, err := s.WebhookDeliveryRepository.GetWebhookDeliveryByIdentity(webhookrepo.GetWebhookDeliveryByIdentityParams{
+		WebhookDeliveryIdentity: input.WebhookDeliveryIdentity,
+		WebhookIdentity:         &wh.Identity,
+	})
+	if err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+
+	if delivery == nil {
+		tx.Rollback()
+		return nil, core.NewNotFoundError("webhook delivery not found")
+	}
+
+	if delivery.IsPending() {
+		tx.Rollback()
+		return nil, core.NewConflictError("webhook delivery is still pending")
+	}
+
+	redelivery := delivery.Redeliver()
+	_, err = s.WebhookDeliveryRepository.StoreWebhookDelivery(webhookrepo.StoreWebhookDeliveryParams{WebhookDelivery: redelivery})
+	if err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+
+	return webhook.WebhookDeliveryToDto(redelivery), nil
+}
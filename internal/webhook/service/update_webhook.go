@@ -0,0 +1,122 @@
+package webhookservice
+
+import (
+	"github.com/gabrielmrtt/taski/internal/core"
+	"github.com/gabrielmrtt/taski/internal/webhook"
+	webhookrepo "github.com/gabrielmrtt/taski/internal/webhook/repository"
+)
+
+type UpdateWebhookService struct {
+	WebhookRepository     webhookrepo.WebhookRepository
+	TransactionRepository core.TransactionRepository
+}
+
+func NewUpdateWebhookService(
+	webhookRepository webhookrepo.WebhookRepository,
+	transactionRepository core.TransactionRepository,
+) *UpdateWebhookService {
+	return &UpdateWebhookService{
+		WebhookRepository:     webhookRepository,
+		TransactionRepository: transactionRepository,
+	}
+}
+
+type UpdateWebhookInput struct {
+	WebhookIdentity      core.Identity
+	OrganizationIdentity core.Identity
+	UserEditorIdentity   core.Identity
+	Url                  *string
+	Events               []webhook.WebhookEventTypes
+	Status               *webhook.WebhookStatuses
+}
+
+func (i UpdateWebhookInput) Validate() error {
+	var fields []core.InvalidInputErrorField
+
+	if i.Url != nil {
+		if _, err := webhook.NewWebhookUrl(*i.Url); err != nil {
+			fields = append(fields, core.InvalidInputErrorField{
+				Field: "url",
+				Error: err.Error(),
+			})
+		}
+	}
+
+	if i.Status != nil && *i.Status != webhook.WebhookStatusActive && *i.Status != webhook.WebhookStatusInactive {
+		fields = append(fields, core.InvalidInputErrorField{
+			Field: "status",
+			Error: "invalid status. valid statuses are: active, inactive",
+		})
+	}
+
+	if len(fields) > 0 {
+		return core.NewInvalidInputError("invalid input", fields)
+	}
+
+	return nil
+}
+
+func (s *UpdateWebhookService) Execute(input UpdateWebhookInput) error {
+	if err := input.Validate(); err != nil {
+		return err
+	}
+
+	tx, err := s.TransactionRepository.BeginTransaction()
+	if err != nil {
+		return err
+	}
+
+	s.WebhookRepository.SetTransaction(tx)
+
+	wh, err := s.WebhookRepository.GetWebhookByIdentity(webhookrepo.GetWebhookByIdentityParams{
+		WebhookIdentity:      input.WebhookIdentity,
+		OrganizationIdentity: &input.OrganizationIdentity,
+	})
+	if err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	if wh == nil {
+		tx.Rollback()
+		return core.NewNotFoundError("webhook not found")
+	}
+
+	if input.Url != nil {
+		err = wh.ChangeUrl(*input.Url, &input.UserEditorIdentity)
+		if err != nil {
+			tx.Rollback()
+			return err
+		}
+	}
+
+	if input.Events != nil {
+		err = wh.ChangeEvents(input.Events, &input.UserEditorIdentity)
+		if err != nil {
+			tx.Rollback()
+			return err
+		}
+	}
+
+	if input.Status != nil {
+		if *input.Status == webhook.WebhookStatusActive {
+			wh.Activate(&input.UserEditorIdentity)
+		} else {
+			wh.Deactivate(&input.UserEditorIdentity)
+		}
+	}
+
+	err = s.WebhookRepository.UpdateWebhook(webhookrepo.UpdateWebhookParams{Webhook: wh})
+	if err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	return nil
+}
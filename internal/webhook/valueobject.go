@@ -0,0 +1,112 @@
+package webhook
+
+import (
+	"net"
+	"net/url"
+	"strings"
+
+	"github.com/gabrielmrtt/taski/internal/core"
+)
+
+// nonPublicWebhookNetworks are the reserved ranges not covered by the net.IP helpers
+var nonPublicWebhookNetworks []*net.IPNet = mustParseWebhookNetworks(
+	"0.0.0.0/8",
+	"100.64.0.0/10",
+	"192.0.0.0/24",
+	"198.18.0.0/15",
+	"240.0.0.0/4",
+	"64:ff9b::/96",
+)
+
+func mustParseWebhookNetworks(cidrs ...string) []*net.IPNet {
+	var networks []*net.IPNet = make([]*net.IPNet, len(cidrs))
+	for i, cidr := range cidrs {
+		_, network, err := net.ParseCIDR(cidr)
+		if err != nil {
+			panic(err)
+		}
+
+		networks[i] = network
+	}
+
+	return networks
+}
+
+/*
+allowPrivateWebhookAddresses lets webhook urls point to localhost, loopback and private hosts. It is off unless the
+infrastructure enables it, to test webhooks against a local sink.
+*/
+var allowPrivateWebhookAddresses bool = false
+
+// AllowPrivateWebhookAddresses sets whether webhook urls may point to localhost, loopback and private hosts
+func AllowPrivateWebhookAddresses(allow bool) {
+	allowPrivateWebhookAddresses = allow
+}
+
+/*
+IsPublicWebhookIp reports whether webhooks may be sent to the ip. Loopback, private, link-local (cloud metadata
+services among them), multicast and other reserved addresses are refused so webhooks cannot reach internal services.
+*/
+func IsPublicWebhookIp(ip net.IP) bool {
+	if ip.IsLoopback() || ip.IsPrivate() || ip.IsUnspecified() || ip.IsLinkLocalUnicast() || ip.IsLinkLocalMulticast() ||
+		ip.IsInterfaceLocalMulticast() || ip.IsMulticast() {
+		return false
+	}
+
+	for _, network := range nonPublicWebhookNetworks {
+		if network.Contains(ip) {
+			return false
+		}
+	}
+
+	return true
+}
+
+type WebhookUrl struct {
+	Value string
+}
+
+func NewWebhookUrl(value string) (WebhookUrl, error) {
+	u := WebhookUrl{Value: value}
+	if err := u.Validate(); err != nil {
+		return WebhookUrl{}, err
+	}
+	return u, nil
+}
+
+func (u WebhookUrl) Validate() error {
+	if len(u.Value) > 2048 {
+		field := core.InvalidInputErrorField{
+			Field: "url",
+			Error: "url must have at most 2048 characters",
+		}
+		return core.NewInvalidInputError("url must have at most 2048 characters", []core.InvalidInputErrorField{field})
+	}
+
+	parsedUrl, err := url.Parse(u.Value)
+	if err != nil || (parsedUrl.Scheme != "http" && parsedUrl.Scheme != "https") || parsedUrl.Host == "" {
+		field := core.InvalidInputErrorField{
+			Field: "url",
+			Error: "url must be an absolute http or https url",
+		}
+		return core.NewInvalidInputError("url must be an absolute http or https url", []core.InvalidInputErrorField{field})
+	}
+
+	// Host names are checked again once resolved, when the webhook is sent
+	hostname := strings.TrimSuffix(strings.ToLower(parsedUrl.Hostname()), ".")
+	ip := net.ParseIP(hostname)
+	isPrivateHost := hostname == "localhost" || strings.HasSuffix(hostname, ".localhost") || (ip != nil && !IsPublicWebhookIp(ip))
+	if isPrivateHost && !allowPrivateWebhookAddresses {
+		field := core.InvalidInputErrorField{
+			Field: "url",
+			Error: "url must point to a public host",
+		}
+		return core.NewInvalidInputError("url must point to a public host", []core.InvalidInputErrorField{field})
+	}
+
+	return nil
+}
+
+func (u WebhookUrl) String() string {
+	return u.Value
+}
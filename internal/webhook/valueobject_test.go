@@ -0,0 +1,73 @@
+package webhook
+
+import (
+	"net"
+	"testing"
+)
+
+func TestWebhookUrlValidate(t *testing.T) {
+	tests := []struct {
+		name  string
+		url   string
+		valid bool
+	}{
+		{name: "https", url: "https://example.com/hooks", valid: true},
+		{name: "http with port", url: "http://example.com:8080/hooks", valid: true},
+		{name: "public ip", url: "https://93.184.216.34/hooks", valid: true},
+		{name: "relative", url: "/hooks"},
+		{name: "other scheme", url: "ftp://example.com/hooks"},
+		{name: "localhost", url: "http://localhost:8080/hooks"},
+		{name: "localhost with trailing dot", url: "http://LOCALHOST./hooks"},
+		{name: "localhost subdomain", url: "http://api.localhost/hooks"},
+		{name: "loopback", url: "http://127.0.0.1/hooks"},
+		{name: "loopback ipv6", url: "http://[::1]/hooks"},
+		{name: "private", url: "http://10.0.0.5/hooks"},
+		{name: "metadata service", url: "http://169.254.169.254/latest/meta-data"},
+		{name: "unspecified", url: "http://0.0.0.0/hooks"},
+		{name: "ipv4 mapped loopback", url: "http://[::ffff:127.0.0.1]/hooks"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := NewWebhookUrl(tt.url)
+			if tt.valid && err != nil {
+				t.Errorf("expected %s to be valid, got %v", tt.url, err)
+			}
+
+			if !tt.valid && err == nil {
+				t.Errorf("expected %s to be refused", tt.url)
+			}
+		})
+	}
+}
+
+func TestIsPublicWebhookIp(t *testing.T) {
+	tests := []struct {
+		ip     string
+		public bool
+	}{
+		{ip: "93.184.216.34", public: true},
+		{ip: "2606:2800:220:1:248:1893:25c8:1946", public: true},
+		{ip: "127.0.0.1"},
+		{ip: "10.1.2.3"},
+		{ip: "172.16.0.1"},
+		{ip: "192.168.1.1"},
+		{ip: "169.254.169.254"},
+		{ip: "100.64.0.1"},
+		{ip: "198.18.0.1"},
+		{ip: "224.0.0.1"},
+		{ip: "255.255.255.255"},
+		{ip: "::1"},
+		{ip: "fc00::1"},
+		{ip: "fe80::1"},
+		{ip: "64:ff9b::7f00:1"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.ip, func(t *testing.T) {
+			if got := IsPublicWebhookIp(net.ParseIP(tt.ip)); got != tt.public {
+				t.Errorf("expected %t, got %t", tt.public, got)
+			}
+		})
+	}
+}
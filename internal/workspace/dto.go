@@ -19,6 +19,7 @@ type WorkspaceDto struct {
 	Organization   *organization.OrganizationDto `json:"organization,omitempty"`
 	CreatedAt      string                        `json:"createdAt"`
 	UpdatedAt      *string                       `json:"updatedAt"`
+	Revision       int64                         `json:"revision"`
 }
 
 func WorkspaceToDto(workspace *Workspace) *WorkspaceDto {
@@ -69,5 +70,6 @@ func WorkspaceToDto(workspace *Workspace) *WorkspaceDto {
 		Organization:   org,
 		CreatedAt:      createdAt,
 		UpdatedAt:      updatedAt,
+		Revision:       workspace.Revision,
 	}
 }
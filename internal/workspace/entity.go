@@ -20,6 +20,7 @@ type Workspace struct {
 	Organization         *organization.Organization
 	Timestamps           core.Timestamps
 	DeletedAt            *core.DateTime
+	Revision             int64
 }
 
 type NewWorkspaceInput struct {
@@ -56,6 +57,7 @@ func NewWorkspace(input NewWorkspaceInput) (*Workspace, error) {
 		UserEditorIdentity:   nil,
 		Timestamps:           core.Timestamps{CreatedAt: &now, UpdatedAt: nil},
 		DeletedAt:            nil,
+		Revision:             core.InitialRevision,
 	}, nil
 }
 
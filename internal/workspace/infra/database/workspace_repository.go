@@ -31,6 +31,7 @@ type WorkspaceTable struct {
 	CreatedAt              int64   `bun:"created_at,notnull,type:bigint"`
 	UpdatedAt              *int64  `bun:"updated_at,type:bigint"`
 	DeletedAt              *int64  `bun:"deleted_at,type:bigint"`
+	Revision               int64   `bun:"revision,notnull,type:bigint"`
 
 	Organization *organizationdatabase.OrganizationTable `bun:"rel:has-one,join:organization_internal_id=internal_id"`
 	Creator      *userdatabase.UserTable                 `bun:"rel:has-one,join:user_creator_internal_id=internal_id"`
@@ -92,9 +93,29 @@ func (w *WorkspaceTable) ToEntity() *workspace.Workspace {
 			UpdatedAt: updatedAt,
 		},
 		DeletedAt: deletedAt,
+		Revision:  w.Revision,
 	}
 }
 
+var workspaceSortableFields = coredatabase.SortableFields{
+	Fields: map[string]string{
+		"name":      "workspace.name",
+		"status":    "workspace.status",
+		"createdAt": "workspace.created_at",
+		"updatedAt": "workspace.updated_at",
+	},
+	IdColumn: "workspace.internal_id",
+}
+
+var workspaceIncludableRelations = coredatabase.IncludableRelations{
+	Relations: []string{
+		"Organization",
+		"Creator",
+		"Editor",
+	},
+	MaxDepth: 1,
+}
+
 type WorkspaceRepository struct {
 	db *bun.DB
 	tx *coredatabase.TransactionBun
@@ -160,9 +181,13 @@ func (r *WorkspaceRepository) GetWorkspaceByIdentity(params workspacerepo.GetWor
 	}
 
 	selectQuery = selectQuery.Model(workspace)
-	selectQuery = coredatabase.ApplyRelations(selectQuery, params.RelationsInput)
+	selectQuery, err := coredatabase.ApplyRelations(selectQuery, workspaceIncludableRelations, params.RelationsInput)
+	if err != nil {
+		return nil, err
+	}
+
 	selectQuery = selectQuery.Where("workspace.internal_id = ?", params.WorkspaceIdentity.Internal.String())
-	err := selectQuery.Scan(context.Background())
+	err = selectQuery.Scan(context.Background())
 	if err != nil {
 		if err == sql.ErrNoRows {
 			return nil, nil
@@ -199,7 +224,11 @@ func (r *WorkspaceRepository) PaginateWorkspacesBy(params workspacerepo.Paginate
 	}
 
 	selectQuery = selectQuery.Model(&workspaces)
-	selectQuery = coredatabase.ApplyRelations(selectQuery, params.RelationsInput)
+	selectQuery, err := coredatabase.ApplyRelations(selectQuery, workspaceIncludableRelations, params.RelationsInput)
+	if err != nil {
+		return nil, err
+	}
+
 	selectQuery = r.applyFilters(selectQuery, params.Filters)
 
 	if !params.ShowDeleted {
@@ -211,7 +240,11 @@ func (r *WorkspaceRepository) PaginateWorkspacesBy(params workspacerepo.Paginate
 		return nil, err
 	}
 
-	selectQuery = coredatabase.ApplySort(selectQuery, params.SortInput)
+	selectQuery, err = coredatabase.ApplySort(selectQuery, workspaceSortableFields, params.SortInput)
+	if err != nil {
+		return nil, err
+	}
+
 	selectQuery = coredatabase.ApplyPagination(selectQuery, params.Pagination)
 	err = selectQuery.Scan(context.Background())
 	if err != nil {
@@ -288,6 +321,7 @@ func (r *WorkspaceRepository) StoreWorkspace(params workspacerepo.StoreWorkspace
 		CreatedAt:              *createdAt,
 		UpdatedAt:              updatedAt,
 		DeletedAt:              deletedAt,
+		Revision:               params.Workspace.Revision,
 	}
 
 	_, err := tx.NewInsert().Model(workspaceTable).Exec(context.Background())
@@ -351,17 +385,20 @@ func (r *WorkspaceRepository) UpdateWorkspace(params workspacerepo.UpdateWorkspa
 		OrganizationInternalId: params.Workspace.OrganizationIdentity.Internal.String(),
 		UserCreatorInternalId:  params.Workspace.UserCreatorIdentity.Internal.String(),
 		UserEditorInternalId:   userEditorInternalId,
+		CreatedAt:              params.Workspace.Timestamps.CreatedAt.Value,
 		UpdatedAt:              updatedAt,
 		DeletedAt:              deletedAt,
+		Revision:               params.Workspace.Revision + 1,
 	}
 
-	_, err := tx.NewUpdate().Model(workspaceTable).Where("workspace.internal_id = ?", params.Workspace.Identity.Internal.String()).Exec(context.Background())
+	updateQuery := tx.NewUpdate().Model(workspaceTable).Where("workspace.internal_id = ?", params.Workspace.Identity.Internal.String())
+	err := coredatabase.ExecRevisionedUpdate(updateQuery, "workspace.revision", params.Workspace.Revision, "workspace")
 	if err != nil {
-		if err == sql.ErrNoRows {
-			return nil
-		}
+		return err
 	}
 
+	params.Workspace.Revision++
+
 	if shouldCommit {
 		err = tx.Commit()
 		if err != nil {
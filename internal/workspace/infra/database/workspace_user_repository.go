@@ -32,6 +32,14 @@ func (w *WorkspaceUserTable) ToEntity() *workspace.WorkspaceUser {
 	}
 }
 
+var workspaceUserIncludableRelations = coredatabase.IncludableRelations{
+	Relations: []string{
+		"Workspace",
+		"User",
+	},
+	MaxDepth: 1,
+}
+
 type WorkspaceUserBunRepository struct {
 	db *bun.DB
 	tx *coredatabase.TransactionBun
@@ -76,10 +84,14 @@ func (r *WorkspaceUserBunRepository) GetWorkspaceUserByIdentity(params workspace
 
 	selectQuery = selectQuery.Model(workspaceUser)
 	selectQuery = selectQuery.Relation("User")
-	selectQuery = coredatabase.ApplyRelations(selectQuery, params.RelationsInput)
+	selectQuery, err := coredatabase.ApplyRelations(selectQuery, workspaceUserIncludableRelations, params.RelationsInput)
+	if err != nil {
+		return nil, err
+	}
+
 	selectQuery = selectQuery.Where("workspace_user.workspace_internal_id = ? and workspace_user.user_internal_id = ?", params.WorkspaceIdentity.Internal.String(), params.UserIdentity.Internal.String())
 
-	err := selectQuery.Scan(context.Background())
+	err = selectQuery.Scan(context.Background())
 	if err != nil {
 		if err == sql.ErrNoRows {
 			return nil, nil
@@ -107,10 +119,14 @@ func (r *WorkspaceUserBunRepository) GetWorkspaceUsersByUserIdentity(params work
 
 	selectQuery = selectQuery.Model(&workspaceUsers)
 	selectQuery = selectQuery.Relation("User")
-	selectQuery = coredatabase.ApplyRelations(selectQuery, params.RelationsInput)
+	selectQuery, err := coredatabase.ApplyRelations(selectQuery, workspaceUserIncludableRelations, params.RelationsInput)
+	if err != nil {
+		return nil, err
+	}
+
 	selectQuery = selectQuery.Where("workspace_user.user_internal_id = ?", params.UserIdentity.Internal.String())
 
-	err := selectQuery.Scan(context.Background())
+	err = selectQuery.Scan(context.Background())
 	if err != nil {
 		if err == sql.ErrNoRows {
 			return []workspace.WorkspaceUser{}, nil
@@ -90,6 +90,7 @@ type GetWorkspaceResponse = corehttp.HttpSuccessResponseWithData[workspace.Works
 // @Param request query workspacehttprequests.GetWorkspaceRequest true "Query parameters"
 // @Produce json
 // @Success 200 {object} GetWorkspaceResponse
+// @Header 200 {string} ETag "Revision of the workspace"
 // @Failure 400 {object} corehttp.HttpErrorResponse
 // @Failure 401 {object} corehttp.HttpErrorResponse
 // @Failure 403 {object} corehttp.HttpErrorResponse
@@ -117,6 +118,8 @@ func (c *WorkspaceHandler) GetWorkspace(ctx *gin.Context) {
 		return
 	}
 
+	corehttp.SetETag(ctx, response.Revision)
+
 	corehttp.NewHttpSuccessResponseWithData(ctx, http.StatusOK, response)
 }
 
@@ -173,12 +176,14 @@ type UpdateWorkspaceResponse = corehttp.EmptyHttpSuccessResponse
 // @Param organizationId path string true "Organization ID"
 // @Param workspaceId path string true "Workspace ID"
 // @Param request body workspacehttprequests.UpdateWorkspaceRequest true "Request body"
+// @Param If-Match header string false "ETag the workspace was read with"
 // @Produce json
 // @Success 200 {object} UpdateWorkspaceResponse
 // @Failure 400 {object} corehttp.HttpErrorResponse
 // @Failure 401 {object} corehttp.HttpErrorResponse
 // @Failure 403 {object} corehttp.HttpErrorResponse
 // @Failure 404 {object} corehttp.HttpErrorResponse
+// @Failure 412 {object} corehttp.HttpErrorResponse
 // @Failure 500 {object} corehttp.HttpErrorResponse
 // @Router /workspace/:workspaceId [put]
 func (c *WorkspaceHandler) UpdateWorkspace(ctx *gin.Context) {
@@ -193,12 +198,19 @@ func (c *WorkspaceHandler) UpdateWorkspace(ctx *gin.Context) {
 		return
 	}
 
+	expectedRevision, err := corehttp.GetIfMatchRevision(ctx)
+	if err != nil {
+		corehttp.NewHttpErrorResponse(ctx, err)
+		return
+	}
+
 	input = request.ToInput()
 	input.OrganizationIdentity = *organizationIdentity
 	input.WorkspaceIdentity = workspaceIdentity
 	input.UserEditorIdentity = *authenticatedUserIdentity
+	input.ExpectedRevision = expectedRevision
 
-	err := c.UpdateWorkspaceService.Execute(input)
+	err = c.UpdateWorkspaceService.Execute(input)
 	if err != nil {
 		corehttp.NewHttpErrorResponse(ctx, err)
 		return
@@ -216,23 +228,33 @@ type DeleteWorkspaceResponse = corehttp.EmptyHttpSuccessResponse
 // @Accept json
 // @Param organizationId path string true "Organization ID"
 // @Param workspaceId path string true "Workspace ID"
+// @Param If-Match header string false "ETag the workspace was read with"
 // @Produce json
 // @Success 200 {object} DeleteWorkspaceResponse
 // @Failure 400 {object} corehttp.HttpErrorResponse
 // @Failure 401 {object} corehttp.HttpErrorResponse
 // @Failure 403 {object} corehttp.HttpErrorResponse
 // @Failure 404 {object} corehttp.HttpErrorResponse
+// @Failure 412 {object} corehttp.HttpErrorResponse
 // @Failure 500 {object} corehttp.HttpErrorResponse
 // @Router /workspace/:workspaceId [delete]
 func (c *WorkspaceHandler) DeleteWorkspace(ctx *gin.Context) {
 	var organizationIdentity *core.Identity = authhttpmiddlewares.GetAuthenticatedUserLastAccessedOrganizationIdentity(ctx)
 	var workspaceIdentity core.Identity = core.NewIdentityFromPublic(ctx.Param("workspaceId"))
+
+	expectedRevision, err := corehttp.GetIfMatchRevision(ctx)
+	if err != nil {
+		corehttp.NewHttpErrorResponse(ctx, err)
+		return
+	}
+
 	var input workspaceservice.DeleteWorkspaceInput = workspaceservice.DeleteWorkspaceInput{
 		OrganizationIdentity: *organizationIdentity,
 		WorkspaceIdentity:    workspaceIdentity,
+		ExpectedRevision:     expectedRevision,
 	}
 
-	err := c.DeleteWorkspaceService.Execute(input)
+	err = c.DeleteWorkspaceService.Execute(input)
 	if err != nil {
 		corehttp.NewHttpErrorResponse(ctx, err)
 		return
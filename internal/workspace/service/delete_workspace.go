@@ -23,6 +23,7 @@ func NewDeleteWorkspaceService(
 type DeleteWorkspaceInput struct {
 	OrganizationIdentity core.Identity
 	WorkspaceIdentity    core.Identity
+	ExpectedRevision     *int64
 }
 
 func (i DeleteWorkspaceInput) Validate() error {
@@ -50,6 +51,11 @@ func (s *DeleteWorkspaceService) Execute(input DeleteWorkspaceInput) error {
 		return core.NewNotFoundError("workspace not found")
 	}
 
+	err = core.CheckRevision(input.ExpectedRevision, workspace.Revision, "workspace")
+	if err != nil {
+		return err
+	}
+
 	workspace.Delete()
 
 	err = s.WorkspaceRepository.UpdateWorkspace(workspacerepo.UpdateWorkspaceParams{Workspace: workspace})
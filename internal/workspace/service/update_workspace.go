@@ -29,6 +29,7 @@ type UpdateWorkspaceInput struct {
 	Color                *string
 	Status               *workspace.WorkspaceStatuses
 	UserEditorIdentity   core.Identity
+	ExpectedRevision     *int64
 }
 
 func (i UpdateWorkspaceInput) Validate() error {
@@ -95,6 +96,11 @@ func (s *UpdateWorkspaceService) Execute(input UpdateWorkspaceInput) error {
 		return core.NewNotFoundError("workspace not found")
 	}
 
+	err = core.CheckRevision(input.ExpectedRevision, wrk.Revision, "workspace")
+	if err != nil {
+		return err
+	}
+
 	if input.Name != nil {
 		err = wrk.ChangeName(*input.Name, &input.UserEditorIdentity)
 		if err != nil {
@@ -0,0 +1,344 @@
+package diffutils
+
+import (
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+)
+
+type OperationTypes string
+
+const (
+	OperationEqual  OperationTypes = "equal"
+	OperationInsert OperationTypes = "insert"
+	OperationDelete OperationTypes = "delete"
+)
+
+/*
+Edit is a token kept, inserted or deleted. FromIndex and ToIndex are the number of tokens of each side that come
+before it, so an equal or deleted token is from[FromIndex] and an equal or inserted token is to[ToIndex].
+*/
+type Edit struct {
+	Type      OperationTypes
+	Value     string
+	FromIndex int
+	ToIndex   int
+}
+
+/*
+Segment is a run of consecutive words with the same operation.
+*/
+type Segment struct {
+	Type  OperationTypes
+	Value string
+}
+
+/*
+Line is a line of a hunk. Words is set on changed lines paired with a line of the other side, and holds the words of
+the line that are kept and the ones that were inserted or deleted.
+*/
+type Line struct {
+	Edit
+	Words []Segment
+}
+
+type Hunk struct {
+	FromStart int
+	FromLines int
+	ToStart   int
+	ToLines   int
+	Lines     []Line
+}
+
+var wordRegex = regexp.MustCompile(`\s+|[^\s]+`)
+
+/*
+Diff returns the shortest sequence of edits that turns from into to, using Myers' algorithm. Deletions come before the
+insertions that replace them.
+*/
+func Diff(from []string, to []string) []Edit {
+	prefix := 0
+	for prefix < len(from) && prefix < len(to) && from[prefix] == to[prefix] {
+		prefix++
+	}
+
+	suffix := 0
+	for suffix < len(from)-prefix && suffix < len(to)-prefix && from[len(from)-1-suffix] == to[len(to)-1-suffix] {
+		suffix++
+	}
+
+	var edits []Edit = make([]Edit, 0, max(len(from), len(to)))
+	for i := 0; i < prefix; i++ {
+		edits = append(edits, Edit{Type: OperationEqual, Value: from[i], FromIndex: i, ToIndex: i})
+	}
+
+	for _, edit := range myers(from[prefix:len(from)-suffix], to[prefix:len(to)-suffix]) {
+		edit.FromIndex += prefix
+		edit.ToIndex += prefix
+		edits = append(edits, edit)
+	}
+
+	for i := suffix; i > 0; i-- {
+		edits = append(edits, Edit{Type: OperationEqual, Value: from[len(from)-i], FromIndex: len(from) - i, ToIndex: len(to) - i})
+	}
+
+	return edits
+}
+
+func myers(from []string, to []string) []Edit {
+	n, m := len(from), len(to)
+	if n == 0 && m == 0 {
+		return nil
+	}
+
+	offset := n + m + 1
+	v := make([]int, 2*offset+1)
+	var trace [][]int
+
+	for d := 0; d <= n+m; d++ {
+		trace = append(trace, slices.Clone(v[offset-d-1:offset+d+2]))
+
+		for k := -d; k <= d; k += 2 {
+			var x int
+			if k == -d || (k != d && v[offset+k-1] < v[offset+k+1]) {
+				x = v[offset+k+1]
+			} else {
+				x = v[offset+k-1] + 1
+			}
+
+			y := x - k
+			for x < n && y < m && from[x] == to[y] {
+				x++
+				y++
+			}
+
+			v[offset+k] = x
+
+			if x >= n && y >= m {
+				return backtrack(trace, from, to)
+			}
+		}
+	}
+
+	return nil
+}
+
+func backtrack(trace [][]int, from []string, to []string) []Edit {
+	var edits []Edit
+	x, y := len(from), len(to)
+
+	for d := len(trace) - 1; d >= 0; d-- {
+		v := trace[d]
+		at := func(k int) int {
+			return v[k+d+1]
+		}
+
+		k := x - y
+		var previousK int
+		if k == -d || (k != d && at(k-1) < at(k+1)) {
+			previousK = k + 1
+		} else {
+			previousK = k - 1
+		}
+
+		previousX := at(previousK)
+		previousY := previousX - previousK
+
+		for x > previousX && y > previousY {
+			x--
+			y--
+			edits = append(edits, Edit{Type: OperationEqual, Value: from[x], FromIndex: x, ToIndex: y})
+		}
+
+		if d > 0 {
+			if x == previousX {
+				edits = append(edits, Edit{Type: OperationInsert, Value: to[previousY], FromIndex: previousX, ToIndex: previousY})
+			} else {
+				edits = append(edits, Edit{Type: OperationDelete, Value: from[previousX], FromIndex: previousX, ToIndex: previousY})
+			}
+		}
+
+		x, y = previousX, previousY
+	}
+
+	slices.Reverse(edits)
+	return edits
+}
+
+// SplitLines splits a text in lines. A trailing line break does not start another line.
+func SplitLines(text string) []string {
+	text = strings.ReplaceAll(text, "\r\n", "\n")
+	if text == "" {
+		return make([]string, 0)
+	}
+
+	return strings.Split(strings.TrimSuffix(text, "\n"), "\n")
+}
+
+// DiffWords compares two texts word by word. Whitespace runs are compared as words of their own.
+func DiffWords(from string, to string) []Segment {
+	var segments []Segment = make([]Segment, 0)
+
+	for _, edit := range Diff(wordRegex.FindAllString(from, -1), wordRegex.FindAllString(to, -1)) {
+		segments = appendSegment(segments, Segment{Type: edit.Type, Value: edit.Value})
+	}
+
+	return segments
+}
+
+func appendSegment(segments []Segment, segment Segment) []Segment {
+	if len(segments) > 0 && segments[len(segments)-1].Type == segment.Type {
+		segments[len(segments)-1].Value += segment.Value
+		return segments
+	}
+
+	return append(segments, segment)
+}
+
+/*
+DiffLines compares two texts line by line and groups the changes in hunks with up to context unchanged lines around
+them. Deleted lines directly followed by inserted lines are paired in order and compared word by word.
+*/
+func DiffLines(from string, to string, context int) []Hunk {
+	edits := Diff(SplitLines(from), SplitLines(to))
+
+	var hunks []Hunk = make([]Hunk, 0)
+	for start := 0; start < len(edits); {
+		firstChange := start
+		for firstChange < len(edits) && edits[firstChange].Type == OperationEqual {
+			firstChange++
+		}
+
+		if firstChange == len(edits) {
+			break
+		}
+
+		end := firstChange
+		for {
+			for end < len(edits) && edits[end].Type != OperationEqual {
+				end++
+			}
+
+			nextChange := end
+			for nextChange < len(edits) && edits[nextChange].Type == OperationEqual {
+				nextChange++
+			}
+
+			if nextChange == len(edits) || nextChange-end > 2*context {
+				break
+			}
+
+			end = nextChange
+		}
+
+		hunkStart := max(firstChange-context, start)
+		hunkEnd := min(end+context, len(edits))
+		hunks = append(hunks, newHunk(edits[hunkStart:hunkEnd]))
+
+		start = hunkEnd
+	}
+
+	return hunks
+}
+
+func newHunk(edits []Edit) Hunk {
+	hunk := Hunk{
+		FromStart: edits[0].FromIndex,
+		ToStart:   edits[0].ToIndex,
+		Lines:     make([]Line, len(edits)),
+	}
+
+	for i, edit := range edits {
+		hunk.Lines[i] = Line{Edit: edit, Words: make([]Segment, 0)}
+
+		if edit.Type != OperationInsert {
+			hunk.FromLines++
+		}
+
+		if edit.Type != OperationDelete {
+			hunk.ToLines++
+		}
+	}
+
+	if hunk.FromLines > 0 {
+		hunk.FromStart++
+	}
+
+	if hunk.ToLines > 0 {
+		hunk.ToStart++
+	}
+
+	for i := 0; i < len(hunk.Lines); {
+		deletedStart := i
+		for i < len(hunk.Lines) && hunk.Lines[i].Type == OperationDelete {
+			i++
+		}
+
+		insertedStart := i
+		for i < len(hunk.Lines) && hunk.Lines[i].Type == OperationInsert {
+			i++
+		}
+
+		if deletedStart == i {
+			i++
+			continue
+		}
+
+		pairs := min(insertedStart-deletedStart, i-insertedStart)
+		for j := 0; j < pairs; j++ {
+			deletedLine := &hunk.Lines[deletedStart+j]
+			insertedLine := &hunk.Lines[insertedStart+j]
+
+			for _, segment := range DiffWords(deletedLine.Value, insertedLine.Value) {
+				if segment.Type != OperationInsert {
+					deletedLine.Words = appendSegment(deletedLine.Words, segment)
+				}
+
+				if segment.Type != OperationDelete {
+					insertedLine.Words = appendSegment(insertedLine.Words, segment)
+				}
+			}
+		}
+	}
+
+	return hunk
+}
+
+// Unified formats hunks as a unified diff between the texts named fromName and toName. No hunks format to "".
+func Unified(fromName string, toName string, hunks []Hunk) string {
+	if len(hunks) == 0 {
+		return ""
+	}
+
+	var builder strings.Builder
+	fmt.Fprintf(&builder, "--- %s\n+++ %s\n", fromName, toName)
+
+	for _, hunk := range hunks {
+		fmt.Fprintf(&builder, "@@ -%s +%s @@\n", hunkRange(hunk.FromStart, hunk.FromLines), hunkRange(hunk.ToStart, hunk.ToLines))
+
+		for _, line := range hunk.Lines {
+			switch line.Type {
+			case OperationEqual:
+				builder.WriteString(" ")
+			case OperationDelete:
+				builder.WriteString("-")
+			case OperationInsert:
+				builder.WriteString("+")
+			}
+
+			builder.WriteString(line.Value)
+			builder.WriteString("\n")
+		}
+	}
+
+	return builder.String()
+}
+
+func hunkRange(start int, lines int) string {
+	if lines == 1 {
+		return fmt.Sprintf("%d", start)
+	}
+
+	return fmt.Sprintf("%d,%d", start, lines)
+}
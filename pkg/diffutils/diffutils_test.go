@@ -0,0 +1,113 @@
+package diffutils
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestDiff(t *testing.T) {
+	from := strings.Split("abcabba", "")
+	to := strings.Split("cbabac", "")
+
+	edits := Diff(from, to)
+
+	var changes int
+	var gotFrom, gotTo []string
+	for _, edit := range edits {
+		if edit.Type != OperationEqual {
+			changes++
+		}
+
+		if edit.Type != OperationInsert {
+			if from[edit.FromIndex] != edit.Value {
+				t.Errorf("expected %q at %d of from, got %q", edit.Value, edit.FromIndex, from[edit.FromIndex])
+			}
+
+			gotFrom = append(gotFrom, edit.Value)
+		}
+
+		if edit.Type != OperationDelete {
+			if to[edit.ToIndex] != edit.Value {
+				t.Errorf("expected %q at %d of to, got %q", edit.Value, edit.ToIndex, to[edit.ToIndex])
+			}
+
+			gotTo = append(gotTo, edit.Value)
+		}
+	}
+
+	if changes != 5 {
+		t.Errorf("expected the shortest edit script of 5 changes, got %d", changes)
+	}
+
+	if !reflect.DeepEqual(gotFrom, from) || !reflect.DeepEqual(gotTo, to) {
+		t.Errorf("edits do not rebuild both sides, got %v and %v", gotFrom, gotTo)
+	}
+}
+
+func TestUnified(t *testing.T) {
+	tests := []struct {
+		name     string
+		from     string
+		to       string
+		expected string
+	}{
+		{name: "identical", from: "a\nb\n", to: "a\nb", expected: ""},
+		{name: "changed line", from: "a\nb\nc\n", to: "a\nB\nc\n", expected: "--- from\n+++ to\n@@ -1,3 +1,3 @@\n a\n-b\n+B\n c\n"},
+		{name: "from empty", from: "", to: "x\n", expected: "--- from\n+++ to\n@@ -0,0 +1 @@\n+x\n"},
+		{name: "removed last line", from: "a\nb", to: "a", expected: "--- from\n+++ to\n@@ -1,2 +1 @@\n a\n-b\n"},
+		{name: "crlf line breaks", from: "a\r\nb\r\n", to: "a\nb\n", expected: ""},
+		{
+			name:     "distant changes",
+			from:     "1\n2\n3\n4\n5\n6\n7",
+			to:       "1\nX\n3\n4\n5\nY\n7",
+			expected: "--- from\n+++ to\n@@ -1,3 +1,3 @@\n 1\n-2\n+X\n 3\n@@ -5,3 +5,3 @@\n 5\n-6\n+Y\n 7\n",
+		},
+		{
+			name:     "close changes share a hunk",
+			from:     "1\n2\n3\n4",
+			to:       "X\n2\n3\nY",
+			expected: "--- from\n+++ to\n@@ -1,4 +1,4 @@\n-1\n+X\n 2\n 3\n-4\n+Y\n",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Unified("from", "to", DiffLines(tt.from, tt.to, 1)); got != tt.expected {
+				t.Errorf("expected\n%s\ngot\n%s", tt.expected, got)
+			}
+		})
+	}
+}
+
+func TestDiffWords(t *testing.T) {
+	expected := []Segment{
+		{Type: OperationEqual, Value: "the "},
+		{Type: OperationDelete, Value: "quick"},
+		{Type: OperationInsert, Value: "slow"},
+		{Type: OperationEqual, Value: " brown fox"},
+	}
+
+	if got := DiffWords("the quick brown fox", "the slow brown fox"); !reflect.DeepEqual(got, expected) {
+		t.Errorf("expected %v, got %v", expected, got)
+	}
+}
+
+func TestDiffLinesComparesPairedLinesWordByWord(t *testing.T) {
+	hunks := DiffLines("title\nthe quick fox\n", "title\nthe slow fox\nnew line\n", 0)
+	if len(hunks) != 1 || len(hunks[0].Lines) != 3 {
+		t.Fatalf("expected a hunk of 3 lines, got %+v", hunks)
+	}
+
+	expected := [][]Segment{
+		{{Type: OperationEqual, Value: "the "}, {Type: OperationDelete, Value: "quick"}, {Type: OperationEqual, Value: " fox"}},
+		{{Type: OperationEqual, Value: "the "}, {Type: OperationInsert, Value: "slow"}, {Type: OperationEqual, Value: " fox"}},
+		{},
+	}
+
+	for i, line := range hunks[0].Lines {
+		if !reflect.DeepEqual(line.Words, expected[i]) {
+			t.Errorf("line %d: expected words %v, got %v", i, expected[i], line.Words)
+		}
+	}
+}
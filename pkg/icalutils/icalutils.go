@@ -0,0 +1,81 @@
+package icalutils
+
+import (
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+const maxLineOctets = 75
+
+var textEscaper = strings.NewReplacer(
+	"\\", "\\\\",
+	";", "\\;",
+	",", "\\,",
+	"\r\n", "\\n",
+	"\n", "\\n",
+	"\r", "\\n",
+)
+
+type Writer struct {
+	builder strings.Builder
+}
+
+func NewWriter() *Writer {
+	return &Writer{}
+}
+
+func (w *Writer) Begin(component string) {
+	w.WriteProperty("BEGIN", component)
+}
+
+func (w *Writer) End(component string) {
+	w.WriteProperty("END", component)
+}
+
+func (w *Writer) WriteProperty(name string, value string) {
+	w.writeLine(name + ":" + value)
+}
+
+func (w *Writer) WriteText(name string, value string) {
+	w.WriteProperty(name, EscapeText(value))
+}
+
+func (w *Writer) WriteDateTime(name string, epoch int64) {
+	w.WriteProperty(name, FormatDateTime(epoch))
+}
+
+func (w *Writer) String() string {
+	return w.builder.String()
+}
+
+// writeLine folds content lines longer than 75 octets without splitting multi-byte characters.
+func (w *Writer) writeLine(line string) {
+	var lineOctets int = 0
+
+	for len(line) > 0 {
+		r, size := utf8.DecodeRuneInString(line)
+		if r == utf8.RuneError && size <= 1 {
+			size = 1
+		}
+
+		if lineOctets+size > maxLineOctets {
+			w.builder.WriteString("\r\n ")
+			lineOctets = 1
+		}
+
+		w.builder.WriteString(line[:size])
+		lineOctets += size
+		line = line[size:]
+	}
+
+	w.builder.WriteString("\r\n")
+}
+
+func EscapeText(value string) string {
+	return textEscaper.Replace(value)
+}
+
+func FormatDateTime(epoch int64) string {
+	return time.Unix(epoch, 0).UTC().Format("20060102T150405Z")
+}
@@ -0,0 +1,269 @@
+package markdownutils
+
+import (
+	"bytes"
+	"net/url"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/yuin/goldmark"
+	"github.com/yuin/goldmark/ast"
+	"github.com/yuin/goldmark/extension"
+	"github.com/yuin/goldmark/text"
+	"golang.org/x/net/html"
+	"golang.org/x/net/html/atom"
+)
+
+var markdown = goldmark.New(
+	goldmark.WithExtensions(
+		extension.NewTable(extension.WithTableCellAlignMethod(extension.TableCellAlignAttribute)),
+		extension.Strikethrough,
+		extension.Linkify,
+		extension.TaskList,
+	),
+)
+
+var allowedElements = map[atom.Atom][]string{
+	atom.P:          nil,
+	atom.Br:         nil,
+	atom.Hr:         nil,
+	atom.H1:         nil,
+	atom.H2:         nil,
+	atom.H3:         nil,
+	atom.H4:         nil,
+	atom.H5:         nil,
+	atom.H6:         nil,
+	atom.Strong:     nil,
+	atom.Em:         nil,
+	atom.Del:        nil,
+	atom.Code:       {"class"},
+	atom.Pre:        nil,
+	atom.Blockquote: nil,
+	atom.Ul:         nil,
+	atom.Ol:         {"start"},
+	atom.Li:         nil,
+	atom.A:          {"href", "title"},
+	atom.Img:        {"src", "alt", "title"},
+	atom.Table:      nil,
+	atom.Thead:      nil,
+	atom.Tbody:      nil,
+	atom.Tr:         nil,
+	atom.Th:         {"align"},
+	atom.Td:         {"align"},
+	atom.Input:      {"type", "checked", "disabled"},
+}
+
+// droppedElements are removed together with their content, the other elements that are not allowed are replaced by
+// their content.
+var droppedElements = []atom.Atom{
+	atom.Script,
+	atom.Style,
+	atom.Iframe,
+	atom.Object,
+	atom.Embed,
+	atom.Template,
+	atom.Noscript,
+	atom.Textarea,
+	atom.Select,
+	atom.Svg,
+	atom.Math,
+}
+
+var allowedUrlSchemes = []string{"http", "https", "mailto"}
+
+var codeClassRegex = regexp.MustCompile(`^language-[0-9A-Za-z_+-]+$`)
+
+var blankLinesRegex = regexp.MustCompile(`\n{3,}`)
+
+// RenderHTML converts markdown to HTML. Raw HTML written in the markdown is left out and the result is sanitized with
+// SanitizeHTML, so it is safe to embed in a page.
+func RenderHTML(source string) (string, error) {
+	var buffer bytes.Buffer
+	if err := markdown.Convert([]byte(source), &buffer); err != nil {
+		return "", err
+	}
+
+	return SanitizeHTML(buffer.String())
+}
+
+// SanitizeHTML keeps only the elements and attributes markdown renders to. Links and images are limited to http,
+// https, mailto and relative URLs, and links are rendered with rel="nofollow noopener noreferrer".
+func SanitizeHTML(source string) (string, error) {
+	context := &html.Node{Type: html.ElementNode, Data: "div", DataAtom: atom.Div}
+
+	nodes, err := html.ParseFragment(strings.NewReader(source), context)
+	if err != nil {
+		return "", err
+	}
+
+	for _, node := range nodes {
+		context.AppendChild(node)
+	}
+
+	sanitizeChildren(context)
+
+	var buffer bytes.Buffer
+	for child := context.FirstChild; child != nil; child = child.NextSibling {
+		if err := html.Render(&buffer, child); err != nil {
+			return "", err
+		}
+	}
+
+	return buffer.String(), nil
+}
+
+func sanitizeChildren(parent *html.Node) {
+	child := parent.FirstChild
+	for child != nil {
+		next := child.NextSibling
+
+		switch child.Type {
+		case html.TextNode:
+		case html.ElementNode:
+			allowedAttributes, allowed := allowedElements[child.DataAtom]
+
+			if slices.Contains(droppedElements, child.DataAtom) {
+				parent.RemoveChild(child)
+				break
+			}
+
+			sanitizeChildren(child)
+
+			if !allowed {
+				for grandchild := child.FirstChild; grandchild != nil; grandchild = child.FirstChild {
+					child.RemoveChild(grandchild)
+					parent.InsertBefore(grandchild, child)
+				}
+				parent.RemoveChild(child)
+				break
+			}
+
+			child.Attr = sanitizeAttributes(child, allowedAttributes)
+			if child.DataAtom == atom.Input && !isCheckbox(child) {
+				parent.RemoveChild(child)
+			}
+		default:
+			parent.RemoveChild(child)
+		}
+
+		child = next
+	}
+}
+
+func sanitizeAttributes(node *html.Node, allowedAttributes []string) []html.Attribute {
+	var attributes []html.Attribute = make([]html.Attribute, 0)
+
+	for _, attribute := range node.Attr {
+		if attribute.Namespace != "" || !slices.Contains(allowedAttributes, attribute.Key) {
+			continue
+		}
+
+		switch attribute.Key {
+		case "href", "src":
+			if !IsSafeURL(attribute.Val) {
+				continue
+			}
+		case "class":
+			if !codeClassRegex.MatchString(attribute.Val) {
+				continue
+			}
+		}
+
+		attributes = append(attributes, attribute)
+	}
+
+	if node.DataAtom == atom.A {
+		attributes = append(attributes, html.Attribute{Key: "rel", Val: "nofollow noopener noreferrer"})
+	}
+
+	return attributes
+}
+
+func isCheckbox(node *html.Node) bool {
+	return slices.ContainsFunc(node.Attr, func(attribute html.Attribute) bool {
+		return attribute.Key == "type" && attribute.Val == "checkbox"
+	})
+}
+
+// IsSafeURL reports whether a link or image URL is relative or uses an allowed scheme.
+func IsSafeURL(rawUrl string) bool {
+	parsedUrl, err := url.Parse(strings.TrimSpace(rawUrl))
+	if err != nil {
+		return false
+	}
+
+	return parsedUrl.Scheme == "" || slices.Contains(allowedUrlSchemes, strings.ToLower(parsedUrl.Scheme))
+}
+
+// ExtractText returns the text of the markdown without its formatting, one line per block. Images are replaced by
+// their alternative text.
+func ExtractText(source string) string {
+	sourceBytes := []byte(source)
+	document := markdown.Parser().Parse(text.NewReader(sourceBytes))
+
+	var builder strings.Builder
+	ast.Walk(document, func(node ast.Node, entering bool) (ast.WalkStatus, error) {
+		if !entering {
+			if node.Type() == ast.TypeBlock {
+				builder.WriteString("\n")
+			}
+			return ast.WalkContinue, nil
+		}
+
+		switch n := node.(type) {
+		case *ast.Text:
+			builder.Write(n.Value(sourceBytes))
+			if n.SoftLineBreak() {
+				builder.WriteString(" ")
+			}
+			if n.HardLineBreak() {
+				builder.WriteString("\n")
+			}
+		case *ast.String:
+			builder.Write(n.Value)
+		case *ast.AutoLink:
+			builder.Write(n.Label(sourceBytes))
+			return ast.WalkSkipChildren, nil
+		case *ast.RawHTML, *ast.HTMLBlock:
+			return ast.WalkSkipChildren, nil
+		case *ast.FencedCodeBlock, *ast.CodeBlock:
+			lines := n.Lines()
+			for i := 0; i < lines.Len(); i++ {
+				line := lines.At(i)
+				builder.Write(line.Value(sourceBytes))
+			}
+			return ast.WalkSkipChildren, nil
+		}
+
+		return ast.WalkContinue, nil
+	})
+
+	return strings.TrimSpace(blankLinesRegex.ReplaceAllString(builder.String(), "\n\n"))
+}
+
+// ExtractLinkDestinations returns the URLs of the links and images of the markdown, in order of appearance.
+func ExtractLinkDestinations(source string) []string {
+	sourceBytes := []byte(source)
+	document := markdown.Parser().Parse(text.NewReader(sourceBytes))
+
+	var destinations []string = make([]string, 0)
+	ast.Walk(document, func(node ast.Node, entering bool) (ast.WalkStatus, error) {
+		if !entering {
+			return ast.WalkContinue, nil
+		}
+
+		switch n := node.(type) {
+		case *ast.Link:
+			destinations = append(destinations, string(n.Destination))
+		case *ast.Image:
+			destinations = append(destinations, string(n.Destination))
+		case *ast.AutoLink:
+			destinations = append(destinations, string(n.URL(sourceBytes)))
+		}
+
+		return ast.WalkContinue, nil
+	})
+
+	return destinations
+}
@@ -0,0 +1,127 @@
+package markdownutils
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestIsSafeURL(t *testing.T) {
+	tests := []struct {
+		url  string
+		safe bool
+	}{
+		{url: "https://example.com", safe: true},
+		{url: "HTTP://example.com", safe: true},
+		{url: "mailto:someone@example.com", safe: true},
+		{url: "/tasks/tsk_1", safe: true},
+		{url: "#heading", safe: true},
+		{url: "javascript:alert(1)"},
+		{url: "JavaScript:alert(1)"},
+		{url: "  javascript:alert(1)"},
+		{url: "java\tscript:alert(1)"},
+		{url: "java\nscript:alert(1)"},
+		{url: "data:text/html;base64,PHNjcmlwdD4="},
+		{url: "vbscript:msgbox(1)"},
+		{url: "file:///etc/passwd"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.url, func(t *testing.T) {
+			if got := IsSafeURL(tt.url); got != tt.safe {
+				t.Errorf("expected %t, got %t", tt.safe, got)
+			}
+		})
+	}
+}
+
+func TestSanitizeHTML(t *testing.T) {
+	tests := []struct {
+		name     string
+		source   string
+		expected string
+	}{
+		{name: "allowed markup", source: "<p><strong>bold</strong> and <em>italic</em></p>", expected: "<p><strong>bold</strong> and <em>italic</em></p>"},
+		{name: "script dropped with content", source: "<p>hi</p><script>alert(1)</script>", expected: "<p>hi</p>"},
+		{name: "style dropped with content", source: "<style>p{}</style><p>hi</p>", expected: "<p>hi</p>"},
+		{name: "svg dropped with content", source: `<svg onload="alert(1)"><circle/></svg>ok`, expected: "ok"},
+		{name: "unknown element unwrapped", source: "<div><span>text</span></div>", expected: "text"},
+		{name: "event handler removed", source: `<p onclick="alert(1)">hi</p>`, expected: "<p>hi</p>"},
+		{name: "link gets rel", source: `<a href="https://example.com" target="_blank">x</a>`, expected: `<a href="https://example.com" rel="nofollow noopener noreferrer">x</a>`},
+		{name: "javascript link", source: `<a href="javascript:alert(1)">x</a>`, expected: `<a rel="nofollow noopener noreferrer">x</a>`},
+		{name: "entity encoded scheme", source: `<a href="javascript&#58;alert(1)">x</a>`, expected: `<a rel="nofollow noopener noreferrer">x</a>`},
+		{name: "hex entity encoded scheme", source: `<a href="&#x6A;avascript:alert(1)">x</a>`, expected: `<a rel="nofollow noopener noreferrer">x</a>`},
+		{name: "entity encoded tab in scheme", source: `<a href="java&#x09;script:alert(1)">x</a>`, expected: `<a rel="nofollow noopener noreferrer">x</a>`},
+		{name: "named entity in scheme", source: `<a href="javascript&colon;alert(1)">x</a>`, expected: `<a rel="nofollow noopener noreferrer">x</a>`},
+		{name: "data image", source: `<img src="data:image/svg+xml;base64,PHN2Zz4=" alt="x">`, expected: `<img alt="x"/>`},
+		{name: "code language class", source: `<pre><code class="language-go">x</code></pre>`, expected: `<pre><code class="language-go">x</code></pre>`},
+		{name: "code other class", source: `<code class="evil x">x</code>`, expected: `<code>x</code>`},
+		{name: "checkbox kept", source: `<input type="checkbox" checked disabled>`, expected: `<input type="checkbox" checked="" disabled=""/>`},
+		{name: "text input removed", source: `<input type="text" value="x">`, expected: ""},
+		{name: "comment removed", source: "<!-- secret --><p>hi</p>", expected: "<p>hi</p>"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := SanitizeHTML(tt.source)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if got != tt.expected {
+				t.Errorf("expected %s, got %s", tt.expected, got)
+			}
+		})
+	}
+}
+
+func TestRenderHTML(t *testing.T) {
+	tests := []struct {
+		name      string
+		source    string
+		contains  string
+		forbidden string
+	}{
+		{name: "raw html omitted", source: "hi <script>alert(1)</script>", contains: "<p>hi", forbidden: "<script"},
+		{name: "javascript link", source: "[x](javascript:alert(1))", contains: "<a", forbidden: "javascript"},
+		{name: "entity encoded javascript link", source: "[x](javascript&#58;alert(1))", forbidden: "javascript:"},
+		{name: "autolink", source: "see https://example.com", contains: `href="https://example.com"`},
+		{name: "task list", source: "- [x] done", contains: `type="checkbox"`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := RenderHTML(tt.source)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if tt.contains != "" && !strings.Contains(got, tt.contains) {
+				t.Errorf("expected %s to contain %s", got, tt.contains)
+			}
+
+			if tt.forbidden != "" && strings.Contains(got, tt.forbidden) {
+				t.Errorf("expected %s not to contain %s", got, tt.forbidden)
+			}
+		})
+	}
+}
+
+func TestExtractText(t *testing.T) {
+	tests := []struct {
+		name     string
+		source   string
+		expected string
+	}{
+		{name: "formatting removed", source: "# Title\n\nSome **bold** text", expected: "Title\nSome bold text"},
+		{name: "image alt text", source: "![diagram](https://example.com/a.png)", expected: "diagram"},
+		{name: "raw html skipped", source: "a <b>b</b>", expected: "a b"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := ExtractText(tt.source); got != tt.expected {
+				t.Errorf("expected %q, got %q", tt.expected, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,91 @@
+package rankutils
+
+import (
+	"errors"
+	"strings"
+)
+
+const digits = "0123456789abcdefghijklmnopqrstuvwxyz"
+
+var ErrInvalidRange = errors.New("previous rank must be lower than next rank")
+
+/*
+Between returns a rank that sorts lexicographically between previous and next.
+An empty previous means the start of the list and an empty next means its end, so
+Between("", "") returns the first rank of an empty list. Ranks never end with the
+lowest digit, which guarantees there is always room for another rank.
+*/
+func Between(previous string, next string) (string, error) {
+	if next != "" && previous >= next {
+		return "", ErrInvalidRange
+	}
+
+	if !isValid(previous) || !isValid(next) {
+		return "", ErrInvalidRange
+	}
+
+	return midpoint(previous, next), nil
+}
+
+func isValid(rank string) bool {
+	if strings.HasSuffix(rank, digits[:1]) {
+		return false
+	}
+
+	for _, c := range rank {
+		if !strings.ContainsRune(digits, c) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func midpoint(previous string, next string) string {
+	if next != "" {
+		n := 0
+		for n < len(next) && digitAt(previous, n) == next[n] {
+			n++
+		}
+
+		if n > 0 {
+			return next[:n] + midpoint(tail(previous, n), next[n:])
+		}
+	}
+
+	digitPrevious := 0
+	if previous != "" {
+		digitPrevious = strings.IndexByte(digits, previous[0])
+	}
+
+	digitNext := len(digits)
+	if next != "" {
+		digitNext = strings.IndexByte(digits, next[0])
+	}
+
+	if digitNext-digitPrevious > 1 {
+		return string(digits[(digitPrevious+digitNext+1)/2])
+	}
+
+	if len(next) > 1 {
+		return next[:1]
+	}
+
+	return string(digits[digitPrevious]) + midpoint(tail(previous, 1), "")
+}
+
+func digitAt(rank string, index int) byte {
+	if index < len(rank) {
+		return rank[index]
+	}
+
+	return digits[0]
+}
+
+func tail(rank string, index int) string {
+	if index < len(rank) {
+		return rank[index:]
+	}
+
+	return ""
+}
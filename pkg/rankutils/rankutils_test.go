@@ -0,0 +1,107 @@
+package rankutils
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestBetween(t *testing.T) {
+	tests := []struct {
+		name     string
+		previous string
+		next     string
+		expected string
+	}{
+		{name: "empty list", previous: "", next: "", expected: "i"},
+		{name: "before first", previous: "", next: "i", expected: "9"},
+		{name: "after last", previous: "i", next: "", expected: "r"},
+		{name: "between distant", previous: "a", next: "c", expected: "b"},
+		{name: "between adjacent", previous: "a", next: "b", expected: "ai"},
+		{name: "shared prefix", previous: "ab", next: "ad", expected: "ac"},
+		{name: "longer previous", previous: "az", next: "b", expected: "azi"},
+		{name: "longer next", previous: "a", next: "a1", expected: "a0i"},
+		{name: "after highest digit", previous: "z", next: "", expected: "zi"},
+		{name: "before lowest rank", previous: "", next: "01", expected: "00i"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Between(tt.previous, tt.next)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if got != tt.expected {
+				t.Errorf("expected %q, got %q", tt.expected, got)
+			}
+
+			if got <= tt.previous || (tt.next != "" && got >= tt.next) {
+				t.Errorf("%q does not sort between %q and %q", got, tt.previous, tt.next)
+			}
+		})
+	}
+}
+
+func TestBetweenInvalidRange(t *testing.T) {
+	tests := []struct {
+		name     string
+		previous string
+		next     string
+	}{
+		{name: "equal", previous: "b", next: "b"},
+		{name: "reversed", previous: "c", next: "b"},
+		{name: "ends with lowest digit", previous: "a0", next: "b"},
+		{name: "invalid character", previous: "A", next: ""},
+		{name: "invalid next", previous: "", next: "b-"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := Between(tt.previous, tt.next); !errors.Is(err, ErrInvalidRange) {
+				t.Errorf("expected ErrInvalidRange, got %v", err)
+			}
+		})
+	}
+}
+
+func TestBetweenRepeatedInsertions(t *testing.T) {
+	tests := []struct {
+		name  string
+		front bool
+	}{
+		{name: "always at the front", front: true},
+		{name: "always after the first", front: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			first, err := Between("", "")
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			previous, next := "", first
+			if !tt.front {
+				second, err := Between(first, "")
+				if err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+
+				previous, next = first, second
+			}
+
+			for i := 0; i < 200; i++ {
+				rank, err := Between(previous, next)
+				if err != nil {
+					t.Fatalf("insertion %d: unexpected error: %v", i, err)
+				}
+
+				if rank <= previous || rank >= next {
+					t.Fatalf("insertion %d: %q does not sort between %q and %q", i, rank, previous, next)
+				}
+
+				next = rank
+			}
+		})
+	}
+}
@@ -62,5 +62,13 @@ func GenerateUniqueString(length int) string {
 }
 
 func CamelCaseToPascalCase(s string) string {
-	return cases.Title(language.English).String(s)
+	return cases.Title(language.English, cases.NoLower).String(s)
+}
+
+func PascalCaseToCamelCase(s string) string {
+	if s == "" {
+		return s
+	}
+
+	return strings.ToLower(s[:1]) + s[1:]
 }